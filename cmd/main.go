@@ -6,6 +6,11 @@ import (
 	"haircompany-shop-rest/config"
 	"haircompany-shop-rest/internal/container"
 	"haircompany-shop-rest/internal/middleware"
+	"haircompany-shop-rest/internal/modules/v1/auth"
+	imageDto "haircompany-shop-rest/internal/modules/v1/image/dto"
+	"haircompany-shop-rest/internal/modules/v1/job"
+	"haircompany-shop-rest/internal/modules/v1/trash"
+	"haircompany-shop-rest/internal/modules/v1/upload"
 	"haircompany-shop-rest/internal/router"
 	"haircompany-shop-rest/internal/services"
 	"log"
@@ -38,14 +43,15 @@ func main() {
 
 	loadEnv()
 	cfg := config.LoadConfig()
+	imageDto.SetBaseURL(cfg.UploadsBaseURL)
 	diContainer := container.NewContainer(cfg, ctx, &wg)
+	diContainer.Scheduler = services.NewScheduler(ctx, &wg, diContainer.RedisService, job.NewRepository(diContainer.DB))
+	runSchedule(diContainer.Scheduler, cfg, diContainer)
+	runQueue(diContainer.Queue, cfg, diContainer)
 
 	srv := newHTTPServer(cfg, diContainer)
 	runServer(srv)
 
-	scheduler := services.NewScheduler(ctx, &wg)
-	runSchedule(scheduler)
-
 	<-ctx.Done()
 	gracefulShutdown(srv, &wg)
 }
@@ -92,12 +98,35 @@ func gracefulShutdown(srv *http.Server, wg *sync.WaitGroup) {
 	log.Println("Server gracefully stopped")
 }
 
-func runSchedule(scheduler services.Scheduler) {
-	filesystem := services.NewFileSystemService()
+func runSchedule(scheduler services.Scheduler, cfg *config.Config, container *container.Container) {
+	tempTTL := time.Duration(cfg.TempUploadTTL) * time.Hour
+	cleanTempTask := scheduler.CreateTask("CleanTempFiles", upload.NewCleanTempTask(container, tempTTL))
+	orphanFilesTask := scheduler.CreateTask("OrphanFiles", upload.NewOrphanTask(container, tempTTL, cfg.OrphanGCDelete))
+
+	retention := time.Duration(cfg.TrashRetention) * 24 * time.Hour
+	purgeTrashTask := scheduler.CreateTask("PurgeTrash", trash.NewPurgeTask(container, retention))
 
-	cleanTempTask := scheduler.CreateTask("CleanTempFiles", func() {
-		filesystem.CleanTemp()
-	})
+	scheduler.StartEvery(time.Hour, cleanTempTask)
+	scheduler.StartEveryDay(4, 0, purgeTrashTask)
+	scheduler.StartEveryDay(5, 0, orphanFilesTask)
+}
+
+func runQueue(queue services.Queue, cfg *config.Config, container *container.Container) {
+	uploads := upload.NewService(upload.NewRepository(container.DB), container.FileService, container.Storage)
+	queue.Register(services.QueueMoveFiles, services.HandleJob(func(ctx context.Context, payload services.FilesPayload) error {
+		return uploads.MoveFiles(payload)
+	}))
+	queue.Register(services.QueueDeleteFiles, services.HandleJob(func(ctx context.Context, payload services.FilesPayload) error {
+		return uploads.DeleteFiles(payload)
+	}))
+	queue.Register(services.QueueSendSMS, services.HandleJob(func(ctx context.Context, payload services.SMSPayload) error {
+		if payload.ExpiresAt != 0 && time.Now().Unix() >= payload.ExpiresAt {
+			log.Printf("[Queue] SMS expired before it could be sent")
+			return nil
+		}
+		return container.SMSSender.Send(payload.Phone, payload.Message)
+	}))
+	queue.Register(services.QueuePasswordReset, auth.NewPasswordResetJob(container))
 
-	scheduler.StartEveryDay(6, 0, cleanTempTask)
+	queue.Start(cfg.QueueWorkers)
 }
@@ -7,21 +7,50 @@ import (
 )
 
 type Config struct {
-	AppEnv          string
-	AppPort         string
-	DbHost          string
-	DbPort          string
-	DbName          string
-	DbUser          string
-	DbPassword      string
-	DbSsl           string
-	CORS            string
-	AuthAppKey      string
-	DashboardSecret string
-	ClientSecret    string
-	RedisAddr       string
-	RedisPassword   string
-	RedisDB         int
+	AppEnv           string
+	AppPort          string
+	DbHost           string
+	DbPort           string
+	DbName           string
+	DbUser           string
+	DbPassword       string
+	DbSsl            string
+	CORS             string
+	AuthAppKey       string
+	DashboardSecret  string
+	ClientSecret     string
+	RedisAddr        string
+	RedisPassword    string
+	RedisDB          int
+	SMSDriver        string
+	SMSLogFile       string
+	SMSGatewayURL    string
+	SMSGatewayToken  string
+	MailDriver       string
+	MailLogFile      string
+	MailFrom         string
+	SMTPHost         string
+	SMTPPort         int
+	SMTPUsername     string
+	SMTPPassword     string
+	PasswordResetURL string
+	TOTPIssuer       string
+	PaymentProvider  string
+	PaymentSecret    string
+	PaymentReturn    string
+	TrashRetention   int
+	QueueWorkers     int
+	StorageDriver    string
+	StorageRoot      string
+	S3Endpoint       string
+	S3Region         string
+	S3Bucket         string
+	S3AccessKey      string
+	S3SecretKey      string
+	S3PathStyle      bool
+	UploadsBaseURL   string
+	TempUploadTTL    int
+	OrphanGCDelete   bool
 }
 
 func LoadConfig() *Config {
@@ -101,21 +130,206 @@ func LoadConfig() *Config {
 		log.Fatal("Invalid REDIS_DB value: ", err)
 	}
 
+	smsDriver := os.Getenv("SMS_DRIVER")
+	if smsDriver == "" {
+		smsDriver = "log"
+	}
+	if smsDriver != "log" && smsDriver != "http" {
+		log.Fatal("Invalid SMS_DRIVER value: ", smsDriver)
+	}
+	// Драйвер log пишет коды входа открытым текстом
+	if appEnv == "production" && smsDriver == "log" {
+		log.Fatal("SMS_DRIVER=log is not allowed in production")
+	}
+
+	smsLogFile := os.Getenv("SMS_LOG_FILE")
+
+	smsGatewayURL := os.Getenv("SMS_GATEWAY_URL")
+	if smsDriver == "http" && smsGatewayURL == "" {
+		log.Fatal("SMS_GATEWAY_URL environment isn't set")
+	}
+
+	smsGatewayToken := os.Getenv("SMS_GATEWAY_TOKEN")
+
+	mailDriver := os.Getenv("MAIL_DRIVER")
+	if mailDriver == "" {
+		mailDriver = "log"
+	}
+	if mailDriver != "log" && mailDriver != "smtp" {
+		log.Fatal("Invalid MAIL_DRIVER value: ", mailDriver)
+	}
+	// Драйвер log никому не доставляет письма, а ссылки сброса пароля пишет в лог
+	if appEnv == "production" && mailDriver == "log" {
+		log.Fatal("MAIL_DRIVER=log is not allowed in production")
+	}
+
+	mailLogFile := os.Getenv("MAIL_LOG_FILE")
+
+	mailFrom := os.Getenv("MAIL_FROM")
+	if mailFrom == "" {
+		mailFrom = "noreply@localhost"
+	}
+
+	smtpHost := os.Getenv("SMTP_HOST")
+	if mailDriver == "smtp" && smtpHost == "" {
+		log.Fatal("SMTP_HOST environment isn't set")
+	}
+
+	smtpPort := os.Getenv("SMTP_PORT")
+	if smtpPort == "" {
+		smtpPort = "587"
+	}
+	smtpPortInt, err := strconv.Atoi(smtpPort)
+	if err != nil || smtpPortInt < 1 || smtpPortInt > 65535 {
+		log.Fatal("Invalid SMTP_PORT value: ", smtpPort)
+	}
+
+	smtpUsername := os.Getenv("SMTP_USERNAME")
+	smtpPassword := os.Getenv("SMTP_PASSWORD")
+
+	passwordResetURL := os.Getenv("PASSWORD_RESET_URL")
+
+	totpIssuer := os.Getenv("TOTP_ISSUER")
+	if totpIssuer == "" {
+		totpIssuer = "Hair Company"
+	}
+
+	paymentProvider := os.Getenv("PAYMENT_PROVIDER")
+	if paymentProvider == "" {
+		paymentProvider = "fake"
+	}
+
+	paymentSecret := os.Getenv("PAYMENT_WEBHOOK_SECRET")
+	if paymentSecret == "" {
+		log.Fatal("PAYMENT_WEBHOOK_SECRET environment isn't set")
+	}
+
+	paymentReturn := os.Getenv("PAYMENT_RETURN_URL")
+
+	trashRetention := os.Getenv("TRASH_RETENTION_DAYS")
+	if trashRetention == "" {
+		trashRetention = "30"
+	}
+	trashRetentionInt, err := strconv.Atoi(trashRetention)
+	if err != nil || trashRetentionInt < 1 {
+		log.Fatal("Invalid TRASH_RETENTION_DAYS value: ", trashRetention)
+	}
+
+	queueWorkers := os.Getenv("QUEUE_WORKERS")
+	if queueWorkers == "" {
+		queueWorkers = "4"
+	}
+	queueWorkersInt, err := strconv.Atoi(queueWorkers)
+	if err != nil || queueWorkersInt < 1 {
+		log.Fatal("Invalid QUEUE_WORKERS value: ", queueWorkers)
+	}
+
+	storageDriver := os.Getenv("STORAGE_DRIVER")
+	if storageDriver == "" {
+		storageDriver = "local"
+	}
+	if storageDriver != "local" && storageDriver != "s3" {
+		log.Fatal("Invalid STORAGE_DRIVER value: ", storageDriver)
+	}
+
+	storageRoot := os.Getenv("STORAGE_LOCAL_ROOT")
+	if storageRoot == "" {
+		storageRoot = "uploads"
+	}
+
+	s3Endpoint := os.Getenv("S3_ENDPOINT")
+	s3Region := os.Getenv("S3_REGION")
+	s3Bucket := os.Getenv("S3_BUCKET")
+	s3AccessKey := os.Getenv("S3_ACCESS_KEY")
+	s3SecretKey := os.Getenv("S3_SECRET_KEY")
+	if storageDriver == "s3" {
+		if s3Endpoint == "" {
+			log.Fatal("S3_ENDPOINT environment isn't set")
+		}
+		if s3Bucket == "" {
+			log.Fatal("S3_BUCKET environment isn't set")
+		}
+		if s3AccessKey == "" || s3SecretKey == "" {
+			log.Fatal("S3_ACCESS_KEY and S3_SECRET_KEY environment aren't set")
+		}
+	}
+
+	s3PathStyle := os.Getenv("S3_PATH_STYLE")
+	if s3PathStyle == "" {
+		s3PathStyle = "false"
+	}
+	s3PathStyleBool, err := strconv.ParseBool(s3PathStyle)
+	if err != nil {
+		log.Fatal("Invalid S3_PATH_STYLE value: ", s3PathStyle)
+	}
+
+	uploadsBaseURL := os.Getenv("UPLOADS_BASE_URL")
+	if uploadsBaseURL == "" {
+		uploadsBaseURL = "/uploads"
+	}
+
+	tempUploadTTL := os.Getenv("TEMP_UPLOAD_TTL_HOURS")
+	if tempUploadTTL == "" {
+		tempUploadTTL = "24"
+	}
+	tempUploadTTLInt, err := strconv.Atoi(tempUploadTTL)
+	if err != nil || tempUploadTTLInt < 1 {
+		log.Fatal("Invalid TEMP_UPLOAD_TTL_HOURS value: ", tempUploadTTL)
+	}
+
+	orphanGCDelete := os.Getenv("ORPHAN_GC_DELETE")
+	if orphanGCDelete == "" {
+		orphanGCDelete = "false"
+	}
+	orphanGCDeleteBool, err := strconv.ParseBool(orphanGCDelete)
+	if err != nil {
+		log.Fatal("Invalid ORPHAN_GC_DELETE value: ", orphanGCDelete)
+	}
+
 	return &Config{
-		AppEnv:          appEnv,
-		AppPort:         appPort,
-		DbHost:          dbHost,
-		DbPort:          dbPort,
-		DbName:          dbName,
-		DbUser:          dbUser,
-		DbPassword:      dbPassword,
-		DbSsl:           dbSsl,
-		CORS:            corsAllowedOrigins,
-		AuthAppKey:      authAppKey,
-		DashboardSecret: dashboardSecret,
-		ClientSecret:    clientSecret,
-		RedisAddr:       redisAddr,
-		RedisPassword:   redisPassword,
-		RedisDB:         redisDBInt,
+		AppEnv:           appEnv,
+		AppPort:          appPort,
+		DbHost:           dbHost,
+		DbPort:           dbPort,
+		DbName:           dbName,
+		DbUser:           dbUser,
+		DbPassword:       dbPassword,
+		DbSsl:            dbSsl,
+		CORS:             corsAllowedOrigins,
+		AuthAppKey:       authAppKey,
+		DashboardSecret:  dashboardSecret,
+		ClientSecret:     clientSecret,
+		RedisAddr:        redisAddr,
+		RedisPassword:    redisPassword,
+		RedisDB:          redisDBInt,
+		SMSDriver:        smsDriver,
+		SMSLogFile:       smsLogFile,
+		SMSGatewayURL:    smsGatewayURL,
+		SMSGatewayToken:  smsGatewayToken,
+		MailDriver:       mailDriver,
+		MailLogFile:      mailLogFile,
+		MailFrom:         mailFrom,
+		SMTPHost:         smtpHost,
+		SMTPPort:         smtpPortInt,
+		SMTPUsername:     smtpUsername,
+		SMTPPassword:     smtpPassword,
+		PasswordResetURL: passwordResetURL,
+		TOTPIssuer:       totpIssuer,
+		PaymentProvider:  paymentProvider,
+		PaymentSecret:    paymentSecret,
+		PaymentReturn:    paymentReturn,
+		TrashRetention:   trashRetentionInt,
+		QueueWorkers:     queueWorkersInt,
+		StorageDriver:    storageDriver,
+		StorageRoot:      storageRoot,
+		S3Endpoint:       s3Endpoint,
+		S3Region:         s3Region,
+		S3Bucket:         s3Bucket,
+		S3AccessKey:      s3AccessKey,
+		S3SecretKey:      s3SecretKey,
+		S3PathStyle:      s3PathStyleBool,
+		UploadsBaseURL:   uploadsBaseURL,
+		TempUploadTTL:    tempUploadTTLInt,
+		OrphanGCDelete:   orphanGCDeleteBool,
 	}
 }
@@ -18,46 +18,90 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/api/v1/auth/dashboard/login": {
-            "post": {
+        "/api/v1/account/order": {
+            "get": {
                 "security": [
+                    {
+                        "BearerAuth": []
+                    },
                     {
                         "AppAuth": []
                     }
                 ],
-                "description": "Authenticate dashboard user with email and password",
-                "consumes": [
+                "description": "Retrieve orders of the authenticated client, newest first",
+                "produces": [
                     "application/json"
                 ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "Get my orders",
+                "responses": {
+                    "200": {
+                        "description": "List of orders",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.OrderList200"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/account/order/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Retrieve an order of the authenticated client with its lines and status history",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Order"
                 ],
-                "summary": "Dashboard user login",
+                "summary": "Get my order by ID",
                 "parameters": [
                     {
-                        "description": "Login credentials",
-                        "name": "credentials",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.DashboardLoginDTO"
-                        }
+                        "type": "integer",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Login successful",
+                        "description": "Order found",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.DashboardLogin200"
+                            "$ref": "#/definitions/docsResponse.OrderGet200"
                         }
                     },
                     "400": {
-                        "description": "Bad Request or Validation Error",
+                        "description": "Invalid ID",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.DashboardLogin400"
+                            "$ref": "#/definitions/docsResponse.Response400"
                         }
                     },
                     "401": {
@@ -72,8 +116,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/docsResponse.Response403"
                         }
                     },
+                    "404": {
+                        "description": "Order not found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
                     "500": {
-                        "description": "Server Error",
+                        "description": "Server error",
                         "schema": {
                             "$ref": "#/definitions/docsResponse.Response500"
                         }
@@ -81,14 +131,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/auth/dashboard/refresh-token": {
+        "/api/v1/auth/client/refresh": {
             "post": {
                 "security": [
                     {
                         "AppAuth": []
                     }
                 ],
-                "description": "Refresh authentication token for dashboard user",
+                "description": "Refresh authentication token for client user",
                 "consumes": [
                     "application/json"
                 ],
@@ -98,7 +148,7 @@ const docTemplate = `{
                 "tags": [
                     "Auth"
                 ],
-                "summary": "Dashboard refresh token",
+                "summary": "Client refresh token",
                 "parameters": [
                     {
                         "description": "Refresh token",
@@ -114,13 +164,13 @@ const docTemplate = `{
                     "200": {
                         "description": "Token refreshed successfully",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.DashboardRefreshToken200"
+                            "$ref": "#/definitions/docsResponse.ClientRefreshToken200"
                         }
                     },
                     "400": {
                         "description": "Bad Request or Validation Error",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.DashboardRefreshToken400"
+                            "$ref": "#/definitions/docsResponse.ClientRefreshToken400"
                         }
                     },
                     "401": {
@@ -144,26 +194,46 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/category": {
-            "get": {
+        "/api/v1/auth/client/request-code": {
+            "post": {
                 "security": [
                     {
                         "AppAuth": []
                     }
                 ],
-                "description": "Retrieve all categories",
+                "description": "Send a one-time login code to the client phone by SMS",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Category"
+                    "Auth"
+                ],
+                "summary": "Client request login code",
+                "parameters": [
+                    {
+                        "description": "Client phone",
+                        "name": "phone",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ClientRequestCodeDTO"
+                        }
+                    }
                 ],
-                "summary": "Get all categories",
                 "responses": {
                     "200": {
-                        "description": "List of categories",
+                        "description": "Code sent",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.CategoryList200"
+                            "$ref": "#/definitions/docsResponse.ClientRequestCode200"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request or Validation Error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.ClientRequestCode400"
                         }
                     },
                     "403": {
@@ -172,8 +242,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/docsResponse.Response403"
                         }
                     },
+                    "429": {
+                        "description": "Code was requested too often",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response429"
+                        }
+                    },
                     "500": {
-                        "description": "Server error",
+                        "description": "Server Error",
                         "schema": {
                             "$ref": "#/definitions/docsResponse.Response500"
                         }
@@ -181,17 +257,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/category/create": {
+        "/api/v1/auth/client/verify": {
             "post": {
                 "security": [
-                    {
-                        "BearerAuth": []
-                    },
                     {
                         "AppAuth": []
                     }
                 ],
-                "description": "Create a new category",
+                "description": "Verify the one-time code and authenticate the client. Unknown phones are registered automatically.",
                 "consumes": [
                     "application/json"
                 ],
@@ -199,37 +272,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Category"
+                    "Auth"
                 ],
-                "summary": "Create a new category",
+                "summary": "Client verify login code",
                 "parameters": [
                     {
-                        "description": "Category to create",
-                        "name": "category",
+                        "description": "Client phone and code",
+                        "name": "credentials",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_category_dto.CreateDTO"
+                            "$ref": "#/definitions/dto.ClientVerifyCodeDTO"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Category created successfully",
+                    "200": {
+                        "description": "Login successful",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.CategoryCreate201"
+                            "$ref": "#/definitions/docsResponse.ClientVerifyCode200"
                         }
                     },
                     "400": {
                         "description": "Bad Request or Validation Error",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.CategoryCreate400"
+                            "$ref": "#/definitions/docsResponse.ClientVerifyCode400"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized",
+                        "description": "Invalid or expired code",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.Response401"
+                            "$ref": "#/definitions/docsResponse.ClientVerifyCode401"
                         }
                     },
                     "403": {
@@ -238,6 +311,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/docsResponse.Response403"
                         }
                     },
+                    "429": {
+                        "description": "Too many attempts",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response429"
+                        }
+                    },
                     "500": {
                         "description": "Server Error",
                         "schema": {
@@ -247,57 +326,45 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/category/{id}": {
+        "/api/v1/auth/dashboard/2fa": {
             "get": {
                 "security": [
+                    {
+                        "BearerAuth": []
+                    },
                     {
                         "AppAuth": []
                     }
                 ],
-                "description": "Retrieve category by its ID",
+                "description": "Whether two-factor authentication is enabled for the current dashboard user and how many recovery codes are left",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Category"
-                ],
-                "summary": "Get category by ID",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Category ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Auth"
                 ],
+                "summary": "Dashboard two-factor status",
                 "responses": {
                     "200": {
-                        "description": "Category found",
+                        "description": "Two-factor status",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.CategoryGetById200"
+                            "$ref": "#/definitions/docsResponse.DashboardTwoFactorStatus200"
                         }
                     },
-                    "400": {
-                        "description": "Invalid ID",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.Response400"
+                            "$ref": "#/definitions/docsResponse.Response401"
                         }
                     },
                     "403": {
-                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "description": "Forbidden - Invalid X-AUTH-APP or user is deactivated",
                         "schema": {
                             "$ref": "#/definitions/docsResponse.Response403"
                         }
                     },
-                    "404": {
-                        "description": "Category not found",
-                        "schema": {
-                            "$ref": "#/definitions/docsResponse.Response404"
-                        }
-                    },
                     "500": {
-                        "description": "Server error",
+                        "description": "Server Error",
                         "schema": {
                             "$ref": "#/definitions/docsResponse.Response500"
                         }
@@ -305,8 +372,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/category/{id}/delete": {
-            "delete": {
+        "/api/v1/auth/dashboard/2fa/confirm": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
@@ -315,34 +382,39 @@ const docTemplate = `{
                         "AppAuth": []
                     }
                 ],
-                "description": "Delete category by ID",
+                "description": "Enable two-factor authentication with a code from the authenticator app. Returns recovery codes; they are shown only once.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Category"
+                    "Auth"
                 ],
-                "summary": "Delete category",
+                "summary": "Dashboard confirm two-factor setup",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Category ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Code from the authenticator app",
+                        "name": "code",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.TwoFactorCodeDTO"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Category deleted",
+                        "description": "Recovery codes",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.CategoryDelete200"
+                            "$ref": "#/definitions/docsResponse.DashboardRecoveryCodes200"
                         }
                     },
                     "400": {
-                        "description": "Invalid ID",
+                        "description": "Bad Request, Validation Error, invalid code or setup not started",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.Response400"
+                            "$ref": "#/definitions/docsResponse.DashboardTwoFactor400"
                         }
                     },
                     "401": {
@@ -352,25 +424,19 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "description": "Forbidden - Invalid X-AUTH-APP or user is deactivated",
                         "schema": {
                             "$ref": "#/definitions/docsResponse.Response403"
                         }
                     },
-                    "404": {
-                        "description": "Category not found",
-                        "schema": {
-                            "$ref": "#/definitions/docsResponse.Response404"
-                        }
-                    },
                     "409": {
-                        "description": "Category has linked entities",
+                        "description": "Two-factor authentication is already enabled",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.Response409"
+                            "$ref": "#/definitions/docsResponse.DashboardTwoFactor409"
                         }
                     },
                     "500": {
-                        "description": "Server error",
+                        "description": "Server Error",
                         "schema": {
                             "$ref": "#/definitions/docsResponse.Response500"
                         }
@@ -378,8 +444,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/category/{id}/update": {
-            "put": {
+        "/api/v1/auth/dashboard/2fa/disable": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
@@ -388,7 +454,7 @@ const docTemplate = `{
                         "AppAuth": []
                     }
                 ],
-                "description": "Update category by ID",
+                "description": "Disable two-factor authentication. Requires the password and a code from the authenticator app or a recovery code; remaining recovery codes are deleted.",
                 "consumes": [
                     "application/json"
                 ],
@@ -396,38 +462,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Category"
+                    "Auth"
                 ],
-                "summary": "Update category",
+                "summary": "Dashboard disable two-factor",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Category ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Category update payload",
-                        "name": "category",
+                        "description": "Password and code",
+                        "name": "credentials",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_category_dto.UpdateDTO"
+                            "$ref": "#/definitions/dto.TwoFactorDisableDTO"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Category updated",
+                        "description": "Two-factor authentication disabled",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.CategoryUpdate200"
+                            "$ref": "#/definitions/docsResponse.DashboardTwoFactorDisable200"
                         }
                     },
                     "400": {
-                        "description": "Bad request or validation error",
+                        "description": "Bad Request, Validation Error, invalid password or code",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.CategoryUpdate400"
+                            "$ref": "#/definitions/docsResponse.DashboardTwoFactor400"
                         }
                     },
                     "401": {
@@ -437,13 +496,25 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "description": "Forbidden - Invalid X-AUTH-APP or user is deactivated",
                         "schema": {
                             "$ref": "#/definitions/docsResponse.Response403"
                         }
                     },
+                    "409": {
+                        "description": "Two-factor authentication is not enabled",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.DashboardTwoFactor409"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many invalid codes, try again later",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response429"
+                        }
+                    },
                     "500": {
-                        "description": "Server error",
+                        "description": "Server Error",
                         "schema": {
                             "$ref": "#/definitions/docsResponse.Response500"
                         }
@@ -451,7 +522,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/dashboard-user/create": {
+        "/api/v1/auth/dashboard/2fa/recovery-codes": {
             "post": {
                 "security": [
                     {
@@ -461,7 +532,7 @@ const docTemplate = `{
                         "AppAuth": []
                     }
                 ],
-                "description": "Create a new dashboard user with the provided details.",
+                "description": "Replace all recovery codes with new ones. Requires a code from the authenticator app; the new codes are shown only once.",
                 "consumes": [
                     "application/json"
                 ],
@@ -469,31 +540,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Dashboard User"
+                    "Auth"
                 ],
-                "summary": "Create a new dashboard user",
+                "summary": "Dashboard regenerate recovery codes",
                 "parameters": [
                     {
-                        "description": "Dashboard User Create DTO",
-                        "name": "dashboardUser",
+                        "description": "Code from the authenticator app",
+                        "name": "code",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_dashboard_user_dto.CreateDTO"
+                            "$ref": "#/definitions/dto.TwoFactorCodeDTO"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Dashboard User Created",
+                    "200": {
+                        "description": "Recovery codes",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.DashboardUserCreate201"
+                            "$ref": "#/definitions/docsResponse.DashboardRecoveryCodes200"
                         }
                     },
                     "400": {
-                        "description": "Bad Request or Validation Error",
+                        "description": "Bad Request, Validation Error or invalid code",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.DashboardUserCreate400"
+                            "$ref": "#/definitions/docsResponse.DashboardTwoFactor400"
                         }
                     },
                     "401": {
@@ -503,11 +574,23 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "description": "Forbidden - Invalid X-AUTH-APP or user is deactivated",
                         "schema": {
                             "$ref": "#/definitions/docsResponse.Response403"
                         }
                     },
+                    "409": {
+                        "description": "Two-factor authentication is not enabled",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.DashboardTwoFactor409"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many invalid codes, try again later",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response429"
+                        }
+                    },
                     "500": {
                         "description": "Server Error",
                         "schema": {
@@ -517,36 +600,51 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/desired-result": {
-            "get": {
+        "/api/v1/auth/dashboard/2fa/setup": {
+            "post": {
                 "security": [
                     {
-                        "AppAuth": []
-                    }
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
                 ],
-                "description": "Retrieve all desiredResults",
+                "description": "Generate a TOTP secret and an otpauth:// URI for the authenticator app. Two-factor authentication is enabled only after confirmation with a code within 10 minutes.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "DesiredResult"
+                    "Auth"
                 ],
-                "summary": "Get all desiredResults",
+                "summary": "Dashboard start two-factor setup",
                 "responses": {
                     "200": {
-                        "description": "List of desiredResults",
+                        "description": "Secret and otpauth URI",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.DesiredResultList200"
+                            "$ref": "#/definitions/docsResponse.DashboardTwoFactorSetup200"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
                         }
                     },
                     "403": {
-                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "description": "Forbidden - Invalid X-AUTH-APP or user is deactivated",
                         "schema": {
                             "$ref": "#/definitions/docsResponse.Response403"
                         }
                     },
+                    "409": {
+                        "description": "Two-factor authentication is already enabled",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.DashboardTwoFactor409"
+                        }
+                    },
                     "500": {
-                        "description": "Server error",
+                        "description": "Server Error",
                         "schema": {
                             "$ref": "#/definitions/docsResponse.Response500"
                         }
@@ -554,17 +652,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/desired-result/create": {
+        "/api/v1/auth/dashboard/2fa/verify": {
             "post": {
                 "security": [
-                    {
-                        "BearerAuth": []
-                    },
                     {
                         "AppAuth": []
                     }
                 ],
-                "description": "Create a new desiredResult",
+                "description": "Finish a login of a user with two-factor authentication: exchange the challenge token from /auth/dashboard/login and a code from the authenticator app or a recovery code for a token pair. The challenge expires in 5 minutes. Invalid codes are counted per user: after 5 of them within 15 minutes no new challenges are issued until the window expires.",
                 "consumes": [
                     "application/json"
                 ],
@@ -572,45 +667,51 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "DesiredResult"
+                    "Auth"
                 ],
-                "summary": "Create a new desiredResult",
+                "summary": "Dashboard verify second factor",
                 "parameters": [
                     {
-                        "description": "DesiredResult to create",
-                        "name": "desiredResult",
+                        "description": "Challenge token and code",
+                        "name": "credentials",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_desired_result_dto.CreateDTO"
+                            "$ref": "#/definitions/dto.TwoFactorVerifyDTO"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "DesiredResult created successfully",
+                    "200": {
+                        "description": "Login successful",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.DesiredResultCreate201"
+                            "$ref": "#/definitions/docsResponse.DashboardLogin200"
                         }
                     },
                     "400": {
                         "description": "Bad Request or Validation Error",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.DesiredResultCreate400"
+                            "$ref": "#/definitions/docsResponse.DashboardTwoFactor400"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized",
+                        "description": "Invalid code or expired challenge",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.Response401"
+                            "$ref": "#/definitions/docsResponse.DashboardVerifyTwoFactor401"
                         }
                     },
                     "403": {
-                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "description": "Forbidden - Invalid X-AUTH-APP or user is deactivated",
                         "schema": {
                             "$ref": "#/definitions/docsResponse.Response403"
                         }
                     },
+                    "429": {
+                        "description": "Too many invalid codes, try again later",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response429"
+                        }
+                    },
                     "500": {
                         "description": "Server Error",
                         "schema": {
@@ -620,57 +721,68 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/desired-result/{id}": {
-            "get": {
+        "/api/v1/auth/dashboard/login": {
+            "post": {
                 "security": [
                     {
                         "AppAuth": []
                     }
                 ],
-                "description": "Retrieve desiredResult by its ID",
+                "description": "Authenticate dashboard user with email and password. If two-factor authentication is enabled, no tokens are returned: the response has twoFactorRequired and a challengeToken to finish the login at /auth/dashboard/2fa/verify.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "DesiredResult"
+                    "Auth"
                 ],
-                "summary": "Get desiredResult by ID",
+                "summary": "Dashboard user login",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "DesiredResult ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Login credentials",
+                        "name": "credentials",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.DashboardLoginDTO"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "DesiredResult found",
+                        "description": "Login successful",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.DesiredResultGetById200"
+                            "$ref": "#/definitions/docsResponse.DashboardLogin200"
                         }
                     },
                     "400": {
-                        "description": "Invalid ID",
+                        "description": "Bad Request or Validation Error",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.Response400"
+                            "$ref": "#/definitions/docsResponse.DashboardLogin400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
                         }
                     },
                     "403": {
-                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "description": "Forbidden - Invalid X-AUTH-APP or user is deactivated",
                         "schema": {
                             "$ref": "#/definitions/docsResponse.Response403"
                         }
                     },
-                    "404": {
-                        "description": "DesiredResult not found",
+                    "429": {
+                        "description": "Two-factor login is locked after too many invalid codes",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.Response404"
+                            "$ref": "#/definitions/docsResponse.Response429"
                         }
                     },
                     "500": {
-                        "description": "Server error",
+                        "description": "Server Error",
                         "schema": {
                             "$ref": "#/definitions/docsResponse.Response500"
                         }
@@ -678,8 +790,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/desired-result/{id}/delete": {
-            "delete": {
+        "/api/v1/auth/dashboard/logout": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
@@ -688,34 +800,19 @@ const docTemplate = `{
                         "AppAuth": []
                     }
                 ],
-                "description": "Delete desiredResult by ID",
+                "description": "End the current session. Its refresh and access tokens stop working immediately.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "DesiredResult"
-                ],
-                "summary": "Delete desiredResult",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "DesiredResult ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Auth"
                 ],
+                "summary": "Dashboard logout",
                 "responses": {
                     "200": {
-                        "description": "DesiredResult deleted",
-                        "schema": {
-                            "$ref": "#/definitions/docsResponse.DesiredResultDelete200"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid ID",
+                        "description": "Logged out",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.Response400"
+                            "$ref": "#/definitions/docsResponse.DashboardLogout200"
                         }
                     },
                     "401": {
@@ -731,19 +828,13 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "DesiredResult not found",
+                        "description": "Session not found",
                         "schema": {
                             "$ref": "#/definitions/docsResponse.Response404"
                         }
                     },
-                    "409": {
-                        "description": "DesiredResult has linked entities",
-                        "schema": {
-                            "$ref": "#/definitions/docsResponse.Response409"
-                        }
-                    },
                     "500": {
-                        "description": "Server error",
+                        "description": "Server Error",
                         "schema": {
                             "$ref": "#/definitions/docsResponse.Response500"
                         }
@@ -751,8 +842,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/desired-result/{id}/update": {
-            "put": {
+        "/api/v1/auth/dashboard/me/password": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
@@ -761,7 +852,7 @@ const docTemplate = `{
                         "AppAuth": []
                     }
                 ],
-                "description": "Update desiredResult by ID",
+                "description": "Change the password of the current dashboard user. All sessions and access tokens of the user are revoked, so every device has to log in again.",
                 "consumes": [
                     "application/json"
                 ],
@@ -769,38 +860,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "DesiredResult"
+                    "Auth"
                 ],
-                "summary": "Update desiredResult",
+                "summary": "Dashboard change password",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "DesiredResult ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "DesiredResult update payload",
-                        "name": "desiredResult",
+                        "description": "Current and new password",
+                        "name": "passwords",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_desired_result_dto.UpdateDTO"
+                            "$ref": "#/definitions/dto.DashboardChangePasswordDTO"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "DesiredResult updated",
+                        "description": "Password changed",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.DesiredResultUpdate200"
+                            "$ref": "#/definitions/docsResponse.DashboardChangePassword200"
                         }
                     },
                     "400": {
-                        "description": "Bad request or validation error",
+                        "description": "Bad Request, Validation Error or invalid current password",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.DesiredResultUpdate400"
+                            "$ref": "#/definitions/docsResponse.DashboardChangePassword400"
                         }
                     },
                     "401": {
@@ -810,13 +894,13 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "description": "Forbidden - Invalid X-AUTH-APP or user is deactivated",
                         "schema": {
                             "$ref": "#/definitions/docsResponse.Response403"
                         }
                     },
                     "500": {
-                        "description": "Server error",
+                        "description": "Server Error",
                         "schema": {
                             "$ref": "#/definitions/docsResponse.Response500"
                         }
@@ -824,77 +908,54 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/image/upload": {
+        "/api/v1/auth/dashboard/password-reset/confirm": {
             "post": {
                 "security": [
-                    {
-                        "BearerAuth": []
-                    },
                     {
                         "AppAuth": []
                     }
                 ],
-                "description": "Upload one or more images with a specific imageType. Requires JWT authentication.",
+                "description": "Set a new password with the token from the reset email. The token can be used once; all sessions and access tokens of the user are revoked.",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Image"
+                    "Auth"
                 ],
-                "summary": "Upload images",
+                "summary": "Dashboard confirm password reset",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Type of image (e.g. category)",
-                        "name": "imageType",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "file",
-                        "description": "Image files to upload (multiple allowed)",
-                        "name": "images",
-                        "in": "formData",
-                        "required": true
+                        "description": "Reset token and new password",
+                        "name": "reset",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.DashboardPasswordResetDTO"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Uploaded images info",
+                        "description": "Password changed",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/docsResponse.ImageUpload200"
-                            }
+                            "$ref": "#/definitions/docsResponse.DashboardPasswordReset200"
                         }
                     },
                     "400": {
-                        "description": "Bad Request or Validation Error",
-                        "schema": {
-                            "$ref": "#/definitions/docsResponse.ImageUpload400"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
+                        "description": "Bad Request, Validation Error or invalid token",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.Response401"
+                            "$ref": "#/definitions/docsResponse.DashboardPasswordReset400"
                         }
                     },
                     "403": {
-                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "description": "Forbidden - Invalid X-AUTH-APP or user is deactivated",
                         "schema": {
                             "$ref": "#/definitions/docsResponse.Response403"
                         }
                     },
-                    "413": {
-                        "description": "File too large",
-                        "schema": {
-                            "$ref": "#/definitions/docsResponse.Response413"
-                        }
-                    },
                     "500": {
                         "description": "Server Error",
                         "schema": {
@@ -904,26 +965,46 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/line": {
-            "get": {
+        "/api/v1/auth/dashboard/password-reset/request": {
+            "post": {
                 "security": [
                     {
                         "AppAuth": []
                     }
                 ],
-                "description": "Retrieve all lines",
+                "description": "Send a single-use password reset link to the email. The response is the same for unknown emails.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Line"
+                    "Auth"
+                ],
+                "summary": "Dashboard request password reset",
+                "parameters": [
+                    {
+                        "description": "Dashboard user email",
+                        "name": "email",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.DashboardPasswordResetRequestDTO"
+                        }
+                    }
                 ],
-                "summary": "Get all lines",
                 "responses": {
                     "200": {
-                        "description": "List of lines",
+                        "description": "Reset link sent if the user exists",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.LineList200"
+                            "$ref": "#/definitions/docsResponse.DashboardPasswordResetRequest200"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request or Validation Error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.DashboardPasswordResetRequest400"
                         }
                     },
                     "403": {
@@ -932,8 +1013,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/docsResponse.Response403"
                         }
                     },
+                    "429": {
+                        "description": "Reset was requested too often",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response429"
+                        }
+                    },
                     "500": {
-                        "description": "Server error",
+                        "description": "Server Error",
                         "schema": {
                             "$ref": "#/definitions/docsResponse.Response500"
                         }
@@ -941,17 +1028,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/line/create": {
+        "/api/v1/auth/dashboard/refresh-token": {
             "post": {
                 "security": [
-                    {
-                        "BearerAuth": []
-                    },
                     {
                         "AppAuth": []
                     }
                 ],
-                "description": "Create a new line",
+                "description": "Refresh authentication token for dashboard user",
                 "consumes": [
                     "application/json"
                 ],
@@ -959,35 +1043,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Line"
+                    "Auth"
                 ],
-                "summary": "Create a new line",
+                "summary": "Dashboard refresh token",
                 "parameters": [
                     {
-                        "description": "Line to create",
-                        "name": "line",
+                        "description": "Refresh token",
+                        "name": "refreshToken",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_line_dto.CreateDTO"
+                            "$ref": "#/definitions/dto.RefreshTokenDTO"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Line created successfully",
+                    "200": {
+                        "description": "Token refreshed successfully",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.LineCreate201"
+                            "$ref": "#/definitions/docsResponse.DashboardRefreshToken200"
                         }
                     },
                     "400": {
                         "description": "Bad Request or Validation Error",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.LineCreate400"
+                            "$ref": "#/definitions/docsResponse.DashboardRefreshToken400"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized",
+                        "description": "Unauthorized or Invalid Token",
                         "schema": {
                             "$ref": "#/definitions/docsResponse.Response401"
                         }
@@ -1007,41 +1091,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/line/{id}": {
+        "/api/v1/auth/dashboard/sessions": {
             "get": {
                 "security": [
+                    {
+                        "BearerAuth": []
+                    },
                     {
                         "AppAuth": []
                     }
                 ],
-                "description": "Retrieve line by its ID",
+                "description": "List active sessions (signed-in devices) of the current dashboard user, most recently used first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Line"
-                ],
-                "summary": "Get line by ID",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Line ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Auth"
                 ],
+                "summary": "Dashboard sessions",
                 "responses": {
                     "200": {
-                        "description": "Line found",
+                        "description": "Sessions",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.LineGetById200"
+                            "$ref": "#/definitions/docsResponse.DashboardSessions200"
                         }
                     },
-                    "400": {
-                        "description": "Invalid ID",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.Response400"
+                            "$ref": "#/definitions/docsResponse.Response401"
                         }
                     },
                     "403": {
@@ -1050,14 +1128,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/docsResponse.Response403"
                         }
                     },
-                    "404": {
-                        "description": "Line not found",
-                        "schema": {
-                            "$ref": "#/definitions/docsResponse.Response404"
-                        }
-                    },
                     "500": {
-                        "description": "Server error",
+                        "description": "Server Error",
                         "schema": {
                             "$ref": "#/definitions/docsResponse.Response500"
                         }
@@ -1065,7 +1137,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/line/{id}/delete": {
+        "/api/v1/auth/dashboard/sessions/delete": {
             "delete": {
                 "security": [
                     {
@@ -1075,34 +1147,19 @@ const docTemplate = `{
                         "AppAuth": []
                     }
                 ],
-                "description": "Delete line by ID",
+                "description": "End every session of the current dashboard user, including the current one",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Line"
-                ],
-                "summary": "Delete line",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Line ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Auth"
                 ],
+                "summary": "Dashboard revoke all sessions",
                 "responses": {
                     "200": {
-                        "description": "Line deleted",
-                        "schema": {
-                            "$ref": "#/definitions/docsResponse.LineDelete200"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid ID",
+                        "description": "Sessions revoked",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.Response400"
+                            "$ref": "#/definitions/docsResponse.DashboardLogout200"
                         }
                     },
                     "401": {
@@ -1117,20 +1174,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/docsResponse.Response403"
                         }
                     },
-                    "404": {
-                        "description": "Line not found",
-                        "schema": {
-                            "$ref": "#/definitions/docsResponse.Response404"
-                        }
-                    },
-                    "409": {
-                        "description": "Line has linked entities",
-                        "schema": {
-                            "$ref": "#/definitions/docsResponse.Response409"
-                        }
-                    },
                     "500": {
-                        "description": "Server error",
+                        "description": "Server Error",
                         "schema": {
                             "$ref": "#/definitions/docsResponse.Response500"
                         }
@@ -1138,8 +1183,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/line/{id}/update": {
-            "put": {
+        "/api/v1/auth/dashboard/sessions/{id}/delete": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
@@ -1148,46 +1193,28 @@ const docTemplate = `{
                         "AppAuth": []
                     }
                 ],
-                "description": "Update line by ID",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "End one of the sessions of the current dashboard user. Its refresh and access tokens stop working immediately.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Line"
+                    "Auth"
                 ],
-                "summary": "Update line",
+                "summary": "Dashboard revoke session",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Line ID",
+                        "type": "string",
+                        "description": "Session ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Line update payload",
-                        "name": "line",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_line_dto.UpdateDTO"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Line updated",
+                        "description": "Session revoked",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.LineUpdate200"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad request or validation error",
-                        "schema": {
-                            "$ref": "#/definitions/docsResponse.LineUpdate400"
+                            "$ref": "#/definitions/docsResponse.DashboardLogout200"
                         }
                     },
                     "401": {
@@ -1202,8 +1229,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/docsResponse.Response403"
                         }
                     },
+                    "404": {
+                        "description": "Session not found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
                     "500": {
-                        "description": "Server error",
+                        "description": "Server Error",
                         "schema": {
                             "$ref": "#/definitions/docsResponse.Response500"
                         }
@@ -1211,26 +1244,43 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/product-type": {
+        "/api/v1/cart": {
             "get": {
                 "security": [
+                    {
+                        "BearerAuth": []
+                    },
                     {
                         "AppAuth": []
                     }
                 ],
-                "description": "Retrieve all productTypes",
+                "description": "Retrieve the cart of the authenticated client or of the guest identified by X-Cart-Token. Totals are recomputed from current prices; lines whose price or stock changed are flagged.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ProductType"
+                    "Cart"
+                ],
+                "summary": "Get cart",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Guest cart token",
+                        "name": "X-Cart-Token",
+                        "in": "header"
+                    }
                 ],
-                "summary": "Get all productTypes",
                 "responses": {
                     "200": {
-                        "description": "List of productTypes",
+                        "description": "Cart",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.ProductTypeList200"
+                            "$ref": "#/definitions/docsResponse.CartGet200"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
                         }
                     },
                     "403": {
@@ -1248,7 +1298,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/product-type/create": {
+        "/api/v1/cart/items/create": {
             "post": {
                 "security": [
                     {
@@ -1258,7 +1308,7 @@ const docTemplate = `{
                         "AppAuth": []
                     }
                 ],
-                "description": "Create a new productType",
+                "description": "Add a product variant to the cart. A guest cart is created on first use; its token is returned in the response and must be sent back in X-Cart-Token.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1266,31 +1316,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ProductType"
+                    "Cart"
                 ],
-                "summary": "Create a new productType",
+                "summary": "Add item to cart",
                 "parameters": [
                     {
-                        "description": "ProductType to create",
-                        "name": "productType",
+                        "type": "string",
+                        "description": "Guest cart token",
+                        "name": "X-Cart-Token",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Cart item",
+                        "name": "item",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_product_type_dto.CreateDTO"
+                            "$ref": "#/definitions/dto.AddItemDTO"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "ProductType created successfully",
+                    "200": {
+                        "description": "Cart updated",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.ProductTypeCreate201"
+                            "$ref": "#/definitions/docsResponse.CartGet200"
                         }
                     },
                     "400": {
-                        "description": "Bad Request or Validation Error",
+                        "description": "Bad request or validation error",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.ProductTypeCreate400"
+                            "$ref": "#/definitions/docsResponse.CartItem400"
                         }
                     },
                     "401": {
@@ -1305,8 +1361,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/docsResponse.Response403"
                         }
                     },
+                    "409": {
+                        "description": "Not enough stock",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.StockAdjust409"
+                        }
+                    },
                     "500": {
-                        "description": "Server Error",
+                        "description": "Server error",
                         "schema": {
                             "$ref": "#/definitions/docsResponse.Response500"
                         }
@@ -1314,35 +1376,44 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/product-type/{id}": {
-            "get": {
+        "/api/v1/cart/items/{itemId}/delete": {
+            "delete": {
                 "security": [
+                    {
+                        "BearerAuth": []
+                    },
                     {
                         "AppAuth": []
                     }
                 ],
-                "description": "Retrieve productType by its ID",
+                "description": "Remove a line from the cart",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ProductType"
+                    "Cart"
                 ],
-                "summary": "Get productType by ID",
+                "summary": "Remove cart item",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Guest cart token",
+                        "name": "X-Cart-Token",
+                        "in": "header"
+                    },
                     {
                         "type": "integer",
-                        "description": "ProductType ID",
-                        "name": "id",
+                        "description": "Cart item ID",
+                        "name": "itemId",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "ProductType found",
+                        "description": "Cart updated",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.ProductTypeGetById200"
+                            "$ref": "#/definitions/docsResponse.CartGet200"
                         }
                     },
                     "400": {
@@ -1351,6 +1422,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/docsResponse.Response400"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
                     "403": {
                         "description": "Forbidden - Invalid X-AUTH-APP",
                         "schema": {
@@ -1358,7 +1435,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "ProductType not found",
+                        "description": "Cart item not found",
                         "schema": {
                             "$ref": "#/definitions/docsResponse.Response404"
                         }
@@ -1372,8 +1449,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/product-type/{id}/delete": {
-            "delete": {
+        "/api/v1/cart/items/{itemId}/update": {
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
@@ -1382,34 +1459,52 @@ const docTemplate = `{
                         "AppAuth": []
                     }
                 ],
-                "description": "Delete productType by ID",
+                "description": "Change quantity of a cart line",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ProductType"
+                    "Cart"
                 ],
-                "summary": "Delete productType",
+                "summary": "Update cart item",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Guest cart token",
+                        "name": "X-Cart-Token",
+                        "in": "header"
+                    },
                     {
                         "type": "integer",
-                        "description": "ProductType ID",
-                        "name": "id",
+                        "description": "Cart item ID",
+                        "name": "itemId",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Cart item quantity",
+                        "name": "item",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateItemDTO"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "ProductType deleted",
+                        "description": "Cart updated",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.ProductTypeDelete200"
+                            "$ref": "#/definitions/docsResponse.CartGet200"
                         }
                     },
                     "400": {
-                        "description": "Invalid ID",
+                        "description": "Bad request or validation error",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.Response400"
+                            "$ref": "#/definitions/docsResponse.CartItem400"
                         }
                     },
                     "401": {
@@ -1425,15 +1520,15 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "ProductType not found",
+                        "description": "Cart item not found",
                         "schema": {
                             "$ref": "#/definitions/docsResponse.Response404"
                         }
                     },
                     "409": {
-                        "description": "ProductType has linked entities",
+                        "description": "Not enough stock",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.Response409"
+                            "$ref": "#/definitions/docsResponse.StockAdjust409"
                         }
                     },
                     "500": {
@@ -1445,8 +1540,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/product-type/{id}/update": {
-            "put": {
+        "/api/v1/cart/merge": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
@@ -1455,7 +1550,7 @@ const docTemplate = `{
                         "AppAuth": []
                     }
                 ],
-                "description": "Update productType by ID",
+                "description": "Move the guest cart identified by cartToken into the cart of the authenticated client",
                 "consumes": [
                     "application/json"
                 ],
@@ -1463,38 +1558,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ProductType"
+                    "Cart"
                 ],
-                "summary": "Update productType",
+                "summary": "Merge guest cart",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "ProductType ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "ProductType update payload",
-                        "name": "productType",
+                        "description": "Guest cart token",
+                        "name": "cart",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_product_type_dto.UpdateDTO"
+                            "$ref": "#/definitions/dto.MergeDTO"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "ProductType updated",
+                        "description": "Merged cart",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.ProductTypeUpdate200"
+                            "$ref": "#/definitions/docsResponse.CartGet200"
                         }
                     },
                     "400": {
                         "description": "Bad request or validation error",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.ProductTypeUpdate400"
+                            "$ref": "#/definitions/docsResponse.Response400"
                         }
                     },
                     "401": {
@@ -1518,26 +1606,61 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/shade": {
-            "get": {
+        "/api/v1/cart/promo-code/apply": {
+            "post": {
                 "security": [
+                    {
+                        "BearerAuth": []
+                    },
                     {
                         "AppAuth": []
                     }
                 ],
-                "description": "Retrieve all shades",
+                "description": "Apply a promo code to the cart. The response shows the discount of every line and why a line is not discounted. A rejected code is reported with a PROMO_CODE_* error code, e.g. PROMO_CODE_EXPIRED or PROMO_CODE_MIN_AMOUNT_NOT_REACHED.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Shade"
+                    "Cart"
+                ],
+                "summary": "Apply promo code",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Guest cart token",
+                        "name": "X-Cart-Token",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Promo code",
+                        "name": "promoCode",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.PromoCodeDTO"
+                        }
+                    }
                 ],
-                "summary": "Get all shades",
                 "responses": {
                     "200": {
-                        "description": "List of shades",
+                        "description": "Cart with the discount applied",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.ShadeList200"
+                            "$ref": "#/definitions/docsResponse.CartGet200"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error or promo code rejected",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.CartPromoCode400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
                         }
                     },
                     "403": {
@@ -1555,8 +1678,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/shade/create": {
-            "post": {
+        "/api/v1/cart/promo-code/delete": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
@@ -1565,39 +1688,27 @@ const docTemplate = `{
                         "AppAuth": []
                     }
                 ],
-                "description": "Create a new shade",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
+                "description": "Remove the applied promo code from the cart",
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Shade"
+                    "Cart"
                 ],
-                "summary": "Create a new shade",
+                "summary": "Remove promo code",
                 "parameters": [
                     {
-                        "description": "Shade to create",
-                        "name": "shade",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_shade_dto.CreateDTO"
-                        }
+                        "type": "string",
+                        "description": "Guest cart token",
+                        "name": "X-Cart-Token",
+                        "in": "header"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Shade created successfully",
-                        "schema": {
-                            "$ref": "#/definitions/docsResponse.ShadeCreate201"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request or Validation Error",
+                    "200": {
+                        "description": "Cart without discount",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.ShadeCreate400"
+                            "$ref": "#/definitions/docsResponse.CartGet200"
                         }
                     },
                     "401": {
@@ -1613,7 +1724,7 @@ const docTemplate = `{
                         }
                     },
                     "500": {
-                        "description": "Server Error",
+                        "description": "Server error",
                         "schema": {
                             "$ref": "#/definitions/docsResponse.Response500"
                         }
@@ -1621,39 +1732,150 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/shade/{id}": {
+        "/api/v1/category": {
             "get": {
                 "security": [
                     {
                         "AppAuth": []
                     }
                 ],
-                "description": "Retrieve shade by its ID",
+                "description": "Retrieve all categories",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Shade"
+                    "Category"
                 ],
-                "summary": "Get shade by ID",
+                "summary": "Get all categories",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Shade ID",
-                        "name": "id",
+                        "description": "Page number, starting from 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page, up to 100 (default 20)",
+                        "name": "perPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated sort fields, prefix with - for descending: id, name, slug, sortIndex, createdAt",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name substring",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by exact slug",
+                        "name": "slug",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by parent ID, ",
+                        "name": "parentId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filter by activity",
+                        "name": "isActive",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filter shade categories",
+                        "name": "isShade",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filter by menu visibility",
+                        "name": "isVisibleInMenu",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filter by main page visibility",
+                        "name": "isVisibleOnMain",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of categories",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.CategoryList200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid page, perPage, sort or filter value",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/category/by-slug/{slug}": {
+            "get": {
+                "security": [
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Retrieve category by its current slug. An old slug answers 301 with the current slug in data and a Location header pointing at it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Category"
+                ],
+                "summary": "Get category by slug",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Category slug",
+                        "name": "slug",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Shade found",
+                        "description": "Category found",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.ShadeGetById200"
+                            "$ref": "#/definitions/docsResponse.CategoryGetById200"
+                        }
+                    },
+                    "301": {
+                        "description": "Slug has changed",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.CategorySlugRedirect301"
                         }
                     },
                     "400": {
-                        "description": "Invalid ID",
+                        "description": "Missing slug",
                         "schema": {
                             "$ref": "#/definitions/docsResponse.Response400"
                         }
@@ -1665,7 +1887,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Shade not found",
+                        "description": "Category not found",
                         "schema": {
                             "$ref": "#/definitions/docsResponse.Response404"
                         }
@@ -1679,8 +1901,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/shade/{id}/delete": {
-            "delete": {
+        "/api/v1/category/create": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
@@ -1689,34 +1911,39 @@ const docTemplate = `{
                         "AppAuth": []
                     }
                 ],
-                "description": "Delete shade by ID",
+                "description": "Create a new category",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Shade"
+                    "Category"
                 ],
-                "summary": "Delete shade",
+                "summary": "Create a new category",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Shade ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Category to create",
+                        "name": "category",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_category_dto.CreateDTO"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Shade deleted",
+                    "201": {
+                        "description": "Category created successfully",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.ShadeDelete200"
+                            "$ref": "#/definitions/docsResponse.CategoryCreate201"
                         }
                     },
                     "400": {
-                        "description": "Invalid ID",
+                        "description": "Bad Request or Validation Error",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.Response400"
+                            "$ref": "#/definitions/docsResponse.CategoryCreate400"
                         }
                     },
                     "401": {
@@ -1731,16 +1958,41 @@ const docTemplate = `{
                             "$ref": "#/definitions/docsResponse.Response403"
                         }
                     },
-                    "404": {
-                        "description": "Shade not found",
+                    "500": {
+                        "description": "Server Error",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.Response404"
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/category/main": {
+            "get": {
+                "security": [
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Retrieve active categories visible on the main page whose parents are all active, ordered by sortIndex",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Category"
+                ],
+                "summary": "Get main page categories",
+                "responses": {
+                    "200": {
+                        "description": "Main page categories",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.CategoryMain200"
                         }
                     },
-                    "409": {
-                        "description": "Shade has linked entities",
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.Response409"
+                            "$ref": "#/definitions/docsResponse.Response403"
                         }
                     },
                     "500": {
@@ -1752,8 +2004,45 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/shade/{id}/update": {
-            "put": {
+        "/api/v1/category/menu": {
+            "get": {
+                "security": [
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Retrieve active categories visible in the menu as a nested tree. A hidden or inactive category hides its whole branch",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Category"
+                ],
+                "summary": "Get menu categories",
+                "responses": {
+                    "200": {
+                        "description": "Menu category tree",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.CategoryTree200"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/category/trash": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
@@ -1762,46 +2051,45 @@ const docTemplate = `{
                         "AppAuth": []
                     }
                 ],
-                "description": "Update shade by ID",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieve categories in the trash. They are purged permanently after the retention period",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Shade"
+                    "Category"
                 ],
-                "summary": "Update shade",
+                "summary": "Get deleted categories",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Shade ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Page number, starting from 1",
+                        "name": "page",
+                        "in": "query"
                     },
                     {
-                        "description": "Shade update payload",
-                        "name": "shade",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_shade_dto.UpdateDTO"
-                        }
+                        "type": "integer",
+                        "description": "Items per page, up to 100 (default 20)",
+                        "name": "perPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated sort fields, prefix with - for descending, e.g. -deletedAt",
+                        "name": "sort",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Shade updated",
+                        "description": "Deleted categories",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.ShadeUpdate200"
+                            "$ref": "#/definitions/docsResponse.CategoryList200"
                         }
                     },
                     "400": {
-                        "description": "Bad request or validation error",
+                        "description": "Invalid page, perPage, sort or filter value",
                         "schema": {
-                            "$ref": "#/definitions/docsResponse.ShadeUpdate400"
+                            "$ref": "#/definitions/docsResponse.Response400"
                         }
                     },
                     "401": {
@@ -1824,20 +2112,5316 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "docsResponse.CategoryCreate201": {
-            "type": "object",
-            "properties": {
-                "data": {
-                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_category_dto.ResponseDTO"
-                },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": true
-                }
-            }
+        },
+        "/api/v1/category/tree": {
+            "get": {
+                "security": [
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Retrieve all categories as a nested tree. Siblings are ordered by sortIndex",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Category"
+                ],
+                "summary": "Get category tree",
+                "responses": {
+                    "200": {
+                        "description": "Category tree",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.CategoryTree200"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/category/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Retrieve category by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Category"
+                ],
+                "summary": "Get category by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Category found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.CategoryGetById200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "Category not found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/category/{id}/breadcrumbs": {
+            "get": {
+                "security": [
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Retrieve the chain of categories from the root down to the given category",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Category"
+                ],
+                "summary": "Get category breadcrumbs",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Breadcrumbs",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.CategoryBreadcrumbs200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "Category not found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/category/{id}/delete": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Delete category by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Category"
+                ],
+                "summary": "Delete category",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Category deleted",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.CategoryDelete200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "Category not found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "409": {
+                        "description": "Category has linked entities",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response409"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/category/{id}/move": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Re-parent a category together with all its descendants. A null parentId moves the category to the root. Moving a category under itself or its descendant is rejected with CATEGORY_CYCLE",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Category"
+                ],
+                "summary": "Move category subtree",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New parent and sort index",
+                        "name": "move",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.MoveDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Category moved",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.CategoryUpdate200"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request, validation error or cycle",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.CategoryUpdate400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "Category not found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/category/{id}/restore": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Move a category out of the trash",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Category"
+                ],
+                "summary": "Restore category",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Category restored",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.CategoryGetById200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID or the restored values are taken",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.CategoryCreate400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "Category not found in the trash",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/category/{id}/update": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Update category by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Category"
+                ],
+                "summary": "Update category",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Category ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Category update payload",
+                        "name": "category",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_category_dto.UpdateDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Category updated",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.CategoryUpdate200"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request or validation error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.CategoryUpdate400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/dashboard-user": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Retrieve dashboard users with pagination",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Dashboard User"
+                ],
+                "summary": "Get dashboard users",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number, starting from 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page, up to 100 (default 20)",
+                        "name": "perPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated sort fields, prefix with - for descending: id, email, role, createdAt",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by email substring",
+                        "name": "email",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "admin",
+                            "manager"
+                        ],
+                        "type": "string",
+                        "description": "Filter by role",
+                        "name": "role",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filter by activity",
+                        "name": "isActive",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of dashboard users",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.DashboardUserList200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid page, perPage, sort or filter value",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/dashboard-user/create": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Create a new dashboard user with the provided details.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Dashboard User"
+                ],
+                "summary": "Create a new dashboard user",
+                "parameters": [
+                    {
+                        "description": "Dashboard User Create DTO",
+                        "name": "dashboardUser",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_dashboard_user_dto.CreateDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Dashboard User Created",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.DashboardUserCreate201"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request or Validation Error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.DashboardUserCreate400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "500": {
+                        "description": "Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/dashboard-user/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Retrieve dashboard user by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Dashboard User"
+                ],
+                "summary": "Get dashboard user by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dashboard user ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Dashboard user found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.DashboardUserGetById200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "Dashboard user not found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/dashboard-user/{id}/activate": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Allow a deactivated dashboard user to log in again",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Dashboard User"
+                ],
+                "summary": "Activate dashboard user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dashboard user ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Dashboard user activated",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.DashboardUserUpdate200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "Dashboard user not found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/dashboard-user/{id}/deactivate": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Refuse further logins of a dashboard user and revoke their refresh token. The last active admin cannot be deactivated",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Dashboard User"
+                ],
+                "summary": "Deactivate dashboard user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dashboard user ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Dashboard user deactivated",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.DashboardUserUpdate200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "Dashboard user not found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "409": {
+                        "description": "Last active admin",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.DashboardUser409"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/dashboard-user/{id}/delete": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Delete a dashboard user and revoke their refresh token. The last active admin cannot be deleted",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Dashboard User"
+                ],
+                "summary": "Delete dashboard user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dashboard user ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Dashboard user deleted",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.DashboardUserDelete200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "Dashboard user not found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "409": {
+                        "description": "Last active admin",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.DashboardUser409"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/dashboard-user/{id}/reset-2fa": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Disable two-factor authentication of a dashboard user who lost the authenticator app and recovery codes. The user can enable it again after logging in with the password",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Dashboard User"
+                ],
+                "summary": "Reset dashboard user two-factor",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dashboard user ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Two-factor authentication reset",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.DashboardUserUpdate200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "Dashboard user not found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/dashboard-user/{id}/update": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Change the role of a dashboard user. The last active admin cannot be demoted",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Dashboard User"
+                ],
+                "summary": "Update dashboard user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Dashboard user ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Dashboard user update payload",
+                        "name": "dashboardUser",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_dashboard_user_dto.UpdateDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Dashboard user updated",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.DashboardUserUpdate200"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request or validation error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "Dashboard user not found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "409": {
+                        "description": "Last active admin",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.DashboardUser409"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/desired-result": {
+            "get": {
+                "security": [
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Retrieve all desiredResults",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "DesiredResult"
+                ],
+                "summary": "Get all desiredResults",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number, starting from 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page, up to 100 (default 20)",
+                        "name": "perPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated sort fields, prefix with - for descending: id, name, createdAt",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name substring",
+                        "name": "name",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of desiredResults",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.DesiredResultList200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid page, perPage, sort or filter value",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/desired-result/create": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Create a new desiredResult",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "DesiredResult"
+                ],
+                "summary": "Create a new desiredResult",
+                "parameters": [
+                    {
+                        "description": "DesiredResult to create",
+                        "name": "desiredResult",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_desired_result_dto.CreateDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "DesiredResult created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.DesiredResultCreate201"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request or Validation Error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.DesiredResultCreate400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "500": {
+                        "description": "Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/desired-result/trash": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Retrieve desired results in the trash. They are purged permanently after the retention period",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "DesiredResult"
+                ],
+                "summary": "Get deleted desired results",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number, starting from 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page, up to 100 (default 20)",
+                        "name": "perPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated sort fields, prefix with - for descending, e.g. -deletedAt",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Deleted desired results",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.DesiredResultList200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid page, perPage, sort or filter value",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/desired-result/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Retrieve desiredResult by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "DesiredResult"
+                ],
+                "summary": "Get desiredResult by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "DesiredResult ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "DesiredResult found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.DesiredResultGetById200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "DesiredResult not found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/desired-result/{id}/delete": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Delete desiredResult by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "DesiredResult"
+                ],
+                "summary": "Delete desiredResult",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "DesiredResult ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "DesiredResult deleted",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.DesiredResultDelete200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "DesiredResult not found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "409": {
+                        "description": "DesiredResult has linked entities",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response409"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/desired-result/{id}/restore": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Move a desired result out of the trash",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "DesiredResult"
+                ],
+                "summary": "Restore desired result",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "DesiredResult ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "DesiredResult restored",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.DesiredResultGetById200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID or the restored values are taken",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.DesiredResultCreate400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "DesiredResult not found in the trash",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/desired-result/{id}/update": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Update desiredResult by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "DesiredResult"
+                ],
+                "summary": "Update desiredResult",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "DesiredResult ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "DesiredResult update payload",
+                        "name": "desiredResult",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_desired_result_dto.UpdateDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "DesiredResult updated",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.DesiredResultUpdate200"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request or validation error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.DesiredResultUpdate400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/image/upload": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Upload one or more images with a specific imageType. Requires JWT authentication.\nEXIF metadata is removed and the orientation is applied. Besides the original, a WebP copy and the size variants configured for the imageType are generated.\nThe file type is detected by its content. Validation error codes per file: FILE_TOO_LARGE, INVALID_FILE_TYPE, CONTENT_TYPE_MISMATCH, INVALID_IMAGE, IMAGE_TOO_SMALL, IMAGE_TOO_LARGE, INVALID_ASPECT_RATIO.\nReturned URLs point to the permanent folder of the imageType and become available once the image is saved to an entity.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Image"
+                ],
+                "summary": "Upload images",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Type of image (e.g. category)",
+                        "name": "imageType",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Image files to upload (multiple allowed)",
+                        "name": "images",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Uploaded images info",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/docsResponse.ImageUpload200"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request or Validation Error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.ImageUpload400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "413": {
+                        "description": "File too large",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response413"
+                        }
+                    },
+                    "500": {
+                        "description": "Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/inventory/{variantId}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Retrieve quantity, reserved and available stock of a product variant",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inventory"
+                ],
+                "summary": "Get stock of product variant",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product variant ID",
+                        "name": "variantId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Stock found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.StockGet200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "Product variant not found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/inventory/{variantId}/adjust": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Increase or decrease stock of a product variant. Every adjustment is written to the stock movement ledger.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inventory"
+                ],
+                "summary": "Adjust stock",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product variant ID",
+                        "name": "variantId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Stock adjustment",
+                        "name": "adjustment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.AdjustDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Stock adjusted",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.StockGet200"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request or validation error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.StockAdjust400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "Product variant not found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "409": {
+                        "description": "Not enough stock",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.StockAdjust409"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/inventory/{variantId}/movements": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Retrieve stock movements of a product variant, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Inventory"
+                ],
+                "summary": "Get stock movements",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product variant ID",
+                        "name": "variantId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of stock movements",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.StockMovementList200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/job": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Retrieve background jobs with their schedule, last run and next run",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Job"
+                ],
+                "summary": "Get scheduled jobs",
+                "responses": {
+                    "200": {
+                        "description": "List of jobs",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.JobList200"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/job/dead-letters": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Retrieve background queue jobs that failed on every attempt",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Job"
+                ],
+                "summary": "Get dead letters",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number, starting from 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page, up to 100 (default 20)",
+                        "name": "perPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated sort fields, prefix with - for descending: id, updatedAt",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by job type",
+                        "name": "type",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of dead letters",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.JobDeadLetters200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid page, perPage, sort or filter value",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/job/dead-letters/{id}/retry": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Return a failed queue job to the queue with a fresh set of attempts",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Job"
+                ],
+                "summary": "Retry dead letter",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Queue job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Job requeued",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.JobDeadLetterRetry200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "Dead letter not found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/job/{name}/run": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Start a job in the background without waiting for its schedule. The run appears in the job history.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Job"
+                ],
+                "summary": "Run job now",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Job name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Job started",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.JobTrigger202"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "Job not found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "409": {
+                        "description": "Job is already running",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Job409"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/job/{name}/runs": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Retrieve the run history of a job, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Job"
+                ],
+                "summary": "Get job runs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Job name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number, starting from 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page, up to 100 (default 20)",
+                        "name": "perPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated sort fields, prefix with - for descending: id, startedAt",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by trigger: schedule, manual",
+                        "name": "trigger",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filter by panicked runs",
+                        "name": "panicked",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of runs",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.JobRuns200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid page, perPage, sort or filter value",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "Job not found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/line": {
+            "get": {
+                "security": [
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Retrieve all lines",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Line"
+                ],
+                "summary": "Get all lines",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number, starting from 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page, up to 100 (default 20)",
+                        "name": "perPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated sort fields, prefix with - for descending: id, name, createdAt",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name substring",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by exact hex color",
+                        "name": "color",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of lines",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.LineList200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid page, perPage, sort or filter value",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/line/create": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Create a new line",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Line"
+                ],
+                "summary": "Create a new line",
+                "parameters": [
+                    {
+                        "description": "Line to create",
+                        "name": "line",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_line_dto.CreateDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Line created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.LineCreate201"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request or Validation Error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.LineCreate400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "500": {
+                        "description": "Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/line/trash": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Retrieve lines in the trash. They are purged permanently after the retention period",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Line"
+                ],
+                "summary": "Get deleted lines",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number, starting from 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page, up to 100 (default 20)",
+                        "name": "perPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated sort fields, prefix with - for descending, e.g. -deletedAt",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Deleted lines",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.LineList200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid page, perPage, sort or filter value",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/line/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Retrieve line by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Line"
+                ],
+                "summary": "Get line by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Line ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Line found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.LineGetById200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "Line not found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/line/{id}/delete": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Delete line by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Line"
+                ],
+                "summary": "Delete line",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Line ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Line deleted",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.LineDelete200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "Line not found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "409": {
+                        "description": "Line has linked entities",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response409"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/line/{id}/restore": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Move a line out of the trash",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Line"
+                ],
+                "summary": "Restore line",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Line ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Line restored",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.LineGetById200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID or the restored values are taken",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.LineCreate400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "Line not found in the trash",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/line/{id}/update": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Update line by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Line"
+                ],
+                "summary": "Update line",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Line ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Line update payload",
+                        "name": "line",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_line_dto.UpdateDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Line updated",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.LineUpdate200"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request or validation error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.LineUpdate400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/order": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Retrieve all orders, newest first, optionally filtered by status",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "Get all orders",
+                "parameters": [
+                    {
+                        "enum": [
+                            "new",
+                            "confirmed",
+                            "paid",
+                            "shipped",
+                            "delivered",
+                            "cancelled",
+                            "returned"
+                        ],
+                        "type": "string",
+                        "description": "Order status",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of orders",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.OrderList200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid status",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/order/checkout": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Convert the cart of the authenticated client or of the guest identified by X-Cart-Token into an order. Prices are snapshotted, the applied promo code is checked again and spent, and stock is reserved. A promo code that no longer applies is reported in the promoCode field with its PROMO_CODE_* reason.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "Checkout",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Guest cart token",
+                        "name": "X-Cart-Token",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Customer and delivery data",
+                        "name": "order",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CheckoutDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Order placed",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.OrderGet200"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request, validation error or empty cart",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Checkout400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "409": {
+                        "description": "Not enough stock",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.StockAdjust409"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/order/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Retrieve an order with its lines and status history",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "Get order by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Order found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.OrderGet200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "Order not found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/order/{id}/pay": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Create an online payment for the order and return the provider confirmation URL. Orders placed by a client can be paid only by that client; guest orders require the customer phone from the order form. A new payment is refused while the previous one is pending.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payment"
+                ],
+                "summary": "Pay for order",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Customer phone for guest orders",
+                        "name": "payment",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PayDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Payment created",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.PaymentGet200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID or request body",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "Order not found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "409": {
+                        "description": "Order cannot be paid or has a pending payment",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Payment409"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/order/{id}/payments": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Retrieve all payment attempts of an order",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payment"
+                ],
+                "summary": "Get order payments",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of payments",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.PaymentList200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/order/{id}/status": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Move an order along its lifecycle: new → confirmed → paid → shipped → delivered, with cancelled and returned as exits. Invalid transitions are rejected.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Order"
+                ],
+                "summary": "Change order status",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Order ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New status",
+                        "name": "status",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ChangeStatusDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Status changed",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.OrderGet200"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request or validation error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "Order not found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "409": {
+                        "description": "Transition not allowed",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.OrderStatus409"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/payment/{id}/refund": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Refund a succeeded payment in full and mark the order payment as refunded",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payment"
+                ],
+                "summary": "Refund payment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Payment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Payment refunded",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.PaymentGet200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "Payment not found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "409": {
+                        "description": "Payment cannot be refunded",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Payment409"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/product": {
+            "get": {
+                "security": [
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Retrieve all products",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "Get all products",
+                "responses": {
+                    "200": {
+                        "description": "List of products",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.ProductList200"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/product-type": {
+            "get": {
+                "security": [
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Retrieve all productTypes",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ProductType"
+                ],
+                "summary": "Get all productTypes",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number, starting from 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page, up to 100 (default 20)",
+                        "name": "perPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated sort fields, prefix with - for descending: id, name, createdAt",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name substring",
+                        "name": "name",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of productTypes",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.ProductTypeList200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid page, perPage, sort or filter value",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/product-type/create": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Create a new productType",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ProductType"
+                ],
+                "summary": "Create a new productType",
+                "parameters": [
+                    {
+                        "description": "ProductType to create",
+                        "name": "productType",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_product_type_dto.CreateDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "ProductType created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.ProductTypeCreate201"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request or Validation Error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.ProductTypeCreate400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "500": {
+                        "description": "Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/product-type/trash": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Retrieve product types in the trash. They are purged permanently after the retention period",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ProductType"
+                ],
+                "summary": "Get deleted product types",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number, starting from 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page, up to 100 (default 20)",
+                        "name": "perPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated sort fields, prefix with - for descending, e.g. -deletedAt",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Deleted product types",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.ProductTypeList200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid page, perPage, sort or filter value",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/product-type/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Retrieve productType by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ProductType"
+                ],
+                "summary": "Get productType by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ProductType ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "ProductType found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.ProductTypeGetById200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "ProductType not found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/product-type/{id}/delete": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Delete productType by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ProductType"
+                ],
+                "summary": "Delete productType",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ProductType ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "ProductType deleted",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.ProductTypeDelete200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "ProductType not found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "409": {
+                        "description": "ProductType has linked entities",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response409"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/product-type/{id}/restore": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Move a product type out of the trash",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ProductType"
+                ],
+                "summary": "Restore product type",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ProductType ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "ProductType restored",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.ProductTypeGetById200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID or the restored values are taken",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.ProductTypeCreate400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "ProductType not found in the trash",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/product-type/{id}/update": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Update productType by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ProductType"
+                ],
+                "summary": "Update productType",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ProductType ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "ProductType update payload",
+                        "name": "productType",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_product_type_dto.UpdateDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "ProductType updated",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.ProductTypeUpdate200"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request or validation error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.ProductTypeUpdate400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/product/by-slug/{slug}": {
+            "get": {
+                "security": [
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Retrieve product by its current slug. An old slug answers 301 with the current slug in data and a Location header pointing at it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "Get product by slug",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Product slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Product found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.ProductGetById200"
+                        }
+                    },
+                    "301": {
+                        "description": "Slug has changed",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.ProductSlugRedirect301"
+                        }
+                    },
+                    "400": {
+                        "description": "Missing slug",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "Product not found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/product/create": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Create a new product",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "Create a new product",
+                "parameters": [
+                    {
+                        "description": "Product to create",
+                        "name": "product",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_product_dto.CreateDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Product created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.ProductCreate201"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request or Validation Error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.ProductCreate400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "500": {
+                        "description": "Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/product/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Retrieve product by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "Get product by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Product found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.ProductGetById200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "Product not found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/product/{id}/delete": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Delete product by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "Delete product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Product deleted",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.ProductDelete200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "Product not found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/product/{id}/update": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Update product by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Product"
+                ],
+                "summary": "Update product",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Product update payload",
+                        "name": "product",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_product_dto.UpdateDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Product updated",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.ProductUpdate200"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request or validation error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.ProductUpdate400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/product/{productId}/variant": {
+            "get": {
+                "security": [
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Retrieve all variants of the product ordered by sort index",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ProductVariant"
+                ],
+                "summary": "Get all product variants",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "productId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of product variants",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.ProductVariantList200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/product/{productId}/variant/create": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Create a new variant (SKU) of the product",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ProductVariant"
+                ],
+                "summary": "Create a new product variant",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "productId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Product variant to create",
+                        "name": "variant",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_product_variant_dto.CreateDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Product variant created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.ProductVariantCreate201"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request or Validation Error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.ProductVariantCreate400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "500": {
+                        "description": "Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/product/{productId}/variant/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Retrieve product variant by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ProductVariant"
+                ],
+                "summary": "Get product variant by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "productId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Product variant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Product variant found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.ProductVariantGetById200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "Product variant not found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/product/{productId}/variant/{id}/delete": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Delete product variant by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ProductVariant"
+                ],
+                "summary": "Delete product variant",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "productId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Product variant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Product variant deleted",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.ProductVariantDelete200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "Product variant not found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/product/{productId}/variant/{id}/update": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Update product variant by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ProductVariant"
+                ],
+                "summary": "Update product variant",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Product ID",
+                        "name": "productId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Product variant ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Product variant update payload",
+                        "name": "variant",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_product_variant_dto.UpdateDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Product variant updated",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.ProductVariantUpdate200"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request or validation error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.ProductVariantUpdate400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/promo-code": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Retrieve all promo codes",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PromoCode"
+                ],
+                "summary": "Get all promo codes",
+                "responses": {
+                    "200": {
+                        "description": "List of promo codes",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.PromoCodeList200"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/promo-code/create": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Create a new promo code with its discount rules. Empty categoryIds, lineIds and productTypeIds mean the code applies to any product.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PromoCode"
+                ],
+                "summary": "Create a new promo code",
+                "parameters": [
+                    {
+                        "description": "Promo code to create",
+                        "name": "promoCode",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_promo_code_dto.CreateDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Promo code created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.PromoCodeCreate201"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request or Validation Error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.PromoCodeCreate400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "500": {
+                        "description": "Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/promo-code/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Retrieve promo code by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PromoCode"
+                ],
+                "summary": "Get promo code by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Promo code ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Promo code found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.PromoCodeGetById200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "Promo code not found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/promo-code/{id}/delete": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Delete a promo code that has never been used. Used codes can only be deactivated.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PromoCode"
+                ],
+                "summary": "Delete promo code",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Promo code ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Promo code deleted",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.PromoCodeDelete200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "Promo code not found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "409": {
+                        "description": "Promo code has been used",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response409"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/promo-code/{id}/update": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Update promo code by ID. Omitted restriction lists are kept; an empty list removes the restriction.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PromoCode"
+                ],
+                "summary": "Update promo code",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Promo code ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Promo code update payload",
+                        "name": "promoCode",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_promo_code_dto.UpdateDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Promo code updated",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.PromoCodeUpdate200"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request or validation error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.PromoCodeCreate400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/shade": {
+            "get": {
+                "security": [
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Retrieve all shades",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Shade"
+                ],
+                "summary": "Get all shades",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number, starting from 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page, up to 100 (default 20)",
+                        "name": "perPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated sort fields, prefix with - for descending: id, name, sortIndex, createdAt",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by name substring",
+                        "name": "name",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of shades",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.ShadeList200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid page, perPage, sort or filter value",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/shade/create": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Create a new shade",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Shade"
+                ],
+                "summary": "Create a new shade",
+                "parameters": [
+                    {
+                        "description": "Shade to create",
+                        "name": "shade",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_shade_dto.CreateDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Shade created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.ShadeCreate201"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request or Validation Error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.ShadeCreate400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "500": {
+                        "description": "Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/shade/trash": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Retrieve shades in the trash. They are purged permanently after the retention period",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Shade"
+                ],
+                "summary": "Get deleted shades",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number, starting from 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Items per page, up to 100 (default 20)",
+                        "name": "perPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated sort fields, prefix with - for descending, e.g. -deletedAt",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Deleted shades",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.ShadeList200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid page, perPage, sort or filter value",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/shade/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Retrieve shade by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Shade"
+                ],
+                "summary": "Get shade by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Shade ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Shade found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.ShadeGetById200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "Shade not found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/shade/{id}/delete": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Delete shade by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Shade"
+                ],
+                "summary": "Delete shade",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Shade ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Shade deleted",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.ShadeDelete200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "Shade not found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "409": {
+                        "description": "Shade has linked entities",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response409"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/shade/{id}/restore": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Move a shade out of the trash",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Shade"
+                ],
+                "summary": "Restore shade",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Shade ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Shade restored",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.ShadeGetById200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "404": {
+                        "description": "Shade not found in the trash",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/shade/{id}/update": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "AppAuth": []
+                    }
+                ],
+                "description": "Update shade by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Shade"
+                ],
+                "summary": "Update shade",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Shade ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Shade update payload",
+                        "name": "shade",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_shade_dto.UpdateDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Shade updated",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.ShadeUpdate200"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request or validation error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.ShadeUpdate400"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response401"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - Invalid X-AUTH-APP",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response403"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/v1/payment": {
+            "post": {
+                "description": "Receive a signed payment notification from the provider. Repeated notifications are acknowledged without side effects. Not covered by X-AUTH-APP.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payment"
+                ],
+                "summary": "Payment webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "HMAC-SHA256 of the body (fake provider)",
+                        "name": "X-Payment-Signature",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Notification processed",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Webhook200"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid notification",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response400"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid signature",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Webhook401"
+                        }
+                    },
+                    "404": {
+                        "description": "Payment not found",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response404"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/docsResponse.Response500"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "docsResponse.CartGet200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_cart_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.CartItem400": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "BAD_REQUEST"
+                    ]
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docsResponse.cartErrorField"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Bad request or validation error"
+                }
+            }
+        },
+        "docsResponse.CartPromoCode400": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "BAD_REQUEST",
+                        "EMPTY_CART",
+                        "PROMO_CODE_NOT_FOUND",
+                        "PROMO_CODE_INACTIVE",
+                        "PROMO_CODE_NOT_STARTED",
+                        "PROMO_CODE_EXPIRED",
+                        "PROMO_CODE_USAGE_LIMIT_REACHED",
+                        "PROMO_CODE_CLIENT_LIMIT_REACHED",
+                        "PROMO_CODE_MIN_AMOUNT_NOT_REACHED",
+                        "PROMO_CODE_NOT_APPLICABLE"
+                    ]
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docsResponse.cartErrorField"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "promo code has expired"
+                }
+            }
+        },
+        "docsResponse.CategoryBreadcrumbs200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.BreadcrumbDTO"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.CategoryCreate201": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_category_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
         },
         "docsResponse.CategoryCreate400": {
             "type": "object",
@@ -1848,965 +7432,3802 @@ const docTemplate = `{
                         "BAD_REQUEST"
                     ]
                 },
-                "fields": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/docsResponse.categoryErrorField"
-                    }
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docsResponse.categoryErrorField"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Bad request or validation error"
+                }
+            }
+        },
+        "docsResponse.CategoryDelete200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_category_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.CategoryGetById200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_category_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.CategoryList200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_category_dto.ResponseDTO"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "pagination": {
+                    "$ref": "#/definitions/response.Pagination"
+                }
+            }
+        },
+        "docsResponse.CategoryMain200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_category_dto.ResponseDTO"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.CategorySlugRedirect301": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_category_dto.RedirectDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.CategoryTree200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TreeDTO"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.CategoryUpdate200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_category_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.CategoryUpdate400": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "BAD_REQUEST"
+                    ]
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docsResponse.categoryErrorField"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Bad request or validation error"
+                }
+            }
+        },
+        "docsResponse.Checkout400": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "BAD_REQUEST"
+                    ]
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docsResponse.checkoutErrorField"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Bad request or validation error"
+                }
+            }
+        },
+        "docsResponse.ClientRefreshToken200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_auth_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.ClientRefreshToken400": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "BAD_REQUEST"
+                    ]
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docsResponse.clientAuthErrorField"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Bad request or validation error"
+                }
+            }
+        },
+        "docsResponse.ClientRequestCode200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.ClientRequestCodeResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.ClientRequestCode400": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "BAD_REQUEST"
+                    ]
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docsResponse.clientAuthErrorField"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Bad request or validation error"
+                }
+            }
+        },
+        "docsResponse.ClientVerifyCode200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_auth_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.ClientVerifyCode400": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "BAD_REQUEST"
+                    ]
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docsResponse.clientAuthErrorField"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Bad request or validation error"
+                }
+            }
+        },
+        "docsResponse.ClientVerifyCode401": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "INVALID_CODE"
+                    ]
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "invalid or expired code"
+                }
+            }
+        },
+        "docsResponse.DashboardChangePassword200": {
+            "type": "object",
+            "properties": {
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.DashboardChangePassword400": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "BAD_REQUEST"
+                    ]
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docsResponse.dashboardPasswordErrorField"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Bad request or validation error"
+                }
+            }
+        },
+        "docsResponse.DashboardLogin200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_auth_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.DashboardLogin400": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "BAD_REQUEST"
+                    ]
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docsResponse.authErrorField"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Bad request or validation error"
+                }
+            }
+        },
+        "docsResponse.DashboardLogout200": {
+            "type": "object",
+            "properties": {
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.DashboardPasswordReset200": {
+            "type": "object",
+            "properties": {
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.DashboardPasswordReset400": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "BAD_REQUEST",
+                        "INVALID_TOKEN"
+                    ]
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docsResponse.dashboardPasswordErrorField"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "invalid or expired password reset token"
+                }
+            }
+        },
+        "docsResponse.DashboardPasswordResetRequest200": {
+            "type": "object",
+            "properties": {
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.DashboardPasswordResetRequest400": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "BAD_REQUEST"
+                    ]
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docsResponse.dashboardPasswordErrorField"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Bad request or validation error"
+                }
+            }
+        },
+        "docsResponse.DashboardRecoveryCodes200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.RecoveryCodesResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.DashboardRefreshToken200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_auth_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.DashboardRefreshToken400": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "BAD_REQUEST"
+                    ]
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docsResponse.authErrorField"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Bad request or validation error"
+                }
+            }
+        },
+        "docsResponse.DashboardSessions200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.SessionResponseDTO"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.DashboardTwoFactor400": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "BAD_REQUEST"
+                    ]
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docsResponse.dashboardTwoFactorErrorField"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Bad request or validation error"
+                }
+            }
+        },
+        "docsResponse.DashboardTwoFactor409": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "TWO_FACTOR_ALREADY_ENABLED",
+                        "TWO_FACTOR_NOT_ENABLED"
+                    ]
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "two-factor authentication is already enabled"
+                }
+            }
+        },
+        "docsResponse.DashboardTwoFactorDisable200": {
+            "type": "object",
+            "properties": {
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.DashboardTwoFactorSetup200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.TwoFactorSetupResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.DashboardTwoFactorStatus200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.TwoFactorStatusResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.DashboardUser409": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "LAST_ADMIN"
+                    ]
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "the last active admin cannot be demoted, deactivated or deleted"
+                }
+            }
+        },
+        "docsResponse.DashboardUserCreate201": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_dashboard_user_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.DashboardUserCreate400": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "BAD_REQUEST"
+                    ]
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docsResponse.dashboardUserErrorField"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Bad request or validation error"
+                }
+            }
+        },
+        "docsResponse.DashboardUserDelete200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_dashboard_user_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.DashboardUserGetById200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_dashboard_user_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.DashboardUserList200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_dashboard_user_dto.ResponseDTO"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "pagination": {
+                    "$ref": "#/definitions/response.Pagination"
+                }
+            }
+        },
+        "docsResponse.DashboardUserUpdate200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_dashboard_user_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.DashboardVerifyTwoFactor401": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "INVALID_CODE",
+                        "INVALID_TOKEN"
+                    ]
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "invalid two-factor code"
+                }
+            }
+        },
+        "docsResponse.DesiredResultCreate201": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_desired_result_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.DesiredResultCreate400": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "BAD_REQUEST"
+                    ]
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docsResponse.categoryErrorField"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Bad request or validation error"
+                }
+            }
+        },
+        "docsResponse.DesiredResultDelete200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_desired_result_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.DesiredResultGetById200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_desired_result_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.DesiredResultList200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_desired_result_dto.ResponseDTO"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "pagination": {
+                    "$ref": "#/definitions/response.Pagination"
+                }
+            }
+        },
+        "docsResponse.DesiredResultUpdate200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_desired_result_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.DesiredResultUpdate400": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "BAD_REQUEST"
+                    ]
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docsResponse.categoryErrorField"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Bad request or validation error"
+                }
+            }
+        },
+        "docsResponse.ImageUpload200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_image_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.ImageUpload400": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "BAD_REQUEST"
+                    ]
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docsResponse.imageErrorField"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Bad request or validation error"
+                }
+            }
+        },
+        "docsResponse.Job409": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "JOB_ALREADY_RUNNING"
+                    ]
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "job PurgeTrash is already running"
+                }
+            }
+        },
+        "docsResponse.JobDeadLetterRetry200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.QueueJobDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.JobDeadLetters200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.QueueJobDTO"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "pagination": {
+                    "$ref": "#/definitions/response.Pagination"
+                }
+            }
+        },
+        "docsResponse.JobList200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.JobDTO"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.JobRuns200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.RunDTO"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "pagination": {
+                    "$ref": "#/definitions/response.Pagination"
+                }
+            }
+        },
+        "docsResponse.JobTrigger202": {
+            "type": "object",
+            "properties": {
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.LineCreate201": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_line_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.LineCreate400": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "BAD_REQUEST"
+                    ]
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docsResponse.categoryErrorField"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Bad request or validation error"
+                }
+            }
+        },
+        "docsResponse.LineDelete200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_line_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.LineGetById200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_line_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.LineList200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_line_dto.ResponseDTO"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "pagination": {
+                    "$ref": "#/definitions/response.Pagination"
+                }
+            }
+        },
+        "docsResponse.LineUpdate200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_line_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.LineUpdate400": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "BAD_REQUEST"
+                    ]
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docsResponse.categoryErrorField"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Bad request or validation error"
+                }
+            }
+        },
+        "docsResponse.OrderGet200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_order_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.OrderList200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_order_dto.ResponseDTO"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.OrderStatus409": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "INVALID_TRANSITION"
+                    ]
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "order cannot move from status delivered to paid"
+                }
+            }
+        },
+        "docsResponse.Payment409": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "PAYMENT_NOT_ALLOWED"
+                    ]
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "order already has a pending payment"
+                }
+            }
+        },
+        "docsResponse.PaymentGet200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_payment_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.PaymentList200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_payment_dto.ResponseDTO"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.ProductCreate201": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_product_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.ProductCreate400": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "BAD_REQUEST"
+                    ]
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docsResponse.productErrorField"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Bad request or validation error"
+                }
+            }
+        },
+        "docsResponse.ProductDelete200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_product_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.ProductGetById200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_product_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.ProductList200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_product_dto.ResponseDTO"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.ProductSlugRedirect301": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_product_dto.RedirectDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.ProductTypeCreate201": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_product_type_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.ProductTypeCreate400": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "BAD_REQUEST"
+                    ]
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docsResponse.categoryErrorField"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Bad request or validation error"
+                }
+            }
+        },
+        "docsResponse.ProductTypeDelete200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_product_type_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.ProductTypeGetById200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_product_type_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.ProductTypeList200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_product_type_dto.ResponseDTO"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "pagination": {
+                    "$ref": "#/definitions/response.Pagination"
+                }
+            }
+        },
+        "docsResponse.ProductTypeUpdate200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_product_type_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.ProductTypeUpdate400": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "BAD_REQUEST"
+                    ]
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docsResponse.categoryErrorField"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Bad request or validation error"
+                }
+            }
+        },
+        "docsResponse.ProductUpdate200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_product_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.ProductUpdate400": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "BAD_REQUEST"
+                    ]
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docsResponse.productErrorField"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Bad request or validation error"
+                }
+            }
+        },
+        "docsResponse.ProductVariantCreate201": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_product_variant_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.ProductVariantCreate400": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "BAD_REQUEST"
+                    ]
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docsResponse.productVariantErrorField"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Bad request or validation error"
+                }
+            }
+        },
+        "docsResponse.ProductVariantDelete200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_product_variant_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.ProductVariantGetById200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_product_variant_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.ProductVariantList200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_product_variant_dto.ResponseDTO"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.ProductVariantUpdate200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_product_variant_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.ProductVariantUpdate400": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "BAD_REQUEST"
+                    ]
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docsResponse.productVariantErrorField"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Bad request or validation error"
+                }
+            }
+        },
+        "docsResponse.PromoCodeCreate201": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_promo_code_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.PromoCodeCreate400": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "BAD_REQUEST"
+                    ]
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docsResponse.promoCodeErrorField"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Bad request or validation error"
+                }
+            }
+        },
+        "docsResponse.PromoCodeDelete200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_promo_code_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.PromoCodeGetById200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_promo_code_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.PromoCodeList200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_promo_code_dto.ResponseDTO"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.PromoCodeUpdate200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_promo_code_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.Response400": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "BAD_REQUEST"
+                    ]
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Bad request or validation error"
+                }
+            }
+        },
+        "docsResponse.Response401": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "UNAUTHORIZED"
+                    ]
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Unauthorized"
+                }
+            }
+        },
+        "docsResponse.Response403": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "FORBIDDEN"
+                    ]
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Forbidden"
+                }
+            }
+        },
+        "docsResponse.Response404": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "NOT_FOUND"
+                    ]
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Resource not found"
+                }
+            }
+        },
+        "docsResponse.Response409": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "HAS_LINKED_ENTITIES"
+                    ]
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Conflict"
+                }
+            }
+        },
+        "docsResponse.Response413": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "REQUEST_TOO_LARGE"
+                    ]
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Request entity too large"
+                }
+            }
+        },
+        "docsResponse.Response429": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "TOO_MANY_REQUESTS"
+                    ]
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Too many requests"
+                }
+            }
+        },
+        "docsResponse.Response500": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "SERVER_ERROR"
+                    ]
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Internal server error"
+                }
+            }
+        },
+        "docsResponse.ShadeCreate201": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_shade_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.ShadeCreate400": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "BAD_REQUEST"
+                    ]
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docsResponse.categoryErrorField"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Bad request or validation error"
+                }
+            }
+        },
+        "docsResponse.ShadeDelete200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_shade_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.ShadeGetById200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_shade_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.ShadeList200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_shade_dto.ResponseDTO"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "pagination": {
+                    "$ref": "#/definitions/response.Pagination"
+                }
+            }
+        },
+        "docsResponse.ShadeUpdate200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_shade_dto.ResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.ShadeUpdate400": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "BAD_REQUEST"
+                    ]
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docsResponse.categoryErrorField"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Bad request or validation error"
+                }
+            }
+        },
+        "docsResponse.StockAdjust400": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "BAD_REQUEST"
+                    ]
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/docsResponse.inventoryErrorField"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Bad request or validation error"
+                }
+            }
+        },
+        "docsResponse.StockAdjust409": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "INSUFFICIENT_STOCK"
+                    ]
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "insufficient stock for product variant 1: requested 5, available 2"
+                }
+            }
+        },
+        "docsResponse.StockGet200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/dto.StockResponseDTO"
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.StockMovementList200": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.MovementResponseDTO"
+                    }
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.Webhook200": {
+            "type": "object",
+            "properties": {
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "docsResponse.Webhook401": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "INVALID_SIGNATURE"
+                    ]
+                },
+                "isSuccess": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "message": {
+                    "type": "string",
+                    "example": "invalid payment callback signature"
+                }
+            }
+        },
+        "docsResponse.authErrorField": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "REQUIRED",
+                        "INVALID_EMAIL",
+                        "MIN_LENGTH",
+                        "MAX_LENGTH",
+                        "INVALID_TOKEN"
+                    ]
+                },
+                "field": {
+                    "type": "string",
+                    "enum": [
+                        "email",
+                        "password",
+                        "device",
+                        "refreshToken"
+                    ]
+                }
+            }
+        },
+        "docsResponse.cartErrorField": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "NOT_BLANK",
+                        "NOT_FOUND",
+                        "BAD_REQUEST"
+                    ]
+                },
+                "field": {
+                    "type": "string",
+                    "enum": [
+                        "productVariantId",
+                        "quantity"
+                    ]
+                }
+            }
+        },
+        "docsResponse.categoryErrorField": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "NOT_UNIQUE",
+                        "NOT_FOUND",
+                        "CATEGORY_CYCLE"
+                    ]
+                },
+                "field": {
+                    "type": "string",
+                    "enum": [
+                        "name",
+                        "slug",
+                        "parentId"
+                    ]
+                }
+            }
+        },
+        "docsResponse.checkoutErrorField": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "EMPTY_CART",
+                        "NOT_FOUND",
+                        "NOT_BLANK",
+                        "MIN_LENGTH",
+                        "MAX_LENGTH",
+                        "INVALID_PHONE",
+                        "INVALID_EMAIL",
+                        "BAD_REQUEST",
+                        "PROMO_CODE_NOT_FOUND",
+                        "PROMO_CODE_INACTIVE",
+                        "PROMO_CODE_NOT_STARTED",
+                        "PROMO_CODE_EXPIRED",
+                        "PROMO_CODE_USAGE_LIMIT_REACHED",
+                        "PROMO_CODE_CLIENT_LIMIT_REACHED",
+                        "PROMO_CODE_MIN_AMOUNT_NOT_REACHED",
+                        "PROMO_CODE_NOT_APPLICABLE"
+                    ]
+                },
+                "field": {
+                    "type": "string",
+                    "enum": [
+                        "cart",
+                        "productVariantId",
+                        "promoCode",
+                        "customerName",
+                        "customerPhone",
+                        "customerEmail",
+                        "deliveryMethod",
+                        "deliveryAddress",
+                        "comment"
+                    ]
+                }
+            }
+        },
+        "docsResponse.clientAuthErrorField": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "NOT_BLANK",
+                        "INVALID_PHONE",
+                        "BAD_REQUEST"
+                    ]
+                },
+                "field": {
+                    "type": "string",
+                    "enum": [
+                        "phone",
+                        "code",
+                        "refreshToken"
+                    ]
+                }
+            }
+        },
+        "docsResponse.dashboardPasswordErrorField": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "NOT_BLANK",
+                        "INVALID_EMAIL",
+                        "MIN_LENGTH",
+                        "MAX_LENGTH",
+                        "INVALID_PASSWORD",
+                        "BAD_REQUEST"
+                    ]
                 },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": false
+                "field": {
+                    "type": "string",
+                    "enum": [
+                        "currentPassword",
+                        "newPassword",
+                        "email",
+                        "token",
+                        "password"
+                    ]
+                }
+            }
+        },
+        "docsResponse.dashboardTwoFactorErrorField": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "NOT_BLANK",
+                        "MAX_LENGTH",
+                        "INVALID_CODE",
+                        "INVALID_PASSWORD",
+                        "BAD_REQUEST"
+                    ]
                 },
-                "message": {
+                "field": {
                     "type": "string",
-                    "example": "Bad request or validation error"
+                    "enum": [
+                        "challengeToken",
+                        "code",
+                        "password"
+                    ]
                 }
             }
         },
-        "docsResponse.CategoryDelete200": {
+        "docsResponse.dashboardUserErrorField": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_category_dto.ResponseDTO"
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "NOT_UNIQUE",
+                        "NOT_BLANK",
+                        "MIN_LENGTH",
+                        "MAX_LENGTH",
+                        "INVALID_EMAIL"
+                    ]
                 },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": true
+                "field": {
+                    "type": "string",
+                    "enum": [
+                        "email",
+                        "password",
+                        "role"
+                    ]
                 }
             }
         },
-        "docsResponse.CategoryGetById200": {
+        "docsResponse.imageErrorField": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_category_dto.ResponseDTO"
+                "errorCode": {
+                    "type": "string",
+                    "example": "IMAGE_TOO_SMALL"
                 },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": true
+                "field": {
+                    "type": "string",
+                    "example": "image_0"
                 }
             }
         },
-        "docsResponse.CategoryList200": {
+        "docsResponse.inventoryErrorField": {
             "type": "object",
             "properties": {
-                "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_category_dto.ResponseDTO"
-                    }
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "NOT_BLANK",
+                        "MIN_LENGTH",
+                        "MAX_LENGTH"
+                    ]
                 },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": true
+                "field": {
+                    "type": "string",
+                    "enum": [
+                        "delta",
+                        "reason"
+                    ]
                 }
             }
         },
-        "docsResponse.CategoryUpdate200": {
+        "docsResponse.productErrorField": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_category_dto.ResponseDTO"
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "NOT_UNIQUE",
+                        "NOT_FOUND"
+                    ]
                 },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": true
+                "field": {
+                    "type": "string",
+                    "enum": [
+                        "name",
+                        "slug",
+                        "categoryId",
+                        "lineId",
+                        "productTypeId",
+                        "desiredResultIds",
+                        "shadeIds"
+                    ]
                 }
             }
         },
-        "docsResponse.CategoryUpdate400": {
+        "docsResponse.productVariantErrorField": {
+            "type": "object",
+            "properties": {
+                "errorCode": {
+                    "type": "string",
+                    "enum": [
+                        "NOT_UNIQUE",
+                        "NOT_FOUND",
+                        "INVALID_BARCODE"
+                    ]
+                },
+                "field": {
+                    "type": "string",
+                    "enum": [
+                        "productId",
+                        "article",
+                        "barcode",
+                        "shadeId",
+                        "volume",
+                        "price"
+                    ]
+                }
+            }
+        },
+        "docsResponse.promoCodeErrorField": {
             "type": "object",
             "properties": {
                 "errorCode": {
                     "type": "string",
                     "enum": [
+                        "NOT_BLANK",
+                        "MIN_LENGTH",
+                        "MAX_LENGTH",
+                        "NOT_UNIQUE",
+                        "NOT_FOUND",
                         "BAD_REQUEST"
                     ]
                 },
-                "fields": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/docsResponse.categoryErrorField"
-                    }
+                "field": {
+                    "type": "string",
+                    "enum": [
+                        "code",
+                        "discountType",
+                        "discountValue",
+                        "minOrderAmount",
+                        "endsAt",
+                        "usageLimit",
+                        "usageLimitPerClient",
+                        "categoryIds",
+                        "lineIds",
+                        "productTypeIds"
+                    ]
+                }
+            }
+        },
+        "dto.AddItemDTO": {
+            "type": "object",
+            "required": [
+                "productVariantId",
+                "quantity"
+            ],
+            "properties": {
+                "productVariantId": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": false
+                "quantity": {
+                    "type": "integer",
+                    "maximum": 999,
+                    "example": 2
+                }
+            }
+        },
+        "dto.AdjustDTO": {
+            "type": "object",
+            "required": [
+                "delta",
+                "reason"
+            ],
+            "properties": {
+                "delta": {
+                    "type": "integer",
+                    "example": 10
                 },
-                "message": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 3,
+                    "example": "Поступление на склад"
+                }
+            }
+        },
+        "dto.BreadcrumbDTO": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ChangeStatusDTO": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "comment": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "example": "Подтверждено по телефону"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "new",
+                        "confirmed",
+                        "paid",
+                        "shipped",
+                        "delivered",
+                        "cancelled",
+                        "returned"
+                    ],
+                    "example": "confirmed"
+                }
+            }
+        },
+        "dto.CheckoutDTO": {
+            "type": "object",
+            "required": [
+                "customerName",
+                "customerPhone",
+                "deliveryMethod"
+            ],
+            "properties": {
+                "comment": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "customerEmail": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "anna@example.com"
+                },
+                "customerName": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 2,
+                    "example": "Анна Иванова"
+                },
+                "customerPhone": {
+                    "type": "string",
+                    "example": "+79991234567"
+                },
+                "deliveryAddress": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "example": "Москва, ул. Тверская, 1"
+                },
+                "deliveryMethod": {
+                    "type": "string",
+                    "enum": [
+                        "courier",
+                        "pickup",
+                        "post"
+                    ],
+                    "example": "courier"
+                }
+            }
+        },
+        "dto.ClientRequestCodeDTO": {
+            "type": "object",
+            "required": [
+                "phone"
+            ],
+            "properties": {
+                "phone": {
                     "type": "string",
-                    "example": "Bad request or validation error"
+                    "example": "+79991234567"
                 }
             }
         },
-        "docsResponse.DashboardLogin200": {
+        "dto.ClientRequestCodeResponseDTO": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_auth_dto.ResponseDTO"
+                "expiresAt": {
+                    "description": "Unix-время истечения кода",
+                    "type": "integer"
                 },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": true
+                "retryAfter": {
+                    "description": "Через сколько секунд можно запросить новый код",
+                    "type": "integer"
                 }
             }
         },
-        "docsResponse.DashboardLogin400": {
+        "dto.ClientVerifyCodeDTO": {
             "type": "object",
+            "required": [
+                "code",
+                "phone"
+            ],
             "properties": {
-                "errorCode": {
+                "cartToken": {
+                    "description": "Guest cart to merge into the client cart",
                     "type": "string",
-                    "enum": [
-                        "BAD_REQUEST"
-                    ]
+                    "maxLength": 64
                 },
-                "fields": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/docsResponse.authErrorField"
-                    }
-                },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": false
+                "code": {
+                    "type": "string",
+                    "example": "123456"
                 },
-                "message": {
+                "phone": {
                     "type": "string",
-                    "example": "Bad request or validation error"
+                    "example": "+79991234567"
                 }
             }
         },
-        "docsResponse.DashboardRefreshToken200": {
+        "dto.DashboardChangePasswordDTO": {
             "type": "object",
+            "required": [
+                "currentPassword",
+                "newPassword"
+            ],
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_auth_dto.ResponseDTO"
+                "currentPassword": {
+                    "type": "string",
+                    "maxLength": 255
                 },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": true
+                "newPassword": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 8
                 }
             }
         },
-        "docsResponse.DashboardRefreshToken400": {
+        "dto.DashboardLoginDTO": {
             "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
             "properties": {
-                "errorCode": {
+                "device": {
+                    "description": "Название устройства для списка сессий",
                     "type": "string",
-                    "enum": [
-                        "BAD_REQUEST"
-                    ]
-                },
-                "fields": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/docsResponse.authErrorField"
-                    }
+                    "maxLength": 100,
+                    "example": "MacBook, Chrome"
                 },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": false
+                "email": {
+                    "type": "string"
                 },
-                "message": {
+                "password": {
                     "type": "string",
-                    "example": "Bad request or validation error"
+                    "maxLength": 255,
+                    "minLength": 8
                 }
             }
         },
-        "docsResponse.DashboardUserCreate201": {
+        "dto.DashboardPasswordResetDTO": {
             "type": "object",
+            "required": [
+                "password",
+                "token"
+            ],
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_category_dto.ResponseDTO"
+                "password": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 8
                 },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": true
+                "token": {
+                    "description": "Токен из письма",
+                    "type": "string"
                 }
             }
         },
-        "docsResponse.DashboardUserCreate400": {
+        "dto.DashboardPasswordResetRequestDTO": {
             "type": "object",
+            "required": [
+                "email"
+            ],
             "properties": {
-                "errorCode": {
+                "email": {
                     "type": "string",
-                    "enum": [
-                        "BAD_REQUEST"
-                    ]
+                    "example": "manager@example.com"
+                }
+            }
+        },
+        "dto.EvaluationDTO": {
+            "type": "object",
+            "properties": {
+                "applied": {
+                    "type": "boolean",
+                    "example": true
                 },
-                "fields": {
+                "code": {
+                    "type": "string",
+                    "example": "SPRING10"
+                },
+                "discount": {
+                    "type": "integer",
+                    "example": 25800
+                },
+                "lines": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/docsResponse.dashboardUserErrorField"
+                        "$ref": "#/definitions/dto.LineEvaluationDTO"
                     }
                 },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": false
-                },
-                "message": {
+                "reason": {
                     "type": "string",
-                    "example": "Bad request or validation error"
+                    "example": "PROMO_CODE_EXPIRED"
+                },
+                "subtotal": {
+                    "type": "integer",
+                    "example": 258000
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 232200
                 }
             }
         },
-        "docsResponse.DesiredResultCreate201": {
+        "dto.HistoryResponseDTO": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_desired_result_dto.ResponseDTO"
+                "changedBy": {
+                    "type": "string",
+                    "example": "manager@example.com"
                 },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": true
+                "comment": {
+                    "type": "string",
+                    "example": "Подтверждено по телефону"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2023-10-01T12:00:00Z"
+                },
+                "fromStatus": {
+                    "type": "string",
+                    "example": "new"
+                },
+                "toStatus": {
+                    "type": "string",
+                    "example": "confirmed"
                 }
             }
         },
-        "docsResponse.DesiredResultCreate400": {
+        "dto.JobDTO": {
             "type": "object",
             "properties": {
-                "errorCode": {
+                "lastRun": {
+                    "$ref": "#/definitions/dto.RunDTO"
+                },
+                "name": {
                     "type": "string",
-                    "enum": [
-                        "BAD_REQUEST"
-                    ]
+                    "example": "PurgeTrash"
                 },
-                "fields": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/docsResponse.categoryErrorField"
-                    }
+                "nextRun": {
+                    "type": "string",
+                    "example": "2023-10-02T04:00:00Z"
                 },
-                "isSuccess": {
+                "running": {
                     "type": "boolean",
                     "example": false
                 },
-                "message": {
+                "schedule": {
                     "type": "string",
-                    "example": "Bad request or validation error"
+                    "example": "0 4 * * *"
                 }
             }
         },
-        "docsResponse.DesiredResultDelete200": {
+        "dto.LineEvaluationDTO": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_desired_result_dto.ResponseDTO"
+                "discount": {
+                    "type": "integer",
+                    "example": 25800
                 },
-                "isSuccess": {
+                "eligible": {
                     "type": "boolean",
                     "example": true
+                },
+                "productVariantId": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "reason": {
+                    "description": "Why the line is not discounted",
+                    "type": "string",
+                    "example": "CATEGORY_NOT_ELIGIBLE"
+                },
+                "subtotal": {
+                    "type": "integer",
+                    "example": 258000
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 232200
                 }
             }
         },
-        "docsResponse.DesiredResultGetById200": {
+        "dto.MergeDTO": {
             "type": "object",
+            "required": [
+                "cartToken"
+            ],
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_desired_result_dto.ResponseDTO"
-                },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": true
+                "cartToken": {
+                    "type": "string",
+                    "maxLength": 64
                 }
             }
         },
-        "docsResponse.DesiredResultList200": {
+        "dto.MoveDTO": {
             "type": "object",
             "properties": {
-                "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_desired_result_dto.ResponseDTO"
-                    }
+                "parentId": {
+                    "description": "null переносит категорию в корень",
+                    "type": "integer"
                 },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": true
+                "sortIndex": {
+                    "type": "integer",
+                    "maximum": 9999,
+                    "minimum": 0
                 }
             }
         },
-        "docsResponse.DesiredResultUpdate200": {
+        "dto.MovementResponseDTO": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_desired_result_dto.ResponseDTO"
+                "createdAt": {
+                    "type": "string",
+                    "example": "2023-10-01T12:00:00Z"
                 },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": true
+                "createdBy": {
+                    "type": "string",
+                    "example": "admin@example.com"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "productVariantId": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "quantityDelta": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "Поступление на склад"
+                },
+                "reference": {
+                    "type": "string",
+                    "example": "order-15"
+                },
+                "reservedDelta": {
+                    "type": "integer",
+                    "example": 0
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "adjustment",
+                        "reserve",
+                        "release",
+                        "commit"
+                    ]
                 }
             }
         },
-        "docsResponse.DesiredResultUpdate400": {
+        "dto.PayDTO": {
             "type": "object",
             "properties": {
-                "errorCode": {
+                "customerPhone": {
+                    "description": "Телефон из формы заказа: подтверждает, что гостевой заказ оплачивает его автор",
                     "type": "string",
-                    "enum": [
-                        "BAD_REQUEST"
-                    ]
+                    "example": "+79991234567"
+                }
+            }
+        },
+        "dto.PromoCodeDTO": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "maxLength": 64,
+                    "example": "SPRING10"
+                }
+            }
+        },
+        "dto.QueueJobDTO": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer",
+                    "example": 8
                 },
-                "fields": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/docsResponse.categoryErrorField"
-                    }
+                "createdAt": {
+                    "type": "string",
+                    "example": "2023-10-01T12:00:00Z"
                 },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": false
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "lastError": {
+                    "type": "string",
+                    "example": "file image_1a2b.png not found in temporary storage"
+                },
+                "maxAttempts": {
+                    "type": "integer",
+                    "example": 8
+                },
+                "payload": {
+                    "type": "string",
+                    "example": "{\"filenames\":[\"image_1a2b.png\"],\"folder\":\"images/category\"}"
+                },
+                "runAt": {
+                    "type": "string",
+                    "example": "2023-10-01T13:00:00Z"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "running",
+                        "dead"
+                    ],
+                    "example": "dead"
                 },
-                "message": {
+                "type": {
                     "type": "string",
-                    "example": "Bad request or validation error"
-                }
-            }
-        },
-        "docsResponse.ImageUpload200": {
-            "type": "object",
-            "properties": {
-                "data": {
-                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_image_dto.ResponseDTO"
+                    "example": "files.move"
                 },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": true
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2023-10-01T13:05:00Z"
                 }
             }
         },
-        "docsResponse.ImageUpload400": {
+        "dto.RecoveryCodesResponseDTO": {
             "type": "object",
             "properties": {
-                "errorCode": {
-                    "type": "string",
-                    "enum": [
-                        "BAD_REQUEST"
-                    ]
-                },
-                "fields": {
+                "recoveryCodes": {
+                    "description": "Показываются один раз",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/docsResponse.imageErrorField"
+                        "type": "string"
                     }
-                },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": false
-                },
-                "message": {
-                    "type": "string",
-                    "example": "Bad request or validation error"
                 }
             }
         },
-        "docsResponse.LineCreate201": {
+        "dto.RefreshTokenDTO": {
             "type": "object",
+            "required": [
+                "refreshToken"
+            ],
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_line_dto.ResponseDTO"
-                },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": true
+                "refreshToken": {
+                    "type": "string"
                 }
             }
         },
-        "docsResponse.LineCreate400": {
+        "dto.RunDTO": {
             "type": "object",
             "properties": {
-                "errorCode": {
+                "durationMs": {
+                    "type": "integer",
+                    "example": 2150
+                },
+                "error": {
                     "type": "string",
-                    "enum": [
-                        "BAD_REQUEST"
-                    ]
+                    "example": "failed to purge shades: connection refused"
                 },
-                "fields": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/docsResponse.categoryErrorField"
-                    }
+                "finishedAt": {
+                    "type": "string",
+                    "example": "2023-10-01T04:00:02Z"
                 },
-                "isSuccess": {
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "instance": {
+                    "type": "string",
+                    "example": "api-7f9c-1-a1b2c3d4"
+                },
+                "jobName": {
+                    "type": "string",
+                    "example": "PurgeTrash"
+                },
+                "panicked": {
                     "type": "boolean",
                     "example": false
                 },
-                "message": {
+                "startedAt": {
                     "type": "string",
-                    "example": "Bad request or validation error"
+                    "example": "2023-10-01T04:00:00Z"
+                },
+                "trigger": {
+                    "type": "string",
+                    "enum": [
+                        "schedule",
+                        "manual"
+                    ],
+                    "example": "schedule"
                 }
             }
         },
-        "docsResponse.LineDelete200": {
+        "dto.SessionResponseDTO": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_line_dto.ResponseDTO"
+                "createdAt": {
+                    "type": "string"
                 },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": true
+                "current": {
+                    "description": "Сессия, из которой сделан запрос",
+                    "type": "boolean"
+                },
+                "device": {
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "ip": {
+                    "type": "string"
+                },
+                "lastUsedAt": {
+                    "description": "Время последнего обновления токенов",
+                    "type": "string"
+                },
+                "userAgent": {
+                    "type": "string"
                 }
             }
         },
-        "docsResponse.LineGetById200": {
+        "dto.StockResponseDTO": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_line_dto.ResponseDTO"
+                "available": {
+                    "type": "integer",
+                    "example": 22
                 },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": true
+                "productVariantId": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 25
+                },
+                "reserved": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2023-10-01T12:00:00Z"
                 }
             }
         },
-        "docsResponse.LineList200": {
+        "dto.TreeDTO": {
             "type": "object",
             "properties": {
-                "data": {
+                "children": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_line_dto.ResponseDTO"
+                        "$ref": "#/definitions/dto.TreeDTO"
                     }
                 },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": true
-                }
-            }
-        },
-        "docsResponse.LineUpdate200": {
-            "type": "object",
-            "properties": {
-                "data": {
-                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_line_dto.ResponseDTO"
+                "createdAt": {
+                    "type": "string"
                 },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": true
+                "deletedAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "headerImage": {
+                    "type": "string"
+                },
+                "headerImageUrls": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_image_dto.ResponseDTO"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "imageUrls": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_image_dto.ResponseDTO"
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "isShade": {
+                    "type": "boolean"
+                },
+                "isVisibleInMenu": {
+                    "type": "boolean"
+                },
+                "isVisibleOnMain": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parentId": {
+                    "type": "integer"
+                },
+                "seoDescription": {
+                    "type": "string"
+                },
+                "seoKeys": {
+                    "type": "string"
+                },
+                "seoTitle": {
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "sortIndex": {
+                    "type": "integer"
+                },
+                "updatedAt": {
+                    "type": "string"
                 }
             }
         },
-        "docsResponse.LineUpdate400": {
+        "dto.TwoFactorCodeDTO": {
             "type": "object",
+            "required": [
+                "code"
+            ],
             "properties": {
-                "errorCode": {
-                    "type": "string",
-                    "enum": [
-                        "BAD_REQUEST"
-                    ]
-                },
-                "fields": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/docsResponse.categoryErrorField"
-                    }
-                },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": false
-                },
-                "message": {
+                "code": {
+                    "description": "Код из приложения или код восстановления",
                     "type": "string",
-                    "example": "Bad request or validation error"
+                    "maxLength": 32,
+                    "example": "123456"
                 }
             }
         },
-        "docsResponse.ProductTypeCreate201": {
+        "dto.TwoFactorDisableDTO": {
             "type": "object",
+            "required": [
+                "code",
+                "password"
+            ],
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_product_type_dto.ResponseDTO"
+                "code": {
+                    "description": "Код из приложения или код восстановления",
+                    "type": "string",
+                    "maxLength": 32,
+                    "example": "123456"
                 },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": true
+                "password": {
+                    "type": "string",
+                    "maxLength": 255
                 }
             }
         },
-        "docsResponse.ProductTypeCreate400": {
+        "dto.TwoFactorSetupResponseDTO": {
             "type": "object",
             "properties": {
-                "errorCode": {
-                    "type": "string",
-                    "enum": [
-                        "BAD_REQUEST"
-                    ]
-                },
-                "fields": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/docsResponse.categoryErrorField"
-                    }
+                "expiresAt": {
+                    "description": "Unix-время, до которого нужно подтвердить подключение",
+                    "type": "integer"
                 },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": false
+                "otpauthUri": {
+                    "description": "URI для QR-кода",
+                    "type": "string"
                 },
-                "message": {
-                    "type": "string",
-                    "example": "Bad request or validation error"
+                "secret": {
+                    "description": "Секрет в base32 для ручного ввода",
+                    "type": "string"
                 }
             }
         },
-        "docsResponse.ProductTypeDelete200": {
+        "dto.TwoFactorStatusResponseDTO": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_product_type_dto.ResponseDTO"
+                "enabled": {
+                    "type": "boolean"
                 },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": true
+                "recoveryCodesLeft": {
+                    "type": "integer"
                 }
             }
         },
-        "docsResponse.ProductTypeGetById200": {
+        "dto.TwoFactorVerifyDTO": {
             "type": "object",
+            "required": [
+                "challengeToken",
+                "code"
+            ],
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_product_type_dto.ResponseDTO"
+                "challengeToken": {
+                    "description": "Токен из ответа на вход по паролю",
+                    "type": "string"
                 },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": true
+                "code": {
+                    "description": "Код из приложения или код восстановления",
+                    "type": "string",
+                    "maxLength": 32,
+                    "example": "123456"
                 }
             }
         },
-        "docsResponse.ProductTypeList200": {
+        "dto.UpdateItemDTO": {
             "type": "object",
+            "required": [
+                "quantity"
+            ],
             "properties": {
-                "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_product_type_dto.ResponseDTO"
-                    }
-                },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": true
+                "quantity": {
+                    "type": "integer",
+                    "maximum": 999,
+                    "example": 3
                 }
             }
         },
-        "docsResponse.ProductTypeUpdate200": {
+        "dto.VariantDTO": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_product_type_dto.ResponseDTO"
+                "height": {
+                    "type": "integer",
+                    "example": 400
                 },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": true
+                "name": {
+                    "type": "string",
+                    "example": "thumb"
+                },
+                "url": {
+                    "type": "string",
+                    "example": "/uploads/images/category/photo_1a2b3c4d5e6f7a8b@thumb.png"
+                },
+                "webp": {
+                    "type": "string",
+                    "example": "/uploads/images/category/photo_1a2b3c4d5e6f7a8b@thumb.webp"
+                },
+                "width": {
+                    "type": "integer",
+                    "example": 400
                 }
             }
         },
-        "docsResponse.ProductTypeUpdate400": {
+        "haircompany-shop-rest_internal_modules_v1_auth_dto.ResponseDTO": {
             "type": "object",
             "properties": {
-                "errorCode": {
-                    "type": "string",
-                    "enum": [
-                        "BAD_REQUEST"
-                    ]
+                "challengeExpiresAt": {
+                    "type": "integer"
                 },
-                "fields": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/docsResponse.categoryErrorField"
-                    }
+                "challengeToken": {
+                    "type": "string"
                 },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": false
+                "refreshExpiresAt": {
+                    "type": "integer"
                 },
-                "message": {
-                    "type": "string",
-                    "example": "Bad request or validation error"
+                "refreshToken": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                },
+                "twoFactorRequired": {
+                    "description": "Заполняются вместо токенов, если для входа нужен второй фактор",
+                    "type": "boolean"
                 }
             }
         },
-        "docsResponse.Response400": {
+        "haircompany-shop-rest_internal_modules_v1_cart_dto.ItemResponseDTO": {
             "type": "object",
             "properties": {
-                "errorCode": {
+                "article": {
                     "type": "string",
-                    "enum": [
-                        "BAD_REQUEST"
-                    ]
+                    "example": "HC-1000-250"
                 },
-                "isSuccess": {
+                "available": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "isActive": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "price": {
+                    "description": "Current price in kopecks",
+                    "type": "integer",
+                    "example": 129000
+                },
+                "priceAtAdd": {
+                    "description": "Price in kopecks when the line was added",
+                    "type": "integer",
+                    "example": 119000
+                },
+                "priceChanged": {
+                    "description": "Price differs from priceAtAdd",
+                    "type": "boolean",
+                    "example": true
+                },
+                "productId": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "productVariantId": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "stockChanged": {
+                    "description": "Not enough stock left for the requested quantity",
                     "type": "boolean",
                     "example": false
                 },
-                "message": {
-                    "type": "string",
-                    "example": "Bad request or validation error"
+                "subtotal": {
+                    "type": "integer",
+                    "example": 258000
                 }
             }
         },
-        "docsResponse.Response401": {
+        "haircompany-shop-rest_internal_modules_v1_cart_dto.ResponseDTO": {
             "type": "object",
             "properties": {
-                "errorCode": {
-                    "type": "string",
-                    "enum": [
-                        "UNAUTHORIZED"
-                    ]
+                "discount": {
+                    "type": "integer",
+                    "example": 25800
                 },
-                "isSuccess": {
+                "hasChanges": {
                     "type": "boolean",
-                    "example": false
+                    "example": true
                 },
-                "message": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_cart_dto.ItemResponseDTO"
+                    }
+                },
+                "promoCode": {
+                    "description": "Breakdown of the applied code, null if none",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.EvaluationDTO"
+                        }
+                    ]
+                },
+                "token": {
+                    "description": "Guest cart token, pass it back in X-Cart-Token",
                     "type": "string",
-                    "example": "Unauthorized"
+                    "example": "3f2a9c..."
+                },
+                "total": {
+                    "description": "Total in kopecks at current prices",
+                    "type": "integer",
+                    "example": 258000
+                },
+                "totalQuantity": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "totalToPay": {
+                    "description": "Total minus the promo code discount",
+                    "type": "integer",
+                    "example": 232200
                 }
             }
         },
-        "docsResponse.Response403": {
+        "haircompany-shop-rest_internal_modules_v1_category_dto.CreateDTO": {
             "type": "object",
+            "required": [
+                "headerImage",
+                "image",
+                "name",
+                "slug",
+                "sortIndex"
+            ],
             "properties": {
-                "errorCode": {
+                "description": {
+                    "type": "string"
+                },
+                "headerImage": {
                     "type": "string",
-                    "enum": [
-                        "FORBIDDEN"
-                    ]
+                    "maxLength": 255
                 },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": false
+                "image": {
+                    "type": "string",
+                    "maxLength": 255
                 },
-                "message": {
+                "isActive": {
+                    "type": "boolean"
+                },
+                "isShade": {
+                    "type": "boolean"
+                },
+                "isVisibleInMenu": {
+                    "type": "boolean"
+                },
+                "isVisibleOnMain": {
+                    "type": "boolean"
+                },
+                "name": {
                     "type": "string",
-                    "example": "Forbidden"
+                    "maxLength": 255,
+                    "minLength": 3
+                },
+                "parentId": {
+                    "description": "Nullable, can be zero",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "seoDescription": {
+                    "type": "string"
+                },
+                "seoKeys": {
+                    "type": "string"
+                },
+                "seoTitle": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "slug": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 3
+                },
+                "sortIndex": {
+                    "type": "integer",
+                    "maximum": 9999,
+                    "minimum": 0
                 }
             }
         },
-        "docsResponse.Response404": {
+        "haircompany-shop-rest_internal_modules_v1_category_dto.RedirectDTO": {
             "type": "object",
             "properties": {
-                "errorCode": {
-                    "type": "string",
-                    "enum": [
-                        "NOT_FOUND"
-                    ]
-                },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": false
-                },
-                "message": {
-                    "type": "string",
-                    "example": "Resource not found"
+                "slug": {
+                    "type": "string"
                 }
             }
         },
-        "docsResponse.Response409": {
+        "haircompany-shop-rest_internal_modules_v1_category_dto.ResponseDTO": {
             "type": "object",
             "properties": {
-                "errorCode": {
-                    "type": "string",
-                    "enum": [
-                        "HAS_LINKED_ENTITIES"
-                    ]
+                "createdAt": {
+                    "type": "string"
+                },
+                "deletedAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "headerImage": {
+                    "type": "string"
+                },
+                "headerImageUrls": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_image_dto.ResponseDTO"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "imageUrls": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_image_dto.ResponseDTO"
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "isShade": {
+                    "type": "boolean"
+                },
+                "isVisibleInMenu": {
+                    "type": "boolean"
+                },
+                "isVisibleOnMain": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parentId": {
+                    "type": "integer"
+                },
+                "seoDescription": {
+                    "type": "string"
+                },
+                "seoKeys": {
+                    "type": "string"
+                },
+                "seoTitle": {
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string"
                 },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": false
+                "sortIndex": {
+                    "type": "integer"
                 },
-                "message": {
-                    "type": "string",
-                    "example": "Conflict"
+                "updatedAt": {
+                    "type": "string"
                 }
             }
         },
-        "docsResponse.Response413": {
+        "haircompany-shop-rest_internal_modules_v1_category_dto.UpdateDTO": {
             "type": "object",
             "properties": {
-                "errorCode": {
+                "description": {
+                    "type": "string"
+                },
+                "headerImage": {
+                    "type": "string"
+                },
+                "image": {
+                    "type": "string"
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "isShade": {
+                    "type": "boolean"
+                },
+                "isVisibleInMenu": {
+                    "type": "boolean"
+                },
+                "isVisibleOnMain": {
+                    "type": "boolean"
+                },
+                "name": {
                     "type": "string",
-                    "enum": [
-                        "REQUEST_TOO_LARGE"
-                    ]
+                    "maxLength": 255,
+                    "minLength": 3
                 },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": false
+                "parentId": {
+                    "type": "integer"
                 },
-                "message": {
+                "seoDescription": {
+                    "type": "string"
+                },
+                "seoKeys": {
+                    "type": "string"
+                },
+                "seoTitle": {
+                    "type": "string"
+                },
+                "slug": {
                     "type": "string",
-                    "example": "Request entity too large"
+                    "maxLength": 255,
+                    "minLength": 3
+                },
+                "sortIndex": {
+                    "type": "integer",
+                    "minimum": 0
                 }
             }
         },
-        "docsResponse.Response500": {
+        "haircompany-shop-rest_internal_modules_v1_dashboard_user_dto.CreateDTO": {
             "type": "object",
+            "required": [
+                "email",
+                "password",
+                "role"
+            ],
             "properties": {
-                "errorCode": {
-                    "type": "string",
-                    "enum": [
-                        "SERVER_ERROR"
-                    ]
+                "email": {
+                    "type": "string"
                 },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": false
+                "password": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 8
                 },
-                "message": {
+                "role": {
                     "type": "string",
-                    "example": "Internal server error"
+                    "enum": [
+                        "admin",
+                        "manager"
+                    ]
                 }
             }
         },
-        "docsResponse.ShadeCreate201": {
+        "haircompany-shop-rest_internal_modules_v1_dashboard_user_dto.ResponseDTO": {
             "type": "object",
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_shade_dto.ResponseDTO"
+                "createdAt": {
+                    "type": "string"
                 },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": true
-                }
-            }
-        },
-        "docsResponse.ShadeCreate400": {
-            "type": "object",
-            "properties": {
-                "errorCode": {
-                    "type": "string",
-                    "enum": [
-                        "BAD_REQUEST"
-                    ]
+                "email": {
+                    "type": "string"
                 },
-                "fields": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/docsResponse.categoryErrorField"
-                    }
+                "id": {
+                    "type": "integer"
                 },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": false
+                "isActive": {
+                    "type": "boolean"
                 },
-                "message": {
-                    "type": "string",
-                    "example": "Bad request or validation error"
-                }
-            }
-        },
-        "docsResponse.ShadeDelete200": {
-            "type": "object",
-            "properties": {
-                "data": {
-                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_shade_dto.ResponseDTO"
+                "role": {
+                    "type": "string"
                 },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": true
-                }
-            }
-        },
-        "docsResponse.ShadeGetById200": {
-            "type": "object",
-            "properties": {
-                "data": {
-                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_shade_dto.ResponseDTO"
+                "twoFactorEnabled": {
+                    "type": "boolean"
                 },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": true
+                "updatedAt": {
+                    "type": "string"
                 }
             }
         },
-        "docsResponse.ShadeList200": {
+        "haircompany-shop-rest_internal_modules_v1_dashboard_user_dto.UpdateDTO": {
             "type": "object",
             "properties": {
-                "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_shade_dto.ResponseDTO"
-                    }
-                },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": true
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "admin",
+                        "manager"
+                    ]
                 }
             }
         },
-        "docsResponse.ShadeUpdate200": {
+        "haircompany-shop-rest_internal_modules_v1_desired_result_dto.CreateDTO": {
             "type": "object",
+            "required": [
+                "name"
+            ],
             "properties": {
-                "data": {
-                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_shade_dto.ResponseDTO"
-                },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": true
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 3
                 }
             }
         },
-        "docsResponse.ShadeUpdate400": {
+        "haircompany-shop-rest_internal_modules_v1_desired_result_dto.ResponseDTO": {
             "type": "object",
             "properties": {
-                "errorCode": {
+                "createdAt": {
                     "type": "string",
-                    "enum": [
-                        "BAD_REQUEST"
-                    ]
+                    "example": "2023-10-01T12:00:00Z"
                 },
-                "fields": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/docsResponse.categoryErrorField"
-                    }
+                "deletedAt": {
+                    "type": "string",
+                    "example": "2023-10-02T12:00:00Z"
                 },
-                "isSuccess": {
-                    "type": "boolean",
-                    "example": false
+                "id": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "message": {
+                "name": {
                     "type": "string",
-                    "example": "Bad request or validation error"
+                    "example": "Product type Name"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2023-10-01T12:00:00Z"
                 }
             }
         },
-        "docsResponse.authErrorField": {
+        "haircompany-shop-rest_internal_modules_v1_desired_result_dto.UpdateDTO": {
             "type": "object",
             "properties": {
-                "errorCode": {
-                    "type": "string",
-                    "enum": [
-                        "REQUIRED",
-                        "INVALID_EMAIL",
-                        "MIN_LENGTH",
-                        "MAX_LENGTH",
-                        "INVALID_TOKEN"
-                    ]
-                },
-                "field": {
+                "name": {
                     "type": "string",
-                    "enum": [
-                        "email",
-                        "password",
-                        "refreshToken"
-                    ]
+                    "maxLength": 255,
+                    "minLength": 3
                 }
             }
         },
-        "docsResponse.categoryErrorField": {
+        "haircompany-shop-rest_internal_modules_v1_image_dto.ResponseDTO": {
             "type": "object",
             "properties": {
-                "errorCode": {
+                "image": {
                     "type": "string",
-                    "enum": [
-                        "NOT_UNIQUE",
-                        "NOT_FOUND"
-                    ]
+                    "example": "photo_1a2b3c4d5e6f7a8b.png"
                 },
-                "field": {
+                "url": {
                     "type": "string",
-                    "enum": [
-                        "name",
-                        "slug",
-                        "parentId"
-                    ]
+                    "example": "/uploads/images/category/photo_1a2b3c4d5e6f7a8b.png"
+                },
+                "variants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.VariantDTO"
+                    }
+                },
+                "webp": {
+                    "type": "string",
+                    "example": "/uploads/images/category/photo_1a2b3c4d5e6f7a8b.webp"
                 }
             }
         },
-        "docsResponse.dashboardUserErrorField": {
+        "haircompany-shop-rest_internal_modules_v1_line_dto.CreateDTO": {
             "type": "object",
+            "required": [
+                "color",
+                "name"
+            ],
             "properties": {
-                "errorCode": {
+                "color": {
                     "type": "string",
-                    "enum": [
-                        "NOT_UNIQUE",
-                        "NOT_FOUND"
-                    ]
+                    "example": "#FF5733"
                 },
-                "field": {
+                "name": {
                     "type": "string",
-                    "enum": [
-                        "name",
-                        "slug",
-                        "parentId"
-                    ]
+                    "maxLength": 255,
+                    "minLength": 3
                 }
             }
         },
-        "docsResponse.imageErrorField": {
+        "haircompany-shop-rest_internal_modules_v1_line_dto.ResponseDTO": {
             "type": "object",
             "properties": {
-                "errorCode": {
+                "color": {
                     "type": "string",
-                    "example": "INVALID_FORMAT"
+                    "example": "#FF5733"
                 },
-                "field": {
+                "createdAt": {
+                    "type": "string",
+                    "example": "2023-10-01T12:00:00Z"
+                },
+                "deletedAt": {
                     "type": "string",
-                    "example": "images"
+                    "example": "2023-10-02T12:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Line Name"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2023-10-01T12:00:00Z"
                 }
             }
         },
-        "dto.DashboardLoginDTO": {
+        "haircompany-shop-rest_internal_modules_v1_line_dto.UpdateDTO": {
             "type": "object",
-            "required": [
-                "email",
-                "password"
-            ],
             "properties": {
-                "email": {
+                "color": {
                     "type": "string"
                 },
-                "password": {
+                "name": {
                     "type": "string",
                     "maxLength": 255,
-                    "minLength": 8
+                    "minLength": 3
                 }
             }
         },
-        "dto.RefreshTokenDTO": {
+        "haircompany-shop-rest_internal_modules_v1_order_dto.ItemResponseDTO": {
             "type": "object",
-            "required": [
-                "refreshToken"
-            ],
             "properties": {
-                "refreshToken": {
-                    "type": "string"
+                "article": {
+                    "type": "string",
+                    "example": "HC-1000-250"
+                },
+                "discount": {
+                    "type": "integer",
+                    "example": 25800
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "price": {
+                    "type": "integer",
+                    "example": 129000
+                },
+                "productName": {
+                    "type": "string",
+                    "example": "Шампунь для окрашенных волос"
+                },
+                "productVariantId": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "subtotal": {
+                    "type": "integer",
+                    "example": 258000
                 }
             }
         },
-        "haircompany-shop-rest_internal_modules_v1_auth_dto.ResponseDTO": {
+        "haircompany-shop-rest_internal_modules_v1_order_dto.ResponseDTO": {
             "type": "object",
             "properties": {
-                "refreshExpiresAt": {
-                    "type": "integer"
+                "clientUserId": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "refreshToken": {
+                "comment": {
                     "type": "string"
                 },
-                "token": {
-                    "type": "string"
+                "createdAt": {
+                    "type": "string",
+                    "example": "2023-10-01T12:00:00Z"
+                },
+                "customerEmail": {
+                    "type": "string",
+                    "example": "anna@example.com"
+                },
+                "customerName": {
+                    "type": "string",
+                    "example": "Анна Иванова"
+                },
+                "customerPhone": {
+                    "type": "string",
+                    "example": "+79991234567"
+                },
+                "deliveryAddress": {
+                    "type": "string",
+                    "example": "Москва, ул. Тверская, 1"
+                },
+                "deliveryMethod": {
+                    "type": "string",
+                    "example": "courier"
+                },
+                "discount": {
+                    "type": "integer",
+                    "example": 25800
+                },
+                "history": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.HistoryResponseDTO"
+                    }
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_order_dto.ItemResponseDTO"
+                    }
+                },
+                "itemsCount": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "paymentStatus": {
+                    "type": "string",
+                    "example": "unpaid"
+                },
+                "promoCode": {
+                    "type": "string",
+                    "example": "SPRING10"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "new"
+                },
+                "subtotal": {
+                    "type": "integer",
+                    "example": 258000
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 232200
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2023-10-01T12:00:00Z"
                 }
             }
         },
-        "haircompany-shop-rest_internal_modules_v1_category_dto.CreateDTO": {
+        "haircompany-shop-rest_internal_modules_v1_payment_dto.ResponseDTO": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer",
+                    "example": 258000
+                },
+                "confirmationUrl": {
+                    "type": "string",
+                    "example": "https://fake-payments.local/pay/fake_9f86d081884c7d65"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2023-10-01T12:00:00Z"
+                },
+                "externalId": {
+                    "type": "string",
+                    "example": "fake_9f86d081884c7d65"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "orderId": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "provider": {
+                    "type": "string",
+                    "example": "fake"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "pending"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2023-10-01T12:00:00Z"
+                }
+            }
+        },
+        "haircompany-shop-rest_internal_modules_v1_product_dto.CreateDTO": {
             "type": "object",
             "required": [
-                "headerImage",
+                "categoryId",
                 "image",
+                "lineId",
                 "name",
+                "productTypeId",
                 "slug",
                 "sortIndex"
             ],
             "properties": {
+                "application": {
+                    "type": "string"
+                },
+                "categoryId": {
+                    "type": "integer"
+                },
+                "composition": {
+                    "type": "string"
+                },
                 "description": {
                     "type": "string"
                 },
-                "headerImage": {
-                    "type": "string",
-                    "maxLength": 255
+                "desiredResultIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
                 },
                 "image": {
                     "type": "string",
@@ -2815,24 +11236,16 @@ const docTemplate = `{
                 "isActive": {
                     "type": "boolean"
                 },
-                "isShade": {
-                    "type": "boolean"
-                },
-                "isVisibleInMenu": {
-                    "type": "boolean"
-                },
-                "isVisibleOnMain": {
-                    "type": "boolean"
+                "lineId": {
+                    "type": "integer"
                 },
                 "name": {
                     "type": "string",
                     "maxLength": 255,
                     "minLength": 3
                 },
-                "parentId": {
-                    "description": "Nullable, can be zero",
-                    "type": "integer",
-                    "minimum": 0
+                "productTypeId": {
+                    "type": "integer"
                 },
                 "seoDescription": {
                     "type": "string"
@@ -2844,6 +11257,12 @@ const docTemplate = `{
                     "type": "string",
                     "maxLength": 255
                 },
+                "shadeIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
                 "slug": {
                     "type": "string",
                     "maxLength": 255,
@@ -2856,17 +11275,37 @@ const docTemplate = `{
                 }
             }
         },
-        "haircompany-shop-rest_internal_modules_v1_category_dto.ResponseDTO": {
+        "haircompany-shop-rest_internal_modules_v1_product_dto.RedirectDTO": {
+            "type": "object",
+            "properties": {
+                "slug": {
+                    "type": "string"
+                }
+            }
+        },
+        "haircompany-shop-rest_internal_modules_v1_product_dto.ResponseDTO": {
             "type": "object",
             "properties": {
+                "application": {
+                    "type": "string"
+                },
+                "categoryId": {
+                    "type": "integer"
+                },
+                "composition": {
+                    "type": "string"
+                },
                 "createdAt": {
                     "type": "string"
                 },
                 "description": {
                     "type": "string"
                 },
-                "headerImage": {
-                    "type": "string"
+                "desiredResults": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_desired_result_dto.ResponseDTO"
+                    }
                 },
                 "id": {
                     "type": "integer"
@@ -2877,19 +11316,13 @@ const docTemplate = `{
                 "isActive": {
                     "type": "boolean"
                 },
-                "isShade": {
-                    "type": "boolean"
-                },
-                "isVisibleInMenu": {
-                    "type": "boolean"
-                },
-                "isVisibleOnMain": {
-                    "type": "boolean"
+                "lineId": {
+                    "type": "integer"
                 },
                 "name": {
                     "type": "string"
                 },
-                "parentId": {
+                "productTypeId": {
                     "type": "integer"
                 },
                 "seoDescription": {
@@ -2901,6 +11334,12 @@ const docTemplate = `{
                 "seoTitle": {
                     "type": "string"
                 },
+                "shades": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_shade_dto.ResponseDTO"
+                    }
+                },
                 "slug": {
                     "type": "string"
                 },
@@ -2909,39 +11348,51 @@ const docTemplate = `{
                 },
                 "updatedAt": {
                     "type": "string"
+                },
+                "variants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_product_variant_dto.ResponseDTO"
+                    }
                 }
             }
         },
-        "haircompany-shop-rest_internal_modules_v1_category_dto.UpdateDTO": {
+        "haircompany-shop-rest_internal_modules_v1_product_dto.UpdateDTO": {
             "type": "object",
             "properties": {
-                "description": {
+                "application": {
                     "type": "string"
                 },
-                "headerImage": {
+                "categoryId": {
+                    "type": "integer"
+                },
+                "composition": {
                     "type": "string"
                 },
-                "image": {
+                "description": {
                     "type": "string"
                 },
-                "isActive": {
-                    "type": "boolean"
+                "desiredResultIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
                 },
-                "isShade": {
-                    "type": "boolean"
+                "image": {
+                    "type": "string"
                 },
-                "isVisibleInMenu": {
+                "isActive": {
                     "type": "boolean"
                 },
-                "isVisibleOnMain": {
-                    "type": "boolean"
+                "lineId": {
+                    "type": "integer"
                 },
                 "name": {
                     "type": "string",
                     "maxLength": 255,
                     "minLength": 3
                 },
-                "parentId": {
+                "productTypeId": {
                     "type": "integer"
                 },
                 "seoDescription": {
@@ -2953,6 +11404,12 @@ const docTemplate = `{
                 "seoTitle": {
                     "type": "string"
                 },
+                "shadeIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
                 "slug": {
                     "type": "string",
                     "maxLength": 255,
@@ -2964,32 +11421,7 @@ const docTemplate = `{
                 }
             }
         },
-        "haircompany-shop-rest_internal_modules_v1_dashboard_user_dto.CreateDTO": {
-            "type": "object",
-            "required": [
-                "email",
-                "password",
-                "role"
-            ],
-            "properties": {
-                "email": {
-                    "type": "string"
-                },
-                "password": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 8
-                },
-                "role": {
-                    "type": "string",
-                    "enum": [
-                        "admin",
-                        "manager"
-                    ]
-                }
-            }
-        },
-        "haircompany-shop-rest_internal_modules_v1_desired_result_dto.CreateDTO": {
+        "haircompany-shop-rest_internal_modules_v1_product_type_dto.CreateDTO": {
             "type": "object",
             "required": [
                 "name"
@@ -3002,13 +11434,17 @@ const docTemplate = `{
                 }
             }
         },
-        "haircompany-shop-rest_internal_modules_v1_desired_result_dto.ResponseDTO": {
+        "haircompany-shop-rest_internal_modules_v1_product_type_dto.ResponseDTO": {
             "type": "object",
             "properties": {
                 "createdAt": {
                     "type": "string",
                     "example": "2023-10-01T12:00:00Z"
                 },
+                "deletedAt": {
+                    "type": "string",
+                    "example": "2023-10-02T12:00:00Z"
+                },
                 "id": {
                     "type": "integer",
                     "example": 1
@@ -3023,7 +11459,7 @@ const docTemplate = `{
                 }
             }
         },
-        "haircompany-shop-rest_internal_modules_v1_desired_result_dto.UpdateDTO": {
+        "haircompany-shop-rest_internal_modules_v1_product_type_dto.UpdateDTO": {
             "type": "object",
             "properties": {
                 "name": {
@@ -3033,38 +11469,60 @@ const docTemplate = `{
                 }
             }
         },
-        "haircompany-shop-rest_internal_modules_v1_image_dto.ResponseDTO": {
-            "type": "object",
-            "properties": {
-                "image": {
-                    "type": "string"
-                }
-            }
-        },
-        "haircompany-shop-rest_internal_modules_v1_line_dto.CreateDTO": {
+        "haircompany-shop-rest_internal_modules_v1_product_variant_dto.CreateDTO": {
             "type": "object",
             "required": [
-                "color",
-                "name"
+                "article",
+                "barcode",
+                "price",
+                "sortIndex",
+                "volume"
             ],
             "properties": {
-                "color": {
+                "article": {
                     "type": "string",
-                    "example": "#FF5733"
+                    "maxLength": 64,
+                    "minLength": 1
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 3
+                "barcode": {
+                    "type": "string"
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "oldPrice": {
+                    "type": "integer"
+                },
+                "price": {
+                    "type": "integer"
+                },
+                "shadeId": {
+                    "type": "integer"
+                },
+                "sortIndex": {
+                    "type": "integer",
+                    "maximum": 9999,
+                    "minimum": 0
+                },
+                "volume": {
+                    "type": "integer"
+                },
+                "weight": {
+                    "type": "integer",
+                    "minimum": 0
                 }
             }
         },
-        "haircompany-shop-rest_internal_modules_v1_line_dto.ResponseDTO": {
+        "haircompany-shop-rest_internal_modules_v1_product_variant_dto.ResponseDTO": {
             "type": "object",
             "properties": {
-                "color": {
+                "article": {
                     "type": "string",
-                    "example": "#FF5733"
+                    "example": "HC-1000-250"
+                },
+                "barcode": {
+                    "type": "string",
+                    "example": "4607010590017"
                 },
                 "createdAt": {
                     "type": "string",
@@ -3074,70 +11532,294 @@ const docTemplate = `{
                     "type": "integer",
                     "example": 1
                 },
-                "name": {
-                    "type": "string",
-                    "example": "Line Name"
+                "isActive": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "oldPrice": {
+                    "type": "integer",
+                    "example": 149000
+                },
+                "price": {
+                    "type": "integer",
+                    "example": 129000
+                },
+                "productId": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "shadeId": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "sortIndex": {
+                    "type": "integer",
+                    "example": 100
                 },
                 "updatedAt": {
                     "type": "string",
                     "example": "2023-10-01T12:00:00Z"
+                },
+                "volume": {
+                    "type": "integer",
+                    "example": 250
+                },
+                "weight": {
+                    "type": "integer",
+                    "example": 280
                 }
             }
         },
-        "haircompany-shop-rest_internal_modules_v1_line_dto.UpdateDTO": {
+        "haircompany-shop-rest_internal_modules_v1_product_variant_dto.UpdateDTO": {
             "type": "object",
             "properties": {
-                "color": {
+                "article": {
+                    "type": "string",
+                    "maxLength": 64,
+                    "minLength": 1
+                },
+                "barcode": {
                     "type": "string"
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 3
+                "isActive": {
+                    "type": "boolean"
+                },
+                "oldPrice": {
+                    "type": "integer"
+                },
+                "price": {
+                    "type": "integer"
+                },
+                "shadeId": {
+                    "type": "integer"
+                },
+                "sortIndex": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "volume": {
+                    "type": "integer"
+                },
+                "weight": {
+                    "type": "integer",
+                    "minimum": 0
                 }
             }
         },
-        "haircompany-shop-rest_internal_modules_v1_product_type_dto.CreateDTO": {
+        "haircompany-shop-rest_internal_modules_v1_promo_code_dto.CreateDTO": {
             "type": "object",
             "required": [
-                "name"
+                "code",
+                "discountType",
+                "discountValue"
             ],
             "properties": {
-                "name": {
+                "categoryIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "code": {
                     "type": "string",
-                    "maxLength": 255,
-                    "minLength": 3
+                    "maxLength": 64,
+                    "minLength": 3,
+                    "example": "SPRING10"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "example": "Весенняя скидка"
+                },
+                "discountType": {
+                    "type": "string",
+                    "enum": [
+                        "percent",
+                        "fixed"
+                    ],
+                    "example": "percent"
+                },
+                "discountValue": {
+                    "description": "Percent for percent codes, kopecks for fixed ones",
+                    "type": "integer",
+                    "example": 10
+                },
+                "endsAt": {
+                    "type": "string",
+                    "example": "2024-04-01T00:00:00Z"
+                },
+                "isActive": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "lineIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "minOrderAmount": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 300000
+                },
+                "productTypeIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "startsAt": {
+                    "type": "string",
+                    "example": "2024-03-01T00:00:00Z"
+                },
+                "usageLimit": {
+                    "type": "integer",
+                    "example": 1000
+                },
+                "usageLimitPerClient": {
+                    "type": "integer",
+                    "example": 1
                 }
             }
         },
-        "haircompany-shop-rest_internal_modules_v1_product_type_dto.ResponseDTO": {
+        "haircompany-shop-rest_internal_modules_v1_promo_code_dto.ResponseDTO": {
             "type": "object",
             "properties": {
+                "categoryIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "code": {
+                    "type": "string",
+                    "example": "SPRING10"
+                },
                 "createdAt": {
                     "type": "string",
                     "example": "2023-10-01T12:00:00Z"
                 },
+                "description": {
+                    "type": "string",
+                    "example": "Весенняя скидка"
+                },
+                "discountType": {
+                    "type": "string",
+                    "example": "percent"
+                },
+                "discountValue": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "endsAt": {
+                    "type": "string",
+                    "example": "2024-04-01T00:00:00Z"
+                },
                 "id": {
                     "type": "integer",
                     "example": 1
                 },
-                "name": {
+                "isActive": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "lineIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "minOrderAmount": {
+                    "type": "integer",
+                    "example": 300000
+                },
+                "productTypeIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "startsAt": {
                     "type": "string",
-                    "example": "Product type Name"
+                    "example": "2024-03-01T00:00:00Z"
                 },
                 "updatedAt": {
                     "type": "string",
                     "example": "2023-10-01T12:00:00Z"
+                },
+                "usageLimit": {
+                    "type": "integer",
+                    "example": 1000
+                },
+                "usageLimitPerClient": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "usedCount": {
+                    "type": "integer",
+                    "example": 12
                 }
             }
         },
-        "haircompany-shop-rest_internal_modules_v1_product_type_dto.UpdateDTO": {
+        "haircompany-shop-rest_internal_modules_v1_promo_code_dto.UpdateDTO": {
             "type": "object",
             "properties": {
-                "name": {
+                "categoryIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "code": {
                     "type": "string",
-                    "maxLength": 255,
+                    "maxLength": 64,
                     "minLength": 3
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "discountType": {
+                    "type": "string",
+                    "enum": [
+                        "percent",
+                        "fixed"
+                    ]
+                },
+                "discountValue": {
+                    "type": "integer"
+                },
+                "endsAt": {
+                    "type": "string"
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "lineIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "minOrderAmount": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "productTypeIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "startsAt": {
+                    "type": "string"
+                },
+                "usageLimit": {
+                    "type": "integer"
+                },
+                "usageLimitPerClient": {
+                    "type": "integer"
                 }
             }
         },
@@ -3172,6 +11854,10 @@ const docTemplate = `{
                     "type": "string",
                     "example": "2023-10-01T12:00:00Z"
                 },
+                "deletedAt": {
+                    "type": "string",
+                    "example": "2023-10-02T12:00:00Z"
+                },
                 "id": {
                     "type": "integer",
                     "example": 1
@@ -3180,6 +11866,9 @@ const docTemplate = `{
                     "type": "string",
                     "example": "shade.png"
                 },
+                "imageUrls": {
+                    "$ref": "#/definitions/haircompany-shop-rest_internal_modules_v1_image_dto.ResponseDTO"
+                },
                 "name": {
                     "type": "string",
                     "example": "Shade Name"
@@ -3210,6 +11899,23 @@ const docTemplate = `{
                     "minimum": 0
                 }
             }
+        },
+        "response.Pagination": {
+            "type": "object",
+            "properties": {
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "perPage": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 42
+                }
+            }
         }
     },
     "securityDefinitions": {
@@ -5,7 +5,7 @@ import (
 )
 
 type authErrorField struct {
-	Field     string `json:"field" enums:"email,password,refreshToken"`
+	Field     string `json:"field" enums:"email,password,device,refreshToken"`
 	ErrorCode string `json:"errorCode" enums:"REQUIRED,INVALID_EMAIL,MIN_LENGTH,MAX_LENGTH,INVALID_TOKEN"`
 }
 
@@ -28,3 +28,128 @@ type DashboardRefreshToken400 struct {
 	Response400
 	Fields []authErrorField `json:"fields,omitempty"`
 }
+
+type DashboardSessions200 struct {
+	IsSuccess bool                     `json:"isSuccess" example:"true"`
+	Data      []dto.SessionResponseDTO `json:"data"`
+}
+
+type DashboardLogout200 struct {
+	IsSuccess bool `json:"isSuccess" example:"true"`
+}
+
+type dashboardPasswordErrorField struct {
+	Field     string `json:"field" enums:"currentPassword,newPassword,email,token,password"`
+	ErrorCode string `json:"errorCode" enums:"NOT_BLANK,INVALID_EMAIL,MIN_LENGTH,MAX_LENGTH,INVALID_PASSWORD,BAD_REQUEST"`
+}
+
+type DashboardChangePassword200 struct {
+	IsSuccess bool `json:"isSuccess" example:"true"`
+}
+
+type DashboardChangePassword400 struct {
+	Response400
+	Fields []dashboardPasswordErrorField `json:"fields,omitempty"`
+}
+
+type DashboardPasswordResetRequest200 struct {
+	IsSuccess bool `json:"isSuccess" example:"true"`
+}
+
+type DashboardPasswordResetRequest400 struct {
+	Response400
+	Fields []dashboardPasswordErrorField `json:"fields,omitempty"`
+}
+
+type DashboardPasswordReset200 struct {
+	IsSuccess bool `json:"isSuccess" example:"true"`
+}
+
+type DashboardPasswordReset400 struct {
+	IsSuccess bool                          `json:"isSuccess" example:"false"`
+	Message   string                        `json:"message" example:"invalid or expired password reset token"`
+	ErrorCode string                        `json:"errorCode" enums:"BAD_REQUEST,INVALID_TOKEN"`
+	Fields    []dashboardPasswordErrorField `json:"fields,omitempty"`
+}
+
+type dashboardTwoFactorErrorField struct {
+	Field     string `json:"field" enums:"challengeToken,code,password"`
+	ErrorCode string `json:"errorCode" enums:"NOT_BLANK,MAX_LENGTH,INVALID_CODE,INVALID_PASSWORD,BAD_REQUEST"`
+}
+
+type DashboardTwoFactor400 struct {
+	Response400
+	Fields []dashboardTwoFactorErrorField `json:"fields,omitempty"`
+}
+
+type DashboardVerifyTwoFactor401 struct {
+	IsSuccess bool   `json:"isSuccess" example:"false"`
+	Message   string `json:"message" example:"invalid two-factor code"`
+	ErrorCode string `json:"errorCode" enums:"INVALID_CODE,INVALID_TOKEN"`
+}
+
+type DashboardTwoFactor409 struct {
+	IsSuccess bool   `json:"isSuccess" example:"false"`
+	Message   string `json:"message" example:"two-factor authentication is already enabled"`
+	ErrorCode string `json:"errorCode" enums:"TWO_FACTOR_ALREADY_ENABLED,TWO_FACTOR_NOT_ENABLED"`
+}
+
+type DashboardTwoFactorStatus200 struct {
+	IsSuccess bool                           `json:"isSuccess" example:"true"`
+	Data      dto.TwoFactorStatusResponseDTO `json:"data"`
+}
+
+type DashboardTwoFactorSetup200 struct {
+	IsSuccess bool                          `json:"isSuccess" example:"true"`
+	Data      dto.TwoFactorSetupResponseDTO `json:"data"`
+}
+
+type DashboardRecoveryCodes200 struct {
+	IsSuccess bool                         `json:"isSuccess" example:"true"`
+	Data      dto.RecoveryCodesResponseDTO `json:"data"`
+}
+
+type DashboardTwoFactorDisable200 struct {
+	IsSuccess bool `json:"isSuccess" example:"true"`
+}
+
+type clientAuthErrorField struct {
+	Field     string `json:"field" enums:"phone,code,refreshToken"`
+	ErrorCode string `json:"errorCode" enums:"NOT_BLANK,INVALID_PHONE,BAD_REQUEST"`
+}
+
+type ClientRequestCode200 struct {
+	IsSuccess bool                             `json:"isSuccess" example:"true"`
+	Data      dto.ClientRequestCodeResponseDTO `json:"data"`
+}
+
+type ClientRequestCode400 struct {
+	Response400
+	Fields []clientAuthErrorField `json:"fields,omitempty"`
+}
+
+type ClientVerifyCode200 struct {
+	IsSuccess bool            `json:"isSuccess" example:"true"`
+	Data      dto.ResponseDTO `json:"data"`
+}
+
+type ClientVerifyCode400 struct {
+	Response400
+	Fields []clientAuthErrorField `json:"fields,omitempty"`
+}
+
+type ClientVerifyCode401 struct {
+	IsSuccess bool   `json:"isSuccess" example:"false"`
+	Message   string `json:"message" example:"invalid or expired code"`
+	ErrorCode string `json:"errorCode" enums:"INVALID_CODE"`
+}
+
+type ClientRefreshToken200 struct {
+	IsSuccess bool            `json:"isSuccess" example:"true"`
+	Data      dto.ResponseDTO `json:"data"`
+}
+
+type ClientRefreshToken400 struct {
+	Response400
+	Fields []clientAuthErrorField `json:"fields,omitempty"`
+}
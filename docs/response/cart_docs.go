@@ -0,0 +1,27 @@
+package docsResponse
+
+import (
+	"haircompany-shop-rest/internal/modules/v1/cart/dto"
+)
+
+type cartErrorField struct {
+	Field     string `json:"field" enums:"productVariantId,quantity"`
+	ErrorCode string `json:"errorCode" enums:"NOT_BLANK,NOT_FOUND,BAD_REQUEST"`
+}
+
+type CartGet200 struct {
+	IsSuccess bool            `json:"isSuccess" example:"true"`
+	Data      dto.ResponseDTO `json:"data"`
+}
+
+type CartItem400 struct {
+	Response400
+	Fields []cartErrorField `json:"fields,omitempty"`
+}
+
+type CartPromoCode400 struct {
+	IsSuccess bool             `json:"isSuccess" example:"false"`
+	Message   string           `json:"message" example:"promo code has expired"`
+	ErrorCode string           `json:"errorCode" enums:"BAD_REQUEST,EMPTY_CART,PROMO_CODE_NOT_FOUND,PROMO_CODE_INACTIVE,PROMO_CODE_NOT_STARTED,PROMO_CODE_EXPIRED,PROMO_CODE_USAGE_LIMIT_REACHED,PROMO_CODE_CLIENT_LIMIT_REACHED,PROMO_CODE_MIN_AMOUNT_NOT_REACHED,PROMO_CODE_NOT_APPLICABLE"`
+	Fields    []cartErrorField `json:"fields,omitempty"`
+}
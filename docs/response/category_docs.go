@@ -2,11 +2,12 @@ package docsResponse
 
 import (
 	"haircompany-shop-rest/internal/modules/v1/category/dto"
+	"haircompany-shop-rest/pkg/response"
 )
 
 type categoryErrorField struct {
 	Field     string `json:"field" enums:"name,slug,parentId"`
-	ErrorCode string `json:"errorCode" enums:"NOT_UNIQUE,NOT_FOUND"`
+	ErrorCode string `json:"errorCode" enums:"NOT_UNIQUE,NOT_FOUND,CATEGORY_CYCLE"`
 }
 
 type CategoryCreate201 struct {
@@ -20,8 +21,9 @@ type CategoryCreate400 struct {
 }
 
 type CategoryList200 struct {
-	IsSuccess bool              `json:"isSuccess" example:"true"`
-	Data      []dto.ResponseDTO `json:"data"`
+	IsSuccess  bool                `json:"isSuccess" example:"true"`
+	Data       []dto.ResponseDTO   `json:"data"`
+	Pagination response.Pagination `json:"pagination"`
 }
 
 type CategoryGetById200 struct {
@@ -43,3 +45,23 @@ type CategoryDelete200 struct {
 	IsSuccess bool            `json:"isSuccess" example:"true"`
 	Data      dto.ResponseDTO `json:"data"`
 }
+
+type CategoryTree200 struct {
+	IsSuccess bool          `json:"isSuccess" example:"true"`
+	Data      []dto.TreeDTO `json:"data"`
+}
+
+type CategoryMain200 struct {
+	IsSuccess bool              `json:"isSuccess" example:"true"`
+	Data      []dto.ResponseDTO `json:"data"`
+}
+
+type CategoryBreadcrumbs200 struct {
+	IsSuccess bool                `json:"isSuccess" example:"true"`
+	Data      []dto.BreadcrumbDTO `json:"data"`
+}
+
+type CategorySlugRedirect301 struct {
+	IsSuccess bool            `json:"isSuccess" example:"true"`
+	Data      dto.RedirectDTO `json:"data"`
+}
@@ -41,3 +41,9 @@ type Response413 struct {
 	Message   string `json:"message" example:"Request entity too large"`
 	ErrorCode string `json:"errorCode" enums:"REQUEST_TOO_LARGE"`
 }
+
+type Response429 struct {
+	IsSuccess bool   `json:"isSuccess" example:"false"`
+	Message   string `json:"message" example:"Too many requests"`
+	ErrorCode string `json:"errorCode" enums:"TOO_MANY_REQUESTS"`
+}
@@ -1,12 +1,13 @@
 package docsResponse
 
 import (
-	"haircompany-shop-rest/internal/modules/v1/category/dto"
+	"haircompany-shop-rest/internal/modules/v1/dashboard_user/dto"
+	"haircompany-shop-rest/pkg/response"
 )
 
 type dashboardUserErrorField struct {
-	Field     string `json:"field" enums:"name,slug,parentId"`
-	ErrorCode string `json:"errorCode" enums:"NOT_UNIQUE,NOT_FOUND"`
+	Field     string `json:"field" enums:"email,password,role"`
+	ErrorCode string `json:"errorCode" enums:"NOT_UNIQUE,NOT_BLANK,MIN_LENGTH,MAX_LENGTH,INVALID_EMAIL"`
 }
 
 type DashboardUserCreate201 struct {
@@ -18,3 +19,30 @@ type DashboardUserCreate400 struct {
 	Response400
 	Fields []dashboardUserErrorField `json:"fields,omitempty"`
 }
+
+type DashboardUserList200 struct {
+	IsSuccess  bool                `json:"isSuccess" example:"true"`
+	Data       []dto.ResponseDTO   `json:"data"`
+	Pagination response.Pagination `json:"pagination"`
+}
+
+type DashboardUserGetById200 struct {
+	IsSuccess bool            `json:"isSuccess" example:"true"`
+	Data      dto.ResponseDTO `json:"data"`
+}
+
+type DashboardUserUpdate200 struct {
+	IsSuccess bool            `json:"isSuccess" example:"true"`
+	Data      dto.ResponseDTO `json:"data"`
+}
+
+type DashboardUserDelete200 struct {
+	IsSuccess bool            `json:"isSuccess" example:"true"`
+	Data      dto.ResponseDTO `json:"data"`
+}
+
+type DashboardUser409 struct {
+	IsSuccess bool   `json:"isSuccess" example:"false"`
+	Message   string `json:"message" example:"the last active admin cannot be demoted, deactivated or deleted"`
+	ErrorCode string `json:"errorCode" enums:"LAST_ADMIN"`
+}
@@ -2,6 +2,7 @@ package docsResponse
 
 import (
 	"haircompany-shop-rest/internal/modules/v1/desired_result/dto"
+	"haircompany-shop-rest/pkg/response"
 )
 
 type desiredResultErrorField struct {
@@ -20,8 +21,9 @@ type DesiredResultCreate400 struct {
 }
 
 type DesiredResultList200 struct {
-	IsSuccess bool              `json:"isSuccess" example:"true"`
-	Data      []dto.ResponseDTO `json:"data"`
+	IsSuccess  bool                `json:"isSuccess" example:"true"`
+	Data       []dto.ResponseDTO   `json:"data"`
+	Pagination response.Pagination `json:"pagination"`
 }
 
 type DesiredResultGetById200 struct {
@@ -3,8 +3,8 @@ package docsResponse
 import "haircompany-shop-rest/internal/modules/v1/image/dto"
 
 type imageErrorField struct {
-	Field     string `json:"field" example:"images"`
-	ErrorCode string `json:"errorCode" example:"INVALID_FORMAT"`
+	Field     string `json:"field" example:"image_0"`
+	ErrorCode string `json:"errorCode" example:"IMAGE_TOO_SMALL"`
 }
 
 type ImageUpload200 struct {
@@ -0,0 +1,31 @@
+package docsResponse
+
+import (
+	"haircompany-shop-rest/internal/modules/v1/inventory/dto"
+)
+
+type inventoryErrorField struct {
+	Field     string `json:"field" enums:"delta,reason"`
+	ErrorCode string `json:"errorCode" enums:"NOT_BLANK,MIN_LENGTH,MAX_LENGTH"`
+}
+
+type StockGet200 struct {
+	IsSuccess bool                 `json:"isSuccess" example:"true"`
+	Data      dto.StockResponseDTO `json:"data"`
+}
+
+type StockAdjust400 struct {
+	Response400
+	Fields []inventoryErrorField `json:"fields,omitempty"`
+}
+
+type StockAdjust409 struct {
+	IsSuccess bool   `json:"isSuccess" example:"false"`
+	Message   string `json:"message" example:"insufficient stock for product variant 1: requested 5, available 2"`
+	ErrorCode string `json:"errorCode" enums:"INSUFFICIENT_STOCK"`
+}
+
+type StockMovementList200 struct {
+	IsSuccess bool                      `json:"isSuccess" example:"true"`
+	Data      []dto.MovementResponseDTO `json:"data"`
+}
@@ -0,0 +1,38 @@
+package docsResponse
+
+import (
+	"haircompany-shop-rest/internal/modules/v1/job/dto"
+	"haircompany-shop-rest/pkg/response"
+)
+
+type JobList200 struct {
+	IsSuccess bool         `json:"isSuccess" example:"true"`
+	Data      []dto.JobDTO `json:"data"`
+}
+
+type JobRuns200 struct {
+	IsSuccess  bool                `json:"isSuccess" example:"true"`
+	Data       []dto.RunDTO        `json:"data"`
+	Pagination response.Pagination `json:"pagination"`
+}
+
+type JobTrigger202 struct {
+	IsSuccess bool `json:"isSuccess" example:"true"`
+}
+
+type Job409 struct {
+	IsSuccess bool   `json:"isSuccess" example:"false"`
+	Message   string `json:"message" example:"job PurgeTrash is already running"`
+	ErrorCode string `json:"errorCode" enums:"JOB_ALREADY_RUNNING"`
+}
+
+type JobDeadLetters200 struct {
+	IsSuccess  bool                `json:"isSuccess" example:"true"`
+	Data       []dto.QueueJobDTO   `json:"data"`
+	Pagination response.Pagination `json:"pagination"`
+}
+
+type JobDeadLetterRetry200 struct {
+	IsSuccess bool            `json:"isSuccess" example:"true"`
+	Data      dto.QueueJobDTO `json:"data"`
+}
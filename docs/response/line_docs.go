@@ -2,6 +2,7 @@ package docsResponse
 
 import (
 	"haircompany-shop-rest/internal/modules/v1/line/dto"
+	"haircompany-shop-rest/pkg/response"
 )
 
 type lineErrorField struct {
@@ -20,8 +21,9 @@ type LineCreate400 struct {
 }
 
 type LineList200 struct {
-	IsSuccess bool              `json:"isSuccess" example:"true"`
-	Data      []dto.ResponseDTO `json:"data"`
+	IsSuccess  bool                `json:"isSuccess" example:"true"`
+	Data       []dto.ResponseDTO   `json:"data"`
+	Pagination response.Pagination `json:"pagination"`
 }
 
 type LineGetById200 struct {
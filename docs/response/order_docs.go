@@ -0,0 +1,31 @@
+package docsResponse
+
+import (
+	"haircompany-shop-rest/internal/modules/v1/order/dto"
+)
+
+type checkoutErrorField struct {
+	Field     string `json:"field" enums:"cart,productVariantId,promoCode,customerName,customerPhone,customerEmail,deliveryMethod,deliveryAddress,comment"`
+	ErrorCode string `json:"errorCode" enums:"EMPTY_CART,NOT_FOUND,NOT_BLANK,MIN_LENGTH,MAX_LENGTH,INVALID_PHONE,INVALID_EMAIL,BAD_REQUEST,PROMO_CODE_NOT_FOUND,PROMO_CODE_INACTIVE,PROMO_CODE_NOT_STARTED,PROMO_CODE_EXPIRED,PROMO_CODE_USAGE_LIMIT_REACHED,PROMO_CODE_CLIENT_LIMIT_REACHED,PROMO_CODE_MIN_AMOUNT_NOT_REACHED,PROMO_CODE_NOT_APPLICABLE"`
+}
+
+type OrderGet200 struct {
+	IsSuccess bool            `json:"isSuccess" example:"true"`
+	Data      dto.ResponseDTO `json:"data"`
+}
+
+type OrderList200 struct {
+	IsSuccess bool              `json:"isSuccess" example:"true"`
+	Data      []dto.ResponseDTO `json:"data"`
+}
+
+type Checkout400 struct {
+	Response400
+	Fields []checkoutErrorField `json:"fields,omitempty"`
+}
+
+type OrderStatus409 struct {
+	IsSuccess bool   `json:"isSuccess" example:"false"`
+	Message   string `json:"message" example:"order cannot move from status delivered to paid"`
+	ErrorCode string `json:"errorCode" enums:"INVALID_TRANSITION"`
+}
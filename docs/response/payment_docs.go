@@ -0,0 +1,31 @@
+package docsResponse
+
+import (
+	"haircompany-shop-rest/internal/modules/v1/payment/dto"
+)
+
+type PaymentGet200 struct {
+	IsSuccess bool            `json:"isSuccess" example:"true"`
+	Data      dto.ResponseDTO `json:"data"`
+}
+
+type PaymentList200 struct {
+	IsSuccess bool              `json:"isSuccess" example:"true"`
+	Data      []dto.ResponseDTO `json:"data"`
+}
+
+type Payment409 struct {
+	IsSuccess bool   `json:"isSuccess" example:"false"`
+	Message   string `json:"message" example:"order already has a pending payment"`
+	ErrorCode string `json:"errorCode" enums:"PAYMENT_NOT_ALLOWED"`
+}
+
+type Webhook200 struct {
+	IsSuccess bool `json:"isSuccess" example:"true"`
+}
+
+type Webhook401 struct {
+	IsSuccess bool   `json:"isSuccess" example:"false"`
+	Message   string `json:"message" example:"invalid payment callback signature"`
+	ErrorCode string `json:"errorCode" enums:"INVALID_SIGNATURE"`
+}
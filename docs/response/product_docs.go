@@ -0,0 +1,50 @@
+package docsResponse
+
+import (
+	"haircompany-shop-rest/internal/modules/v1/product/dto"
+)
+
+type productErrorField struct {
+	Field     string `json:"field" enums:"name,slug,categoryId,lineId,productTypeId,desiredResultIds,shadeIds"`
+	ErrorCode string `json:"errorCode" enums:"NOT_UNIQUE,NOT_FOUND"`
+}
+
+type ProductCreate201 struct {
+	IsSuccess bool            `json:"isSuccess" example:"true"`
+	Data      dto.ResponseDTO `json:"data"`
+}
+
+type ProductCreate400 struct {
+	Response400
+	Fields []productErrorField `json:"fields,omitempty"`
+}
+
+type ProductList200 struct {
+	IsSuccess bool              `json:"isSuccess" example:"true"`
+	Data      []dto.ResponseDTO `json:"data"`
+}
+
+type ProductGetById200 struct {
+	IsSuccess bool            `json:"isSuccess" example:"true"`
+	Data      dto.ResponseDTO `json:"data"`
+}
+
+type ProductUpdate200 struct {
+	IsSuccess bool            `json:"isSuccess" example:"true"`
+	Data      dto.ResponseDTO `json:"data"`
+}
+
+type ProductUpdate400 struct {
+	Response400
+	Fields []productErrorField `json:"fields,omitempty"`
+}
+
+type ProductDelete200 struct {
+	IsSuccess bool            `json:"isSuccess" example:"true"`
+	Data      dto.ResponseDTO `json:"data"`
+}
+
+type ProductSlugRedirect301 struct {
+	IsSuccess bool            `json:"isSuccess" example:"true"`
+	Data      dto.RedirectDTO `json:"data"`
+}
@@ -2,6 +2,7 @@ package docsResponse
 
 import (
 	"haircompany-shop-rest/internal/modules/v1/product_type/dto"
+	"haircompany-shop-rest/pkg/response"
 )
 
 type productTypeErrorField struct {
@@ -20,8 +21,9 @@ type ProductTypeCreate400 struct {
 }
 
 type ProductTypeList200 struct {
-	IsSuccess bool              `json:"isSuccess" example:"true"`
-	Data      []dto.ResponseDTO `json:"data"`
+	IsSuccess  bool                `json:"isSuccess" example:"true"`
+	Data       []dto.ResponseDTO   `json:"data"`
+	Pagination response.Pagination `json:"pagination"`
 }
 
 type ProductTypeGetById200 struct {
@@ -0,0 +1,45 @@
+package docsResponse
+
+import (
+	"haircompany-shop-rest/internal/modules/v1/product_variant/dto"
+)
+
+type productVariantErrorField struct {
+	Field     string `json:"field" enums:"productId,article,barcode,shadeId,volume,price"`
+	ErrorCode string `json:"errorCode" enums:"NOT_UNIQUE,NOT_FOUND,INVALID_BARCODE"`
+}
+
+type ProductVariantCreate201 struct {
+	IsSuccess bool            `json:"isSuccess" example:"true"`
+	Data      dto.ResponseDTO `json:"data"`
+}
+
+type ProductVariantCreate400 struct {
+	Response400
+	Fields []productVariantErrorField `json:"fields,omitempty"`
+}
+
+type ProductVariantList200 struct {
+	IsSuccess bool              `json:"isSuccess" example:"true"`
+	Data      []dto.ResponseDTO `json:"data"`
+}
+
+type ProductVariantGetById200 struct {
+	IsSuccess bool            `json:"isSuccess" example:"true"`
+	Data      dto.ResponseDTO `json:"data"`
+}
+
+type ProductVariantUpdate200 struct {
+	IsSuccess bool            `json:"isSuccess" example:"true"`
+	Data      dto.ResponseDTO `json:"data"`
+}
+
+type ProductVariantUpdate400 struct {
+	Response400
+	Fields []productVariantErrorField `json:"fields,omitempty"`
+}
+
+type ProductVariantDelete200 struct {
+	IsSuccess bool            `json:"isSuccess" example:"true"`
+	Data      dto.ResponseDTO `json:"data"`
+}
@@ -0,0 +1,40 @@
+package docsResponse
+
+import (
+	"haircompany-shop-rest/internal/modules/v1/promo_code/dto"
+)
+
+type promoCodeErrorField struct {
+	Field     string `json:"field" enums:"code,discountType,discountValue,minOrderAmount,endsAt,usageLimit,usageLimitPerClient,categoryIds,lineIds,productTypeIds"`
+	ErrorCode string `json:"errorCode" enums:"NOT_BLANK,MIN_LENGTH,MAX_LENGTH,NOT_UNIQUE,NOT_FOUND,BAD_REQUEST"`
+}
+
+type PromoCodeCreate201 struct {
+	IsSuccess bool            `json:"isSuccess" example:"true"`
+	Data      dto.ResponseDTO `json:"data"`
+}
+
+type PromoCodeCreate400 struct {
+	Response400
+	Fields []promoCodeErrorField `json:"fields,omitempty"`
+}
+
+type PromoCodeList200 struct {
+	IsSuccess bool              `json:"isSuccess" example:"true"`
+	Data      []dto.ResponseDTO `json:"data"`
+}
+
+type PromoCodeGetById200 struct {
+	IsSuccess bool            `json:"isSuccess" example:"true"`
+	Data      dto.ResponseDTO `json:"data"`
+}
+
+type PromoCodeUpdate200 struct {
+	IsSuccess bool            `json:"isSuccess" example:"true"`
+	Data      dto.ResponseDTO `json:"data"`
+}
+
+type PromoCodeDelete200 struct {
+	IsSuccess bool            `json:"isSuccess" example:"true"`
+	Data      dto.ResponseDTO `json:"data"`
+}
@@ -2,6 +2,7 @@ package docsResponse
 
 import (
 	"haircompany-shop-rest/internal/modules/v1/shade/dto"
+	"haircompany-shop-rest/pkg/response"
 )
 
 type shadeErrorField struct {
@@ -20,8 +21,9 @@ type ShadeCreate400 struct {
 }
 
 type ShadeList200 struct {
-	IsSuccess bool              `json:"isSuccess" example:"true"`
-	Data      []dto.ResponseDTO `json:"data"`
+	IsSuccess  bool                `json:"isSuccess" example:"true"`
+	Data       []dto.ResponseDTO   `json:"data"`
+	Pagination response.Pagination `json:"pagination"`
 }
 
 type ShadeGetById200 struct {
@@ -0,0 +1,33 @@
+package constraint
+
+import "github.com/go-playground/validator/v10"
+
+// validateEAN checks that the value is an EAN-8 or EAN-13 barcode with a valid check digit.
+func validateEAN(fl validator.FieldLevel) bool {
+	value := fl.Field().String()
+
+	if len(value) != 8 && len(value) != 13 {
+		return false
+	}
+
+	sum := 0
+	for i := len(value) - 2; i >= 0; i-- {
+		digit := value[i]
+		if digit < '0' || digit > '9' {
+			return false
+		}
+
+		weight := 1
+		if (len(value)-2-i)%2 == 0 {
+			weight = 3
+		}
+		sum += int(digit-'0') * weight
+	}
+
+	checkDigit := value[len(value)-1]
+	if checkDigit < '0' || checkDigit > '9' {
+		return false
+	}
+
+	return (10-sum%10)%10 == int(checkDigit-'0')
+}
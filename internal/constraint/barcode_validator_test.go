@@ -0,0 +1,31 @@
+package constraint
+
+import (
+	"haircompany-shop-rest/pkg/response"
+	"testing"
+)
+
+type barcodeDTO struct {
+	Barcode string `validate:"required,ean"`
+}
+
+func TestValidateDTO_ValidEAN(t *testing.T) {
+	for _, barcode := range []string{"4006381333931", "96385074", "4607010590017"} {
+		if errFields := ValidateDTO(barcodeDTO{Barcode: barcode}); errFields != nil {
+			t.Errorf("Expected barcode %s to be valid, got %v", barcode, errFields)
+		}
+	}
+}
+
+func TestValidateDTO_InvalidEAN(t *testing.T) {
+	for _, barcode := range []string{"4006381333932", "400638133393", "40063813339a1", "96385075"} {
+		errFields := ValidateDTO(barcodeDTO{Barcode: barcode})
+		if len(errFields) != 1 {
+			t.Fatalf("Expected 1 validation error for barcode %s, got %d", barcode, len(errFields))
+		}
+
+		if errFields[0].ErrorCode != string(response.InvalidBarcode) {
+			t.Errorf("Expected error code %s, got %s", response.InvalidBarcode, errFields[0].ErrorCode)
+		}
+	}
+}
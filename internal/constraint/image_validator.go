@@ -1,13 +1,62 @@
 package constraint
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"haircompany-shop-rest/pkg/response"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"math"
 	"mime/multipart"
+	"net/http"
 )
 
-var imageTypes = []string{"category", "shade"}
+var imageTypes = []string{"category", "shade", "product"}
+
+type imageRules struct {
+	sizeLimit    int64
+	contentTypes []string
+	minWidth     int
+	minHeight    int
+	maxWidth     int
+	maxHeight    int
+	// aspectRatio — требуемое отношение ширины к высоте, 0 — любое
+	aspectRatio float64
+}
+
+// aspectRatioTolerance — допустимое относительное отклонение пропорций
+const aspectRatioTolerance = 0.01
+
+var imageTypeRules = map[string]imageRules{
+	"category": {
+		sizeLimit:    1 * 1024 * 1024, // 1 MB limit for category images
+		contentTypes: []string{"image/jpeg", "image/png"},
+		minWidth:     200,
+		minHeight:    200,
+		maxWidth:     6000,
+		maxHeight:    6000,
+	},
+	"shade": {
+		sizeLimit:    500 * 1024, // 500 KB limit for shade images
+		contentTypes: []string{"image/jpeg", "image/png"},
+		minWidth:     64,
+		minHeight:    64,
+		maxWidth:     1000,
+		maxHeight:    1000,
+		aspectRatio:  1, // Свотчи оттенков квадратные
+	},
+	"product": {
+		sizeLimit:    1 * 1024 * 1024, // 1 MB limit for product images
+		contentTypes: []string{"image/jpeg", "image/png"},
+		minWidth:     300,
+		minHeight:    300,
+		maxWidth:     6000,
+		maxHeight:    6000,
+	},
+}
 
 func ValidateImageType(t string) error {
 	for _, validType := range imageTypes {
@@ -40,40 +89,88 @@ func validateImages(files []*multipart.FileHeader, imageType string) ([]response
 		return nil, errors.New("no images provided")
 	}
 
-	var validationErrors []response.ErrorField
-	var imageSizeLimit int64
-	var imageContentType []string
-
-	switch imageType {
-	case "category":
-		imageSizeLimit = 1 * 1024 * 1024 // 1 MB limit for category images
-		imageContentType = []string{"image/jpeg", "image/png"}
-		break
-	case "shade":
-		imageSizeLimit = 500 * 1024 // 500 KB limit for shade images
-		imageContentType = []string{"image/jpeg", "image/png"}
-		break
-	default:
+	rules, ok := imageTypeRules[imageType]
+	if !ok {
 		return nil, fmt.Errorf("unsupported image type: %s", imageType)
 	}
 
+	var validationErrors []response.ErrorField
 	for i, file := range files {
-		if file.Size > imageSizeLimit {
-			validationErrors = append(validationErrors, response.NewErrorField(fmt.Sprintf("image_%d", i), string(response.FileTooLarge)))
+		field := fmt.Sprintf("image_%d", i)
+		addError := func(code response.ErrorCode) {
+			validationErrors = append(validationErrors, response.NewErrorField(field, string(code)))
 		}
-		fileHeader := file.Header.Get("Content-Type")
-
-		validContentType := false
-		for _, contentType := range imageContentType {
-			if fileHeader == contentType {
-				validContentType = true
-				break
-			}
+
+		if file.Size > rules.sizeLimit {
+			addError(response.FileTooLarge)
 		}
-		if !validContentType {
-			validationErrors = append(validationErrors, response.NewErrorField(fmt.Sprintf("image_%d", i), string(response.InvalidFileType)))
+
+		codes, err := validateImageContent(file, rules)
+		if err != nil {
+			return nil, err
+		}
+		for _, code := range codes {
+			addError(code)
 		}
 	}
 
 	return validationErrors, nil
 }
+
+// validateImageContent определяет тип файла по сигнатуре, а не по заголовку
+// Content-Type от клиента, и проверяет размеры изображения по его заголовку.
+func validateImageContent(file *multipart.FileHeader, rules imageRules) ([]response.ErrorCode, error) {
+	f, err := file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", file.Filename, err)
+	}
+	defer f.Close()
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(f, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to read %s: %w", file.Filename, err)
+	}
+	head = head[:n]
+
+	contentType := http.DetectContentType(head)
+	if !containsString(rules.contentTypes, contentType) {
+		return []response.ErrorCode{response.InvalidFileType}, nil
+	}
+
+	var codes []response.ErrorCode
+	// Пустой заголовок и application/octet-stream означают, что клиент тип не указал
+	declared := file.Header.Get("Content-Type")
+	if declared != "" && declared != "application/octet-stream" && declared != contentType {
+		codes = append(codes, response.ContentTypeMismatch)
+	}
+
+	config, _, err := image.DecodeConfig(io.MultiReader(bytes.NewReader(head), f))
+	if err != nil {
+		return append(codes, response.InvalidImage), nil
+	}
+
+	if config.Width < rules.minWidth || config.Height < rules.minHeight {
+		codes = append(codes, response.ImageTooSmall)
+	}
+	if (rules.maxWidth > 0 && config.Width > rules.maxWidth) || (rules.maxHeight > 0 && config.Height > rules.maxHeight) {
+		codes = append(codes, response.ImageTooLarge)
+	}
+	if rules.aspectRatio > 0 {
+		ratio := float64(config.Width) / float64(config.Height)
+		if math.Abs(ratio-rules.aspectRatio)/rules.aspectRatio > aspectRatioTolerance {
+			codes = append(codes, response.InvalidAspectRatio)
+		}
+	}
+
+	return codes, nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
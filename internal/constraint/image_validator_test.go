@@ -0,0 +1,151 @@
+package constraint
+
+import (
+	"bytes"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"mime/multipart"
+	"net/textproto"
+	"testing"
+
+	"haircompany-shop-rest/pkg/response"
+)
+
+type testUpload struct {
+	contentType string
+	data        []byte
+}
+
+// parseUploads собирает multipart-форму так же, как её разбирает обработчик.
+func parseUploads(t *testing.T, uploads ...testUpload) []*multipart.FileHeader {
+	t.Helper()
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	for _, upload := range uploads {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Disposition", `form-data; name="images"; filename="image"`)
+		if upload.contentType != "" {
+			header.Set("Content-Type", upload.contentType)
+		}
+		part, err := writer.CreatePart(header)
+		if err != nil {
+			t.Fatal(err)
+		}
+		part.Write(upload.data)
+	}
+	writer.Close()
+
+	form, err := multipart.NewReader(&body, writer.Boundary()).ReadForm(10 << 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { form.RemoveAll() })
+
+	return form.File["images"]
+}
+
+func pngImage(t *testing.T, width, height int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewGray(image.Rect(0, 0, width, height))); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func jpegImage(t *testing.T, width, height int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, image.NewGray(image.Rect(0, 0, width, height)), nil); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func errorCodes(fields []response.ErrorField) []string {
+	codes := make([]string, 0, len(fields))
+	for _, field := range fields {
+		codes = append(codes, field.ErrorCode)
+	}
+	return codes
+}
+
+func TestValidateImage_Valid(t *testing.T) {
+	files := parseUploads(t,
+		testUpload{contentType: "image/png", data: pngImage(t, 400, 300)},
+		testUpload{contentType: "image/jpeg", data: jpegImage(t, 300, 300)},
+		testUpload{contentType: "application/octet-stream", data: pngImage(t, 250, 250)},
+	)
+
+	errFields, err := ValidateImage(files, "category")
+	if err != nil || errFields != nil {
+		t.Errorf("Expected images to be valid, got %v, %v", errFields, err)
+	}
+}
+
+func TestValidateImage_Errors(t *testing.T) {
+	cases := []struct {
+		name      string
+		imageType string
+		upload    testUpload
+		want      []string
+	}{
+		{
+			name:      "not an image declared as png",
+			imageType: "category",
+			upload:    testUpload{contentType: "image/png", data: []byte("<html><script>alert(1)</script></html>")},
+			want:      []string{string(response.InvalidFileType)},
+		},
+		{
+			name:      "png declared as jpeg",
+			imageType: "category",
+			upload:    testUpload{contentType: "image/jpeg", data: pngImage(t, 300, 300)},
+			want:      []string{string(response.ContentTypeMismatch)},
+		},
+		{
+			name:      "truncated png",
+			imageType: "category",
+			upload:    testUpload{contentType: "image/png", data: pngImage(t, 300, 300)[:20]},
+			want:      []string{string(response.InvalidImage)},
+		},
+		{
+			name:      "too small",
+			imageType: "category",
+			upload:    testUpload{contentType: "image/png", data: pngImage(t, 100, 300)},
+			want:      []string{string(response.ImageTooSmall)},
+		},
+		{
+			name:      "too large",
+			imageType: "shade",
+			upload:    testUpload{contentType: "image/png", data: pngImage(t, 1200, 1200)},
+			want:      []string{string(response.ImageTooLarge)},
+		},
+		{
+			name:      "shade is not square",
+			imageType: "shade",
+			upload:    testUpload{contentType: "image/png", data: pngImage(t, 200, 100)},
+			want:      []string{string(response.InvalidAspectRatio)},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			errFields, err := ValidateImage(parseUploads(t, c.upload), c.imageType)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			got := errorCodes(errFields)
+			if len(got) != len(c.want) {
+				t.Fatalf("Expected codes %v, got %v", c.want, got)
+			}
+			for i := range got {
+				if got[i] != c.want[i] || errFields[i].Field != "image_0" {
+					t.Errorf("Expected %s on image_0, got %s on %s", c.want[i], got[i], errFields[i].Field)
+				}
+			}
+		})
+	}
+}
@@ -16,6 +16,12 @@ func ValidateDTO(dto interface{}) []response.ErrorField {
 		return errorFields
 	}
 
+	err = validate.RegisterValidation("ean", validateEAN)
+	if err != nil {
+		errorFields = append(errorFields, response.NewErrorField("validation", string(response.ServerError)))
+		return errorFields
+	}
+
 	err = validate.Struct(dto)
 	if err != nil {
 		var validationErrors validator.ValidationErrors
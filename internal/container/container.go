@@ -5,33 +5,93 @@ import (
 	"haircompany-shop-rest/config"
 	"haircompany-shop-rest/internal/services"
 	"haircompany-shop-rest/pkg/database"
+	"log"
 	"sync"
 )
 
 type Container struct {
 	DB              *database.DB
 	JWTService      services.JWTService
+	Storage         services.StorageDriver
 	FileService     services.FileSystemService
 	PasswordService services.PasswordService
+	TOTPService     services.TOTPService
 	RedisService    services.RedisService
-	Ctx             context.Context
-	Wg              *sync.WaitGroup
+	SMSSender       services.SMSSender
+	Mailer          services.Mailer
+	PaymentProvider services.PaymentProvider
+	Queue           services.Queue
+	// DashboardSessions keeps signed-in devices of dashboard users in Redis.
+	DashboardSessions services.DashboardSessionStore
+	// TokenDenylist revokes dashboard access tokens before they expire.
+	TokenDenylist services.TokenDenylist
+	// PasswordResetURL is the dashboard page that receives password reset tokens.
+	PasswordResetURL string
+	// ClientCodeKey signs SMS login codes stored in Redis.
+	ClientCodeKey []byte
+	// Scheduler is set by main after the container is built, because job runs
+	// are recorded by the job module which itself depends on the container.
+	Scheduler services.Scheduler
+	Ctx       context.Context
+	Wg        *sync.WaitGroup
 }
 
 func NewContainer(cfg *config.Config, ctx context.Context, wg *sync.WaitGroup) *Container {
 	db := database.NewDB(cfg)
 	jwtSvc := services.NewJWTService(cfg.DashboardSecret, cfg.ClientSecret)
-	fileSvc := services.NewFileSystemService()
+	storage, err := services.NewStorageDriver(cfg.StorageDriver, cfg.StorageRoot, services.S3Options{
+		Endpoint:  cfg.S3Endpoint,
+		Region:    cfg.S3Region,
+		Bucket:    cfg.S3Bucket,
+		AccessKey: cfg.S3AccessKey,
+		SecretKey: cfg.S3SecretKey,
+		PathStyle: cfg.S3PathStyle,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fileSvc := services.NewFileSystemService(storage)
 	passwordSvc := services.NewPasswordService()
 	redisSvc := services.NewRedisService(ctx, cfg.RedisAddr, cfg.RedisPassword, cfg.RedisDB)
+	smsSender, err := services.NewSMSSender(cfg.SMSDriver, cfg.SMSLogFile, services.SMSGatewayOptions{
+		URL:   cfg.SMSGatewayURL,
+		Token: cfg.SMSGatewayToken,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	mailer, err := services.NewMailer(cfg.MailDriver, cfg.MailLogFile, services.SMTPOptions{
+		Host:     cfg.SMTPHost,
+		Port:     cfg.SMTPPort,
+		Username: cfg.SMTPUsername,
+		Password: cfg.SMTPPassword,
+		From:     cfg.MailFrom,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	paymentProvider, err := services.NewPaymentProvider(cfg.PaymentProvider, cfg.PaymentSecret, cfg.PaymentReturn)
+	if err != nil {
+		log.Fatal(err)
+	}
 
 	return &Container{
-		DB:              db,
-		JWTService:      jwtSvc,
-		FileService:     fileSvc,
-		PasswordService: passwordSvc,
-		RedisService:    redisSvc,
-		Ctx:             ctx,
-		Wg:              wg,
+		DB:                db,
+		JWTService:        jwtSvc,
+		Storage:           storage,
+		FileService:       fileSvc,
+		PasswordService:   passwordSvc,
+		TOTPService:       services.NewTOTPService(cfg.TOTPIssuer),
+		RedisService:      redisSvc,
+		DashboardSessions: services.NewDashboardSessionStore(redisSvc),
+		TokenDenylist:     services.NewTokenDenylist(redisSvc),
+		SMSSender:         smsSender,
+		Mailer:            mailer,
+		PasswordResetURL:  cfg.PasswordResetURL,
+		ClientCodeKey:     []byte(cfg.ClientSecret),
+		PaymentProvider:   paymentProvider,
+		Queue:             services.NewQueue(ctx, wg, services.NewQueueStore(db)),
+		Ctx:               ctx,
+		Wg:                wg,
 	}
 }
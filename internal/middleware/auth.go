@@ -4,11 +4,14 @@ import (
 	"context"
 	"haircompany-shop-rest/internal/services"
 	"haircompany-shop-rest/pkg/response"
+	"log"
 	"net/http"
 	"strings"
 )
 
-func DashboardAuthMiddleware(jwtSvc services.JWTService) func(http.Handler) http.Handler {
+// DashboardAuthMiddleware validates the dashboard access token and rejects
+// tokens revoked before they expired.
+func DashboardAuthMiddleware(jwtSvc services.JWTService, denylist services.TokenDenylist) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			authedHeader := r.Header.Get("Authorization")
@@ -24,6 +27,17 @@ func DashboardAuthMiddleware(jwtSvc services.JWTService) func(http.Handler) http
 				return
 			}
 
+			revoked, err := denylist.IsRevoked(claims)
+			if err != nil {
+				log.Printf("Failed to check token revocation for %s: %v", claims.Email, err)
+				response.SendError(w, http.StatusInternalServerError, "Failed to check token", response.ServerError)
+				return
+			}
+			if revoked {
+				response.SendError(w, http.StatusUnauthorized, "Token has been revoked", response.Unauthorized)
+				return
+			}
+
 			ctx := context.WithValue(r.Context(), "dashboardClaims", claims)
 			req := r.WithContext(ctx)
 			next.ServeHTTP(w, req)
@@ -53,3 +67,19 @@ func ClientAuthMiddleware(jwtSvc services.JWTService) func(http.Handler) http.Ha
 		})
 	}
 }
+
+// OptionalClientAuthMiddleware authenticates the client when a bearer token is
+// sent and lets anonymous requests through, e.g. for guest carts.
+func OptionalClientAuthMiddleware(jwtSvc services.JWTService) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authedHeader := r.Header.Get("Authorization")
+			if authedHeader == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ClientAuthMiddleware(jwtSvc)(next).ServeHTTP(w, r)
+		})
+	}
+}
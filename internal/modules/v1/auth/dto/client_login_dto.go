@@ -0,0 +1,16 @@
+package dto
+
+type ClientRequestCodeDTO struct {
+	Phone string `json:"phone" validate:"required,e164" example:"+79991234567"`
+}
+
+type ClientVerifyCodeDTO struct {
+	Phone     string `json:"phone" validate:"required,e164" example:"+79991234567"`
+	Code      string `json:"code" validate:"required,len=6,numeric" example:"123456"`
+	CartToken string `json:"cartToken" validate:"omitempty,max=64"` // Guest cart to merge into the client cart
+}
+
+type ClientRequestCodeResponseDTO struct {
+	ExpiresAt  int64 `json:"expiresAt"`  // Unix-время истечения кода
+	RetryAfter int   `json:"retryAfter"` // Через сколько секунд можно запросить новый код
+}
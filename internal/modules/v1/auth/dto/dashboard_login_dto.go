@@ -3,4 +3,5 @@ package dto
 type DashboardLoginDTO struct {
 	Email    string `json:"email" validate:"required,email"`
 	Password string `json:"password" validate:"required,min=8,max=255"`
+	Device   string `json:"device" validate:"omitempty,max=100" example:"MacBook, Chrome"` // Название устройства для списка сессий
 }
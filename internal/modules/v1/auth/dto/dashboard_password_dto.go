@@ -0,0 +1,15 @@
+package dto
+
+type DashboardChangePasswordDTO struct {
+	CurrentPassword string `json:"currentPassword" validate:"required,max=255"`
+	NewPassword     string `json:"newPassword" validate:"required,min=8,max=255"`
+}
+
+type DashboardPasswordResetRequestDTO struct {
+	Email string `json:"email" validate:"required,email" example:"manager@example.com"`
+}
+
+type DashboardPasswordResetDTO struct {
+	Token    string `json:"token" validate:"required,len=64,hexadecimal"` // Токен из письма
+	Password string `json:"password" validate:"required,min=8,max=255"`
+}
@@ -1,7 +1,11 @@
 package dto
 
 type ResponseDTO struct {
-	Token            string `json:"token"`
-	RefreshToken     string `json:"refreshToken"`
-	RefreshExpiresAt int64  `json:"refreshExpiresAt"`
+	Token            string `json:"token,omitempty"`
+	RefreshToken     string `json:"refreshToken,omitempty"`
+	RefreshExpiresAt int64  `json:"refreshExpiresAt,omitempty"`
+	// Заполняются вместо токенов, если для входа нужен второй фактор
+	TwoFactorRequired  bool   `json:"twoFactorRequired,omitempty"`
+	ChallengeToken     string `json:"challengeToken,omitempty"`
+	ChallengeExpiresAt int64  `json:"challengeExpiresAt,omitempty"`
 }
@@ -0,0 +1,30 @@
+package dto
+
+import (
+	"haircompany-shop-rest/internal/services"
+	"time"
+)
+
+type SessionResponseDTO struct {
+	Id         string    `json:"id"`
+	Device     string    `json:"device"`
+	IP         string    `json:"ip"`
+	UserAgent  string    `json:"userAgent"`
+	CreatedAt  time.Time `json:"createdAt"`
+	LastUsedAt time.Time `json:"lastUsedAt"` // Время последнего обновления токенов
+	ExpiresAt  time.Time `json:"expiresAt"`
+	Current    bool      `json:"current"` // Сессия, из которой сделан запрос
+}
+
+func TransformSessionToResponseDTO(session *services.DashboardSession, current bool) *SessionResponseDTO {
+	return &SessionResponseDTO{
+		Id:         session.ID,
+		Device:     session.Device,
+		IP:         session.IP,
+		UserAgent:  session.UserAgent,
+		CreatedAt:  session.CreatedAt,
+		LastUsedAt: session.LastUsedAt,
+		ExpiresAt:  session.ExpiresAt,
+		Current:    current,
+	}
+}
@@ -0,0 +1,30 @@
+package dto
+
+type TwoFactorCodeDTO struct {
+	Code string `json:"code" validate:"required,max=32" example:"123456"` // Код из приложения или код восстановления
+}
+
+type TwoFactorVerifyDTO struct {
+	ChallengeToken string `json:"challengeToken" validate:"required,len=64,hexadecimal"` // Токен из ответа на вход по паролю
+	Code           string `json:"code" validate:"required,max=32" example:"123456"`      // Код из приложения или код восстановления
+}
+
+type TwoFactorDisableDTO struct {
+	Password string `json:"password" validate:"required,max=255"`
+	Code     string `json:"code" validate:"required,max=32" example:"123456"` // Код из приложения или код восстановления
+}
+
+type TwoFactorSetupResponseDTO struct {
+	Secret     string `json:"secret"`     // Секрет в base32 для ручного ввода
+	OtpauthURI string `json:"otpauthUri"` // URI для QR-кода
+	ExpiresAt  int64  `json:"expiresAt"`  // Unix-время, до которого нужно подтвердить подключение
+}
+
+type TwoFactorStatusResponseDTO struct {
+	Enabled           bool  `json:"enabled"`
+	RecoveryCodesLeft int64 `json:"recoveryCodesLeft"`
+}
+
+type RecoveryCodesResponseDTO struct {
+	RecoveryCodes []string `json:"recoveryCodes"` // Показываются один раз
+}
@@ -1,12 +1,15 @@
 package auth
 
 import (
+	"errors"
 	"fmt"
 	_ "haircompany-shop-rest/docs/response"
 	"haircompany-shop-rest/internal/constraint"
 	"haircompany-shop-rest/internal/modules/v1/auth/dto"
+	"haircompany-shop-rest/internal/services"
 	"haircompany-shop-rest/pkg/request"
 	"haircompany-shop-rest/pkg/response"
+	"net"
 	"net/http"
 )
 
@@ -21,7 +24,7 @@ func NewHandler(s Service) *Handler {
 }
 
 // @Summary		Dashboard user login
-// @Description	Authenticate dashboard user with email and password
+// @Description	Authenticate dashboard user with email and password. If two-factor authentication is enabled, no tokens are returned: the response has twoFactorRequired and a challengeToken to finish the login at /auth/dashboard/2fa/verify.
 // @Tags			Auth
 // @Security		AppAuth
 // @Accept			json
@@ -30,7 +33,8 @@ func NewHandler(s Service) *Handler {
 // @Success		200			{object}	docsResponse.DashboardLogin200	"Login successful"
 // @Failure		400			{object}	docsResponse.DashboardLogin400	"Bad Request or Validation Error"
 // @Failure		401			{object}	docsResponse.Response401		"Unauthorized"
-// @Failure		403			{object}	docsResponse.Response403		"Forbidden - Invalid X-AUTH-APP"
+// @Failure		403			{object}	docsResponse.Response403		"Forbidden - Invalid X-AUTH-APP or user is deactivated"
+// @Failure		429			{object}	docsResponse.Response429		"Two-factor login is locked after too many invalid codes"
 // @Failure		500			{object}	docsResponse.Response500		"Server Error"
 // @Router			/api/v1/auth/dashboard/login [post]
 func (h *Handler) DashboardLogin(w http.ResponseWriter, r *http.Request) {
@@ -48,7 +52,15 @@ func (h *Handler) DashboardLogin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	authData, err := h.svc.DashboardLogin(dashboardLoginDto)
+	authData, err := h.svc.DashboardLogin(dashboardLoginDto, sessionInfo(r))
+	if errors.Is(err, ErrUserDeactivated) {
+		response.SendError(w, http.StatusForbidden, err.Error(), response.Forbidden)
+		return
+	}
+	if errors.Is(err, ErrTwoFactorLocked) {
+		response.SendError(w, http.StatusTooManyRequests, err.Error(), response.TooManyRequests)
+		return
+	}
 	if err != nil {
 		msg := fmt.Sprintf("failed to login: %v", err)
 		response.SendError(w, http.StatusBadRequest, msg, response.ServerError)
@@ -91,7 +103,657 @@ func (h *Handler) DashboardRefreshToken(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	tokenPair, err := h.svc.DashboardRefreshToken(refreshTokenDto)
+	tokenPair, err := h.svc.DashboardRefreshToken(refreshTokenDto, sessionInfo(r))
+	if err != nil {
+		msg := fmt.Sprintf("failed to refresh token: %v", err)
+		response.SendError(w, http.StatusUnauthorized, msg, response.Unauthorized)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, tokenPair)
+}
+
+// @Summary		Dashboard sessions
+// @Description	List active sessions (signed-in devices) of the current dashboard user, most recently used first
+// @Tags			Auth
+// @Security		BearerAuth
+// @Security		AppAuth
+// @Produce		json
+// @Success		200	{object}	docsResponse.DashboardSessions200	"Sessions"
+// @Failure		401	{object}	docsResponse.Response401			"Unauthorized"
+// @Failure		403	{object}	docsResponse.Response403			"Forbidden - Invalid X-AUTH-APP"
+// @Failure		500	{object}	docsResponse.Response500			"Server Error"
+// @Router			/api/v1/auth/dashboard/sessions [get]
+func (h *Handler) DashboardSessions(w http.ResponseWriter, r *http.Request) {
+	claims, ok := r.Context().Value("dashboardClaims").(*services.DashboardClaims)
+	if !ok || claims == nil {
+		response.SendError(w, http.StatusUnauthorized, "Unauthorized", response.Unauthorized)
+		return
+	}
+
+	sessions, err := h.svc.DashboardSessions(claims.Email, claims.SessionID)
+	if err != nil {
+		msg := fmt.Sprintf("failed to get sessions: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, sessions)
+}
+
+// @Summary		Dashboard logout
+// @Description	End the current session. Its refresh and access tokens stop working immediately.
+// @Tags			Auth
+// @Security		BearerAuth
+// @Security		AppAuth
+// @Produce		json
+// @Success		200	{object}	docsResponse.DashboardLogout200	"Logged out"
+// @Failure		401	{object}	docsResponse.Response401		"Unauthorized"
+// @Failure		403	{object}	docsResponse.Response403		"Forbidden - Invalid X-AUTH-APP"
+// @Failure		404	{object}	docsResponse.Response404		"Session not found"
+// @Failure		500	{object}	docsResponse.Response500		"Server Error"
+// @Router			/api/v1/auth/dashboard/logout [post]
+func (h *Handler) DashboardLogout(w http.ResponseWriter, r *http.Request) {
+	claims, ok := r.Context().Value("dashboardClaims").(*services.DashboardClaims)
+	if !ok || claims == nil {
+		response.SendError(w, http.StatusUnauthorized, "Unauthorized", response.Unauthorized)
+		return
+	}
+
+	h.revokeSession(w, claims.Email, claims.SessionID)
+}
+
+// @Summary		Dashboard revoke session
+// @Description	End one of the sessions of the current dashboard user. Its refresh and access tokens stop working immediately.
+// @Tags			Auth
+// @Security		BearerAuth
+// @Security		AppAuth
+// @Produce		json
+// @Param			id	path		string							true	"Session ID"
+// @Success		200	{object}	docsResponse.DashboardLogout200	"Session revoked"
+// @Failure		401	{object}	docsResponse.Response401		"Unauthorized"
+// @Failure		403	{object}	docsResponse.Response403		"Forbidden - Invalid X-AUTH-APP"
+// @Failure		404	{object}	docsResponse.Response404		"Session not found"
+// @Failure		500	{object}	docsResponse.Response500		"Server Error"
+// @Router			/api/v1/auth/dashboard/sessions/{id}/delete [delete]
+func (h *Handler) DashboardRevokeSession(w http.ResponseWriter, r *http.Request) {
+	claims, ok := r.Context().Value("dashboardClaims").(*services.DashboardClaims)
+	if !ok || claims == nil {
+		response.SendError(w, http.StatusUnauthorized, "Unauthorized", response.Unauthorized)
+		return
+	}
+
+	h.revokeSession(w, claims.Email, r.PathValue("id"))
+}
+
+// @Summary		Dashboard revoke all sessions
+// @Description	End every session of the current dashboard user, including the current one
+// @Tags			Auth
+// @Security		BearerAuth
+// @Security		AppAuth
+// @Produce		json
+// @Success		200	{object}	docsResponse.DashboardLogout200	"Sessions revoked"
+// @Failure		401	{object}	docsResponse.Response401		"Unauthorized"
+// @Failure		403	{object}	docsResponse.Response403		"Forbidden - Invalid X-AUTH-APP"
+// @Failure		500	{object}	docsResponse.Response500		"Server Error"
+// @Router			/api/v1/auth/dashboard/sessions/delete [delete]
+func (h *Handler) DashboardRevokeAllSessions(w http.ResponseWriter, r *http.Request) {
+	claims, ok := r.Context().Value("dashboardClaims").(*services.DashboardClaims)
+	if !ok || claims == nil {
+		response.SendError(w, http.StatusUnauthorized, "Unauthorized", response.Unauthorized)
+		return
+	}
+
+	if err := h.svc.DashboardRevokeAllSessions(claims.Email); err != nil {
+		msg := fmt.Sprintf("failed to revoke sessions: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, nil)
+}
+
+func (h *Handler) revokeSession(w http.ResponseWriter, email, sessionID string) {
+	err := h.svc.DashboardRevokeSession(email, sessionID)
+	if errors.Is(err, ErrSessionNotFound) {
+		response.SendError(w, http.StatusNotFound, err.Error(), response.NotFound)
+		return
+	}
+	if err != nil {
+		msg := fmt.Sprintf("failed to revoke session: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, nil)
+}
+
+// @Summary		Dashboard change password
+// @Description	Change the password of the current dashboard user. All sessions and access tokens of the user are revoked, so every device has to log in again.
+// @Tags			Auth
+// @Security		BearerAuth
+// @Security		AppAuth
+// @Accept			json
+// @Produce		json
+// @Param			passwords	body		dto.DashboardChangePasswordDTO			true	"Current and new password"
+// @Success		200			{object}	docsResponse.DashboardChangePassword200	"Password changed"
+// @Failure		400			{object}	docsResponse.DashboardChangePassword400	"Bad Request, Validation Error or invalid current password"
+// @Failure		401			{object}	docsResponse.Response401				"Unauthorized"
+// @Failure		403			{object}	docsResponse.Response403				"Forbidden - Invalid X-AUTH-APP or user is deactivated"
+// @Failure		500			{object}	docsResponse.Response500				"Server Error"
+// @Router			/api/v1/auth/dashboard/me/password [post]
+func (h *Handler) DashboardChangePassword(w http.ResponseWriter, r *http.Request) {
+	claims, ok := r.Context().Value("dashboardClaims").(*services.DashboardClaims)
+	if !ok || claims == nil {
+		response.SendError(w, http.StatusUnauthorized, "Unauthorized", response.Unauthorized)
+		return
+	}
+
+	changeDto, err := request.DecodeBody[dto.DashboardChangePasswordDTO](r.Body)
+	if err != nil {
+		msg := fmt.Sprintf("invalid request body: %v", err)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	errFields := constraint.ValidateDTO(changeDto)
+	if errFields != nil {
+		msg := "validation errors occurred"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	err = h.svc.DashboardChangePassword(claims.Email, changeDto)
+	if errors.Is(err, ErrInvalidPassword) {
+		errFields := []response.ErrorField{response.NewErrorField("currentPassword", string(response.InvalidPassword))}
+		response.SendValidationError(w, http.StatusBadRequest, err.Error(), response.BadRequest, errFields)
+		return
+	}
+	if errors.Is(err, ErrUserDeactivated) {
+		response.SendError(w, http.StatusForbidden, err.Error(), response.Forbidden)
+		return
+	}
+	if err != nil {
+		msg := fmt.Sprintf("failed to change password: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, nil)
+}
+
+// @Summary		Dashboard request password reset
+// @Description	Send a single-use password reset link to the email. The response is the same for unknown emails.
+// @Tags			Auth
+// @Security		AppAuth
+// @Accept			json
+// @Produce		json
+// @Param			email	body		dto.DashboardPasswordResetRequestDTO			true	"Dashboard user email"
+// @Success		200		{object}	docsResponse.DashboardPasswordResetRequest200	"Reset link sent if the user exists"
+// @Failure		400		{object}	docsResponse.DashboardPasswordResetRequest400	"Bad Request or Validation Error"
+// @Failure		403		{object}	docsResponse.Response403						"Forbidden - Invalid X-AUTH-APP"
+// @Failure		429		{object}	docsResponse.Response429						"Reset was requested too often"
+// @Failure		500		{object}	docsResponse.Response500						"Server Error"
+// @Router			/api/v1/auth/dashboard/password-reset/request [post]
+func (h *Handler) DashboardRequestPasswordReset(w http.ResponseWriter, r *http.Request) {
+	requestDto, err := request.DecodeBody[dto.DashboardPasswordResetRequestDTO](r.Body)
+	if err != nil {
+		msg := fmt.Sprintf("invalid request body: %v", err)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	errFields := constraint.ValidateDTO(requestDto)
+	if errFields != nil {
+		msg := "validation errors occurred"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	err = h.svc.DashboardRequestPasswordReset(requestDto)
+	if errors.Is(err, ErrResetRequestedTooOften) {
+		response.SendError(w, http.StatusTooManyRequests, err.Error(), response.TooManyRequests)
+		return
+	}
+	if err != nil {
+		msg := fmt.Sprintf("failed to request password reset: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, nil)
+}
+
+// @Summary		Dashboard confirm password reset
+// @Description	Set a new password with the token from the reset email. The token can be used once; all sessions and access tokens of the user are revoked.
+// @Tags			Auth
+// @Security		AppAuth
+// @Accept			json
+// @Produce		json
+// @Param			reset	body		dto.DashboardPasswordResetDTO			true	"Reset token and new password"
+// @Success		200		{object}	docsResponse.DashboardPasswordReset200	"Password changed"
+// @Failure		400		{object}	docsResponse.DashboardPasswordReset400	"Bad Request, Validation Error or invalid token"
+// @Failure		403		{object}	docsResponse.Response403				"Forbidden - Invalid X-AUTH-APP or user is deactivated"
+// @Failure		500		{object}	docsResponse.Response500				"Server Error"
+// @Router			/api/v1/auth/dashboard/password-reset/confirm [post]
+func (h *Handler) DashboardResetPassword(w http.ResponseWriter, r *http.Request) {
+	resetDto, err := request.DecodeBody[dto.DashboardPasswordResetDTO](r.Body)
+	if err != nil {
+		msg := fmt.Sprintf("invalid request body: %v", err)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	errFields := constraint.ValidateDTO(resetDto)
+	if errFields != nil {
+		msg := "validation errors occurred"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	err = h.svc.DashboardResetPassword(resetDto)
+	if errors.Is(err, ErrInvalidResetToken) {
+		response.SendError(w, http.StatusBadRequest, err.Error(), response.InvalidToken)
+		return
+	}
+	if errors.Is(err, ErrUserDeactivated) {
+		response.SendError(w, http.StatusForbidden, err.Error(), response.Forbidden)
+		return
+	}
+	if err != nil {
+		msg := fmt.Sprintf("failed to reset password: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, nil)
+}
+
+// @Summary		Dashboard verify second factor
+// @Description	Finish a login of a user with two-factor authentication: exchange the challenge token from /auth/dashboard/login and a code from the authenticator app or a recovery code for a token pair. The challenge expires in 5 minutes. Invalid codes are counted per user: after 5 of them within 15 minutes no new challenges are issued until the window expires.
+// @Tags			Auth
+// @Security		AppAuth
+// @Accept			json
+// @Produce		json
+// @Param			credentials	body		dto.TwoFactorVerifyDTO						true	"Challenge token and code"
+// @Success		200			{object}	docsResponse.DashboardLogin200				"Login successful"
+// @Failure		400			{object}	docsResponse.DashboardTwoFactor400			"Bad Request or Validation Error"
+// @Failure		401			{object}	docsResponse.DashboardVerifyTwoFactor401	"Invalid code or expired challenge"
+// @Failure		403			{object}	docsResponse.Response403					"Forbidden - Invalid X-AUTH-APP or user is deactivated"
+// @Failure		429			{object}	docsResponse.Response429					"Too many invalid codes, try again later"
+// @Failure		500			{object}	docsResponse.Response500					"Server Error"
+// @Router			/api/v1/auth/dashboard/2fa/verify [post]
+func (h *Handler) DashboardVerifyTwoFactor(w http.ResponseWriter, r *http.Request) {
+	verifyDto, err := request.DecodeBody[dto.TwoFactorVerifyDTO](r.Body)
+	if err != nil {
+		msg := fmt.Sprintf("invalid request body: %v", err)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	errFields := constraint.ValidateDTO(verifyDto)
+	if errFields != nil {
+		msg := "validation errors occurred"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	authData, err := h.svc.DashboardVerifyTwoFactor(verifyDto, sessionInfo(r))
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrInvalidTwoFactorCode):
+			response.SendError(w, http.StatusUnauthorized, err.Error(), response.InvalidCode)
+		case errors.Is(err, ErrInvalidChallenge):
+			response.SendError(w, http.StatusUnauthorized, err.Error(), response.InvalidToken)
+		case errors.Is(err, ErrTwoFactorLocked):
+			response.SendError(w, http.StatusTooManyRequests, err.Error(), response.TooManyRequests)
+		case errors.Is(err, ErrUserDeactivated):
+			response.SendError(w, http.StatusForbidden, err.Error(), response.Forbidden)
+		default:
+			msg := fmt.Sprintf("failed to login: %v", err)
+			response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		}
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, authData)
+}
+
+// @Summary		Dashboard two-factor status
+// @Description	Whether two-factor authentication is enabled for the current dashboard user and how many recovery codes are left
+// @Tags			Auth
+// @Security		BearerAuth
+// @Security		AppAuth
+// @Produce		json
+// @Success		200	{object}	docsResponse.DashboardTwoFactorStatus200	"Two-factor status"
+// @Failure		401	{object}	docsResponse.Response401					"Unauthorized"
+// @Failure		403	{object}	docsResponse.Response403					"Forbidden - Invalid X-AUTH-APP or user is deactivated"
+// @Failure		500	{object}	docsResponse.Response500					"Server Error"
+// @Router			/api/v1/auth/dashboard/2fa [get]
+func (h *Handler) DashboardTwoFactorStatus(w http.ResponseWriter, r *http.Request) {
+	claims, ok := r.Context().Value("dashboardClaims").(*services.DashboardClaims)
+	if !ok || claims == nil {
+		response.SendError(w, http.StatusUnauthorized, "Unauthorized", response.Unauthorized)
+		return
+	}
+
+	status, err := h.svc.DashboardTwoFactorStatus(claims.Email)
+	if err != nil {
+		sendTwoFactorError(w, err, "failed to get two-factor status")
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, status)
+}
+
+// @Summary		Dashboard start two-factor setup
+// @Description	Generate a TOTP secret and an otpauth:// URI for the authenticator app. Two-factor authentication is enabled only after confirmation with a code within 10 minutes.
+// @Tags			Auth
+// @Security		BearerAuth
+// @Security		AppAuth
+// @Produce		json
+// @Success		200	{object}	docsResponse.DashboardTwoFactorSetup200	"Secret and otpauth URI"
+// @Failure		401	{object}	docsResponse.Response401				"Unauthorized"
+// @Failure		403	{object}	docsResponse.Response403				"Forbidden - Invalid X-AUTH-APP or user is deactivated"
+// @Failure		409	{object}	docsResponse.DashboardTwoFactor409		"Two-factor authentication is already enabled"
+// @Failure		500	{object}	docsResponse.Response500				"Server Error"
+// @Router			/api/v1/auth/dashboard/2fa/setup [post]
+func (h *Handler) DashboardSetupTwoFactor(w http.ResponseWriter, r *http.Request) {
+	claims, ok := r.Context().Value("dashboardClaims").(*services.DashboardClaims)
+	if !ok || claims == nil {
+		response.SendError(w, http.StatusUnauthorized, "Unauthorized", response.Unauthorized)
+		return
+	}
+
+	setup, err := h.svc.DashboardSetupTwoFactor(claims.Email)
+	if err != nil {
+		sendTwoFactorError(w, err, "failed to start two-factor setup")
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, setup)
+}
+
+// @Summary		Dashboard confirm two-factor setup
+// @Description	Enable two-factor authentication with a code from the authenticator app. Returns recovery codes; they are shown only once.
+// @Tags			Auth
+// @Security		BearerAuth
+// @Security		AppAuth
+// @Accept			json
+// @Produce		json
+// @Param			code	body		dto.TwoFactorCodeDTO					true	"Code from the authenticator app"
+// @Success		200		{object}	docsResponse.DashboardRecoveryCodes200	"Recovery codes"
+// @Failure		400		{object}	docsResponse.DashboardTwoFactor400		"Bad Request, Validation Error, invalid code or setup not started"
+// @Failure		401		{object}	docsResponse.Response401				"Unauthorized"
+// @Failure		403		{object}	docsResponse.Response403				"Forbidden - Invalid X-AUTH-APP or user is deactivated"
+// @Failure		409		{object}	docsResponse.DashboardTwoFactor409		"Two-factor authentication is already enabled"
+// @Failure		500		{object}	docsResponse.Response500				"Server Error"
+// @Router			/api/v1/auth/dashboard/2fa/confirm [post]
+func (h *Handler) DashboardConfirmTwoFactor(w http.ResponseWriter, r *http.Request) {
+	claims, ok := r.Context().Value("dashboardClaims").(*services.DashboardClaims)
+	if !ok || claims == nil {
+		response.SendError(w, http.StatusUnauthorized, "Unauthorized", response.Unauthorized)
+		return
+	}
+
+	codeDto, err := request.DecodeBody[dto.TwoFactorCodeDTO](r.Body)
+	if err != nil {
+		msg := fmt.Sprintf("invalid request body: %v", err)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	errFields := constraint.ValidateDTO(codeDto)
+	if errFields != nil {
+		msg := "validation errors occurred"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	codes, err := h.svc.DashboardConfirmTwoFactor(claims.Email, codeDto)
+	if err != nil {
+		sendTwoFactorError(w, err, "failed to enable two-factor authentication")
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, codes)
+}
+
+// @Summary		Dashboard disable two-factor
+// @Description	Disable two-factor authentication. Requires the password and a code from the authenticator app or a recovery code; remaining recovery codes are deleted.
+// @Tags			Auth
+// @Security		BearerAuth
+// @Security		AppAuth
+// @Accept			json
+// @Produce		json
+// @Param			credentials	body		dto.TwoFactorDisableDTO						true	"Password and code"
+// @Success		200			{object}	docsResponse.DashboardTwoFactorDisable200	"Two-factor authentication disabled"
+// @Failure		400			{object}	docsResponse.DashboardTwoFactor400			"Bad Request, Validation Error, invalid password or code"
+// @Failure		401			{object}	docsResponse.Response401					"Unauthorized"
+// @Failure		403			{object}	docsResponse.Response403					"Forbidden - Invalid X-AUTH-APP or user is deactivated"
+// @Failure		409			{object}	docsResponse.DashboardTwoFactor409			"Two-factor authentication is not enabled"
+// @Failure		429			{object}	docsResponse.Response429					"Too many invalid codes, try again later"
+// @Failure		500			{object}	docsResponse.Response500					"Server Error"
+// @Router			/api/v1/auth/dashboard/2fa/disable [post]
+func (h *Handler) DashboardDisableTwoFactor(w http.ResponseWriter, r *http.Request) {
+	claims, ok := r.Context().Value("dashboardClaims").(*services.DashboardClaims)
+	if !ok || claims == nil {
+		response.SendError(w, http.StatusUnauthorized, "Unauthorized", response.Unauthorized)
+		return
+	}
+
+	disableDto, err := request.DecodeBody[dto.TwoFactorDisableDTO](r.Body)
+	if err != nil {
+		msg := fmt.Sprintf("invalid request body: %v", err)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	errFields := constraint.ValidateDTO(disableDto)
+	if errFields != nil {
+		msg := "validation errors occurred"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	err = h.svc.DashboardDisableTwoFactor(claims.Email, disableDto)
+	if err != nil {
+		sendTwoFactorError(w, err, "failed to disable two-factor authentication")
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, nil)
+}
+
+// @Summary		Dashboard regenerate recovery codes
+// @Description	Replace all recovery codes with new ones. Requires a code from the authenticator app; the new codes are shown only once.
+// @Tags			Auth
+// @Security		BearerAuth
+// @Security		AppAuth
+// @Accept			json
+// @Produce		json
+// @Param			code	body		dto.TwoFactorCodeDTO					true	"Code from the authenticator app"
+// @Success		200		{object}	docsResponse.DashboardRecoveryCodes200	"Recovery codes"
+// @Failure		400		{object}	docsResponse.DashboardTwoFactor400		"Bad Request, Validation Error or invalid code"
+// @Failure		401		{object}	docsResponse.Response401				"Unauthorized"
+// @Failure		403		{object}	docsResponse.Response403				"Forbidden - Invalid X-AUTH-APP or user is deactivated"
+// @Failure		409		{object}	docsResponse.DashboardTwoFactor409		"Two-factor authentication is not enabled"
+// @Failure		429		{object}	docsResponse.Response429				"Too many invalid codes, try again later"
+// @Failure		500		{object}	docsResponse.Response500				"Server Error"
+// @Router			/api/v1/auth/dashboard/2fa/recovery-codes [post]
+func (h *Handler) DashboardRegenerateRecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	claims, ok := r.Context().Value("dashboardClaims").(*services.DashboardClaims)
+	if !ok || claims == nil {
+		response.SendError(w, http.StatusUnauthorized, "Unauthorized", response.Unauthorized)
+		return
+	}
+
+	codeDto, err := request.DecodeBody[dto.TwoFactorCodeDTO](r.Body)
+	if err != nil {
+		msg := fmt.Sprintf("invalid request body: %v", err)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	errFields := constraint.ValidateDTO(codeDto)
+	if errFields != nil {
+		msg := "validation errors occurred"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	codes, err := h.svc.DashboardRegenerateRecoveryCodes(claims.Email, codeDto)
+	if err != nil {
+		sendTwoFactorError(w, err, "failed to regenerate recovery codes")
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, codes)
+}
+
+// sendTwoFactorError отвечает на ошибки управления 2FA текущего пользователя.
+func sendTwoFactorError(w http.ResponseWriter, err error, action string) {
+	switch {
+	case errors.Is(err, ErrInvalidTwoFactorCode):
+		errFields := []response.ErrorField{response.NewErrorField("code", string(response.InvalidCode))}
+		response.SendValidationError(w, http.StatusBadRequest, err.Error(), response.BadRequest, errFields)
+	case errors.Is(err, ErrInvalidPassword):
+		errFields := []response.ErrorField{response.NewErrorField("password", string(response.InvalidPassword))}
+		response.SendValidationError(w, http.StatusBadRequest, err.Error(), response.BadRequest, errFields)
+	case errors.Is(err, ErrTwoFactorSetupNotStarted):
+		response.SendError(w, http.StatusBadRequest, err.Error(), response.BadRequest)
+	case errors.Is(err, ErrTwoFactorLocked):
+		response.SendError(w, http.StatusTooManyRequests, err.Error(), response.TooManyRequests)
+	case errors.Is(err, ErrTwoFactorAlreadyEnabled):
+		response.SendError(w, http.StatusConflict, err.Error(), response.TwoFactorEnabled)
+	case errors.Is(err, ErrTwoFactorNotEnabled):
+		response.SendError(w, http.StatusConflict, err.Error(), response.TwoFactorDisabled)
+	case errors.Is(err, ErrUserDeactivated):
+		response.SendError(w, http.StatusForbidden, err.Error(), response.Forbidden)
+	default:
+		msg := fmt.Sprintf("%s: %v", action, err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+	}
+}
+
+// @Summary		Client request login code
+// @Description	Send a one-time login code to the client phone by SMS
+// @Tags			Auth
+// @Security		AppAuth
+// @Accept			json
+// @Produce		json
+// @Param			phone	body		dto.ClientRequestCodeDTO			true	"Client phone"
+// @Success		200		{object}	docsResponse.ClientRequestCode200	"Code sent"
+// @Failure		400		{object}	docsResponse.ClientRequestCode400	"Bad Request or Validation Error"
+// @Failure		403		{object}	docsResponse.Response403			"Forbidden - Invalid X-AUTH-APP"
+// @Failure		429		{object}	docsResponse.Response429			"Code was requested too often"
+// @Failure		500		{object}	docsResponse.Response500			"Server Error"
+// @Router			/api/v1/auth/client/request-code [post]
+func (h *Handler) ClientRequestCode(w http.ResponseWriter, r *http.Request) {
+	requestCodeDto, err := request.DecodeBody[dto.ClientRequestCodeDTO](r.Body)
+	if err != nil {
+		msg := fmt.Sprintf("invalid request body: %v", err)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	errFields := constraint.ValidateDTO(requestCodeDto)
+	if errFields != nil {
+		msg := "validation errors occurred"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	codeData, err := h.svc.ClientRequestCode(requestCodeDto)
+	if err != nil {
+		if errors.Is(err, ErrCodeRequestedTooOften) {
+			response.SendError(w, http.StatusTooManyRequests, err.Error(), response.TooManyRequests)
+			return
+		}
+
+		msg := fmt.Sprintf("failed to send code: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, codeData)
+}
+
+// @Summary		Client verify login code
+// @Description	Verify the one-time code and authenticate the client. Unknown phones are registered automatically.
+// @Tags			Auth
+// @Security		AppAuth
+// @Accept			json
+// @Produce		json
+// @Param			credentials	body		dto.ClientVerifyCodeDTO				true	"Client phone and code"
+// @Success		200			{object}	docsResponse.ClientVerifyCode200	"Login successful"
+// @Failure		400			{object}	docsResponse.ClientVerifyCode400	"Bad Request or Validation Error"
+// @Failure		401			{object}	docsResponse.ClientVerifyCode401	"Invalid or expired code"
+// @Failure		403			{object}	docsResponse.Response403			"Forbidden - Invalid X-AUTH-APP"
+// @Failure		429			{object}	docsResponse.Response429			"Too many attempts"
+// @Failure		500			{object}	docsResponse.Response500			"Server Error"
+// @Router			/api/v1/auth/client/verify [post]
+func (h *Handler) ClientVerifyCode(w http.ResponseWriter, r *http.Request) {
+	verifyCodeDto, err := request.DecodeBody[dto.ClientVerifyCodeDTO](r.Body)
+	if err != nil {
+		msg := fmt.Sprintf("invalid request body: %v", err)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	errFields := constraint.ValidateDTO(verifyCodeDto)
+	if errFields != nil {
+		msg := "validation errors occurred"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	authData, err := h.svc.ClientVerifyCode(verifyCodeDto)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrInvalidCode):
+			response.SendError(w, http.StatusUnauthorized, err.Error(), response.InvalidCode)
+		case errors.Is(err, ErrTooManyAttempts):
+			response.SendError(w, http.StatusTooManyRequests, err.Error(), response.TooManyRequests)
+		default:
+			msg := fmt.Sprintf("failed to login: %v", err)
+			response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		}
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, authData)
+}
+
+// @Summary		Client refresh token
+// @Description	Refresh authentication token for client user
+// @Tags			Auth
+// @Security		AppAuth
+// @Accept			json
+// @Produce		json
+// @Param			refreshToken	body		dto.RefreshTokenDTO					true	"Refresh token"
+// @Success		200				{object}	docsResponse.ClientRefreshToken200	"Token refreshed successfully"
+// @Failure		400				{object}	docsResponse.ClientRefreshToken400	"Bad Request or Validation Error"
+// @Failure		401				{object}	docsResponse.Response401			"Unauthorized or Invalid Token"
+// @Failure		403				{object}	docsResponse.Response403			"Forbidden - Invalid X-AUTH-APP"
+// @Failure		500				{object}	docsResponse.Response500			"Server Error"
+// @Router			/api/v1/auth/client/refresh [post]
+func (h *Handler) ClientRefreshToken(w http.ResponseWriter, r *http.Request) {
+	refreshTokenDto, err := request.DecodeBody[dto.RefreshTokenDTO](r.Body)
+	if err != nil {
+		msg := fmt.Sprintf("invalid request body: %v", err)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	errFields := constraint.ValidateDTO(refreshTokenDto)
+	if errFields != nil {
+		msg := "validation errors occurred"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	tokenPair, err := h.svc.ClientRefreshToken(refreshTokenDto)
 	if err != nil {
 		msg := fmt.Sprintf("failed to refresh token: %v", err)
 		response.SendError(w, http.StatusUnauthorized, msg, response.Unauthorized)
@@ -100,3 +762,16 @@ func (h *Handler) DashboardRefreshToken(w http.ResponseWriter, r *http.Request)
 
 	response.SendSuccess(w, http.StatusOK, tokenPair)
 }
+
+// sessionInfo берёт адрес и User-Agent устройства из запроса.
+func sessionInfo(r *http.Request) SessionInfo {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+
+	return SessionInfo{
+		IP:        ip,
+		UserAgent: r.UserAgent(),
+	}
+}
@@ -2,16 +2,29 @@ package auth
 
 import (
 	"haircompany-shop-rest/internal/container"
+	"haircompany-shop-rest/internal/middleware"
+	"haircompany-shop-rest/internal/modules/v1/cart"
 	"haircompany-shop-rest/internal/modules/v1/client_user"
 	"haircompany-shop-rest/internal/modules/v1/dashboard_user"
+	"haircompany-shop-rest/internal/modules/v1/inventory"
+	"haircompany-shop-rest/internal/modules/v1/product_variant"
+	"haircompany-shop-rest/internal/modules/v1/promo_code"
 	"haircompany-shop-rest/pkg/response"
 	"net/http"
 )
 
-func RegisterV1AuthRoutes(mux *http.ServeMux, container *container.Container) {
+func newService(container *container.Container) Service {
 	dashboardUserRepo := dashboard_user.NewRepository(container.DB)
 	clientUserRepo := client_user.NewRepository(container.DB)
-	svc := NewService(container.RedisService, container.JWTService, container.PasswordService, dashboardUserRepo, clientUserRepo)
+	variantRepo := product_variant.NewRepository(container.DB)
+	inventorySvc := inventory.NewService(inventory.NewRepository(container.DB), variantRepo)
+	promoCodeSvc := promo_code.NewService(promo_code.NewRepository(container.DB))
+	cartSvc := cart.NewService(cart.NewRepository(container.DB), variantRepo, clientUserRepo, inventorySvc, promoCodeSvc)
+	return NewService(container.RedisService, container.DashboardSessions, container.TokenDenylist, container.JWTService, container.PasswordService, container.TOTPService, dashboardUserRepo, clientUserRepo, container.Queue, container.Mailer, container.PasswordResetURL, container.ClientCodeKey, cartSvc)
+}
+
+func RegisterV1AuthRoutes(mux *http.ServeMux, container *container.Container) {
+	svc := newService(container)
 	h := NewHandler(svc)
 
 	mux.HandleFunc("/auth/dashboard/login", func(w http.ResponseWriter, r *http.Request) {
@@ -33,4 +46,164 @@ func RegisterV1AuthRoutes(mux *http.ServeMux, container *container.Container) {
 			response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
 		}
 	})
+
+	mux.Handle("/auth/dashboard/logout", middleware.ChainMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			h.DashboardLogout(w, r)
+		default:
+			msg := "Method not allowed. Allowed methods: POST"
+			response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+		}
+	}), middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist)))
+
+	mux.Handle("/auth/dashboard/sessions", middleware.ChainMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			h.DashboardSessions(w, r)
+		default:
+			msg := "Method not allowed. Allowed methods: GET"
+			response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+		}
+	}), middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist)))
+
+	mux.Handle("/auth/dashboard/sessions/delete", middleware.ChainMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodDelete:
+			h.DashboardRevokeAllSessions(w, r)
+		default:
+			msg := "Method not allowed. Allowed methods: DELETE"
+			response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+		}
+	}), middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist)))
+
+	mux.Handle("/auth/dashboard/sessions/{id}/delete", middleware.ChainMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodDelete:
+			h.DashboardRevokeSession(w, r)
+		default:
+			msg := "Method not allowed. Allowed methods: DELETE"
+			response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+		}
+	}), middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist)))
+
+	mux.Handle("/auth/dashboard/me/password", middleware.ChainMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			h.DashboardChangePassword(w, r)
+		default:
+			msg := "Method not allowed. Allowed methods: POST"
+			response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+		}
+	}), middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist)))
+
+	mux.HandleFunc("/auth/dashboard/password-reset/request", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			h.DashboardRequestPasswordReset(w, r)
+		default:
+			msg := "Method not allowed. Allowed methods: POST"
+			response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+		}
+	})
+
+	mux.HandleFunc("/auth/dashboard/password-reset/confirm", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			h.DashboardResetPassword(w, r)
+		default:
+			msg := "Method not allowed. Allowed methods: POST"
+			response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+		}
+	})
+
+	mux.HandleFunc("/auth/dashboard/2fa/verify", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			h.DashboardVerifyTwoFactor(w, r)
+		default:
+			msg := "Method not allowed. Allowed methods: POST"
+			response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+		}
+	})
+
+	mux.Handle("/auth/dashboard/2fa", middleware.ChainMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			h.DashboardTwoFactorStatus(w, r)
+		default:
+			msg := "Method not allowed. Allowed methods: GET"
+			response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+		}
+	}), middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist)))
+
+	mux.Handle("/auth/dashboard/2fa/setup", middleware.ChainMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			h.DashboardSetupTwoFactor(w, r)
+		default:
+			msg := "Method not allowed. Allowed methods: POST"
+			response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+		}
+	}), middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist)))
+
+	mux.Handle("/auth/dashboard/2fa/confirm", middleware.ChainMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			h.DashboardConfirmTwoFactor(w, r)
+		default:
+			msg := "Method not allowed. Allowed methods: POST"
+			response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+		}
+	}), middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist)))
+
+	mux.Handle("/auth/dashboard/2fa/disable", middleware.ChainMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			h.DashboardDisableTwoFactor(w, r)
+		default:
+			msg := "Method not allowed. Allowed methods: POST"
+			response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+		}
+	}), middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist)))
+
+	mux.Handle("/auth/dashboard/2fa/recovery-codes", middleware.ChainMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			h.DashboardRegenerateRecoveryCodes(w, r)
+		default:
+			msg := "Method not allowed. Allowed methods: POST"
+			response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+		}
+	}), middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist)))
+
+	mux.HandleFunc("/auth/client/request-code", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			h.ClientRequestCode(w, r)
+		default:
+			msg := "Method not allowed. Allowed methods: POST"
+			response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+		}
+	})
+
+	mux.HandleFunc("/auth/client/verify", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			h.ClientVerifyCode(w, r)
+		default:
+			msg := "Method not allowed. Allowed methods: POST"
+			response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+		}
+	})
+
+	mux.HandleFunc("/auth/client/refresh", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			h.ClientRefreshToken(w, r)
+		default:
+			msg := "Method not allowed. Allowed methods: POST"
+			response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+		}
+	})
 }
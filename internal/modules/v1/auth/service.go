@@ -1,39 +1,110 @@
 package auth
 
 import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"haircompany-shop-rest/internal/modules/v1/auth/dto"
+	"haircompany-shop-rest/internal/modules/v1/cart"
 	"haircompany-shop-rest/internal/modules/v1/client_user"
+	clientUserModel "haircompany-shop-rest/internal/modules/v1/client_user/model"
 	"haircompany-shop-rest/internal/modules/v1/dashboard_user"
+	dashboardUserModel "haircompany-shop-rest/internal/modules/v1/dashboard_user/model"
 	"haircompany-shop-rest/internal/services"
 	"log"
+	"math/big"
+	"net/url"
 	"time"
 )
 
+const (
+	clientCodeExpiration    = 5 * time.Minute
+	clientCodeResendTimeout = 1 * time.Minute
+	clientCodeMaxAttempts   = 5
+
+	dashboardRefreshExpiration = 30 * 24 * time.Hour
+
+	passwordResetExpiration    = 30 * time.Minute
+	passwordResetResendTimeout = 1 * time.Minute
+)
+
+var (
+	ErrCodeRequestedTooOften  = errors.New("code was requested too often, try again later")
+	ErrInvalidCode            = errors.New("invalid or expired code")
+	ErrTooManyAttempts        = errors.New("too many attempts, request a new code")
+	ErrUserDeactivated        = errors.New("user is deactivated")
+	ErrInvalidPassword        = errors.New("current password is invalid")
+	ErrInvalidResetToken      = errors.New("invalid or expired password reset token")
+	ErrResetRequestedTooOften = errors.New("password reset was requested too often, try again later")
+	ErrSessionNotFound        = errors.New("session not found")
+)
+
+// SessionInfo описывает устройство, с которого пришёл запрос.
+type SessionInfo struct {
+	IP        string
+	UserAgent string
+}
+
 type Service interface {
-	DashboardLogin(loginDto dto.DashboardLoginDTO) (*dto.ResponseDTO, error)
-	DashboardRefreshToken(refreshTokenDto dto.RefreshTokenDTO) (*dto.ResponseDTO, error)
+	DashboardLogin(loginDto dto.DashboardLoginDTO, info SessionInfo) (*dto.ResponseDTO, error)
+	DashboardRefreshToken(refreshTokenDto dto.RefreshTokenDTO, info SessionInfo) (*dto.ResponseDTO, error)
+	DashboardSessions(email, currentSessionID string) ([]*dto.SessionResponseDTO, error)
+	DashboardRevokeSession(email, sessionID string) error
+	DashboardRevokeAllSessions(email string) error
+	DashboardChangePassword(email string, changeDto dto.DashboardChangePasswordDTO) error
+	DashboardRequestPasswordReset(requestDto dto.DashboardPasswordResetRequestDTO) error
+	DashboardResetPassword(resetDto dto.DashboardPasswordResetDTO) error
+	SendPasswordReset(email string) error
+	DashboardVerifyTwoFactor(verifyDto dto.TwoFactorVerifyDTO, info SessionInfo) (*dto.ResponseDTO, error)
+	DashboardTwoFactorStatus(email string) (*dto.TwoFactorStatusResponseDTO, error)
+	DashboardSetupTwoFactor(email string) (*dto.TwoFactorSetupResponseDTO, error)
+	DashboardConfirmTwoFactor(email string, confirmDto dto.TwoFactorCodeDTO) (*dto.RecoveryCodesResponseDTO, error)
+	DashboardDisableTwoFactor(email string, disableDto dto.TwoFactorDisableDTO) error
+	DashboardRegenerateRecoveryCodes(email string, codeDto dto.TwoFactorCodeDTO) (*dto.RecoveryCodesResponseDTO, error)
+	ClientRequestCode(requestCodeDto dto.ClientRequestCodeDTO) (*dto.ClientRequestCodeResponseDTO, error)
+	ClientVerifyCode(verifyCodeDto dto.ClientVerifyCodeDTO) (*dto.ResponseDTO, error)
+	ClientRefreshToken(refreshTokenDto dto.RefreshTokenDTO) (*dto.ResponseDTO, error)
 }
 
 type service struct {
 	redisSvc          services.RedisService
+	sessions          services.DashboardSessionStore
+	denylist          services.TokenDenylist
 	jwtSvc            services.JWTService
 	passwordSvc       services.PasswordService
+	totpSvc           services.TOTPService
 	dashboardUserRepo dashboard_user.Repository
 	clientUserRepo    client_user.Repository
+	queue             services.Queue
+	mailer            services.Mailer
+	passwordResetURL  string
+	clientCodeKey     []byte
+	cartSvc           cart.Service
 }
 
-func NewService(redisSvc services.RedisService, jwtSvc services.JWTService, passwordSvc services.PasswordService, dashboardUserRepo dashboard_user.Repository, clientUserRepo client_user.Repository) Service {
+func NewService(redisSvc services.RedisService, sessions services.DashboardSessionStore, denylist services.TokenDenylist, jwtSvc services.JWTService, passwordSvc services.PasswordService, totpSvc services.TOTPService, dashboardUserRepo dashboard_user.Repository, clientUserRepo client_user.Repository, queue services.Queue, mailer services.Mailer, passwordResetURL string, clientCodeKey []byte, cartSvc cart.Service) Service {
 	return &service{
 		redisSvc:          redisSvc,
+		sessions:          sessions,
+		denylist:          denylist,
 		jwtSvc:            jwtSvc,
 		passwordSvc:       passwordSvc,
+		totpSvc:           totpSvc,
 		dashboardUserRepo: dashboardUserRepo,
 		clientUserRepo:    clientUserRepo,
+		queue:             queue,
+		mailer:            mailer,
+		passwordResetURL:  passwordResetURL,
+		clientCodeKey:     clientCodeKey,
+		cartSvc:           cartSvc,
 	}
 }
 
-func (s *service) DashboardLogin(loginDto dto.DashboardLoginDTO) (*dto.ResponseDTO, error) {
+func (s *service) DashboardLogin(loginDto dto.DashboardLoginDTO, info SessionInfo) (*dto.ResponseDTO, error) {
 	user, err := s.dashboardUserRepo.GetByEmail(loginDto.Email)
 	if err != nil {
 		return nil, err
@@ -44,72 +115,418 @@ func (s *service) DashboardLogin(loginDto dto.DashboardLoginDTO) (*dto.ResponseD
 	if err = s.passwordSvc.CompareHashAndPassword(user.Password, loginDto.Password); err != nil {
 		return nil, nil
 	}
-
-	activeTokenKey := fmt.Sprintf("refresh_token:%s", user.Email)
-	activeRefreshToken, err := s.redisSvc.Get(activeTokenKey)
-	if err == nil && activeRefreshToken != "" {
-		if err := s.redisSvc.Delete(activeRefreshToken); err != nil {
-			log.Printf("Failed to delete old refresh token key %s for user %s: %v", activeRefreshToken, user.Email, err)
-		}
-		if err := s.redisSvc.Delete(activeTokenKey); err != nil {
-			log.Printf("Failed to delete user refresh token key %s: %v", activeTokenKey, err)
-		}
+	// Проверяем после пароля, чтобы не раскрывать статус учётной записи
+	if !user.IsActive {
+		return nil, ErrUserDeactivated
+	}
+	// С включённой 2FA пароль лишь открывает второй шаг входа
+	if user.TOTPEnabled {
+		return s.startLoginChallenge(user, loginDto.Device)
 	}
 
-	tokenPair, err := s.jwtSvc.GenerateDashboardTokenPair(user.Email, user.Role)
+	return s.startDashboardSession(user, loginDto.Device, info)
+}
+
+// startDashboardSession открывает новую сессию: каждое устройство входит
+// отдельно, остальные остаются в системе.
+func (s *service) startDashboardSession(user *dashboardUserModel.DashboardUser, device string, info SessionInfo) (*dto.ResponseDTO, error) {
+	sessionID, err := generateSessionID()
 	if err != nil {
 		return nil, err
 	}
+	tokenPair, err := s.jwtSvc.GenerateDashboardTokenPair(user.Email, user.Role, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	session := &services.DashboardSession{
+		ID:         sessionID,
+		Email:      user.Email,
+		Device:     device,
+		IP:         info.IP,
+		UserAgent:  info.UserAgent,
+		CreatedAt:  now,
+		LastUsedAt: now,
+	}
+	if err := s.sessions.Save(session, tokenPair.RefreshToken, dashboardRefreshExpiration); err != nil {
+		return nil, err
+	}
+
+	return &dto.ResponseDTO{
+		Token:            tokenPair.AccessToken,
+		RefreshToken:     tokenPair.RefreshToken,
+		RefreshExpiresAt: session.ExpiresAt.Unix(),
+	}, nil
+}
+
+func (s *service) DashboardRefreshToken(refreshTokenDto dto.RefreshTokenDTO, info SessionInfo) (*dto.ResponseDTO, error) {
+	session, err := s.sessions.GetByRefreshToken(refreshTokenDto.RefreshToken)
+	if err != nil {
+		return nil, fmt.Errorf("invalid refresh token")
+	}
 
-	refreshExpiration := 30 * 24 * time.Hour // 30 days
+	user, err := s.dashboardUserRepo.GetByEmail(session.Email)
+	if err != nil || user == nil {
+		return nil, fmt.Errorf("user not found")
+	}
+	if !user.IsActive {
+		return nil, ErrUserDeactivated
+	}
 
-	if err := s.redisSvc.Set(tokenPair.RefreshToken, user.Email, refreshExpiration); err != nil {
+	tokenPair, err := s.jwtSvc.GenerateDashboardTokenPair(user.Email, user.Role, session.ID)
+	if err != nil {
 		return nil, err
 	}
 
-	if err := s.redisSvc.Set(activeTokenKey, tokenPair.RefreshToken, refreshExpiration); err != nil {
+	session.IP = info.IP
+	session.UserAgent = info.UserAgent
+	session.LastUsedAt = time.Now()
+	err = s.sessions.Rotate(session, refreshTokenDto.RefreshToken, tokenPair.RefreshToken, dashboardRefreshExpiration)
+	if errors.Is(err, services.ErrSessionNotFound) {
+		return nil, fmt.Errorf("refresh token is not active")
+	}
+	if err != nil {
 		return nil, err
 	}
 
 	return &dto.ResponseDTO{
 		Token:            tokenPair.AccessToken,
 		RefreshToken:     tokenPair.RefreshToken,
-		RefreshExpiresAt: time.Now().Add(refreshExpiration).Unix(),
+		RefreshExpiresAt: session.ExpiresAt.Unix(),
+	}, nil
+}
+
+// DashboardSessions возвращает активные сессии пользователя; текущая
+// определяется по идентификатору сессии из access-токена.
+func (s *service) DashboardSessions(email, currentSessionID string) ([]*dto.SessionResponseDTO, error) {
+	sessions, err := s.sessions.List(email)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionDTOs := make([]*dto.SessionResponseDTO, 0, len(sessions))
+	for _, session := range sessions {
+		sessionDTOs = append(sessionDTOs, dto.TransformSessionToResponseDTO(session, session.ID == currentSessionID))
+	}
+
+	return sessionDTOs, nil
+}
+
+// DashboardRevokeSession завершает одну сессию пользователя вместе с
+// выданными ей access-токенами.
+func (s *service) DashboardRevokeSession(email, sessionID string) error {
+	if sessionID == "" {
+		return ErrSessionNotFound
+	}
+
+	err := s.sessions.Revoke(email, sessionID)
+	if errors.Is(err, services.ErrSessionNotFound) {
+		return ErrSessionNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	return s.denylist.RevokeSession(sessionID)
+}
+
+func (s *service) DashboardRevokeAllSessions(email string) error {
+	return services.RevokeDashboardUser(s.sessions, s.denylist, email)
+}
+
+// DashboardChangePassword меняет пароль по текущему паролю. Все сессии
+// пользователя, включая текущую, завершаются: нужно войти заново.
+func (s *service) DashboardChangePassword(email string, changeDto dto.DashboardChangePasswordDTO) error {
+	user, err := s.dashboardUserRepo.GetByEmail(email)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return fmt.Errorf("user not found")
+	}
+	if !user.IsActive {
+		return ErrUserDeactivated
+	}
+	if err := s.passwordSvc.CompareHashAndPassword(user.Password, changeDto.CurrentPassword); err != nil {
+		return ErrInvalidPassword
+	}
+
+	return s.setDashboardPassword(user, changeDto.NewPassword)
+}
+
+// DashboardRequestPasswordReset ставит в очередь отправку ссылки для сброса
+// пароля. Пользователь ищется уже в задаче: запрос на любой адрес выполняется
+// одинаково и по времени ответа нельзя узнать, зарегистрирован ли адрес.
+func (s *service) DashboardRequestPasswordReset(requestDto dto.DashboardPasswordResetRequestDTO) error {
+	resendKey := fmt.Sprintf("password_reset_resend:%s", requestDto.Email)
+	ok, err := s.redisSvc.SetNX(resendKey, 1, passwordResetResendTimeout)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrResetRequestedTooOften
+	}
+
+	return s.queue.Enqueue(services.QueuePasswordReset, services.PasswordResetPayload{Email: requestDto.Email})
+}
+
+// SendPasswordReset отправляет на почту одноразовую ссылку для сброса пароля.
+// Для неизвестных и деактивированных адресов ничего не отправляется.
+func (s *service) SendPasswordReset(email string) error {
+	user, err := s.dashboardUserRepo.GetByEmail(email)
+	if err != nil {
+		return err
+	}
+	if user == nil || !user.IsActive {
+		return nil
+	}
+
+	token, err := generateToken()
+	if err != nil {
+		return err
+	}
+
+	// Новый запрос отменяет ссылку из предыдущего письма
+	userKey := fmt.Sprintf("password_reset_user:%s", user.Email)
+	if oldHash, err := s.redisSvc.Get(userKey); err == nil && oldHash != "" {
+		if err := s.redisSvc.Delete(passwordResetKey(oldHash)); err != nil {
+			log.Printf("Failed to delete previous password reset token for user %s: %v", user.Email, err)
+		}
+	}
+
+	// В Redis хранится только хэш токена, сам токен уходит пользователю письмом
+	tokenHash := hashToken(token)
+	if err := s.redisSvc.Set(passwordResetKey(tokenHash), user.Email, passwordResetExpiration); err != nil {
+		return err
+	}
+	if err := s.redisSvc.Set(userKey, tokenHash, passwordResetExpiration); err != nil {
+		return err
+	}
+
+	mail := services.Mail{
+		To:      user.Email,
+		Subject: "Восстановление пароля",
+		Body: fmt.Sprintf("Для смены пароля в панели управления перейдите по ссылке:\n%s\n\n"+
+			"Ссылка действует %d минут и может быть использована один раз. "+
+			"Если вы не запрашивали восстановление пароля, проигнорируйте это письмо.",
+			s.passwordResetLink(token), int(passwordResetExpiration.Minutes())),
+	}
+	if err := s.mailer.Send(mail); err != nil {
+		if err := s.redisSvc.Delete(passwordResetKey(tokenHash)); err != nil {
+			log.Printf("Failed to delete password reset token for user %s: %v", user.Email, err)
+		}
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+
+	return nil
+}
+
+// DashboardResetPassword устанавливает новый пароль по токену из письма.
+// Токен одноразовый: удаляется атомарно, повторное использование не пройдёт.
+func (s *service) DashboardResetPassword(resetDto dto.DashboardPasswordResetDTO) error {
+	tokenKey := passwordResetKey(hashToken(resetDto.Token))
+	email, err := s.redisSvc.Get(tokenKey)
+	if err != nil || email == "" {
+		return ErrInvalidResetToken
+	}
+	deleted, err := s.redisSvc.CompareAndDelete(tokenKey, email)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrInvalidResetToken
+	}
+	if err := s.redisSvc.Delete(fmt.Sprintf("password_reset_user:%s", email)); err != nil {
+		log.Printf("Failed to delete password reset key for user %s: %v", email, err)
+	}
+
+	user, err := s.dashboardUserRepo.GetByEmail(email)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return ErrInvalidResetToken
+	}
+	if !user.IsActive {
+		return ErrUserDeactivated
+	}
+
+	return s.setDashboardPassword(user, resetDto.Password)
+}
+
+func (s *service) setDashboardPassword(user *dashboardUserModel.DashboardUser, password string) error {
+	passwordHash, err := s.passwordSvc.GenerateHash(password)
+	if err != nil {
+		return err
+	}
+	user.Password = passwordHash
+
+	if _, err := s.dashboardUserRepo.Update(user); err != nil {
+		return err
+	}
+
+	return services.RevokeDashboardUser(s.sessions, s.denylist, user.Email)
+}
+
+// passwordResetLink подставляет токен в PASSWORD_RESET_URL; без адреса
+// в письмо уходит сам токен.
+func (s *service) passwordResetLink(token string) string {
+	if s.passwordResetURL == "" {
+		return token
+	}
+
+	link, err := url.Parse(s.passwordResetURL)
+	if err != nil {
+		return token
+	}
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+
+	return link.String()
+}
+
+func (s *service) ClientRequestCode(requestCodeDto dto.ClientRequestCodeDTO) (*dto.ClientRequestCodeResponseDTO, error) {
+	resendKey := fmt.Sprintf("client_code_resend:%s", requestCodeDto.Phone)
+	exists, err := s.redisSvc.Exists(resendKey)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, ErrCodeRequestedTooOften
+	}
+
+	code, err := generateClientCode()
+	if err != nil {
+		return nil, err
+	}
+
+	codeKey := fmt.Sprintf("client_code:%s", requestCodeDto.Phone)
+	attemptsKey := fmt.Sprintf("client_code_attempts:%s", requestCodeDto.Phone)
+
+	// В Redis хранится только HMAC кода, сам код уходит пользователю по SMS
+	if err := s.redisSvc.Set(codeKey, s.hashClientCode(requestCodeDto.Phone, code), clientCodeExpiration); err != nil {
+		return nil, err
+	}
+	if err := s.redisSvc.Delete(attemptsKey); err != nil {
+		return nil, err
+	}
+	if err := s.redisSvc.Set(resendKey, 1, clientCodeResendTimeout); err != nil {
+		return nil, err
+	}
+
+	expiresAt := time.Now().Add(clientCodeExpiration)
+	sms := services.SMSPayload{
+		Phone:     requestCodeDto.Phone,
+		Message:   fmt.Sprintf("Код для входа: %s", code),
+		ExpiresAt: expiresAt.Unix(),
+	}
+	if err := s.queue.Enqueue(services.QueueSendSMS, sms); err != nil {
+		if err := s.redisSvc.Delete(codeKey); err != nil {
+			log.Printf("Failed to delete code key %s: %v", codeKey, err)
+		}
+		return nil, fmt.Errorf("failed to queue sms: %w", err)
+	}
+
+	return &dto.ClientRequestCodeResponseDTO{
+		ExpiresAt:  expiresAt.Unix(),
+		RetryAfter: int(clientCodeResendTimeout.Seconds()),
 	}, nil
 }
 
-func (s *service) DashboardRefreshToken(refreshTokenDto dto.RefreshTokenDTO) (*dto.ResponseDTO, error) {
-	userEmail, err := s.redisSvc.Get(refreshTokenDto.RefreshToken) // получаем email по переданному токену
-	if err != nil || userEmail == "" {
+func (s *service) ClientVerifyCode(verifyCodeDto dto.ClientVerifyCodeDTO) (*dto.ResponseDTO, error) {
+	codeKey := fmt.Sprintf("client_code:%s", verifyCodeDto.Phone)
+	attemptsKey := fmt.Sprintf("client_code_attempts:%s", verifyCodeDto.Phone)
+
+	codeHash, err := s.redisSvc.Get(codeKey)
+	if err != nil || codeHash == "" {
+		return nil, ErrInvalidCode
+	}
+
+	attempts, err := s.redisSvc.Incr(attemptsKey, clientCodeExpiration)
+	if err != nil {
+		return nil, err
+	}
+	if attempts > clientCodeMaxAttempts {
+		if err := s.redisSvc.Delete(codeKey); err != nil {
+			log.Printf("Failed to delete code key %s: %v", codeKey, err)
+		}
+		return nil, ErrTooManyAttempts
+	}
+
+	expectedHash := s.hashClientCode(verifyCodeDto.Phone, verifyCodeDto.Code)
+	if subtle.ConstantTimeCompare([]byte(codeHash), []byte(expectedHash)) != 1 {
+		return nil, ErrInvalidCode
+	}
+
+	if err := s.redisSvc.Delete(codeKey); err != nil {
+		return nil, err
+	}
+	if err := s.redisSvc.Delete(attemptsKey); err != nil {
+		log.Printf("Failed to delete attempts key %s: %v", attemptsKey, err)
+	}
+
+	user, err := s.getOrCreateClientUser(verifyCodeDto.Phone)
+	if err != nil {
+		return nil, err
+	}
+
+	// Корзина гостя переносится клиенту; ошибка слияния не должна мешать входу
+	if verifyCodeDto.CartToken != "" {
+		if _, err := s.cartSvc.Merge(user.Phone, verifyCodeDto.CartToken); err != nil {
+			log.Printf("Failed to merge guest cart for client %s: %v", user.Phone, err)
+		}
+	}
+
+	activeTokenKey := fmt.Sprintf("client_refresh_token:%s", user.Phone)
+	activeRefreshToken, err := s.redisSvc.Get(activeTokenKey)
+	if err == nil && activeRefreshToken != "" {
+		if err := s.redisSvc.Delete(clientRefreshTokenKey(activeRefreshToken)); err != nil {
+			log.Printf("Failed to delete old refresh token key for client %s: %v", user.Phone, err)
+		}
+	}
+
+	return s.issueClientTokenPair(user.Phone)
+}
+
+func (s *service) ClientRefreshToken(refreshTokenDto dto.RefreshTokenDTO) (*dto.ResponseDTO, error) {
+	phone, err := s.redisSvc.Get(clientRefreshTokenKey(refreshTokenDto.RefreshToken)) // получаем телефон по переданному токену
+	if err != nil || phone == "" {
 		return nil, fmt.Errorf("invalid refresh token")
 	}
 
-	activeTokenKey := fmt.Sprintf("refresh_token:%s", userEmail)
+	activeTokenKey := fmt.Sprintf("client_refresh_token:%s", phone)
 	activeRefreshToken, err := s.redisSvc.Get(activeTokenKey)
 	if err != nil || activeRefreshToken != refreshTokenDto.RefreshToken {
 		return nil, fmt.Errorf("refresh token is not active")
 	}
 
-	user, err := s.dashboardUserRepo.GetByEmail(userEmail)
+	user, err := s.clientUserRepo.GetByPhone(phone)
 	if err != nil || user == nil {
 		return nil, fmt.Errorf("user not found")
 	}
 
-	tokenPair, err := s.jwtSvc.GenerateDashboardTokenPair(user.Email, user.Role)
-	if err != nil {
+	if err := s.redisSvc.Delete(clientRefreshTokenKey(refreshTokenDto.RefreshToken)); err != nil {
 		return nil, err
 	}
 
-	refreshExpiration := 30 * 24 * time.Hour
+	return s.issueClientTokenPair(user.Phone)
+}
 
-	if err := s.redisSvc.Delete(refreshTokenDto.RefreshToken); err != nil {
+func (s *service) issueClientTokenPair(phone string) (*dto.ResponseDTO, error) {
+	tokenPair, err := s.jwtSvc.GenerateClientTokenPair(phone)
+	if err != nil {
 		return nil, err
 	}
 
-	if err := s.redisSvc.Set(tokenPair.RefreshToken, user.Email, refreshExpiration); err != nil {
+	refreshExpiration := 30 * 24 * time.Hour
+
+	if err := s.redisSvc.Set(clientRefreshTokenKey(tokenPair.RefreshToken), phone, refreshExpiration); err != nil {
 		return nil, err
 	}
 
+	activeTokenKey := fmt.Sprintf("client_refresh_token:%s", phone)
 	if err := s.redisSvc.Set(activeTokenKey, tokenPair.RefreshToken, refreshExpiration); err != nil {
 		return nil, err
 	}
@@ -120,3 +537,76 @@ func (s *service) DashboardRefreshToken(refreshTokenDto dto.RefreshTokenDTO) (*d
 		RefreshExpiresAt: time.Now().Add(refreshExpiration).Unix(),
 	}, nil
 }
+
+// getOrCreateClientUser регистрирует клиента при первом входе по номеру телефона.
+func (s *service) getOrCreateClientUser(phone string) (*clientUserModel.ClientUser, error) {
+	user, err := s.clientUserRepo.GetByPhone(phone)
+	if err != nil {
+		return nil, err
+	}
+	if user != nil {
+		return user, nil
+	}
+
+	user, err = s.clientUserRepo.Create(&clientUserModel.ClientUser{Phone: phone})
+	if err != nil {
+		// Параллельный запрос мог успеть создать пользователя с тем же номером
+		existing, getErr := s.clientUserRepo.GetByPhone(phone)
+		if getErr != nil || existing == nil {
+			return nil, err
+		}
+		return existing, nil
+	}
+
+	return user, nil
+}
+
+// clientRefreshTokenKey отделяет клиентские refresh-токены от токенов панели управления.
+func clientRefreshTokenKey(refreshToken string) string {
+	return fmt.Sprintf("client_refresh:%s", refreshToken)
+}
+
+func generateClientCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+func passwordResetKey(tokenHash string) string {
+	return fmt.Sprintf("password_reset:%s", tokenHash)
+}
+
+func generateSessionID() (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(id), nil
+}
+
+func generateToken() (string, error) {
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(token), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// hashClientCode подписывает код ключом сервера. Кодов всего миллион, и
+// простой хэш по данным из Redis перебирается мгновенно; HMAC без ключа не
+// перебрать, поэтому утечка Redis не раскрывает действующие коды.
+func (s *service) hashClientCode(phone, code string) string {
+	mac := hmac.New(sha256.New, s.clientCodeKey)
+	mac.Write([]byte(phone + ":" + code))
+	return hex.EncodeToString(mac.Sum(nil))
+}
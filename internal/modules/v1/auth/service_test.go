@@ -0,0 +1,532 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"haircompany-shop-rest/internal/modules/v1/auth/dto"
+	"haircompany-shop-rest/internal/modules/v1/client_user"
+	"haircompany-shop-rest/internal/modules/v1/client_user/model"
+	"haircompany-shop-rest/internal/modules/v1/dashboard_user"
+	dashboardUserModel "haircompany-shop-rest/internal/modules/v1/dashboard_user/model"
+	"haircompany-shop-rest/internal/services"
+	"haircompany-shop-rest/pkg/database"
+	"strings"
+	"testing"
+	"time"
+)
+
+// mockRedisService хранит значения в памяти без учёта TTL
+type mockRedisService struct {
+	values map[string]string
+	sets   map[string]map[string]bool
+}
+
+func (m *mockRedisService) Set(key string, value interface{}, _ time.Duration) error {
+	m.values[key] = fmt.Sprint(value)
+	return nil
+}
+
+func (m *mockRedisService) Get(key string) (string, error) {
+	val, ok := m.values[key]
+	if !ok {
+		return "", services.ErrKeyNotFound
+	}
+	return val, nil
+}
+
+func (m *mockRedisService) Delete(key string) error {
+	delete(m.values, key)
+	delete(m.sets, key)
+	return nil
+}
+
+func (m *mockRedisService) Exists(key string) (bool, error) {
+	_, ok := m.values[key]
+	return ok, nil
+}
+
+func (m *mockRedisService) Incr(key string, _ time.Duration) (int64, error) {
+	var val int64
+	fmt.Sscan(m.values[key], &val)
+	val++
+	m.values[key] = fmt.Sprint(val)
+	return val, nil
+}
+
+func (m *mockRedisService) SetNX(key string, value interface{}, _ time.Duration) (bool, error) {
+	if _, ok := m.values[key]; ok {
+		return false, nil
+	}
+	m.values[key] = fmt.Sprint(value)
+	return true, nil
+}
+
+func (m *mockRedisService) CompareAndDelete(key, value string) (bool, error) {
+	if m.values[key] != value {
+		return false, nil
+	}
+	delete(m.values, key)
+	return true, nil
+}
+
+func (m *mockRedisService) CompareAndExpire(key, value string, _ time.Duration) (bool, error) {
+	_, ok := m.values[key]
+	return ok && m.values[key] == value, nil
+}
+
+func (m *mockRedisService) SAdd(key, member string, _ time.Duration) error {
+	if m.sets[key] == nil {
+		m.sets[key] = make(map[string]bool)
+	}
+	m.sets[key][member] = true
+	return nil
+}
+
+func (m *mockRedisService) SMembers(key string) ([]string, error) {
+	members := make([]string, 0, len(m.sets[key]))
+	for member := range m.sets[key] {
+		members = append(members, member)
+	}
+	return members, nil
+}
+
+func (m *mockRedisService) SRem(key, member string) error {
+	delete(m.sets[key], member)
+	return nil
+}
+
+type mockSMSSender struct {
+	messages map[string]string
+}
+
+func (m *mockSMSSender) Send(phone, message string) error {
+	m.messages[phone] = message
+	return nil
+}
+
+func (m *mockSMSSender) lastCode(phone string) string {
+	message := m.messages[phone]
+	return message[strings.LastIndex(message, " ")+1:]
+}
+
+// mockQueue сразу выполняет задачи: SMS доставляет через мок отправителя,
+// письма для сброса пароля отправляет через сервис
+type mockQueue struct {
+	sms *mockSMSSender
+	svc Service
+}
+
+func (m *mockQueue) Register(jobType string, handler services.JobHandler) {
+}
+
+func (m *mockQueue) Enqueue(jobType string, payload any) error {
+	switch payload := payload.(type) {
+	case services.SMSPayload:
+		if jobType == services.QueueSendSMS {
+			return m.sms.Send(payload.Phone, payload.Message)
+		}
+	case services.PasswordResetPayload:
+		if jobType == services.QueuePasswordReset {
+			return m.svc.SendPasswordReset(payload.Email)
+		}
+	}
+	return fmt.Errorf("unexpected job %s with payload %T", jobType, payload)
+}
+
+func (m *mockQueue) EnqueueTx(db *database.DB, jobType string, payload any) error {
+	return m.Enqueue(jobType, payload)
+}
+
+func (m *mockQueue) Start(workers int) {
+}
+
+// mockMailer запоминает последнее письмо каждому адресату
+type mockMailer struct {
+	mails map[string]services.Mail
+}
+
+func (m *mockMailer) Send(mail services.Mail) error {
+	m.mails[mail.To] = mail
+	return nil
+}
+
+// lastToken достаёт токен из ссылки в последнем письме
+func (m *mockMailer) lastToken(email string) string {
+	body := m.mails[email].Body
+	_, token, _ := strings.Cut(body, "token=")
+	token, _, _ = strings.Cut(token, "\n")
+	return token
+}
+
+func setupTestService(t *testing.T) (Service, *mockRedisService, *mockSMSSender, *mockMailer, *database.DB) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatal("Failed to connect to test database:", err)
+	}
+
+	err = db.AutoMigrate(&model.ClientUser{})
+	if err != nil {
+		t.Fatal("Failed to migrate test database:", err)
+	}
+	// Тип ENUM из модели пользователя панели SQLite не понимает
+	err = db.Exec(`CREATE TABLE dashboard_users (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		created_at DATETIME,
+		updated_at DATETIME,
+		email VARCHAR(255) NOT NULL UNIQUE,
+		password VARCHAR(255) NOT NULL,
+		role VARCHAR(16) NOT NULL,
+		is_active BOOLEAN NOT NULL DEFAULT TRUE,
+		totp_secret VARCHAR(64),
+		totp_enabled BOOLEAN NOT NULL DEFAULT FALSE
+	)`).Error
+	if err != nil {
+		t.Fatal("Failed to migrate test database:", err)
+	}
+	err = db.AutoMigrate(&dashboardUserModel.RecoveryCode{})
+	if err != nil {
+		t.Fatal("Failed to migrate test database:", err)
+	}
+
+	testDB := &database.DB{DB: db}
+	redisSvc := &mockRedisService{values: make(map[string]string), sets: make(map[string]map[string]bool)}
+	smsSender := &mockSMSSender{messages: make(map[string]string)}
+	mailer := &mockMailer{mails: make(map[string]services.Mail)}
+	jwtSvc := services.NewJWTService("dashboard-secret", "client-secret")
+
+	queue := &mockQueue{sms: smsSender}
+	svc := NewService(redisSvc, services.NewDashboardSessionStore(redisSvc), services.NewTokenDenylist(redisSvc), jwtSvc, services.NewPasswordService(), services.NewTOTPService("Hair Company"), dashboard_user.NewRepository(testDB), client_user.NewRepository(testDB), queue, mailer, "https://dashboard.example.com/reset-password", []byte("client-code-key"), nil)
+	queue.svc = svc
+
+	return svc, redisSvc, smsSender, mailer, testDB
+}
+
+func TestService_ClientVerifyCode_RegistersUser(t *testing.T) {
+	svc, redisSvc, smsSender, _, db := setupTestService(t)
+	phone := "+79991234567"
+
+	_, err := svc.ClientRequestCode(dto.ClientRequestCodeDTO{Phone: phone})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	code := smsSender.lastCode(phone)
+	if stored := redisSvc.values["client_code:"+phone]; stored == "" || stored == code {
+		t.Fatal("Expected hashed code to be stored in redis")
+	}
+
+	authData, err := svc.ClientVerifyCode(dto.ClientVerifyCodeDTO{Phone: phone, Code: code})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if authData.Token == "" || authData.RefreshToken == "" {
+		t.Fatal("Expected token pair to be issued")
+	}
+
+	var count int64
+	db.Model(&model.ClientUser{}).Where("phone = ?", phone).Count(&count)
+	if count != 1 {
+		t.Errorf("Expected client user to be registered, got %d users", count)
+	}
+
+	// Код одноразовый
+	_, err = svc.ClientVerifyCode(dto.ClientVerifyCodeDTO{Phone: phone, Code: code})
+	if !errors.Is(err, ErrInvalidCode) {
+		t.Errorf("Expected ErrInvalidCode for reused code, got %v", err)
+	}
+}
+
+func TestService_ClientRequestCode_TooOften(t *testing.T) {
+	svc, _, _, _, _ := setupTestService(t)
+	phone := "+79991234567"
+
+	if _, err := svc.ClientRequestCode(dto.ClientRequestCodeDTO{Phone: phone}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	_, err := svc.ClientRequestCode(dto.ClientRequestCodeDTO{Phone: phone})
+	if !errors.Is(err, ErrCodeRequestedTooOften) {
+		t.Errorf("Expected ErrCodeRequestedTooOften, got %v", err)
+	}
+}
+
+func TestService_ClientVerifyCode_TooManyAttempts(t *testing.T) {
+	svc, _, smsSender, _, _ := setupTestService(t)
+	phone := "+79991234567"
+
+	if _, err := svc.ClientRequestCode(dto.ClientRequestCodeDTO{Phone: phone}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	code := smsSender.lastCode(phone)
+
+	wrongCode := "000000"
+	if code == wrongCode {
+		wrongCode = "111111"
+	}
+
+	for i := 0; i < clientCodeMaxAttempts; i++ {
+		_, err := svc.ClientVerifyCode(dto.ClientVerifyCodeDTO{Phone: phone, Code: wrongCode})
+		if !errors.Is(err, ErrInvalidCode) {
+			t.Fatalf("Expected ErrInvalidCode on attempt %d, got %v", i+1, err)
+		}
+	}
+
+	_, err := svc.ClientVerifyCode(dto.ClientVerifyCodeDTO{Phone: phone, Code: code})
+	if !errors.Is(err, ErrTooManyAttempts) {
+		t.Errorf("Expected ErrTooManyAttempts, got %v", err)
+	}
+}
+
+func TestService_ClientRefreshToken_Rotation(t *testing.T) {
+	svc, _, smsSender, _, _ := setupTestService(t)
+	phone := "+79991234567"
+
+	if _, err := svc.ClientRequestCode(dto.ClientRequestCodeDTO{Phone: phone}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	authData, err := svc.ClientVerifyCode(dto.ClientVerifyCodeDTO{Phone: phone, Code: smsSender.lastCode(phone)})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	refreshed, err := svc.ClientRefreshToken(dto.RefreshTokenDTO{RefreshToken: authData.RefreshToken})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if refreshed.RefreshToken == authData.RefreshToken {
+		t.Error("Expected refresh token to be rotated")
+	}
+
+	_, err = svc.ClientRefreshToken(dto.RefreshTokenDTO{RefreshToken: authData.RefreshToken})
+	if err == nil {
+		t.Error("Expected error when reusing rotated refresh token")
+	}
+
+	// Клиентский refresh-токен не должен подходить для панели управления
+	_, err = svc.DashboardRefreshToken(dto.RefreshTokenDTO{RefreshToken: refreshed.RefreshToken}, SessionInfo{})
+	if err == nil {
+		t.Error("Expected client refresh token to be rejected by dashboard refresh")
+	}
+}
+
+func TestService_DashboardLogin_Deactivated(t *testing.T) {
+	svc, _, _, _, db := setupTestService(t)
+
+	hash, err := services.NewPasswordService().GenerateHash("password123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	user := &dashboardUserModel.DashboardUser{Email: "manager@example.com", Password: hash, Role: "manager"}
+	if err := db.Create(user).Error; err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+
+	authData, err := svc.DashboardLogin(dto.DashboardLoginDTO{Email: user.Email, Password: "password123"}, SessionInfo{})
+	if err != nil || authData == nil {
+		t.Fatalf("Expected active user to log in, got %v", err)
+	}
+
+	db.Model(user).Update("is_active", false)
+
+	if _, err := svc.DashboardLogin(dto.DashboardLoginDTO{Email: user.Email, Password: "password123"}, SessionInfo{}); !errors.Is(err, ErrUserDeactivated) {
+		t.Errorf("Expected ErrUserDeactivated, got %v", err)
+	}
+	// Неверный пароль не должен раскрывать статус учётной записи
+	if authData, err := svc.DashboardLogin(dto.DashboardLoginDTO{Email: user.Email, Password: "wrong-password"}, SessionInfo{}); authData != nil || err != nil {
+		t.Errorf("Expected invalid credentials, got %v, %v", authData, err)
+	}
+	if _, err := svc.DashboardRefreshToken(dto.RefreshTokenDTO{RefreshToken: authData.RefreshToken}, SessionInfo{}); !errors.Is(err, ErrUserDeactivated) {
+		t.Errorf("Expected refresh of deactivated user to fail, got %v", err)
+	}
+}
+
+// assertAccessTokenRevoked проверяет токен так же, как DashboardAuthMiddleware.
+func assertAccessTokenRevoked(t *testing.T, redisSvc *mockRedisService, token string, want bool) {
+	t.Helper()
+	claims, err := services.NewJWTService("dashboard-secret", "client-secret").ValidateDashboardToken(token)
+	if err != nil {
+		t.Fatalf("Failed to validate token: %v", err)
+	}
+
+	revoked, err := services.NewTokenDenylist(redisSvc).IsRevoked(claims)
+	if err != nil {
+		t.Fatalf("Failed to check token: %v", err)
+	}
+	if revoked != want {
+		t.Errorf("Expected token revoked=%v, got %v", want, revoked)
+	}
+}
+
+func createDashboardUser(t *testing.T, db *database.DB, email, password string) *dashboardUserModel.DashboardUser {
+	t.Helper()
+	hash, err := services.NewPasswordService().GenerateHash(password)
+	if err != nil {
+		t.Fatal(err)
+	}
+	user := &dashboardUserModel.DashboardUser{Email: email, Password: hash, Role: "manager", IsActive: true}
+	if err := db.Create(user).Error; err != nil {
+		t.Fatalf("Failed to create user: %v", err)
+	}
+
+	return user
+}
+
+func TestService_DashboardChangePassword(t *testing.T) {
+	svc, redisSvc, _, _, db := setupTestService(t)
+	user := createDashboardUser(t, db, "manager@example.com", "password123")
+
+	authData, err := svc.DashboardLogin(dto.DashboardLoginDTO{Email: user.Email, Password: "password123"}, SessionInfo{})
+	if err != nil || authData == nil {
+		t.Fatalf("Expected login to succeed, got %v", err)
+	}
+
+	err = svc.DashboardChangePassword(user.Email, dto.DashboardChangePasswordDTO{CurrentPassword: "wrong-password", NewPassword: "new-password"})
+	if !errors.Is(err, ErrInvalidPassword) {
+		t.Fatalf("Expected ErrInvalidPassword, got %v", err)
+	}
+
+	err = svc.DashboardChangePassword(user.Email, dto.DashboardChangePasswordDTO{CurrentPassword: "password123", NewPassword: "new-password"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if _, err := svc.DashboardRefreshToken(dto.RefreshTokenDTO{RefreshToken: authData.RefreshToken}, SessionInfo{}); err == nil {
+		t.Error("Expected refresh token to be revoked after password change")
+	}
+	assertAccessTokenRevoked(t, redisSvc, authData.Token, true)
+	if authData, err := svc.DashboardLogin(dto.DashboardLoginDTO{Email: user.Email, Password: "password123"}, SessionInfo{}); authData != nil || err != nil {
+		t.Errorf("Expected old password to be rejected, got %v, %v", authData, err)
+	}
+	if authData, err := svc.DashboardLogin(dto.DashboardLoginDTO{Email: user.Email, Password: "new-password"}, SessionInfo{}); authData == nil || err != nil {
+		t.Errorf("Expected new password to work, got %v", err)
+	}
+}
+
+func TestService_DashboardResetPassword(t *testing.T) {
+	svc, redisSvc, _, mailer, db := setupTestService(t)
+	user := createDashboardUser(t, db, "manager@example.com", "password123")
+
+	authData, err := svc.DashboardLogin(dto.DashboardLoginDTO{Email: user.Email, Password: "password123"}, SessionInfo{})
+	if err != nil || authData == nil {
+		t.Fatalf("Expected login to succeed, got %v", err)
+	}
+
+	requestDto := dto.DashboardPasswordResetRequestDTO{Email: user.Email}
+	if err := svc.DashboardRequestPasswordReset(requestDto); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	firstToken := mailer.lastToken(user.Email)
+	if len(firstToken) != 64 {
+		t.Fatalf("Expected reset link in email, got %q", mailer.mails[user.Email].Body)
+	}
+	for key, value := range redisSvc.values {
+		if strings.Contains(key, firstToken) || value == firstToken {
+			t.Fatal("Expected only token hash to be stored in redis")
+		}
+	}
+
+	if err := svc.DashboardRequestPasswordReset(requestDto); !errors.Is(err, ErrResetRequestedTooOften) {
+		t.Fatalf("Expected ErrResetRequestedTooOften, got %v", err)
+	}
+
+	// Повторный запрос после паузы отменяет предыдущую ссылку
+	delete(redisSvc.values, "password_reset_resend:"+user.Email)
+	if err := svc.DashboardRequestPasswordReset(requestDto); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	token := mailer.lastToken(user.Email)
+
+	err = svc.DashboardResetPassword(dto.DashboardPasswordResetDTO{Token: firstToken, Password: "new-password"})
+	if !errors.Is(err, ErrInvalidResetToken) {
+		t.Fatalf("Expected previous token to be invalid, got %v", err)
+	}
+
+	if err := svc.DashboardResetPassword(dto.DashboardPasswordResetDTO{Token: token, Password: "new-password"}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	// Токен одноразовый
+	err = svc.DashboardResetPassword(dto.DashboardPasswordResetDTO{Token: token, Password: "other-password"})
+	if !errors.Is(err, ErrInvalidResetToken) {
+		t.Errorf("Expected ErrInvalidResetToken for reused token, got %v", err)
+	}
+
+	if _, err := svc.DashboardRefreshToken(dto.RefreshTokenDTO{RefreshToken: authData.RefreshToken}, SessionInfo{}); err == nil {
+		t.Error("Expected refresh token to be revoked after password reset")
+	}
+	if authData, err := svc.DashboardLogin(dto.DashboardLoginDTO{Email: user.Email, Password: "new-password"}, SessionInfo{}); authData == nil || err != nil {
+		t.Errorf("Expected new password to work, got %v", err)
+	}
+}
+
+func TestService_DashboardRequestPasswordReset_UnknownEmail(t *testing.T) {
+	svc, _, _, mailer, _ := setupTestService(t)
+
+	if err := svc.DashboardRequestPasswordReset(dto.DashboardPasswordResetRequestDTO{Email: "nobody@example.com"}); err != nil {
+		t.Fatalf("Expected no error for unknown email, got %v", err)
+	}
+	if len(mailer.mails) != 0 {
+		t.Errorf("Expected no email to be sent, got %v", mailer.mails)
+	}
+}
+
+func TestService_DashboardSessions(t *testing.T) {
+	svc, redisSvc, _, _, db := setupTestService(t)
+	user := createDashboardUser(t, db, "manager@example.com", "password123")
+	jwtSvc := services.NewJWTService("dashboard-secret", "client-secret")
+
+	laptop, err := svc.DashboardLogin(dto.DashboardLoginDTO{Email: user.Email, Password: "password123", Device: "Laptop"}, SessionInfo{IP: "10.0.0.1", UserAgent: "Firefox"})
+	if err != nil || laptop == nil {
+		t.Fatalf("Expected login to succeed, got %v", err)
+	}
+	phone, err := svc.DashboardLogin(dto.DashboardLoginDTO{Email: user.Email, Password: "password123", Device: "Phone"}, SessionInfo{IP: "10.0.0.2", UserAgent: "Safari"})
+	if err != nil || phone == nil {
+		t.Fatalf("Expected login to succeed, got %v", err)
+	}
+
+	// Вход со второго устройства не завершает первую сессию
+	laptop, err = svc.DashboardRefreshToken(dto.RefreshTokenDTO{RefreshToken: laptop.RefreshToken}, SessionInfo{IP: "10.0.0.3", UserAgent: "Firefox"})
+	if err != nil {
+		t.Fatalf("Expected first session to stay active, got %v", err)
+	}
+
+	claims, err := jwtSvc.ValidateDashboardToken(laptop.Token)
+	if err != nil || claims.SessionID == "" {
+		t.Fatalf("Expected session id in access token, got %v", err)
+	}
+
+	sessions, err := svc.DashboardSessions(user.Email, claims.SessionID)
+	if err != nil || len(sessions) != 2 {
+		t.Fatalf("Expected 2 sessions, got %v, %v", sessions, err)
+	}
+	if sessions[0].Id != claims.SessionID || !sessions[0].Current || sessions[0].IP != "10.0.0.3" || sessions[0].Device != "Laptop" {
+		t.Errorf("Expected refreshed laptop session first and current, got %+v", sessions[0])
+	}
+	if sessions[1].Current || sessions[1].Device != "Phone" {
+		t.Errorf("Unexpected phone session: %+v", sessions[1])
+	}
+
+	if err := svc.DashboardRevokeSession("other@example.com", sessions[1].Id); !errors.Is(err, ErrSessionNotFound) {
+		t.Errorf("Expected foreign session to be not found, got %v", err)
+	}
+	if err := svc.DashboardRevokeSession(user.Email, sessions[1].Id); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, err := svc.DashboardRefreshToken(dto.RefreshTokenDTO{RefreshToken: phone.RefreshToken}, SessionInfo{}); err == nil {
+		t.Error("Expected revoked session refresh token to be rejected")
+	}
+	assertAccessTokenRevoked(t, redisSvc, phone.Token, true)
+	assertAccessTokenRevoked(t, redisSvc, laptop.Token, false)
+
+	if err := svc.DashboardRevokeAllSessions(user.Email); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if _, err := svc.DashboardRefreshToken(dto.RefreshTokenDTO{RefreshToken: laptop.RefreshToken}, SessionInfo{}); err == nil {
+		t.Error("Expected all sessions to be revoked")
+	}
+	assertAccessTokenRevoked(t, redisSvc, laptop.Token, true)
+	if sessions, _ := svc.DashboardSessions(user.Email, ""); len(sessions) != 0 {
+		t.Errorf("Expected no sessions, got %v", sessions)
+	}
+}
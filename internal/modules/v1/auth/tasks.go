@@ -0,0 +1,17 @@
+package auth
+
+import (
+	"context"
+	"haircompany-shop-rest/internal/container"
+	"haircompany-shop-rest/internal/services"
+)
+
+// NewPasswordResetJob returns the queue handler that mails password reset
+// links requested through DashboardRequestPasswordReset.
+func NewPasswordResetJob(container *container.Container) services.JobHandler {
+	svc := newService(container)
+
+	return services.HandleJob(func(ctx context.Context, payload services.PasswordResetPayload) error {
+		return svc.SendPasswordReset(payload.Email)
+	})
+}
@@ -0,0 +1,431 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"haircompany-shop-rest/internal/modules/v1/auth/dto"
+	"haircompany-shop-rest/internal/modules/v1/dashboard_user"
+	dashboardUserModel "haircompany-shop-rest/internal/modules/v1/dashboard_user/model"
+	"haircompany-shop-rest/internal/services"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	loginChallengeExpiration = 5 * time.Minute
+	// Неверные коды считаются по пользователю, а не по токену проверки:
+	// новый вход выдаёт новый токен и не должен сбрасывать счётчик
+	twoFactorMaxFailures = 5
+	twoFactorLockout     = 15 * time.Minute
+
+	totpSetupExpiration = 10 * time.Minute
+	// totpUsedExpiration покрывает все интервалы, которые принимает Validate
+	totpUsedExpiration = 2 * time.Minute
+
+	recoveryCodeCount = 10
+)
+
+var (
+	ErrTwoFactorAlreadyEnabled  = errors.New("two-factor authentication is already enabled")
+	ErrTwoFactorNotEnabled      = errors.New("two-factor authentication is not enabled")
+	ErrTwoFactorSetupNotStarted = errors.New("two-factor setup was not started or has expired")
+	ErrInvalidTwoFactorCode     = errors.New("invalid two-factor code")
+	ErrInvalidChallenge         = errors.New("invalid or expired login challenge")
+	ErrTwoFactorLocked          = errors.New("too many invalid two-factor codes, try again later")
+)
+
+// loginChallenge — вход, прошедший проверку пароля и ожидающий второй фактор.
+type loginChallenge struct {
+	Email  string `json:"email"`
+	Device string `json:"device"`
+}
+
+// startLoginChallenge выдаёт одноразовый токен, по которому вход завершается
+// кодом из приложения. В Redis хранится только хэш токена.
+func (s *service) startLoginChallenge(user *dashboardUserModel.DashboardUser, device string) (*dto.ResponseDTO, error) {
+	locked, err := s.twoFactorLocked(user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if locked {
+		return nil, ErrTwoFactorLocked
+	}
+
+	token, err := generateToken()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(loginChallenge{Email: user.Email, Device: device})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.redisSvc.Set(loginChallengeKey(hashToken(token)), string(data), loginChallengeExpiration); err != nil {
+		return nil, err
+	}
+
+	return &dto.ResponseDTO{
+		TwoFactorRequired:  true,
+		ChallengeToken:     token,
+		ChallengeExpiresAt: time.Now().Add(loginChallengeExpiration).Unix(),
+	}, nil
+}
+
+func (s *service) DashboardVerifyTwoFactor(verifyDto dto.TwoFactorVerifyDTO, info SessionInfo) (*dto.ResponseDTO, error) {
+	challengeKey := loginChallengeKey(hashToken(verifyDto.ChallengeToken))
+
+	data, err := s.redisSvc.Get(challengeKey)
+	if err != nil || data == "" {
+		return nil, ErrInvalidChallenge
+	}
+
+	var challenge loginChallenge
+	if err := json.Unmarshal([]byte(data), &challenge); err != nil {
+		return nil, ErrInvalidChallenge
+	}
+
+	user, err := s.dashboardUserRepo.GetByEmail(challenge.Email)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil || !user.TOTPEnabled {
+		return nil, ErrInvalidChallenge
+	}
+	if !user.IsActive {
+		return nil, ErrUserDeactivated
+	}
+
+	if err := s.countTwoFactorAttempt(user.ID); err != nil {
+		if errors.Is(err, ErrTwoFactorLocked) {
+			if err := s.redisSvc.Delete(challengeKey); err != nil {
+				log.Printf("Failed to delete login challenge key %s: %v", challengeKey, err)
+			}
+		}
+		return nil, err
+	}
+
+	if err := s.verifySecondFactor(user, verifyDto.Code); err != nil {
+		return nil, err
+	}
+
+	// Токен одноразовый: из двух параллельных запросов сессию получит один
+	deleted, err := s.redisSvc.CompareAndDelete(challengeKey, data)
+	if err != nil {
+		return nil, err
+	}
+	if !deleted {
+		return nil, ErrInvalidChallenge
+	}
+	s.resetTwoFactorFailures(user.ID)
+
+	return s.startDashboardSession(user, challenge.Device, info)
+}
+
+func (s *service) DashboardTwoFactorStatus(email string) (*dto.TwoFactorStatusResponseDTO, error) {
+	user, err := s.getActiveDashboardUser(email)
+	if err != nil {
+		return nil, err
+	}
+	if !user.TOTPEnabled {
+		return &dto.TwoFactorStatusResponseDTO{}, nil
+	}
+
+	left, err := s.dashboardUserRepo.CountUnusedRecoveryCodes(user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.TwoFactorStatusResponseDTO{
+		Enabled:           true,
+		RecoveryCodesLeft: left,
+	}, nil
+}
+
+// DashboardSetupTwoFactor генерирует секрет, который включается только после
+// подтверждения кодом из приложения.
+func (s *service) DashboardSetupTwoFactor(email string) (*dto.TwoFactorSetupResponseDTO, error) {
+	user, err := s.getActiveDashboardUser(email)
+	if err != nil {
+		return nil, err
+	}
+	if user.TOTPEnabled {
+		return nil, ErrTwoFactorAlreadyEnabled
+	}
+
+	secret, err := s.totpSvc.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.redisSvc.Set(totpSetupKey(user.Email), secret, totpSetupExpiration); err != nil {
+		return nil, err
+	}
+
+	return &dto.TwoFactorSetupResponseDTO{
+		Secret:     secret,
+		OtpauthURI: s.totpSvc.URI(secret, user.Email),
+		ExpiresAt:  time.Now().Add(totpSetupExpiration).Unix(),
+	}, nil
+}
+
+func (s *service) DashboardConfirmTwoFactor(email string, confirmDto dto.TwoFactorCodeDTO) (*dto.RecoveryCodesResponseDTO, error) {
+	user, err := s.getActiveDashboardUser(email)
+	if err != nil {
+		return nil, err
+	}
+	if user.TOTPEnabled {
+		return nil, ErrTwoFactorAlreadyEnabled
+	}
+
+	setupKey := totpSetupKey(user.Email)
+	secret, err := s.redisSvc.Get(setupKey)
+	if err != nil || secret == "" {
+		return nil, ErrTwoFactorSetupNotStarted
+	}
+	if err := s.useTOTPCode(user.ID, secret, confirmDto.Code); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	user.TOTPSecret = &secret
+	user.TOTPEnabled = true
+	err = s.dashboardUserRepo.Transaction(func(repo dashboard_user.Repository) error {
+		if _, err := repo.Update(user); err != nil {
+			return err
+		}
+		return repo.ReplaceRecoveryCodes(user.ID, hashes)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.redisSvc.Delete(setupKey); err != nil {
+		log.Printf("Failed to delete totp setup key for user %s: %v", user.Email, err)
+	}
+
+	return &dto.RecoveryCodesResponseDTO{RecoveryCodes: codes}, nil
+}
+
+// DashboardDisableTwoFactor требует и пароль, и второй фактор, чтобы
+// украденная сессия не могла отключить защиту.
+func (s *service) DashboardDisableTwoFactor(email string, disableDto dto.TwoFactorDisableDTO) error {
+	user, err := s.getActiveDashboardUser(email)
+	if err != nil {
+		return err
+	}
+	if !user.TOTPEnabled {
+		return ErrTwoFactorNotEnabled
+	}
+	if err := s.passwordSvc.CompareHashAndPassword(user.Password, disableDto.Password); err != nil {
+		return ErrInvalidPassword
+	}
+	if err := s.countTwoFactorAttempt(user.ID); err != nil {
+		return err
+	}
+	if err := s.verifySecondFactor(user, disableDto.Code); err != nil {
+		return err
+	}
+	s.resetTwoFactorFailures(user.ID)
+
+	user.TOTPSecret = nil
+	user.TOTPEnabled = false
+
+	return s.dashboardUserRepo.Transaction(func(repo dashboard_user.Repository) error {
+		if _, err := repo.Update(user); err != nil {
+			return err
+		}
+		return repo.DeleteRecoveryCodes(user.ID)
+	})
+}
+
+// DashboardRegenerateRecoveryCodes заменяет все коды восстановления новыми.
+func (s *service) DashboardRegenerateRecoveryCodes(email string, codeDto dto.TwoFactorCodeDTO) (*dto.RecoveryCodesResponseDTO, error) {
+	user, err := s.getActiveDashboardUser(email)
+	if err != nil {
+		return nil, err
+	}
+	if !user.TOTPEnabled || user.TOTPSecret == nil {
+		return nil, ErrTwoFactorNotEnabled
+	}
+	if err := s.countTwoFactorAttempt(user.ID); err != nil {
+		return nil, err
+	}
+	if err := s.useTOTPCode(user.ID, *user.TOTPSecret, codeDto.Code); err != nil {
+		return nil, err
+	}
+	s.resetTwoFactorFailures(user.ID)
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.dashboardUserRepo.ReplaceRecoveryCodes(user.ID, hashes); err != nil {
+		return nil, err
+	}
+
+	return &dto.RecoveryCodesResponseDTO{RecoveryCodes: codes}, nil
+}
+
+// verifySecondFactor принимает код из приложения или неиспользованный код
+// восстановления.
+func (s *service) verifySecondFactor(user *dashboardUserModel.DashboardUser, code string) error {
+	code = strings.TrimSpace(code)
+	if isTOTPCode(code) {
+		if user.TOTPSecret == nil {
+			return ErrInvalidTwoFactorCode
+		}
+		return s.useTOTPCode(user.ID, *user.TOTPSecret, code)
+	}
+
+	used, err := s.dashboardUserRepo.UseRecoveryCode(user.ID, hashRecoveryCode(code))
+	if err != nil {
+		return err
+	}
+	if !used {
+		return ErrInvalidTwoFactorCode
+	}
+
+	return nil
+}
+
+// useTOTPCode проверяет код и запоминает его интервал, чтобы перехваченный
+// код нельзя было использовать повторно.
+func (s *service) useTOTPCode(userID uint, secret, code string) error {
+	step, ok := s.totpSvc.Validate(secret, code, time.Now())
+	if !ok {
+		return ErrInvalidTwoFactorCode
+	}
+
+	fresh, err := s.redisSvc.SetNX(fmt.Sprintf("totp_used:%d:%d", userID, step), 1, totpUsedExpiration)
+	if err != nil {
+		return err
+	}
+	if !fresh {
+		return ErrInvalidTwoFactorCode
+	}
+
+	return nil
+}
+
+func (s *service) getActiveDashboardUser(email string) (*dashboardUserModel.DashboardUser, error) {
+	user, err := s.dashboardUserRepo.GetByEmail(email)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, fmt.Errorf("user not found")
+	}
+	if !user.IsActive {
+		return nil, ErrUserDeactivated
+	}
+
+	return user, nil
+}
+
+// generateRecoveryCodes возвращает коды вида xxxx-xxxx-xxxx-xxxx и их хэши.
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+	for i := 0; i < recoveryCodeCount; i++ {
+		raw := make([]byte, 8)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, nil, err
+		}
+		value := hex.EncodeToString(raw)
+		code := strings.Join([]string{value[0:4], value[4:8], value[8:12], value[12:16]}, "-")
+
+		codes = append(codes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+
+	return codes, hashes, nil
+}
+
+// hashRecoveryCode не учитывает регистр, дефисы и пробелы, чтобы код можно
+// было ввести в любом виде.
+func hashRecoveryCode(code string) string {
+	normalized := strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, strings.ToLower(strings.TrimSpace(code)))
+
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
+
+// countTwoFactorAttempt засчитывает попытку ввода второго фактора. Попытка
+// считается до проверки кода, чтобы параллельные запросы не обходили лимит.
+func (s *service) countTwoFactorAttempt(userID uint) error {
+	failures, err := s.redisSvc.Incr(twoFactorFailuresKey(userID), twoFactorLockout)
+	if err != nil {
+		return err
+	}
+	if failures > twoFactorMaxFailures {
+		return ErrTwoFactorLocked
+	}
+
+	return nil
+}
+
+// resetTwoFactorFailures сбрасывает счётчик после верного кода.
+func (s *service) resetTwoFactorFailures(userID uint) {
+	key := twoFactorFailuresKey(userID)
+	if err := s.redisSvc.Delete(key); err != nil {
+		log.Printf("Failed to delete two-factor failures key %s: %v", key, err)
+	}
+}
+
+// twoFactorLocked сообщает, исчерпал ли пользователь попытки ввода второго
+// фактора. Пока счётчик не истёк, новые проверки входа не выдаются.
+func (s *service) twoFactorLocked(userID uint) (bool, error) {
+	value, err := s.redisSvc.Get(twoFactorFailuresKey(userID))
+	if errors.Is(err, services.ErrKeyNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	failures, err := strconv.Atoi(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid two-factor failures counter %q: %w", value, err)
+	}
+
+	return failures >= twoFactorMaxFailures, nil
+}
+
+func isTOTPCode(code string) bool {
+	if len(code) != 6 {
+		return false
+	}
+	for _, r := range code {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func loginChallengeKey(tokenHash string) string {
+	return fmt.Sprintf("login_challenge:%s", tokenHash)
+}
+
+func twoFactorFailuresKey(userID uint) string {
+	return fmt.Sprintf("login_2fa_failures:%d", userID)
+}
+
+func totpSetupKey(email string) string {
+	return fmt.Sprintf("totp_setup:%s", email)
+}
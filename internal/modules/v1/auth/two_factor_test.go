@@ -0,0 +1,239 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"haircompany-shop-rest/internal/modules/v1/auth/dto"
+	"strings"
+	"testing"
+	"time"
+)
+
+// totpAt считает код так же, как приложение-аутентификатор
+func totpAt(t *testing.T, secret string, at time.Time) string {
+	t.Helper()
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(at.Unix()/30))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%06d", value%1_000_000)
+}
+
+// enableTwoFactor подключает 2FA кодом текущего интервала и возвращает секрет,
+// использованный код и коды восстановления. В тестах входа берутся соседние
+// интервалы, чтобы не попасть на защиту от повтора.
+func enableTwoFactor(t *testing.T, svc Service, email string) (string, string, []string) {
+	t.Helper()
+	setup, err := svc.DashboardSetupTwoFactor(email)
+	if err != nil {
+		t.Fatalf("Expected setup to succeed, got %v", err)
+	}
+	if !strings.HasPrefix(setup.OtpauthURI, "otpauth://totp/") || !strings.Contains(setup.OtpauthURI, setup.Secret) {
+		t.Errorf("Unexpected otpauth URI %s", setup.OtpauthURI)
+	}
+
+	code := totpAt(t, setup.Secret, time.Now())
+	codes, err := svc.DashboardConfirmTwoFactor(email, dto.TwoFactorCodeDTO{Code: code})
+	if err != nil {
+		t.Fatalf("Expected confirmation to succeed, got %v", err)
+	}
+	if len(codes.RecoveryCodes) != recoveryCodeCount {
+		t.Fatalf("Expected %d recovery codes, got %d", recoveryCodeCount, len(codes.RecoveryCodes))
+	}
+
+	return setup.Secret, code, codes.RecoveryCodes
+}
+
+func loginChallengeToken(t *testing.T, svc Service, email string) string {
+	t.Helper()
+	authData, err := svc.DashboardLogin(dto.DashboardLoginDTO{Email: email, Password: "password123"}, SessionInfo{})
+	if err != nil || authData == nil {
+		t.Fatalf("Expected password check to succeed, got %v", err)
+	}
+	if !authData.TwoFactorRequired || authData.ChallengeToken == "" {
+		t.Fatalf("Expected a login challenge, got %+v", authData)
+	}
+	if authData.Token != "" || authData.RefreshToken != "" {
+		t.Fatal("Expected no tokens before the second factor")
+	}
+
+	return authData.ChallengeToken
+}
+
+func TestService_DashboardTwoFactorLogin(t *testing.T) {
+	svc, redisSvc, _, _, db := setupTestService(t)
+	user := createDashboardUser(t, db, "admin@example.com", "password123")
+
+	if _, err := svc.DashboardConfirmTwoFactor(user.Email, dto.TwoFactorCodeDTO{Code: "123456"}); !errors.Is(err, ErrTwoFactorSetupNotStarted) {
+		t.Errorf("Expected ErrTwoFactorSetupNotStarted, got %v", err)
+	}
+
+	secret, confirmCode, _ := enableTwoFactor(t, svc, user.Email)
+	if _, err := svc.DashboardSetupTwoFactor(user.Email); !errors.Is(err, ErrTwoFactorAlreadyEnabled) {
+		t.Errorf("Expected ErrTwoFactorAlreadyEnabled, got %v", err)
+	}
+	if _, err := redisSvc.Get(totpSetupKey(user.Email)); err == nil {
+		t.Error("Expected pending secret to be deleted after confirmation")
+	}
+
+	challenge := loginChallengeToken(t, svc, user.Email)
+
+	if _, err := svc.DashboardVerifyTwoFactor(dto.TwoFactorVerifyDTO{ChallengeToken: challenge, Code: "000000"}, SessionInfo{}); !errors.Is(err, ErrInvalidTwoFactorCode) {
+		t.Errorf("Expected ErrInvalidTwoFactorCode, got %v", err)
+	}
+	// Код, которым подтверждали подключение, повторно не принимается
+	if _, err := svc.DashboardVerifyTwoFactor(dto.TwoFactorVerifyDTO{ChallengeToken: challenge, Code: confirmCode}, SessionInfo{}); !errors.Is(err, ErrInvalidTwoFactorCode) {
+		t.Errorf("Expected replayed code to be rejected, got %v", err)
+	}
+
+	code := totpAt(t, secret, time.Now().Add(30*time.Second))
+	authData, err := svc.DashboardVerifyTwoFactor(dto.TwoFactorVerifyDTO{ChallengeToken: challenge, Code: code}, SessionInfo{IP: "10.0.0.1"})
+	if err != nil {
+		t.Fatalf("Expected second factor to be accepted, got %v", err)
+	}
+	if authData.Token == "" || authData.RefreshToken == "" {
+		t.Fatalf("Expected a token pair, got %+v", authData)
+	}
+
+	// Токен проверки одноразовый
+	code = totpAt(t, secret, time.Now().Add(-30*time.Second))
+	if _, err := svc.DashboardVerifyTwoFactor(dto.TwoFactorVerifyDTO{ChallengeToken: challenge, Code: code}, SessionInfo{}); !errors.Is(err, ErrInvalidChallenge) {
+		t.Errorf("Expected ErrInvalidChallenge, got %v", err)
+	}
+}
+
+func TestService_DashboardTwoFactorRecoveryCode(t *testing.T) {
+	svc, _, _, _, db := setupTestService(t)
+	user := createDashboardUser(t, db, "admin@example.com", "password123")
+	_, _, recoveryCodes := enableTwoFactor(t, svc, user.Email)
+
+	// Код принимается в любом регистре и без дефисов
+	code := strings.ToUpper(strings.ReplaceAll(recoveryCodes[0], "-", ""))
+	challenge := loginChallengeToken(t, svc, user.Email)
+	if _, err := svc.DashboardVerifyTwoFactor(dto.TwoFactorVerifyDTO{ChallengeToken: challenge, Code: code}, SessionInfo{}); err != nil {
+		t.Fatalf("Expected recovery code to be accepted, got %v", err)
+	}
+
+	challenge = loginChallengeToken(t, svc, user.Email)
+	if _, err := svc.DashboardVerifyTwoFactor(dto.TwoFactorVerifyDTO{ChallengeToken: challenge, Code: recoveryCodes[0]}, SessionInfo{}); !errors.Is(err, ErrInvalidTwoFactorCode) {
+		t.Errorf("Expected used recovery code to be rejected, got %v", err)
+	}
+
+	status, err := svc.DashboardTwoFactorStatus(user.Email)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !status.Enabled || status.RecoveryCodesLeft != recoveryCodeCount-1 {
+		t.Errorf("Expected %d recovery codes left, got %+v", recoveryCodeCount-1, status)
+	}
+}
+
+func TestService_DashboardTwoFactorAttemptsLimit(t *testing.T) {
+	svc, _, _, _, db := setupTestService(t)
+	user := createDashboardUser(t, db, "admin@example.com", "password123")
+	secret, _, _ := enableTwoFactor(t, svc, user.Email)
+
+	// Новый вход выдаёт новый токен, но не сбрасывает счётчик ошибок
+	var challenge string
+	for i := 0; i < twoFactorMaxFailures; i++ {
+		challenge = loginChallengeToken(t, svc, user.Email)
+		_, err := svc.DashboardVerifyTwoFactor(dto.TwoFactorVerifyDTO{ChallengeToken: challenge, Code: "000000"}, SessionInfo{})
+		if !errors.Is(err, ErrInvalidTwoFactorCode) {
+			t.Fatalf("Attempt %d: expected ErrInvalidTwoFactorCode, got %v", i+1, err)
+		}
+	}
+
+	code := totpAt(t, secret, time.Now().Add(30*time.Second))
+	if _, err := svc.DashboardVerifyTwoFactor(dto.TwoFactorVerifyDTO{ChallengeToken: challenge, Code: code}, SessionInfo{}); !errors.Is(err, ErrTwoFactorLocked) {
+		t.Errorf("Expected ErrTwoFactorLocked, got %v", err)
+	}
+	if _, err := svc.DashboardVerifyTwoFactor(dto.TwoFactorVerifyDTO{ChallengeToken: challenge, Code: code}, SessionInfo{}); !errors.Is(err, ErrInvalidChallenge) {
+		t.Errorf("Expected challenge to be deleted, got %v", err)
+	}
+	if _, err := svc.DashboardLogin(dto.DashboardLoginDTO{Email: user.Email, Password: "password123"}, SessionInfo{}); !errors.Is(err, ErrTwoFactorLocked) {
+		t.Errorf("Expected no new challenge while locked out, got %v", err)
+	}
+}
+
+func TestService_DashboardDisableTwoFactor(t *testing.T) {
+	svc, _, _, _, db := setupTestService(t)
+	user := createDashboardUser(t, db, "admin@example.com", "password123")
+	_, _, recoveryCodes := enableTwoFactor(t, svc, user.Email)
+
+	err := svc.DashboardDisableTwoFactor(user.Email, dto.TwoFactorDisableDTO{Password: "wrong-password", Code: recoveryCodes[0]})
+	if !errors.Is(err, ErrInvalidPassword) {
+		t.Errorf("Expected ErrInvalidPassword, got %v", err)
+	}
+	err = svc.DashboardDisableTwoFactor(user.Email, dto.TwoFactorDisableDTO{Password: "password123", Code: "not-a-code"})
+	if !errors.Is(err, ErrInvalidTwoFactorCode) {
+		t.Errorf("Expected ErrInvalidTwoFactorCode, got %v", err)
+	}
+	err = svc.DashboardDisableTwoFactor(user.Email, dto.TwoFactorDisableDTO{Password: "password123", Code: recoveryCodes[0]})
+	if err != nil {
+		t.Fatalf("Expected two-factor authentication to be disabled, got %v", err)
+	}
+
+	authData, err := svc.DashboardLogin(dto.DashboardLoginDTO{Email: user.Email, Password: "password123"}, SessionInfo{})
+	if err != nil || authData == nil || authData.Token == "" || authData.TwoFactorRequired {
+		t.Errorf("Expected login without second factor, got %+v, %v", authData, err)
+	}
+	if _, err := svc.DashboardRegenerateRecoveryCodes(user.Email, dto.TwoFactorCodeDTO{Code: "123456"}); !errors.Is(err, ErrTwoFactorNotEnabled) {
+		t.Errorf("Expected ErrTwoFactorNotEnabled, got %v", err)
+	}
+}
+
+func TestService_DashboardTwoFactorManagementAttemptsLimit(t *testing.T) {
+	svc, _, _, _, db := setupTestService(t)
+	user := createDashboardUser(t, db, "admin@example.com", "password123")
+	secret, _, recoveryCodes := enableTwoFactor(t, svc, user.Email)
+
+	// Верный код сбрасывает счётчик ошибок
+	for i := 0; i < twoFactorMaxFailures-1; i++ {
+		if _, err := svc.DashboardRegenerateRecoveryCodes(user.Email, dto.TwoFactorCodeDTO{Code: "000000"}); !errors.Is(err, ErrInvalidTwoFactorCode) {
+			t.Fatalf("Attempt %d: expected ErrInvalidTwoFactorCode, got %v", i+1, err)
+		}
+	}
+	codes, err := svc.DashboardRegenerateRecoveryCodes(user.Email, dto.TwoFactorCodeDTO{Code: totpAt(t, secret, time.Now().Add(30*time.Second))})
+	if err != nil {
+		t.Fatalf("Expected recovery codes to be regenerated, got %v", err)
+	}
+	recoveryCodes = codes.RecoveryCodes
+
+	// Отключение и замена кодов делят лимит со входом
+	for i := 0; i < twoFactorMaxFailures; i++ {
+		err := svc.DashboardDisableTwoFactor(user.Email, dto.TwoFactorDisableDTO{Password: "password123", Code: "not-a-code"})
+		if !errors.Is(err, ErrInvalidTwoFactorCode) {
+			t.Fatalf("Attempt %d: expected ErrInvalidTwoFactorCode, got %v", i+1, err)
+		}
+	}
+
+	err = svc.DashboardDisableTwoFactor(user.Email, dto.TwoFactorDisableDTO{Password: "password123", Code: recoveryCodes[0]})
+	if !errors.Is(err, ErrTwoFactorLocked) {
+		t.Errorf("Expected ErrTwoFactorLocked, got %v", err)
+	}
+	code := totpAt(t, secret, time.Now().Add(-30*time.Second))
+	if _, err := svc.DashboardRegenerateRecoveryCodes(user.Email, dto.TwoFactorCodeDTO{Code: code}); !errors.Is(err, ErrTwoFactorLocked) {
+		t.Errorf("Expected ErrTwoFactorLocked, got %v", err)
+	}
+	if _, err := svc.DashboardLogin(dto.DashboardLoginDTO{Email: user.Email, Password: "password123"}, SessionInfo{}); !errors.Is(err, ErrTwoFactorLocked) {
+		t.Errorf("Expected no new challenge while locked out, got %v", err)
+	}
+
+	status, _ := svc.DashboardTwoFactorStatus(user.Email)
+	if !status.Enabled || status.RecoveryCodesLeft != recoveryCodeCount {
+		t.Errorf("Expected two-factor to stay enabled with all recovery codes, got %+v", status)
+	}
+}
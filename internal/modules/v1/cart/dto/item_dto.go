@@ -0,0 +1,14 @@
+package dto
+
+type AddItemDTO struct {
+	ProductVariantID uint `json:"productVariantId" validate:"required" example:"1"`
+	Quantity         int  `json:"quantity" validate:"required,gt=0,lte=999" example:"2"`
+}
+
+type UpdateItemDTO struct {
+	Quantity int `json:"quantity" validate:"required,gt=0,lte=999" example:"3"`
+}
+
+type PromoCodeDTO struct {
+	Code string `json:"code" validate:"required,max=64" example:"SPRING10"`
+}
@@ -0,0 +1,33 @@
+package dto
+
+import promoCodeDto "haircompany-shop-rest/internal/modules/v1/promo_code/dto"
+
+type ItemResponseDTO struct {
+	Id               uint   `json:"id" example:"1"`
+	ProductVariantID uint   `json:"productVariantId" example:"1"`
+	ProductID        uint   `json:"productId" example:"1"`
+	Article          string `json:"article" example:"HC-1000-250"`
+	Quantity         int    `json:"quantity" example:"2"`
+	Price            int64  `json:"price" example:"129000"`      // Current price in kopecks
+	PriceAtAdd       int64  `json:"priceAtAdd" example:"119000"` // Price in kopecks when the line was added
+	Subtotal         int64  `json:"subtotal" example:"258000"`
+	Available        int    `json:"available" example:"10"`
+	IsActive         bool   `json:"isActive" example:"true"`
+	PriceChanged     bool   `json:"priceChanged" example:"true"`  // Price differs from priceAtAdd
+	StockChanged     bool   `json:"stockChanged" example:"false"` // Not enough stock left for the requested quantity
+}
+
+type ResponseDTO struct {
+	Token         *string                     `json:"token" example:"3f2a9c..."` // Guest cart token, pass it back in X-Cart-Token
+	Items         []*ItemResponseDTO          `json:"items"`
+	TotalQuantity int                         `json:"totalQuantity" example:"2"`
+	Total         int64                       `json:"total" example:"258000"` // Total in kopecks at current prices
+	Discount      int64                       `json:"discount" example:"25800"`
+	TotalToPay    int64                       `json:"totalToPay" example:"232200"` // Total minus the promo code discount
+	PromoCode     *promoCodeDto.EvaluationDTO `json:"promoCode"`                   // Breakdown of the applied code, null if none
+	HasChanges    bool                        `json:"hasChanges" example:"true"`
+}
+
+type MergeDTO struct {
+	CartToken string `json:"cartToken" validate:"required,max=64"`
+}
@@ -0,0 +1,42 @@
+package dto
+
+import "haircompany-shop-rest/internal/modules/v1/cart/model"
+
+// TransformModelToResponseDTO recomputes totals from current variant prices.
+// available maps product variant IDs to the stock available for sale.
+func TransformModelToResponseDTO(cart *model.Cart, available map[uint]int) *ResponseDTO {
+	cartDto := &ResponseDTO{
+		Items: make([]*ItemResponseDTO, 0),
+	}
+	if cart == nil {
+		return cartDto
+	}
+
+	cartDto.Token = cart.Token
+	for _, item := range cart.Items {
+		itemDto := &ItemResponseDTO{
+			Id:               item.ID,
+			ProductVariantID: item.ProductVariantID,
+			Quantity:         item.Quantity,
+			PriceAtAdd:       item.PriceAtAdd,
+			Available:        available[item.ProductVariantID],
+		}
+		if item.ProductVariant != nil {
+			itemDto.ProductID = item.ProductVariant.ProductID
+			itemDto.Article = item.ProductVariant.Article
+			itemDto.Price = item.ProductVariant.Price
+			itemDto.IsActive = item.ProductVariant.IsActive
+		}
+		itemDto.Subtotal = itemDto.Price * int64(itemDto.Quantity)
+		itemDto.PriceChanged = itemDto.Price != itemDto.PriceAtAdd
+		itemDto.StockChanged = !itemDto.IsActive || itemDto.Quantity > itemDto.Available
+
+		cartDto.TotalQuantity += itemDto.Quantity
+		cartDto.Total += itemDto.Subtotal
+		cartDto.HasChanges = cartDto.HasChanges || itemDto.PriceChanged || itemDto.StockChanged
+		cartDto.Items = append(cartDto.Items, itemDto)
+	}
+	cartDto.TotalToPay = cartDto.Total
+
+	return cartDto
+}
@@ -0,0 +1,327 @@
+package cart
+
+import (
+	"errors"
+	"fmt"
+	"haircompany-shop-rest/internal/constraint"
+	"haircompany-shop-rest/internal/modules/v1/cart/dto"
+	"haircompany-shop-rest/internal/modules/v1/inventory"
+	"haircompany-shop-rest/internal/modules/v1/promo_code"
+	"haircompany-shop-rest/internal/services"
+	"haircompany-shop-rest/pkg/request"
+	"haircompany-shop-rest/pkg/response"
+	"net/http"
+	"strconv"
+)
+
+type Handler struct {
+	svc Service
+}
+
+func NewHandler(s Service) *Handler {
+	return &Handler{
+		svc: s,
+	}
+}
+
+// owner resolves the cart owner: an authenticated client wins over the guest cart token.
+func owner(r *http.Request) Owner {
+	if claims, ok := r.Context().Value("clientClaims").(*services.ClientClaims); ok && claims != nil {
+		return Owner{ClientPhone: claims.Phone}
+	}
+
+	return Owner{Token: r.Header.Get("X-Cart-Token")}
+}
+
+// GetCart retrieves the current cart
+//
+//	@Summary		Get cart
+//	@Description	Retrieve the cart of the authenticated client or of the guest identified by X-Cart-Token. Totals are recomputed from current prices; lines whose price or stock changed are flagged.
+//	@Tags			Cart
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			X-Cart-Token	header		string						false	"Guest cart token"
+//	@Success		200				{object}	docsResponse.CartGet200		"Cart"
+//	@Failure		401				{object}	docsResponse.Response401	"Unauthorized"
+//	@Failure		403				{object}	docsResponse.Response403	"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		500				{object}	docsResponse.Response500	"Server error"
+//	@Router			/api/v1/cart [get]
+func (h *Handler) GetCart(w http.ResponseWriter, r *http.Request) {
+	cart, err := h.svc.GetCart(owner(r))
+	if err != nil {
+		msg := fmt.Sprintf("failed to retrieve cart: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, cart)
+}
+
+// AddItem adds a product variant to the cart
+//
+//	@Summary		Add item to cart
+//	@Description	Add a product variant to the cart. A guest cart is created on first use; its token is returned in the response and must be sent back in X-Cart-Token.
+//	@Tags			Cart
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			X-Cart-Token	header		string						false	"Guest cart token"
+//	@Param			item			body		dto.AddItemDTO				true	"Cart item"
+//	@Success		200				{object}	docsResponse.CartGet200		"Cart updated"
+//	@Failure		400				{object}	docsResponse.CartItem400	"Bad request or validation error"
+//	@Failure		401				{object}	docsResponse.Response401	"Unauthorized"
+//	@Failure		403				{object}	docsResponse.Response403	"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		409				{object}	docsResponse.StockAdjust409	"Not enough stock"
+//	@Failure		500				{object}	docsResponse.Response500	"Server error"
+//	@Router			/api/v1/cart/items/create [post]
+func (h *Handler) AddItem(w http.ResponseWriter, r *http.Request) {
+	addDto, err := request.DecodeBody[dto.AddItemDTO](r.Body)
+	if err != nil {
+		msg := fmt.Sprintf("invalid request body: %v", err)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	errFields := constraint.ValidateDTO(addDto)
+	if errFields != nil {
+		msg := "validation errors occurred"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	cart, errFields, err := h.svc.AddItem(owner(r), addDto)
+	if err != nil {
+		h.sendServiceError(w, "failed to add item to cart", err)
+		return
+	}
+	if errFields != nil {
+		msg := "validation errors occurred"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, cart)
+}
+
+// UpdateItem changes quantity of a cart line
+//
+//	@Summary		Update cart item
+//	@Description	Change quantity of a cart line
+//	@Tags			Cart
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			X-Cart-Token	header		string						false	"Guest cart token"
+//	@Param			itemId			path		int							true	"Cart item ID"
+//	@Param			item			body		dto.UpdateItemDTO			true	"Cart item quantity"
+//	@Success		200				{object}	docsResponse.CartGet200		"Cart updated"
+//	@Failure		400				{object}	docsResponse.CartItem400	"Bad request or validation error"
+//	@Failure		401				{object}	docsResponse.Response401	"Unauthorized"
+//	@Failure		403				{object}	docsResponse.Response403	"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		404				{object}	docsResponse.Response404	"Cart item not found"
+//	@Failure		409				{object}	docsResponse.StockAdjust409	"Not enough stock"
+//	@Failure		500				{object}	docsResponse.Response500	"Server error"
+//	@Router			/api/v1/cart/items/{itemId}/update [patch]
+func (h *Handler) UpdateItem(w http.ResponseWriter, r *http.Request) {
+	itemIdStr := r.PathValue("itemId")
+	itemId, err := strconv.Atoi(itemIdStr)
+	if err != nil || itemId < 0 {
+		msg := fmt.Sprintf("invalid cart item id: %s", itemIdStr)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	updateDto, err := request.DecodeBody[dto.UpdateItemDTO](r.Body)
+	if err != nil {
+		msg := fmt.Sprintf("invalid request body: %v", err)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	errFields := constraint.ValidateDTO(updateDto)
+	if errFields != nil {
+		msg := "validation errors occurred"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	cart, errFields, err := h.svc.UpdateItem(owner(r), uint(itemId), updateDto)
+	if err != nil {
+		h.sendServiceError(w, "failed to update cart item", err)
+		return
+	}
+	if errFields != nil {
+		msg := fmt.Sprintf("cart item with id %d not found", itemId)
+		response.SendError(w, http.StatusNotFound, msg, response.NotFound)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, cart)
+}
+
+// RemoveItem removes a line from the cart
+//
+//	@Summary		Remove cart item
+//	@Description	Remove a line from the cart
+//	@Tags			Cart
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			X-Cart-Token	header		string						false	"Guest cart token"
+//	@Param			itemId			path		int							true	"Cart item ID"
+//	@Success		200				{object}	docsResponse.CartGet200		"Cart updated"
+//	@Failure		400				{object}	docsResponse.Response400	"Invalid ID"
+//	@Failure		401				{object}	docsResponse.Response401	"Unauthorized"
+//	@Failure		403				{object}	docsResponse.Response403	"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		404				{object}	docsResponse.Response404	"Cart item not found"
+//	@Failure		500				{object}	docsResponse.Response500	"Server error"
+//	@Router			/api/v1/cart/items/{itemId}/delete [delete]
+func (h *Handler) RemoveItem(w http.ResponseWriter, r *http.Request) {
+	itemIdStr := r.PathValue("itemId")
+	itemId, err := strconv.Atoi(itemIdStr)
+	if err != nil || itemId < 0 {
+		msg := fmt.Sprintf("invalid cart item id: %s", itemIdStr)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	cart, errFields, err := h.svc.RemoveItem(owner(r), uint(itemId))
+	if err != nil {
+		h.sendServiceError(w, "failed to remove cart item", err)
+		return
+	}
+	if errFields != nil {
+		msg := fmt.Sprintf("cart item with id %d not found", itemId)
+		response.SendError(w, http.StatusNotFound, msg, response.NotFound)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, cart)
+}
+
+// Merge moves a guest cart into the cart of the authenticated client
+//
+//	@Summary		Merge guest cart
+//	@Description	Move the guest cart identified by cartToken into the cart of the authenticated client
+//	@Tags			Cart
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			cart	body		dto.MergeDTO				true	"Guest cart token"
+//	@Success		200		{object}	docsResponse.CartGet200		"Merged cart"
+//	@Failure		400		{object}	docsResponse.Response400	"Bad request or validation error"
+//	@Failure		401		{object}	docsResponse.Response401	"Unauthorized"
+//	@Failure		403		{object}	docsResponse.Response403	"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		500		{object}	docsResponse.Response500	"Server error"
+//	@Router			/api/v1/cart/merge [post]
+func (h *Handler) Merge(w http.ResponseWriter, r *http.Request) {
+	mergeDto, err := request.DecodeBody[dto.MergeDTO](r.Body)
+	if err != nil {
+		msg := fmt.Sprintf("invalid request body: %v", err)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	errFields := constraint.ValidateDTO(mergeDto)
+	if errFields != nil {
+		msg := "validation errors occurred"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	cart, err := h.svc.Merge(owner(r).ClientPhone, mergeDto.CartToken)
+	if err != nil {
+		msg := fmt.Sprintf("failed to merge cart: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, cart)
+}
+
+// ApplyPromoCode applies a promo code to the cart
+//
+//	@Summary		Apply promo code
+//	@Description	Apply a promo code to the cart. The response shows the discount of every line and why a line is not discounted. A rejected code is reported with a PROMO_CODE_* error code, e.g. PROMO_CODE_EXPIRED or PROMO_CODE_MIN_AMOUNT_NOT_REACHED.
+//	@Tags			Cart
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			X-Cart-Token	header		string							false	"Guest cart token"
+//	@Param			promoCode		body		dto.PromoCodeDTO				true	"Promo code"
+//	@Success		200				{object}	docsResponse.CartGet200			"Cart with the discount applied"
+//	@Failure		400				{object}	docsResponse.CartPromoCode400	"Validation error or promo code rejected"
+//	@Failure		401				{object}	docsResponse.Response401		"Unauthorized"
+//	@Failure		403				{object}	docsResponse.Response403		"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		500				{object}	docsResponse.Response500		"Server error"
+//	@Router			/api/v1/cart/promo-code/apply [post]
+func (h *Handler) ApplyPromoCode(w http.ResponseWriter, r *http.Request) {
+	promoDto, err := request.DecodeBody[dto.PromoCodeDTO](r.Body)
+	if err != nil {
+		msg := fmt.Sprintf("invalid request body: %v", err)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	errFields := constraint.ValidateDTO(promoDto)
+	if errFields != nil {
+		msg := "validation errors occurred"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	cart, err := h.svc.ApplyPromoCode(owner(r), promoDto)
+	if err != nil {
+		h.sendServiceError(w, "failed to apply promo code", err)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, cart)
+}
+
+// RemovePromoCode removes the promo code from the cart
+//
+//	@Summary		Remove promo code
+//	@Description	Remove the applied promo code from the cart
+//	@Tags			Cart
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			X-Cart-Token	header		string						false	"Guest cart token"
+//	@Success		200				{object}	docsResponse.CartGet200		"Cart without discount"
+//	@Failure		401				{object}	docsResponse.Response401	"Unauthorized"
+//	@Failure		403				{object}	docsResponse.Response403	"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		500				{object}	docsResponse.Response500	"Server error"
+//	@Router			/api/v1/cart/promo-code/delete [delete]
+func (h *Handler) RemovePromoCode(w http.ResponseWriter, r *http.Request) {
+	cart, err := h.svc.RemovePromoCode(owner(r))
+	if err != nil {
+		msg := fmt.Sprintf("failed to remove promo code: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, cart)
+}
+
+func (h *Handler) sendServiceError(w http.ResponseWriter, prefix string, err error) {
+	var stockErr *inventory.InsufficientStockError
+	if errors.As(err, &stockErr) {
+		response.SendError(w, http.StatusConflict, stockErr.Error(), response.InsufficientStock)
+		return
+	}
+
+	var rejection *promo_code.RejectionError
+	if errors.As(err, &rejection) {
+		response.SendError(w, http.StatusBadRequest, rejection.Error(), rejection.Reason)
+		return
+	}
+
+	msg := fmt.Sprintf("%s: %v", prefix, err)
+	response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+}
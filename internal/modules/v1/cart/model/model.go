@@ -0,0 +1,28 @@
+package model
+
+import (
+	variantModel "haircompany-shop-rest/internal/modules/v1/product_variant/model"
+	"time"
+)
+
+// Cart belongs either to a guest (identified by Token) or to a client user.
+type Cart struct {
+	ID           uint `gorm:"primarykey" json:"id"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	Token        *string    `gorm:"type:varchar(64);unique" json:"token"`
+	ClientUserID *uint      `gorm:"unique" json:"clientUserId"`
+	PromoCode    *string    `gorm:"type:varchar(64)" json:"promoCode"` // Applied code, re-evaluated on every read
+	Items        []CartItem `gorm:"constraint:OnDelete:CASCADE" json:"items"`
+}
+
+type CartItem struct {
+	ID               uint `gorm:"primarykey" json:"id"`
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	CartID           uint                         `gorm:"not null;uniqueIndex:idx_cart_items_cart_variant" json:"cartId"`
+	ProductVariantID uint                         `gorm:"not null;uniqueIndex:idx_cart_items_cart_variant" json:"productVariantId"`
+	ProductVariant   *variantModel.ProductVariant `json:"productVariant"`
+	Quantity         int                          `gorm:"not null" json:"quantity"`
+	PriceAtAdd       int64                        `gorm:"not null" json:"priceAtAdd"` // Price in kopecks at the moment the line was added
+}
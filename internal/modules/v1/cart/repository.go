@@ -0,0 +1,180 @@
+package cart
+
+import (
+	"errors"
+	"fmt"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"haircompany-shop-rest/internal/modules/v1/cart/model"
+	"haircompany-shop-rest/pkg/database"
+	"time"
+)
+
+type Repository interface {
+	Transaction(fn func(repo Repository) error) error
+	Create(model *model.Cart) (*model.Cart, error)
+	GetById(id uint) (*model.Cart, error)
+	GetByToken(token string) (*model.Cart, error)
+	GetByClientUserId(clientUserId uint) (*model.Cart, error)
+	GetByTokenForUpdate(token string) (*model.Cart, error)
+	GetByClientUserIdForUpdate(clientUserId uint) (*model.Cart, error)
+	AssignToClient(id, clientUserId uint) error
+	SetPromoCode(id uint, code *string) error
+	Delete(id uint) error
+	GetItemById(cartId, itemId uint) (*model.CartItem, error)
+	GetItemByVariantId(cartId, variantId uint) (*model.CartItem, error)
+	AddItem(item *model.CartItem) error
+	UpdateItemQuantity(itemId uint, quantity int) error
+	DeleteItem(itemId uint) error
+}
+
+type repository struct {
+	DB *database.DB
+}
+
+func NewRepository(db *database.DB) Repository {
+	return &repository{
+		DB: db,
+	}
+}
+
+// Transaction runs fn against a repository bound to a single database transaction.
+func (r *repository) Transaction(fn func(repo Repository) error) error {
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		return fn(NewRepository(&database.DB{DB: tx}))
+	})
+}
+
+func (r *repository) withItems() *gorm.DB {
+	return r.DB.
+		Preload("Items", func(db *gorm.DB) *gorm.DB {
+			return db.Order("cart_items.id")
+		}).
+		Preload("Items.ProductVariant")
+}
+
+func (r *repository) Create(model *model.Cart) (*model.Cart, error) {
+	result := r.DB.Omit("Items").Create(&model)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return model, nil
+}
+
+func (r *repository) GetById(id uint) (*model.Cart, error) {
+	return r.first(r.withItems(), "id = ?", id)
+}
+
+func (r *repository) GetByToken(token string) (*model.Cart, error) {
+	return r.first(r.withItems(), "token = ?", token)
+}
+
+func (r *repository) GetByClientUserId(clientUserId uint) (*model.Cart, error) {
+	return r.first(r.withItems(), "client_user_id = ?", clientUserId)
+}
+
+// GetByTokenForUpdate блокирует строку корзины до конца транзакции, чтобы
+// одну корзину нельзя было оформить дважды параллельными запросами.
+func (r *repository) GetByTokenForUpdate(token string) (*model.Cart, error) {
+	return r.first(r.forUpdate(), "token = ?", token)
+}
+
+func (r *repository) GetByClientUserIdForUpdate(clientUserId uint) (*model.Cart, error) {
+	return r.first(r.forUpdate(), "client_user_id = ?", clientUserId)
+}
+
+func (r *repository) forUpdate() *gorm.DB {
+	query := r.withItems()
+	if r.DB.Dialector.Name() == "postgres" {
+		query = query.Clauses(clause.Locking{Strength: "UPDATE", Table: clause.Table{Name: clause.CurrentTable}})
+	}
+
+	return query
+}
+
+func (r *repository) first(db *gorm.DB, query string, args ...interface{}) (*model.Cart, error) {
+	var cart *model.Cart
+	var err error
+
+	result := db.Where(query, args...).First(&cart)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		err = result.Error
+	}
+
+	return cart, err
+}
+
+// AssignToClient turns a guest cart into a client cart; the guest token stops working.
+func (r *repository) AssignToClient(id, clientUserId uint) error {
+	return r.DB.Model(&model.Cart{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{"token": nil, "client_user_id": clientUserId}).Error
+}
+
+func (r *repository) SetPromoCode(id uint, code *string) error {
+	return r.DB.Model(&model.Cart{}).Where("id = ?", id).Update("promo_code", code).Error
+}
+
+func (r *repository) Delete(id uint) error {
+	result := r.DB.Where("cart_id = ?", id).Delete(&model.CartItem{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	result = r.DB.Delete(&model.Cart{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected != 1 {
+		return fmt.Errorf("cart with id %d not found", id)
+	}
+
+	return nil
+}
+
+func (r *repository) GetItemById(cartId, itemId uint) (*model.CartItem, error) {
+	return r.firstItem("cart_id = ? AND id = ?", cartId, itemId)
+}
+
+func (r *repository) GetItemByVariantId(cartId, variantId uint) (*model.CartItem, error) {
+	return r.firstItem("cart_id = ? AND product_variant_id = ?", cartId, variantId)
+}
+
+func (r *repository) firstItem(query string, args ...interface{}) (*model.CartItem, error) {
+	var item *model.CartItem
+	var err error
+
+	result := r.DB.Where(query, args...).First(&item)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		err = result.Error
+	}
+
+	return item, err
+}
+
+// AddItem inserts a cart line or, if the variant is already in the cart, adds
+// the quantity to the existing line. The original priceAtAdd is kept.
+func (r *repository) AddItem(item *model.CartItem) error {
+	return r.DB.Omit("ProductVariant").Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "cart_id"}, {Name: "product_variant_id"}},
+		DoUpdates: clause.Set{
+			{Column: clause.Column{Name: "quantity"}, Value: gorm.Expr("cart_items.quantity + excluded.quantity")},
+			{Column: clause.Column{Name: "updated_at"}, Value: time.Now()},
+		},
+	}).Create(item).Error
+}
+
+func (r *repository) UpdateItemQuantity(itemId uint, quantity int) error {
+	return r.DB.Model(&model.CartItem{}).Where("id = ?", itemId).Update("quantity", quantity).Error
+}
+
+func (r *repository) DeleteItem(itemId uint) error {
+	return r.DB.Delete(&model.CartItem{}, itemId).Error
+}
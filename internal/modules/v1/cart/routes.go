@@ -0,0 +1,127 @@
+package cart
+
+import (
+	"haircompany-shop-rest/internal/container"
+	"haircompany-shop-rest/internal/middleware"
+	"haircompany-shop-rest/internal/modules/v1/client_user"
+	"haircompany-shop-rest/internal/modules/v1/inventory"
+	"haircompany-shop-rest/internal/modules/v1/product_variant"
+	"haircompany-shop-rest/internal/modules/v1/promo_code"
+	"haircompany-shop-rest/pkg/response"
+	"net/http"
+)
+
+func RegisterV1CartRoutes(mux *http.ServeMux, container *container.Container) {
+	repo := NewRepository(container.DB)
+	variantRepo := product_variant.NewRepository(container.DB)
+	clientUserRepo := client_user.NewRepository(container.DB)
+	inventorySvc := inventory.NewService(inventory.NewRepository(container.DB), variantRepo)
+	promoCodeSvc := promo_code.NewService(promo_code.NewRepository(container.DB))
+	svc := NewService(repo, variantRepo, clientUserRepo, inventorySvc, promoCodeSvc)
+	h := NewHandler(svc)
+
+	mux.Handle("/cart",
+		middleware.ChainMiddleware(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodGet:
+					h.GetCart(w, r)
+				default:
+					msg := "Method not allowed. Allowed methods: GET"
+					response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+				}
+			}),
+			middleware.OptionalClientAuthMiddleware(container.JWTService),
+		),
+	)
+
+	mux.Handle("/cart/items/create",
+		middleware.ChainMiddleware(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodPost:
+					h.AddItem(w, r)
+				default:
+					msg := "Method not allowed. Allowed methods: POST"
+					response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+				}
+			}),
+			middleware.OptionalClientAuthMiddleware(container.JWTService),
+		),
+	)
+
+	mux.Handle("/cart/items/{itemId}/update",
+		middleware.ChainMiddleware(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodPatch:
+					h.UpdateItem(w, r)
+				default:
+					msg := "Method not allowed. Allowed methods: PATCH"
+					response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+				}
+			}),
+			middleware.OptionalClientAuthMiddleware(container.JWTService),
+		),
+	)
+
+	mux.Handle("/cart/items/{itemId}/delete",
+		middleware.ChainMiddleware(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodDelete:
+					h.RemoveItem(w, r)
+				default:
+					msg := "Method not allowed. Allowed methods: DELETE"
+					response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+				}
+			}),
+			middleware.OptionalClientAuthMiddleware(container.JWTService),
+		),
+	)
+
+	mux.Handle("/cart/promo-code/apply",
+		middleware.ChainMiddleware(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodPost:
+					h.ApplyPromoCode(w, r)
+				default:
+					msg := "Method not allowed. Allowed methods: POST"
+					response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+				}
+			}),
+			middleware.OptionalClientAuthMiddleware(container.JWTService),
+		),
+	)
+
+	mux.Handle("/cart/promo-code/delete",
+		middleware.ChainMiddleware(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodDelete:
+					h.RemovePromoCode(w, r)
+				default:
+					msg := "Method not allowed. Allowed methods: DELETE"
+					response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+				}
+			}),
+			middleware.OptionalClientAuthMiddleware(container.JWTService),
+		),
+	)
+
+	mux.Handle("/cart/merge",
+		middleware.ChainMiddleware(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodPost:
+					h.Merge(w, r)
+				default:
+					msg := "Method not allowed. Allowed methods: POST"
+					response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+				}
+			}),
+			middleware.ClientAuthMiddleware(container.JWTService),
+		),
+	)
+}
@@ -0,0 +1,397 @@
+package cart
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"haircompany-shop-rest/internal/modules/v1/cart/dto"
+	"haircompany-shop-rest/internal/modules/v1/cart/model"
+	"haircompany-shop-rest/internal/modules/v1/client_user"
+	"haircompany-shop-rest/internal/modules/v1/inventory"
+	"haircompany-shop-rest/internal/modules/v1/product_variant"
+	"haircompany-shop-rest/internal/modules/v1/promo_code"
+	promoCodeDto "haircompany-shop-rest/internal/modules/v1/promo_code/dto"
+	"haircompany-shop-rest/pkg/response"
+)
+
+// Owner identifies whose cart is accessed: an authenticated client (by phone
+// from the access token) or a guest (by the opaque cart token).
+type Owner struct {
+	ClientPhone string
+	Token       string
+}
+
+type Service interface {
+	GetCart(owner Owner) (*dto.ResponseDTO, error)
+	AddItem(owner Owner, addDto dto.AddItemDTO) (*dto.ResponseDTO, []response.ErrorField, error)
+	UpdateItem(owner Owner, itemId uint, updateDto dto.UpdateItemDTO) (*dto.ResponseDTO, []response.ErrorField, error)
+	RemoveItem(owner Owner, itemId uint) (*dto.ResponseDTO, []response.ErrorField, error)
+	Merge(clientPhone, cartToken string) (*dto.ResponseDTO, error)
+	ApplyPromoCode(owner Owner, promoDto dto.PromoCodeDTO) (*dto.ResponseDTO, error)
+	RemovePromoCode(owner Owner) (*dto.ResponseDTO, error)
+}
+
+type service struct {
+	repo           Repository
+	variantRepo    product_variant.Repository
+	clientUserRepo client_user.Repository
+	inventorySvc   inventory.Service
+	promoCodeSvc   promo_code.Service
+}
+
+func NewService(r Repository, variantRepo product_variant.Repository, clientUserRepo client_user.Repository, inventorySvc inventory.Service, promoCodeSvc promo_code.Service) Service {
+	return &service{
+		repo:           r,
+		variantRepo:    variantRepo,
+		clientUserRepo: clientUserRepo,
+		inventorySvc:   inventorySvc,
+		promoCodeSvc:   promoCodeSvc,
+	}
+}
+
+func (s *service) GetCart(owner Owner) (*dto.ResponseDTO, error) {
+	cart, err := s.findCart(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toResponseDTO(cart, owner.ClientPhone)
+}
+
+func (s *service) AddItem(owner Owner, addDto dto.AddItemDTO) (*dto.ResponseDTO, []response.ErrorField, error) {
+	variant, err := s.variantRepo.GetById(addDto.ProductVariantID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if variant == nil || !variant.IsActive {
+		return nil, []response.ErrorField{response.NewErrorField("productVariantId", string(response.NotFound))}, nil
+	}
+
+	cart, err := s.getOrCreateCart(owner)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	quantity := addDto.Quantity
+	existing, err := s.repo.GetItemByVariantId(cart.ID, variant.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if existing != nil {
+		quantity += existing.Quantity
+	}
+
+	if err := s.checkAvailable(variant.ID, quantity); err != nil {
+		return nil, nil, err
+	}
+
+	item := &model.CartItem{
+		CartID:           cart.ID,
+		ProductVariantID: variant.ID,
+		Quantity:         addDto.Quantity,
+		PriceAtAdd:       variant.Price,
+	}
+	if err := s.repo.AddItem(item); err != nil {
+		return nil, nil, err
+	}
+
+	cartDto, err := s.reload(cart.ID, owner.ClientPhone)
+	return cartDto, nil, err
+}
+
+func (s *service) UpdateItem(owner Owner, itemId uint, updateDto dto.UpdateItemDTO) (*dto.ResponseDTO, []response.ErrorField, error) {
+	cart, item, errFields, err := s.findItem(owner, itemId)
+	if err != nil || errFields != nil {
+		return nil, errFields, err
+	}
+
+	if updateDto.Quantity > item.Quantity {
+		if err := s.checkAvailable(item.ProductVariantID, updateDto.Quantity); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err := s.repo.UpdateItemQuantity(item.ID, updateDto.Quantity); err != nil {
+		return nil, nil, err
+	}
+
+	cartDto, err := s.reload(cart.ID, owner.ClientPhone)
+	return cartDto, nil, err
+}
+
+func (s *service) RemoveItem(owner Owner, itemId uint) (*dto.ResponseDTO, []response.ErrorField, error) {
+	cart, item, errFields, err := s.findItem(owner, itemId)
+	if err != nil || errFields != nil {
+		return nil, errFields, err
+	}
+
+	if err := s.repo.DeleteItem(item.ID); err != nil {
+		return nil, nil, err
+	}
+
+	cartDto, err := s.reload(cart.ID, owner.ClientPhone)
+	return cartDto, nil, err
+}
+
+// Merge moves the guest cart into the client cart. Quantities of the same
+// variant are summed; the guest cart is removed afterwards.
+func (s *service) Merge(clientPhone, cartToken string) (*dto.ResponseDTO, error) {
+	clientUser, err := s.clientUserRepo.GetByPhone(clientPhone)
+	if err != nil {
+		return nil, err
+	}
+	if clientUser == nil {
+		return nil, fmt.Errorf("client user not found")
+	}
+
+	var cartId uint
+	err = s.repo.Transaction(func(repo Repository) error {
+		guestCart, err := repo.GetByToken(cartToken)
+		if err != nil {
+			return err
+		}
+
+		clientCart, err := repo.GetByClientUserId(clientUser.ID)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case guestCart == nil && clientCart == nil:
+			return nil
+		case guestCart == nil:
+			cartId = clientCart.ID
+			return nil
+		case clientCart == nil:
+			cartId = guestCart.ID
+			return repo.AssignToClient(guestCart.ID, clientUser.ID)
+		}
+
+		cartId = clientCart.ID
+		if clientCart.PromoCode == nil && guestCart.PromoCode != nil {
+			if err := repo.SetPromoCode(clientCart.ID, guestCart.PromoCode); err != nil {
+				return err
+			}
+		}
+		for _, item := range guestCart.Items {
+			mergedItem := &model.CartItem{
+				CartID:           clientCart.ID,
+				ProductVariantID: item.ProductVariantID,
+				Quantity:         item.Quantity,
+				PriceAtAdd:       item.PriceAtAdd,
+			}
+			if err := repo.AddItem(mergedItem); err != nil {
+				return err
+			}
+		}
+
+		return repo.Delete(guestCart.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if cartId == 0 {
+		return s.toResponseDTO(nil, clientPhone)
+	}
+
+	return s.reload(cartId, clientPhone)
+}
+
+// ApplyPromoCode checks the code against the cart and keeps it on the cart
+// if it applies. A rejected code is returned as *promo_code.RejectionError.
+func (s *service) ApplyPromoCode(owner Owner, promoDto dto.PromoCodeDTO) (*dto.ResponseDTO, error) {
+	cart, err := s.findCart(owner)
+	if err != nil {
+		return nil, err
+	}
+	if cart == nil || len(cart.Items) == 0 {
+		return nil, &promo_code.RejectionError{Reason: response.EmptyCart}
+	}
+
+	evaluation, err := s.promoCodeSvc.Evaluate(promoDto.Code, owner.ClientPhone, PromoCodeItems(cart))
+	if err != nil {
+		return nil, err
+	}
+	if rejection := promo_code.NewRejectionError(evaluation); rejection != nil {
+		return nil, rejection
+	}
+
+	if err := s.repo.SetPromoCode(cart.ID, &evaluation.Code); err != nil {
+		return nil, err
+	}
+
+	return s.reload(cart.ID, owner.ClientPhone)
+}
+
+func (s *service) RemovePromoCode(owner Owner) (*dto.ResponseDTO, error) {
+	cart, err := s.findCart(owner)
+	if err != nil || cart == nil {
+		return s.toResponseDTO(cart, owner.ClientPhone)
+	}
+
+	if err := s.repo.SetPromoCode(cart.ID, nil); err != nil {
+		return nil, err
+	}
+
+	return s.reload(cart.ID, owner.ClientPhone)
+}
+
+// PromoCodeItems converts cart lines at current prices into promo code rule input.
+func PromoCodeItems(cart *model.Cart) []promoCodeDto.ItemDTO {
+	items := make([]promoCodeDto.ItemDTO, 0, len(cart.Items))
+	for _, item := range cart.Items {
+		if item.ProductVariant == nil {
+			continue
+		}
+		items = append(items, promoCodeDto.ItemDTO{
+			ProductVariantID: item.ProductVariantID,
+			ProductID:        item.ProductVariant.ProductID,
+			Price:            item.ProductVariant.Price,
+			Quantity:         item.Quantity,
+		})
+	}
+
+	return items
+}
+
+func (s *service) findCart(owner Owner) (*model.Cart, error) {
+	if owner.ClientPhone != "" {
+		clientUser, err := s.clientUserRepo.GetByPhone(owner.ClientPhone)
+		if err != nil {
+			return nil, err
+		}
+		if clientUser == nil {
+			return nil, fmt.Errorf("client user not found")
+		}
+
+		return s.repo.GetByClientUserId(clientUser.ID)
+	}
+
+	if owner.Token != "" {
+		return s.repo.GetByToken(owner.Token)
+	}
+
+	return nil, nil
+}
+
+// getOrCreateCart returns the owner's cart, creating it on first use. Guests
+// always get a freshly generated token: unknown client-supplied tokens are never adopted.
+func (s *service) getOrCreateCart(owner Owner) (*model.Cart, error) {
+	cart, err := s.findCart(owner)
+	if err != nil || cart != nil {
+		return cart, err
+	}
+
+	cart = &model.Cart{}
+	if owner.ClientPhone != "" {
+		clientUser, err := s.clientUserRepo.GetByPhone(owner.ClientPhone)
+		if err != nil {
+			return nil, err
+		}
+		cart.ClientUserID = &clientUser.ID
+	} else {
+		token, err := generateCartToken()
+		if err != nil {
+			return nil, err
+		}
+		cart.Token = &token
+	}
+
+	created, err := s.repo.Create(cart)
+	if err != nil && cart.ClientUserID != nil {
+		// Параллельный запрос мог успеть создать корзину клиента
+		existing, getErr := s.repo.GetByClientUserId(*cart.ClientUserID)
+		if getErr == nil && existing != nil {
+			return existing, nil
+		}
+	}
+
+	return created, err
+}
+
+func (s *service) findItem(owner Owner, itemId uint) (*model.Cart, *model.CartItem, []response.ErrorField, error) {
+	notFound := []response.ErrorField{response.NewErrorField("itemId", string(response.NotFound))}
+
+	cart, err := s.findCart(owner)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if cart == nil {
+		return nil, nil, notFound, nil
+	}
+
+	item, err := s.repo.GetItemById(cart.ID, itemId)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if item == nil {
+		return nil, nil, notFound, nil
+	}
+
+	return cart, item, nil, nil
+}
+
+func (s *service) checkAvailable(variantId uint, quantity int) error {
+	available, err := s.inventorySvc.GetAvailable([]uint{variantId})
+	if err != nil {
+		return err
+	}
+	if quantity > available[variantId] {
+		return &inventory.InsufficientStockError{
+			ProductVariantID: variantId,
+			Requested:        quantity,
+			Available:        available[variantId],
+		}
+	}
+
+	return nil
+}
+
+func (s *service) reload(cartId uint, clientPhone string) (*dto.ResponseDTO, error) {
+	cart, err := s.repo.GetById(cartId)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toResponseDTO(cart, clientPhone)
+}
+
+// toResponseDTO builds the cart view. The applied promo code is evaluated
+// again, so a code that has since expired or stopped matching the cart is
+// shown with its rejection reason and gives no discount.
+func (s *service) toResponseDTO(cart *model.Cart, clientPhone string) (*dto.ResponseDTO, error) {
+	if cart == nil || len(cart.Items) == 0 {
+		return dto.TransformModelToResponseDTO(cart, nil), nil
+	}
+
+	variantIds := make([]uint, 0, len(cart.Items))
+	for _, item := range cart.Items {
+		variantIds = append(variantIds, item.ProductVariantID)
+	}
+
+	available, err := s.inventorySvc.GetAvailable(variantIds)
+	if err != nil {
+		return nil, err
+	}
+
+	cartDto := dto.TransformModelToResponseDTO(cart, available)
+	if cart.PromoCode != nil {
+		evaluation, err := s.promoCodeSvc.Evaluate(*cart.PromoCode, clientPhone, PromoCodeItems(cart))
+		if err != nil {
+			return nil, err
+		}
+		cartDto.PromoCode = evaluation
+		cartDto.Discount = evaluation.Discount
+		cartDto.TotalToPay = cartDto.Total - cartDto.Discount
+	}
+
+	return cartDto, nil
+}
+
+func generateCartToken() (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bytes), nil
+}
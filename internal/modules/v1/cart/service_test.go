@@ -0,0 +1,230 @@
+package cart
+
+import (
+	"errors"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"haircompany-shop-rest/internal/modules/v1/cart/dto"
+	"haircompany-shop-rest/internal/modules/v1/cart/model"
+	"haircompany-shop-rest/internal/modules/v1/client_user"
+	clientUserModel "haircompany-shop-rest/internal/modules/v1/client_user/model"
+	"haircompany-shop-rest/internal/modules/v1/inventory"
+	inventoryModel "haircompany-shop-rest/internal/modules/v1/inventory/model"
+	productModel "haircompany-shop-rest/internal/modules/v1/product/model"
+	"haircompany-shop-rest/internal/modules/v1/product_variant"
+	variantModel "haircompany-shop-rest/internal/modules/v1/product_variant/model"
+	"haircompany-shop-rest/internal/modules/v1/promo_code"
+	promoCodeModel "haircompany-shop-rest/internal/modules/v1/promo_code/model"
+	"haircompany-shop-rest/pkg/database"
+	"haircompany-shop-rest/pkg/response"
+	"testing"
+)
+
+func setupTestService(t *testing.T) (Service, *gorm.DB) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatal("Failed to connect to test database:", err)
+	}
+
+	err = db.AutoMigrate(
+		&clientUserModel.ClientUser{},
+		&variantModel.ProductVariant{},
+		&inventoryModel.Stock{},
+		&model.Cart{},
+		&model.CartItem{},
+		&productModel.Product{},
+		&promoCodeModel.PromoCode{},
+		&promoCodeModel.PromoCodeUsage{},
+	)
+	if err != nil {
+		t.Fatal("Failed to migrate test database:", err)
+	}
+
+	testDB := &database.DB{DB: db}
+	variantRepo := product_variant.NewRepository(testDB)
+	inventorySvc := inventory.NewService(inventory.NewRepository(testDB), variantRepo)
+	promoCodeSvc := promo_code.NewService(promo_code.NewRepository(testDB))
+	svc := NewService(NewRepository(testDB), variantRepo, client_user.NewRepository(testDB), inventorySvc, promoCodeSvc)
+
+	return svc, db
+}
+
+func createVariant(t *testing.T, db *gorm.DB, article string, price int64, quantity int) *variantModel.ProductVariant {
+	variant := &variantModel.ProductVariant{
+		ProductID: 1,
+		Article:   article,
+		Barcode:   article,
+		Volume:    250,
+		Price:     price,
+		IsActive:  true,
+	}
+	if err := db.Create(variant).Error; err != nil {
+		t.Fatalf("Failed to create test variant: %v", err)
+	}
+	if err := db.Create(&inventoryModel.Stock{ProductVariantID: variant.ID, Quantity: quantity}).Error; err != nil {
+		t.Fatalf("Failed to create test stock: %v", err)
+	}
+
+	return variant
+}
+
+func TestService_AddItem_Guest(t *testing.T) {
+	svc, db := setupTestService(t)
+	variant := createVariant(t, db, "HC-001", 1000, 10)
+
+	cart, errFields, err := svc.AddItem(Owner{}, dto.AddItemDTO{ProductVariantID: variant.ID, Quantity: 2})
+	if err != nil || errFields != nil {
+		t.Fatalf("Expected no error, got %v %v", err, errFields)
+	}
+	if cart.Token == nil || *cart.Token == "" {
+		t.Fatal("Expected guest cart token to be issued")
+	}
+
+	cart, _, err = svc.AddItem(Owner{Token: *cart.Token}, dto.AddItemDTO{ProductVariantID: variant.ID, Quantity: 3})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(cart.Items) != 1 || cart.Items[0].Quantity != 5 {
+		t.Fatalf("Expected one line with quantity 5, got %+v", cart.Items)
+	}
+	if cart.Total != 5000 {
+		t.Errorf("Expected total 5000, got %d", cart.Total)
+	}
+}
+
+func TestService_AddItem_InsufficientStock(t *testing.T) {
+	svc, db := setupTestService(t)
+	variant := createVariant(t, db, "HC-001", 1000, 1)
+
+	_, _, err := svc.AddItem(Owner{}, dto.AddItemDTO{ProductVariantID: variant.ID, Quantity: 2})
+	var stockErr *inventory.InsufficientStockError
+	if !errors.As(err, &stockErr) {
+		t.Fatalf("Expected InsufficientStockError, got %v", err)
+	}
+}
+
+func TestService_GetCart_FlagsChanges(t *testing.T) {
+	svc, db := setupTestService(t)
+	variant := createVariant(t, db, "HC-001", 1000, 5)
+
+	cart, _, err := svc.AddItem(Owner{}, dto.AddItemDTO{ProductVariantID: variant.ID, Quantity: 4})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	db.Model(variant).Update("price", 1200)
+	db.Model(&inventoryModel.Stock{}).Where("product_variant_id = ?", variant.ID).Update("quantity", 3)
+
+	cart, err = svc.GetCart(Owner{Token: *cart.Token})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	item := cart.Items[0]
+	if !item.PriceChanged || !item.StockChanged || !cart.HasChanges {
+		t.Errorf("Expected price and stock changes to be flagged, got %+v", item)
+	}
+	if item.PriceAtAdd != 1000 || cart.Total != 4800 {
+		t.Errorf("Expected priceAtAdd 1000 and total 4800, got %d and %d", item.PriceAtAdd, cart.Total)
+	}
+}
+
+func TestService_Merge(t *testing.T) {
+	svc, db := setupTestService(t)
+	first := createVariant(t, db, "HC-001", 1000, 10)
+	second := createVariant(t, db, "HC-002", 500, 10)
+
+	clientUser := &clientUserModel.ClientUser{Phone: "+79991234567"}
+	db.Create(clientUser)
+	client := Owner{ClientPhone: clientUser.Phone}
+
+	if _, _, err := svc.AddItem(client, dto.AddItemDTO{ProductVariantID: first.ID, Quantity: 1}); err != nil {
+		t.Fatalf("Failed to add item to client cart: %v", err)
+	}
+
+	guestCart, _, err := svc.AddItem(Owner{}, dto.AddItemDTO{ProductVariantID: first.ID, Quantity: 2})
+	if err != nil {
+		t.Fatalf("Failed to add item to guest cart: %v", err)
+	}
+	guest := Owner{Token: *guestCart.Token}
+	if _, _, err := svc.AddItem(guest, dto.AddItemDTO{ProductVariantID: second.ID, Quantity: 1}); err != nil {
+		t.Fatalf("Failed to add item to guest cart: %v", err)
+	}
+
+	cart, err := svc.Merge(clientUser.Phone, *guestCart.Token)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if cart.TotalQuantity != 4 || len(cart.Items) != 2 {
+		t.Errorf("Expected 2 lines with 4 items, got %d lines with %d items", len(cart.Items), cart.TotalQuantity)
+	}
+
+	guestCartDto, err := svc.GetCart(guest)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(guestCartDto.Items) != 0 {
+		t.Error("Expected guest cart to be removed after merge")
+	}
+}
+
+func TestService_ApplyPromoCode(t *testing.T) {
+	svc, db := setupTestService(t)
+	variant := createVariant(t, db, "HC-001", 1000, 10)
+	db.Omit("DesiredResults.*", "Shades.*", "Variants").Create(&productModel.Product{ID: 1, Name: "Шампунь", Slug: "shampoo", CategoryID: 1, LineID: 1, ProductTypeID: 1})
+	db.Create(&promoCodeModel.PromoCode{Code: "SALE10", DiscountType: promoCodeModel.DiscountPercent, DiscountValue: 10, IsActive: true})
+
+	cart, _, err := svc.AddItem(Owner{}, dto.AddItemDTO{ProductVariantID: variant.ID, Quantity: 2})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	guest := Owner{Token: *cart.Token}
+
+	_, err = svc.ApplyPromoCode(guest, dto.PromoCodeDTO{Code: "unknown"})
+	var rejection *promo_code.RejectionError
+	if !errors.As(err, &rejection) || rejection.Reason != response.PromoCodeNotFound {
+		t.Fatalf("Expected PROMO_CODE_NOT_FOUND rejection, got %v", err)
+	}
+
+	cart, err = svc.ApplyPromoCode(guest, dto.PromoCodeDTO{Code: " sale10 "})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if cart.PromoCode == nil || !cart.PromoCode.Applied || cart.Discount != 200 || cart.TotalToPay != 1800 {
+		t.Fatalf("Expected 10%% discount on 2000, got discount %d, total to pay %d", cart.Discount, cart.TotalToPay)
+	}
+
+	db.Model(&promoCodeModel.PromoCode{}).Where("code = ?", "SALE10").Update("is_active", false)
+	cart, err = svc.GetCart(guest)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if cart.PromoCode.Applied || *cart.PromoCode.Reason != string(response.PromoCodeInactive) || cart.Discount != 0 {
+		t.Errorf("Expected disabled code to give no discount, got %+v", cart.PromoCode)
+	}
+
+	cart, err = svc.RemovePromoCode(guest)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if cart.PromoCode != nil || cart.TotalToPay != 2000 {
+		t.Errorf("Expected promo code to be removed, got %+v", cart.PromoCode)
+	}
+}
+
+func TestRepository_DeleteMissingCart(t *testing.T) {
+	_, db := setupTestService(t)
+	repo := NewRepository(&database.DB{DB: db})
+
+	cart, err := repo.Create(&model.Cart{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Delete(cart.ID); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	// Корзину, оформленную параллельным запросом, повторно удалить нельзя
+	if err := repo.Delete(cart.ID); err == nil {
+		t.Error("Expected an error for an already deleted cart")
+	}
+}
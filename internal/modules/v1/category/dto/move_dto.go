@@ -0,0 +1,6 @@
+package dto
+
+type MoveDTO struct {
+	ParentID  *uint `json:"parentId"` // null переносит категорию в корень
+	SortIndex *int  `json:"sortIndex" validate:"omitempty,gte=0,lte=9999"`
+}
@@ -0,0 +1,6 @@
+package dto
+
+// RedirectDTO возвращается, когда поиск попал на устаревший slug.
+type RedirectDTO struct {
+	Slug string `json:"slug"`
+}
@@ -2,6 +2,7 @@ package dto
 
 import (
 	categoryModel "haircompany-shop-rest/internal/modules/v1/category/model"
+	imageDto "haircompany-shop-rest/internal/modules/v1/image/dto"
 )
 
 func TransformCreateDTOToModel(dto CreateDTO) *categoryModel.Category {
@@ -71,7 +72,7 @@ func TransformUpdateDTOToModel(dto UpdateDTO, model *categoryModel.Category) *ca
 }
 
 func TransformModelToResponseDTO(model *categoryModel.Category) *ResponseDTO {
-	return &ResponseDTO{
+	responseDTO := &ResponseDTO{
 		Id:              model.ID,
 		CreatedAt:       model.CreatedAt,
 		UpdatedAt:       model.UpdatedAt,
@@ -79,6 +80,8 @@ func TransformModelToResponseDTO(model *categoryModel.Category) *ResponseDTO {
 		Description:     model.Description,
 		Image:           model.Image,
 		HeaderImage:     model.HeaderImage,
+		ImageUrls:       imageDto.TransformFilenameToResponseDTO(model.Image, "category"),
+		HeaderImageUrls: imageDto.TransformFilenameToResponseDTO(model.HeaderImage, "category"),
 		Slug:            model.Slug,
 		ParentID:        model.ParentID,
 		SortIndex:       model.SortIndex,
@@ -90,4 +93,44 @@ func TransformModelToResponseDTO(model *categoryModel.Category) *ResponseDTO {
 		IsVisibleInMenu: model.IsVisibleInMenu,
 		IsVisibleOnMain: model.IsVisibleOnMain,
 	}
+	if model.DeletedAt.Valid {
+		deletedAt := model.DeletedAt.Time
+		responseDTO.DeletedAt = &deletedAt
+	}
+
+	return responseDTO
+}
+
+// TransformModelsToTree собирает дерево из плоского списка, упорядоченного по sortIndex.
+// Категории, родитель которых отсутствует в списке, в дерево не попадают.
+func TransformModelsToTree(models []*categoryModel.Category) []*TreeDTO {
+	nodes := make(map[uint]*TreeDTO, len(models))
+	for _, model := range models {
+		nodes[model.ID] = &TreeDTO{
+			ResponseDTO: *TransformModelToResponseDTO(model),
+			Children:    make([]*TreeDTO, 0),
+		}
+	}
+
+	roots := make([]*TreeDTO, 0)
+	for _, model := range models {
+		node := nodes[model.ID]
+		if model.ParentID == nil {
+			roots = append(roots, node)
+			continue
+		}
+		if parent, ok := nodes[*model.ParentID]; ok {
+			parent.Children = append(parent.Children, node)
+		}
+	}
+
+	return roots
+}
+
+func TransformModelToBreadcrumbDTO(model *categoryModel.Category) *BreadcrumbDTO {
+	return &BreadcrumbDTO{
+		Id:   model.ID,
+		Name: model.Name,
+		Slug: model.Slug,
+	}
 }
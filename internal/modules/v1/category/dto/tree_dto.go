@@ -0,0 +1,12 @@
+package dto
+
+type TreeDTO struct {
+	ResponseDTO
+	Children []*TreeDTO `json:"children"`
+}
+
+type BreadcrumbDTO struct {
+	Id   uint   `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
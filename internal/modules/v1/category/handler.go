@@ -7,6 +7,7 @@ import (
 	"haircompany-shop-rest/pkg/request"
 	"haircompany-shop-rest/pkg/response"
 	"net/http"
+	"net/url"
 	"strconv"
 )
 
@@ -66,6 +67,27 @@ func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 	response.SendSuccess(w, http.StatusCreated, createdCategory)
 }
 
+var listOptions = request.ListOptions{
+	SortFields: map[string]string{
+		"id":        "id",
+		"name":      "name",
+		"slug":      "slug",
+		"sortIndex": "sort_index",
+		"createdAt": "created_at",
+		"deletedAt": "deleted_at",
+	},
+	FilterFields: map[string]request.Filter{
+		"name":            {Column: "name", Type: request.FilterContains},
+		"slug":            {Column: "slug", Type: request.FilterEquals},
+		"parentId":        {Column: "parent_id", Type: request.FilterInt},
+		"isActive":        {Column: "is_active", Type: request.FilterBool},
+		"isShade":         {Column: "is_shade", Type: request.FilterBool},
+		"isVisibleInMenu": {Column: "is_visible_in_menu", Type: request.FilterBool},
+		"isVisibleOnMain": {Column: "is_visible_on_main", Type: request.FilterBool},
+	},
+	DefaultSort: []request.Sort{{Column: "sort_index"}},
+}
+
 // GetAll retrieves all categories
 //
 //	@Summary		Get all categories
@@ -73,19 +95,37 @@ func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 //	@Tags			Category
 //	@Security		AppAuth
 //	@Produce		json
-//	@Success		200	{object}	docsResponse.CategoryList200	"List of categories"
-//	@Failure		403	{object}	docsResponse.Response403		"Forbidden - Invalid X-AUTH-APP"
-//	@Failure		500	{object}	docsResponse.Response500		"Server error"
+//	@Param			page			query		int								false	"Page number, starting from 1"
+//	@Param			perPage			query		int								false	"Items per page, up to 100 (default 20)"
+//	@Param			sort			query		string							false	"Comma-separated sort fields, prefix with - for descending: id, name, slug, sortIndex, createdAt"
+//	@Param			name			query		string							false	"Filter by name substring"
+//	@Param			slug			query		string							false	"Filter by exact slug"
+//	@Param			parentId		query		string							false	"Filter by parent ID, "null" for root categories"
+//	@Param			isActive		query		bool							false	"Filter by activity"
+//	@Param			isShade			query		bool							false	"Filter shade categories"
+//	@Param			isVisibleInMenu	query		bool							false	"Filter by menu visibility"
+//	@Param			isVisibleOnMain	query		bool							false	"Filter by main page visibility"
+//	@Success		200				{object}	docsResponse.CategoryList200	"List of categories"
+//	@Failure		400				{object}	docsResponse.Response400		"Invalid page, perPage, sort or filter value"
+//	@Failure		403				{object}	docsResponse.Response403		"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		500				{object}	docsResponse.Response500		"Server error"
 //	@Router			/api/v1/category [get]
-func (h *Handler) GetAll(w http.ResponseWriter) {
-	categories, err := h.svc.GetAll()
+func (h *Handler) GetAll(w http.ResponseWriter, r *http.Request) {
+	query, errFields := request.ParseListQuery(r.URL.Query(), listOptions)
+	if errFields != nil {
+		msg := "invalid list query"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	categories, total, err := h.svc.GetAll(query)
 	if err != nil {
 		msg := fmt.Sprintf("failed to retrieve categories: %v", err)
 		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
 		return
 	}
 
-	response.SendSuccess(w, http.StatusOK, categories)
+	response.SendPaginated(w, http.StatusOK, categories, response.Pagination{Total: total, Page: query.Page, PerPage: query.PerPage})
 }
 
 // GetById retrieves a category by its ID
@@ -244,3 +284,309 @@ func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
 
 	response.SendSuccess(w, http.StatusOK, category)
 }
+
+// GetTree retrieves the full category tree
+//
+//	@Summary		Get category tree
+//	@Description	Retrieve all categories as a nested tree. Siblings are ordered by sortIndex
+//	@Tags			Category
+//	@Security		AppAuth
+//	@Produce		json
+//	@Success		200	{object}	docsResponse.CategoryTree200	"Category tree"
+//	@Failure		403	{object}	docsResponse.Response403		"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		500	{object}	docsResponse.Response500		"Server error"
+//	@Router			/api/v1/category/tree [get]
+func (h *Handler) GetTree(w http.ResponseWriter) {
+	tree, err := h.svc.GetTree()
+	if err != nil {
+		msg := fmt.Sprintf("failed to retrieve category tree: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, tree)
+}
+
+// GetMenu retrieves the category tree for the storefront menu
+//
+//	@Summary		Get menu categories
+//	@Description	Retrieve active categories visible in the menu as a nested tree. A hidden or inactive category hides its whole branch
+//	@Tags			Category
+//	@Security		AppAuth
+//	@Produce		json
+//	@Success		200	{object}	docsResponse.CategoryTree200	"Menu category tree"
+//	@Failure		403	{object}	docsResponse.Response403		"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		500	{object}	docsResponse.Response500		"Server error"
+//	@Router			/api/v1/category/menu [get]
+func (h *Handler) GetMenu(w http.ResponseWriter) {
+	tree, err := h.svc.GetMenu()
+	if err != nil {
+		msg := fmt.Sprintf("failed to retrieve menu categories: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, tree)
+}
+
+// GetMain retrieves the categories shown on the main page
+//
+//	@Summary		Get main page categories
+//	@Description	Retrieve active categories visible on the main page whose parents are all active, ordered by sortIndex
+//	@Tags			Category
+//	@Security		AppAuth
+//	@Produce		json
+//	@Success		200	{object}	docsResponse.CategoryMain200	"Main page categories"
+//	@Failure		403	{object}	docsResponse.Response403		"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		500	{object}	docsResponse.Response500		"Server error"
+//	@Router			/api/v1/category/main [get]
+func (h *Handler) GetMain(w http.ResponseWriter) {
+	categories, err := h.svc.GetMain()
+	if err != nil {
+		msg := fmt.Sprintf("failed to retrieve main page categories: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, categories)
+}
+
+// GetBreadcrumbs retrieves the path from the root to a category
+//
+//	@Summary		Get category breadcrumbs
+//	@Description	Retrieve the chain of categories from the root down to the given category
+//	@Tags			Category
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			id	path		int									true	"Category ID"
+//	@Success		200	{object}	docsResponse.CategoryBreadcrumbs200	"Breadcrumbs"
+//	@Failure		400	{object}	docsResponse.Response400			"Invalid ID"
+//	@Failure		403	{object}	docsResponse.Response403			"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		404	{object}	docsResponse.Response404			"Category not found"
+//	@Failure		500	{object}	docsResponse.Response500			"Server error"
+//	@Router			/api/v1/category/{id}/breadcrumbs [get]
+func (h *Handler) GetBreadcrumbs(w http.ResponseWriter, r *http.Request) {
+	idStr := r.PathValue("id")
+	if idStr == "" {
+		msg := "missing category id"
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	id, err := strconv.Atoi(idStr)
+	if err != nil || id < 0 {
+		msg := fmt.Sprintf("invalid category id: %s", idStr)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	breadcrumbs, err := h.svc.GetBreadcrumbs(uint(id))
+	if err != nil {
+		msg := fmt.Sprintf("failed to retrieve breadcrumbs: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+	if breadcrumbs == nil {
+		msg := fmt.Sprintf("category with id %d not found", id)
+		response.SendError(w, http.StatusNotFound, msg, response.NotFound)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, breadcrumbs)
+}
+
+// GetBySlug retrieves a category by its slug
+//
+//	@Summary		Get category by slug
+//	@Description	Retrieve category by its current slug. An old slug answers 301 with the current slug in data and a Location header pointing at it
+//	@Tags			Category
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			slug	path		string									true	"Category slug"
+//	@Success		200		{object}	docsResponse.CategoryGetById200			"Category found"
+//	@Success		301		{object}	docsResponse.CategorySlugRedirect301	"Slug has changed"
+//	@Failure		400		{object}	docsResponse.Response400				"Missing slug"
+//	@Failure		403		{object}	docsResponse.Response403				"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		404		{object}	docsResponse.Response404				"Category not found"
+//	@Failure		500		{object}	docsResponse.Response500				"Server error"
+//	@Router			/api/v1/category/by-slug/{slug} [get]
+func (h *Handler) GetBySlug(w http.ResponseWriter, r *http.Request) {
+	slug := r.PathValue("slug")
+	if slug == "" {
+		msg := "missing category slug"
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	category, redirect, err := h.svc.GetBySlug(slug)
+	if err != nil {
+		msg := fmt.Sprintf("failed to retrieve category: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+	if redirect != nil {
+		w.Header().Set("Location", "/api/v1/category/by-slug/"+url.PathEscape(redirect.Slug))
+		response.SendSuccess(w, http.StatusMovedPermanently, redirect)
+		return
+	}
+	if category == nil {
+		msg := fmt.Sprintf("category with slug %s not found", slug)
+		response.SendError(w, http.StatusNotFound, msg, response.NotFound)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, category)
+}
+
+// Move moves a category with its whole subtree
+//
+//	@Summary		Move category subtree
+//	@Description	Re-parent a category together with all its descendants. A null parentId moves the category to the root. Moving a category under itself or its descendant is rejected with CATEGORY_CYCLE
+//	@Tags			Category
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int								true	"Category ID"
+//	@Param			move	body		dto.MoveDTO						true	"New parent and sort index"
+//	@Success		200		{object}	docsResponse.CategoryUpdate200	"Category moved"
+//	@Failure		400		{object}	docsResponse.CategoryUpdate400	"Bad request, validation error or cycle"
+//	@Failure		401		{object}	docsResponse.Response401		"Unauthorized"
+//	@Failure		403		{object}	docsResponse.Response403		"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		404		{object}	docsResponse.Response404		"Category not found"
+//	@Failure		500		{object}	docsResponse.Response500		"Server error"
+//	@Router			/api/v1/category/{id}/move [patch]
+func (h *Handler) Move(w http.ResponseWriter, r *http.Request) {
+	idStr := r.PathValue("id")
+	if idStr == "" {
+		msg := "missing category id"
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	id, err := strconv.Atoi(idStr)
+	if err != nil || id < 0 {
+		msg := fmt.Sprintf("invalid category id: %s", idStr)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	moveDto, err := request.DecodeBody[dto.MoveDTO](r.Body)
+	if err != nil {
+		msg := fmt.Sprintf("invalid request body: %v", err)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	errFields := constraint.ValidateDTO(moveDto)
+	if errFields != nil {
+		msg := "validation errors occurred"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	movedCategory, errFields, err := h.svc.Move(uint(id), moveDto)
+	if err != nil {
+		msg := fmt.Sprintf("failed to move category: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+	if errFields != nil {
+		msg := "validation errors occurred"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+	if movedCategory == nil {
+		msg := fmt.Sprintf("category with id %d not found", id)
+		response.SendError(w, http.StatusNotFound, msg, response.NotFound)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, movedCategory)
+}
+
+// GetTrash retrieves deleted categories
+//
+//	@Summary		Get deleted categories
+//	@Description	Retrieve categories in the trash. They are purged permanently after the retention period
+//	@Tags			Category
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			page	query		int								false	"Page number, starting from 1"
+//	@Param			perPage	query		int								false	"Items per page, up to 100 (default 20)"
+//	@Param			sort	query		string							false	"Comma-separated sort fields, prefix with - for descending, e.g. -deletedAt"
+//	@Success		200		{object}	docsResponse.CategoryList200	"Deleted categories"
+//	@Failure		400		{object}	docsResponse.Response400		"Invalid page, perPage, sort or filter value"
+//	@Failure		401		{object}	docsResponse.Response401		"Unauthorized"
+//	@Failure		403		{object}	docsResponse.Response403		"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		500		{object}	docsResponse.Response500		"Server error"
+//	@Router			/api/v1/category/trash [get]
+func (h *Handler) GetTrash(w http.ResponseWriter, r *http.Request) {
+	query, errFields := request.ParseListQuery(r.URL.Query(), listOptions)
+	if errFields != nil {
+		msg := "invalid list query"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	categoryList, total, err := h.svc.GetTrash(query)
+	if err != nil {
+		msg := fmt.Sprintf("failed to retrieve deleted categories: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+
+	response.SendPaginated(w, http.StatusOK, categoryList, response.Pagination{Total: total, Page: query.Page, PerPage: query.PerPage})
+}
+
+// Restore restores a deleted category
+//
+//	@Summary		Restore category
+//	@Description	Move a category out of the trash
+//	@Tags			Category
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			id	path		int								true	"Category ID"
+//	@Success		200	{object}	docsResponse.CategoryGetById200	"Category restored"
+//	@Failure		400	{object}	docsResponse.CategoryCreate400	"Invalid ID or the restored values are taken"
+//	@Failure		401	{object}	docsResponse.Response401		"Unauthorized"
+//	@Failure		403	{object}	docsResponse.Response403		"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		404	{object}	docsResponse.Response404		"Category not found in the trash"
+//	@Failure		500	{object}	docsResponse.Response500		"Server error"
+//	@Router			/api/v1/category/{id}/restore [patch]
+func (h *Handler) Restore(w http.ResponseWriter, r *http.Request) {
+	idStr := r.PathValue("id")
+	if idStr == "" {
+		msg := "missing category id"
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	id, err := strconv.Atoi(idStr)
+	if err != nil || id < 0 {
+		msg := fmt.Sprintf("invalid category id: %s", idStr)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	category, errFields, err := h.svc.Restore(uint(id))
+	if err != nil {
+		msg := fmt.Sprintf("failed to restore category: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+	if errFields != nil {
+		msg := "validation errors occurred"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+	if category == nil {
+		msg := fmt.Sprintf("deleted category with id %d not found", id)
+		response.SendError(w, http.StatusNotFound, msg, response.NotFound)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, category)
+}
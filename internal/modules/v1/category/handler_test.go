@@ -5,14 +5,17 @@ import (
 	"encoding/json"
 	"fmt"
 	"haircompany-shop-rest/internal/modules/v1/category/dto"
+	"haircompany-shop-rest/pkg/request"
 	"haircompany-shop-rest/pkg/response"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 type mockService struct {
 	categories                     map[uint]*dto.ResponseDTO
+	redirects                      map[string]string
 	nextId                         uint
 	validationErrors               []response.ErrorField
 	shouldReturnError              bool
@@ -22,6 +25,7 @@ type mockService struct {
 func newMockService() *mockService {
 	return &mockService{
 		categories: make(map[uint]*dto.ResponseDTO),
+		redirects:  make(map[string]string),
 		nextId:     1,
 	}
 }
@@ -58,16 +62,16 @@ func (m *mockService) Create(createDto dto.CreateDTO) (*dto.ResponseDTO, []respo
 	return category, nil, nil
 }
 
-func (m *mockService) GetAll() ([]*dto.ResponseDTO, error) {
+func (m *mockService) GetAll(query request.ListQuery) ([]*dto.ResponseDTO, int64, error) {
 	if m.shouldReturnError {
-		return nil, fmt.Errorf("service error")
+		return nil, 0, fmt.Errorf("service error")
 	}
 
 	var categories []*dto.ResponseDTO
 	for _, cat := range m.categories {
 		categories = append(categories, cat)
 	}
-	return categories, nil
+	return categories, int64(len(categories)), nil
 }
 
 func (m *mockService) GetById(id uint) (*dto.ResponseDTO, error) {
@@ -159,6 +163,90 @@ func (m *mockService) Delete(id uint) (*dto.ResponseDTO, int64, error) {
 	return category, 0, nil
 }
 
+func (m *mockService) GetTree() ([]*dto.TreeDTO, error) {
+	if m.shouldReturnError {
+		return nil, fmt.Errorf("service error")
+	}
+	return make([]*dto.TreeDTO, 0), nil
+}
+
+func (m *mockService) GetMenu() ([]*dto.TreeDTO, error) {
+	return m.GetTree()
+}
+
+func (m *mockService) GetMain() ([]*dto.ResponseDTO, error) {
+	if m.shouldReturnError {
+		return nil, fmt.Errorf("service error")
+	}
+	return make([]*dto.ResponseDTO, 0), nil
+}
+
+func (m *mockService) GetBreadcrumbs(id uint) ([]*dto.BreadcrumbDTO, error) {
+	if m.shouldReturnError {
+		return nil, fmt.Errorf("service error")
+	}
+
+	category, exists := m.categories[id]
+	if !exists {
+		return nil, nil
+	}
+	return []*dto.BreadcrumbDTO{{Id: category.Id, Name: category.Name, Slug: category.Slug}}, nil
+}
+
+func (m *mockService) GetBySlug(slug string) (*dto.ResponseDTO, *dto.RedirectDTO, error) {
+	if m.shouldReturnError {
+		return nil, nil, fmt.Errorf("service error")
+	}
+
+	for _, category := range m.categories {
+		if category.Slug == slug {
+			return category, nil, nil
+		}
+	}
+	if target, exists := m.redirects[slug]; exists {
+		return nil, &dto.RedirectDTO{Slug: target}, nil
+	}
+	return nil, nil, nil
+}
+
+func (m *mockService) Move(id uint, moveDto dto.MoveDTO) (*dto.ResponseDTO, []response.ErrorField, error) {
+	if m.shouldReturnError {
+		return nil, nil, fmt.Errorf("service error")
+	}
+
+	category, exists := m.categories[id]
+	if !exists {
+		return nil, nil, nil
+	}
+	if moveDto.ParentID != nil && *moveDto.ParentID == id {
+		return nil, []response.ErrorField{response.NewErrorField("parentId", string(response.CategoryCycle))}, nil
+	}
+
+	category.ParentID = moveDto.ParentID
+	return category, nil, nil
+}
+
+func (m *mockService) GetTrash(query request.ListQuery) ([]*dto.ResponseDTO, int64, error) {
+	if m.shouldReturnError {
+		return nil, 0, fmt.Errorf("service error")
+	}
+	return make([]*dto.ResponseDTO, 0), 0, nil
+}
+
+func (m *mockService) Restore(id uint) (*dto.ResponseDTO, []response.ErrorField, error) {
+	if m.shouldReturnError {
+		return nil, nil, fmt.Errorf("service error")
+	}
+	if len(m.validationErrors) > 0 {
+		return nil, m.validationErrors, nil
+	}
+	return m.categories[id], nil, nil
+}
+
+func (m *mockService) Purge(before time.Time) (int, error) {
+	return 0, nil
+}
+
 func setupTestHandler() (*Handler, *mockService) {
 	mockSvc := newMockService()
 	handler := NewHandler(mockSvc)
@@ -284,8 +372,9 @@ func TestHandler_GetAll_Success(t *testing.T) {
 		}
 	}
 
+	req := httptest.NewRequest(http.MethodGet, "/category?page=1&perPage=10", nil)
 	rr := httptest.NewRecorder()
-	handler.GetAll(rr)
+	handler.GetAll(rr, req)
 
 	if rr.Code != http.StatusOK {
 		t.Errorf("Expected status code %d, got %d", http.StatusOK, rr.Code)
@@ -305,6 +394,23 @@ func TestHandler_GetAll_Success(t *testing.T) {
 	if len(data) != 2 {
 		t.Errorf("Expected 2 categories, got %d", len(data))
 	}
+
+	pagination := res["pagination"].(map[string]interface{})
+	if pagination["total"] != float64(2) || pagination["perPage"] != float64(10) {
+		t.Errorf("Expected pagination with total 2 and perPage 10, got %v", pagination)
+	}
+}
+
+func TestHandler_GetAll_InvalidQuery(t *testing.T) {
+	handler, _ := setupTestHandler()
+
+	req := httptest.NewRequest(http.MethodGet, "/category?perPage=1000&sort=unknown", nil)
+	rr := httptest.NewRecorder()
+	handler.GetAll(rr, req)
+
+	if rr.Code != http.StatusBadRequest {
+		t.Errorf("Expected status code %d, got %d", http.StatusBadRequest, rr.Code)
+	}
 }
 
 func TestHandler_GetAll_ServiceError(t *testing.T) {
@@ -312,8 +418,9 @@ func TestHandler_GetAll_ServiceError(t *testing.T) {
 
 	mockSvc.shouldReturnError = true
 
+	req := httptest.NewRequest(http.MethodGet, "/category", nil)
 	rr := httptest.NewRecorder()
-	handler.GetAll(rr)
+	handler.GetAll(rr, req)
 
 	if rr.Code != http.StatusInternalServerError {
 		t.Errorf("Expected status code %d, got %d", http.StatusInternalServerError, rr.Code)
@@ -401,6 +508,117 @@ func TestHandler_GetById_MissingID(t *testing.T) {
 	}
 }
 
+func TestHandler_GetBreadcrumbs(t *testing.T) {
+	handler, mockSvc := setupTestHandler()
+	mockSvc.categories[1] = &dto.ResponseDTO{Id: 1, Name: "Test Category", Slug: "test-category"}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/category/1/breadcrumbs", nil)
+	req.SetPathValue("id", "1")
+	rr := httptest.NewRecorder()
+	handler.GetBreadcrumbs(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Errorf("Expected status code %d, got %d", http.StatusOK, rr.Code)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/category/999/breadcrumbs", nil)
+	req.SetPathValue("id", "999")
+	rr = httptest.NewRecorder()
+	handler.GetBreadcrumbs(rr, req)
+
+	if rr.Code != http.StatusNotFound {
+		t.Errorf("Expected status code %d, got %d", http.StatusNotFound, rr.Code)
+	}
+}
+
+func TestHandler_GetBySlug(t *testing.T) {
+	handler, mockSvc := setupTestHandler()
+	mockSvc.categories[1] = &dto.ResponseDTO{Id: 1, Name: "Test Category", Slug: "new-slug"}
+	mockSvc.redirects["old-slug"] = "new-slug"
+
+	tests := []struct {
+		slug     string
+		status   int
+		location string
+	}{
+		{"new-slug", http.StatusOK, ""},
+		{"old-slug", http.StatusMovedPermanently, "/api/v1/category/by-slug/new-slug"},
+		{"missing", http.StatusNotFound, ""},
+		{"", http.StatusBadRequest, ""},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/category/by-slug/"+tt.slug, nil)
+		req.SetPathValue("slug", tt.slug)
+		rr := httptest.NewRecorder()
+		handler.GetBySlug(rr, req)
+
+		if rr.Code != tt.status {
+			t.Errorf("%s: expected status code %d, got %d", tt.slug, tt.status, rr.Code)
+		}
+		if location := rr.Header().Get("Location"); location != tt.location {
+			t.Errorf("%s: expected location %q, got %q", tt.slug, tt.location, location)
+		}
+	}
+}
+
+func TestHandler_Move(t *testing.T) {
+	handler, mockSvc := setupTestHandler()
+	mockSvc.categories[1] = &dto.ResponseDTO{Id: 1, Name: "Test Category", Slug: "test-category"}
+
+	tests := []struct {
+		id     string
+		body   string
+		status int
+	}{
+		{"1", `{"parentId": null}`, http.StatusOK},
+		{"1", `{"parentId": 1}`, http.StatusBadRequest},
+		{"1", `{"sortIndex": -1}`, http.StatusBadRequest},
+		{"999", `{"parentId": null}`, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodPatch, "/api/v1/category/"+tt.id+"/move", bytes.NewBufferString(tt.body))
+		req.SetPathValue("id", tt.id)
+		rr := httptest.NewRecorder()
+		handler.Move(rr, req)
+
+		if rr.Code != tt.status {
+			t.Errorf("%s %s: expected status code %d, got %d", tt.id, tt.body, tt.status, rr.Code)
+		}
+	}
+}
+
+func TestHandler_Restore(t *testing.T) {
+	handler, mockSvc := setupTestHandler()
+	mockSvc.categories[1] = &dto.ResponseDTO{Id: 1, Name: "Test Category", Slug: "test-category"}
+
+	req := httptest.NewRequest(http.MethodPatch, "/api/v1/category/1/restore", nil)
+	req.SetPathValue("id", "1")
+	rr := httptest.NewRecorder()
+	handler.Restore(rr, req)
+	if rr.Code != http.StatusOK {
+		t.Errorf("Expected status code %d, got %d", http.StatusOK, rr.Code)
+	}
+
+	req = httptest.NewRequest(http.MethodPatch, "/api/v1/category/999/restore", nil)
+	req.SetPathValue("id", "999")
+	rr = httptest.NewRecorder()
+	handler.Restore(rr, req)
+	if rr.Code != http.StatusNotFound {
+		t.Errorf("Expected status code %d, got %d", http.StatusNotFound, rr.Code)
+	}
+
+	mockSvc.validationErrors = []response.ErrorField{response.NewErrorField("slug", string(response.NotUnique))}
+	req = httptest.NewRequest(http.MethodPatch, "/api/v1/category/1/restore", nil)
+	req.SetPathValue("id", "1")
+	rr = httptest.NewRecorder()
+	handler.Restore(rr, req)
+	if rr.Code != http.StatusBadRequest {
+		t.Errorf("Expected status code %d, got %d", http.StatusBadRequest, rr.Code)
+	}
+}
+
 func TestHandler_Update_Success(t *testing.T) {
 	handler, mockSvc := setupTestHandler()
 
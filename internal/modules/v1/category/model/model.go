@@ -1,6 +1,7 @@
 package model
 
 import (
+	"gorm.io/gorm"
 	"time"
 )
 
@@ -8,18 +9,27 @@ type Category struct {
 	ID              uint `gorm:"primarykey"`
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
-	Name            string `gorm:"type:varchar(255);not null;unique" json:"name"`
-	Description     string `gorm:"type:text" json:"description"`
-	Image           string `gorm:"type:varchar(255)" json:"image"`
-	HeaderImage     string `gorm:"type:varchar(255)" json:"headerImage"`
-	Slug            string `gorm:"type:varchar(255);not null;unique" json:"slug"`
-	ParentID        *uint  `gorm:"index" json:"parentId"`
-	SortIndex       int    `gorm:"default:100" json:"sortIndex"`
-	SeoTitle        string `gorm:"type:varchar(255)" json:"seoTitle"`
-	SeoDescription  string `gorm:"type:text" json:"seoText"`
-	SeoKeys         string `gorm:"type:text" json:"seoKeys"`
-	IsActive        bool   `gorm:"default:true" json:"isActive"`
-	IsShade         bool   `gorm:"default:false" json:"isShade"`
-	IsVisibleInMenu bool   `gorm:"default:true" json:"isVisibleInMenu"`
-	IsVisibleOnMain bool   `gorm:"default:false" json:"isVisibleOnMain"`
+	DeletedAt       gorm.DeletedAt `gorm:"index" json:"deletedAt"`
+	Name            string         `gorm:"type:varchar(255);not null;index:idx_categories_name,unique,where:deleted_at IS NULL" json:"name"`
+	Description     string         `gorm:"type:text" json:"description"`
+	Image           string         `gorm:"type:varchar(255)" json:"image"`
+	HeaderImage     string         `gorm:"type:varchar(255)" json:"headerImage"`
+	Slug            string         `gorm:"type:varchar(255);not null;index:idx_categories_slug,unique,where:deleted_at IS NULL" json:"slug"`
+	ParentID        *uint          `gorm:"index" json:"parentId"`
+	SortIndex       int            `gorm:"default:100" json:"sortIndex"`
+	SeoTitle        string         `gorm:"type:varchar(255)" json:"seoTitle"`
+	SeoDescription  string         `gorm:"type:text" json:"seoText"`
+	SeoKeys         string         `gorm:"type:text" json:"seoKeys"`
+	IsActive        bool           `gorm:"default:true" json:"isActive"`
+	IsShade         bool           `gorm:"default:false" json:"isShade"`
+	IsVisibleInMenu bool           `gorm:"default:true" json:"isVisibleInMenu"`
+	IsVisibleOnMain bool           `gorm:"default:false" json:"isVisibleOnMain"`
+}
+
+// CategorySlugRedirect хранит прежний slug категории, чтобы старые ссылки вели на актуальный.
+type CategorySlugRedirect struct {
+	ID         uint `gorm:"primarykey"`
+	CreatedAt  time.Time
+	OldSlug    string `gorm:"type:varchar(255);not null;unique" json:"oldSlug"`
+	CategoryID uint   `gorm:"not null;index" json:"categoryId"`
 }
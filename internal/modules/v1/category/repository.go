@@ -4,19 +4,50 @@ import (
 	"errors"
 	"gorm.io/gorm"
 	"haircompany-shop-rest/internal/modules/v1/category/model"
+	productModel "haircompany-shop-rest/internal/modules/v1/product/model"
+	"haircompany-shop-rest/internal/services"
 	"haircompany-shop-rest/pkg/database"
+	"haircompany-shop-rest/pkg/request"
+	"time"
 )
 
 type Repository interface {
+	Transaction(fn func(repo Repository) error) error
+	LockTree() error
+	Enqueue(queue services.Queue, jobType string, payload any) error
 	Create(model *model.Category) (*model.Category, error)
-	GetAll() ([]*model.Category, error)
+	GetAll(query request.ListQuery) ([]*model.Category, int64, error)
 	GetById(id uint) (*model.Category, error)
 	Update(model *model.Category) (*model.Category, error)
 	Delete(id uint) error
 	GetByUniqueFields(name, slug string) (*model.Category, error)
 	CountChildrenByParentId(parentId uint) (int64, error)
+	CountProductsByCategoryId(categoryId uint) (int64, error)
+	GetTree(filter TreeFilter) ([]*model.Category, error)
+	GetAncestors(id uint) ([]*model.Category, error)
+	GetBySlug(slug string) (*model.Category, error)
+	GetRedirectBySlug(slug string) (*model.CategorySlugRedirect, error)
+	UpdateWithSlugRedirect(model *model.Category, oldSlug string) (*model.Category, error)
+	GetDeleted(query request.ListQuery) ([]*model.Category, int64, error)
+	GetDeletedById(id uint) (*model.Category, error)
+	Restore(id uint) error
+	PurgeDeleted(before time.Time) ([]*model.Category, error)
 }
 
+// TreeFilter ограничивает обход дерева: ветка, корень которой не прошёл фильтр,
+// отбрасывается целиком вместе с потомками.
+type TreeFilter struct {
+	OnlyActive        bool
+	OnlyVisibleInMenu bool
+}
+
+const (
+	// maxTreeDepth защищает рекурсивные запросы от зацикленных parent_id.
+	maxTreeDepth = 64
+	// treeLockKey — ключ advisory-блокировки, под которой меняется иерархия категорий.
+	treeLockKey = 7_240_001
+)
+
 type repository struct {
 	DB *database.DB
 }
@@ -27,6 +58,29 @@ func NewRepository(db *database.DB) Repository {
 	}
 }
 
+// Transaction runs fn against a repository bound to a single database transaction.
+func (r *repository) Transaction(fn func(repo Repository) error) error {
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		return fn(NewRepository(&database.DB{DB: tx}))
+	})
+}
+
+// Enqueue ставит фоновую задачу через соединение репозитория, внутри Transaction —
+// в той же транзакции, что и изменения категории.
+func (r *repository) Enqueue(queue services.Queue, jobType string, payload any) error {
+	return queue.EnqueueTx(r.DB, jobType, payload)
+}
+
+// LockTree сериализует изменения иерархии до конца транзакции: без неё два встречных
+// переноса могут по отдельности пройти проверку на цикл и вместе его образовать.
+func (r *repository) LockTree() error {
+	if r.DB.Dialector.Name() != "postgres" {
+		return nil
+	}
+
+	return r.DB.Exec("SELECT pg_advisory_xact_lock(?)", treeLockKey).Error
+}
+
 func (r *repository) Create(model *model.Category) (*model.Category, error) {
 	result := r.DB.Create(&model)
 	if result.Error != nil {
@@ -36,16 +90,8 @@ func (r *repository) Create(model *model.Category) (*model.Category, error) {
 	return model, nil
 }
 
-func (r *repository) GetAll() ([]*model.Category, error) {
-	var categories []*model.Category
-	var err error
-
-	result := r.DB.Find(&categories)
-	if result.Error != nil {
-		err = result.Error
-	}
-
-	return categories, err
+func (r *repository) GetAll(query request.ListQuery) ([]*model.Category, int64, error) {
+	return database.FindPage[model.Category](r.DB.DB, query)
 }
 
 func (r *repository) GetById(id uint) (*model.Category, error) {
@@ -101,3 +147,128 @@ func (r *repository) CountChildrenByParentId(parentId uint) (int64, error) {
 
 	return count, nil
 }
+
+func (r *repository) CountProductsByCategoryId(categoryId uint) (int64, error) {
+	var count int64
+	result := r.DB.Model(&productModel.Product{}).Where("category_id = ?", categoryId).Count(&count)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return count, nil
+}
+
+func (r *repository) GetTree(filter TreeFilter) ([]*model.Category, error) {
+	condition := "c.deleted_at IS NULL"
+	if filter.OnlyActive {
+		condition += " AND c.is_active = true"
+	}
+	if filter.OnlyVisibleInMenu {
+		condition += " AND c.is_visible_in_menu = true"
+	}
+
+	query := `
+		WITH RECURSIVE tree AS (
+			SELECT c.*, 0 AS depth FROM categories c
+			WHERE c.parent_id IS NULL AND ` + condition + `
+			UNION ALL
+			SELECT c.*, t.depth + 1 FROM categories c
+			JOIN tree t ON c.parent_id = t.id
+			WHERE t.depth < ? AND ` + condition + `
+		)
+		SELECT * FROM tree ORDER BY sort_index, id`
+
+	var categories []*model.Category
+	result := r.DB.Raw(query, maxTreeDepth).Scan(&categories)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return categories, nil
+}
+
+func (r *repository) GetAncestors(id uint) ([]*model.Category, error) {
+	query := `
+		WITH RECURSIVE path AS (
+			SELECT c.*, 0 AS depth FROM categories c WHERE c.id = ? AND c.deleted_at IS NULL
+			UNION ALL
+			SELECT c.*, p.depth + 1 FROM categories c
+			JOIN path p ON c.id = p.parent_id
+			WHERE p.depth < ? AND c.deleted_at IS NULL
+		)
+		SELECT * FROM path ORDER BY depth DESC`
+
+	var categories []*model.Category
+	result := r.DB.Raw(query, id, maxTreeDepth).Scan(&categories)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return categories, nil
+}
+
+func (r *repository) GetBySlug(slug string) (*model.Category, error) {
+	var category *model.Category
+	var err error
+
+	result := r.DB.First(&category, "slug = ?", slug)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		err = result.Error
+	}
+
+	return category, err
+}
+
+func (r *repository) GetRedirectBySlug(slug string) (*model.CategorySlugRedirect, error) {
+	var redirect *model.CategorySlugRedirect
+	var err error
+
+	result := r.DB.First(&redirect, "old_slug = ?", slug)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		err = result.Error
+	}
+
+	return redirect, err
+}
+
+// UpdateWithSlugRedirect сохраняет категорию и запоминает её прежний slug.
+// Перенаправления ссылаются на категорию, а не на slug, поэтому цепочки переименований не образуются.
+func (r *repository) UpdateWithSlugRedirect(category *model.Category, oldSlug string) (*model.Category, error) {
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&category).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("old_slug IN ?", []string{oldSlug, category.Slug}).Delete(&model.CategorySlugRedirect{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(&model.CategorySlugRedirect{OldSlug: oldSlug, CategoryID: category.ID}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+func (r *repository) GetDeleted(query request.ListQuery) ([]*model.Category, int64, error) {
+	return database.FindDeletedPage[model.Category](r.DB.DB, query)
+}
+
+func (r *repository) GetDeletedById(id uint) (*model.Category, error) {
+	return database.FindDeleted[model.Category](r.DB.DB, id)
+}
+
+func (r *repository) Restore(id uint) error {
+	return database.Restore[model.Category](r.DB.DB, id)
+}
+
+func (r *repository) PurgeDeleted(before time.Time) ([]*model.Category, error) {
+	return database.PurgeDeleted[model.Category](r.DB.DB, before)
+}
@@ -6,7 +6,10 @@ import (
 	"gorm.io/gorm"
 	"haircompany-shop-rest/internal/modules/v1/category/model"
 	"haircompany-shop-rest/pkg/database"
+	"haircompany-shop-rest/pkg/request"
+	"net/url"
 	"testing"
+	"time"
 )
 
 func setupTestDB(t *testing.T) *database.DB {
@@ -15,7 +18,7 @@ func setupTestDB(t *testing.T) *database.DB {
 		t.Fatal("Failed to connect to test database:", err)
 	}
 
-	err = db.AutoMigrate(&model.Category{})
+	err = db.AutoMigrate(&model.Category{}, &model.CategorySlugRedirect{})
 	if err != nil {
 		t.Fatal("Failed to migrate test database:", err)
 	}
@@ -57,9 +60,9 @@ func TestRepository_GetAll(t *testing.T) {
 	repo := NewRepository(db)
 
 	categories := []*model.Category{
-		{Name: "Category 1", Slug: "category-1", IsActive: true},
-		{Name: "Category 2", Slug: "category-2", IsActive: true},
-		{Name: "Category 3", Slug: "category-3", IsActive: false},
+		{Name: "Category 1", Slug: "category-1", SortIndex: 300, IsActive: true},
+		{Name: "Category 2", Slug: "category-2", SortIndex: 100, IsActive: true},
+		{Name: "Category 3", Slug: "category-3", SortIndex: 200, IsActive: false},
 	}
 
 	for _, cat := range categories {
@@ -69,13 +72,124 @@ func TestRepository_GetAll(t *testing.T) {
 		}
 	}
 
-	result, err := repo.GetAll()
+	query, errFields := request.ParseListQuery(url.Values{}, listOptions)
+	if errFields != nil {
+		t.Fatalf("Expected no errors, got %v", errFields)
+	}
+	result, total, err := repo.GetAll(query)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(result) != 3 || total != 3 {
+		t.Fatalf("Expected 3 categories, got %d of %d", len(result), total)
+	}
+	if result[0].Name != "Category 2" || result[2].Name != "Category 1" {
+		t.Errorf("Expected categories ordered by sort index, got %s, %s", result[0].Name, result[2].Name)
+	}
+}
+
+func TestRepository_GetAll_FilterSortPage(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepository(db)
+
+	categories := []*model.Category{
+		{Name: "Уход за волосами", Slug: "care", SortIndex: 100, IsActive: true},
+		{Name: "Окрашивание волос", Slug: "color", SortIndex: 200, IsActive: true},
+		{Name: "Стайлинг для волос", Slug: "styling", SortIndex: 300, IsActive: false},
+		{Name: "Аксессуары", Slug: "accessories", SortIndex: 400, IsActive: true},
+	}
+	for _, cat := range categories {
+		if _, err := repo.Create(cat); err != nil {
+			t.Fatalf("Failed to create test category: %v", err)
+		}
+	}
+	// default:true в модели не даёт создать неактивную категорию через Create
+	db.Model(&model.Category{}).Where("slug = ?", "styling").Update("is_active", false)
+
+	values := url.Values{"name": {"волос"}, "isActive": {"true"}, "sort": {"-sortIndex"}, "page": {"2"}, "perPage": {"1"}}
+	query, errFields := request.ParseListQuery(values, listOptions)
+	if errFields != nil {
+		t.Fatalf("Expected no errors, got %v", errFields)
+	}
+
+	result, total, err := repo.GetAll(query)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if total != 2 {
+		t.Errorf("Expected 2 matching categories, got %d", total)
+	}
+	if len(result) != 1 || result[0].Slug != "care" {
+		t.Errorf("Expected second page to hold the care category, got %+v", result)
+	}
+}
+
+func TestRepository_GetTree(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepository(db)
+
+	root, _ := repo.Create(&model.Category{Name: "Root", Slug: "root", SortIndex: 200, IsActive: true, IsVisibleInMenu: true})
+	hidden, _ := repo.Create(&model.Category{Name: "Hidden", Slug: "hidden", ParentID: &root.ID, SortIndex: 100, IsActive: true, IsVisibleInMenu: true})
+	leaf, _ := repo.Create(&model.Category{Name: "Leaf", Slug: "leaf", ParentID: &hidden.ID, SortIndex: 100, IsActive: true, IsVisibleInMenu: true})
+	_, _ = repo.Create(&model.Category{Name: "Other Root", Slug: "other-root", SortIndex: 100, IsActive: true, IsVisibleInMenu: true})
+	db.Model(&model.Category{}).Where("id = ?", hidden.ID).Update("is_visible_in_menu", false)
+
+	all, err := repo.GetTree(TreeFilter{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(all) != 4 || all[3].Slug != "root" {
+		t.Errorf("Expected all categories ordered by sort index, got %+v", all)
+	}
+
+	menu, err := repo.GetTree(TreeFilter{OnlyActive: true, OnlyVisibleInMenu: true})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(menu) != 2 {
+		t.Errorf("Expected hidden category to cut off its descendants, got %d categories", len(menu))
+	}
+
+	path, err := repo.GetAncestors(leaf.ID)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(path) != 3 || path[0].ID != root.ID || path[2].ID != leaf.ID {
+		t.Errorf("Expected root-to-leaf path, got %+v", path)
+	}
+}
+
+func TestRepository_UpdateWithSlugRedirect(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepository(db)
+
+	created, err := repo.Create(&model.Category{Name: "Test Category", Slug: "first", IsActive: true})
 	if err != nil {
+		t.Fatalf("Failed to create test category: %v", err)
+	}
+
+	created.Slug = "second"
+	if _, err := repo.UpdateWithSlugRedirect(created, "first"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	created.Slug = "first"
+	if _, err := repo.UpdateWithSlugRedirect(created, "second"); err != nil {
 		t.Fatalf("Expected no error, got %v", err)
 	}
 
-	if len(result) != 3 {
-		t.Errorf("Expected 3 categories, got %d", len(result))
+	redirect, err := repo.GetRedirectBySlug("first")
+	if err != nil || redirect != nil {
+		t.Errorf("Expected live slug to drop its redirect, got %+v, %v", redirect, err)
+	}
+	redirect, err = repo.GetRedirectBySlug("second")
+	if err != nil || redirect == nil || redirect.CategoryID != created.ID {
+		t.Errorf("Expected redirect from the previous slug, got %+v, %v", redirect, err)
+	}
+
+	found, err := repo.GetBySlug("first")
+	if err != nil || found == nil || found.ID != created.ID {
+		t.Errorf("Expected category by slug, got %+v, %v", found, err)
 	}
 }
 
@@ -181,6 +295,65 @@ func TestRepository_Delete(t *testing.T) {
 	}
 }
 
+func TestRepository_SoftDelete(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepository(db)
+
+	created, err := repo.Create(&model.Category{Name: "Test Category", Slug: "test-category", IsActive: true})
+	if err != nil {
+		t.Fatalf("Failed to create test category: %v", err)
+	}
+	if err := repo.Delete(created.ID); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// Имя и slug удалённой категории можно занять заново
+	if _, err := repo.Create(&model.Category{Name: "Test Category", Slug: "test-category", IsActive: true}); err != nil {
+		t.Fatalf("Expected trashed name and slug to be reusable, got %v", err)
+	}
+
+	query, _ := request.ParseListQuery(url.Values{}, listOptions)
+	trash, total, err := repo.GetDeleted(query)
+	if err != nil || total != 1 || trash[0].ID != created.ID || !trash[0].DeletedAt.Valid {
+		t.Fatalf("Expected deleted category in the trash, got %+v, %d, %v", trash, total, err)
+	}
+
+	deleted, err := repo.GetDeletedById(created.ID)
+	if err != nil || deleted == nil {
+		t.Fatalf("Expected deleted category by id, got %+v, %v", deleted, err)
+	}
+
+	purged, err := repo.PurgeDeleted(time.Now().Add(-time.Hour))
+	if err != nil || len(purged) != 0 {
+		t.Errorf("Expected recently deleted category to stay in the trash, got %d purged, %v", len(purged), err)
+	}
+
+	purged, err = repo.PurgeDeleted(time.Now().Add(time.Hour))
+	if err != nil || len(purged) != 1 {
+		t.Fatalf("Expected category to be purged, got %d purged, %v", len(purged), err)
+	}
+	if deleted, _ := repo.GetDeletedById(created.ID); deleted != nil {
+		t.Error("Expected purged category to be gone")
+	}
+}
+
+func TestRepository_Restore(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepository(db)
+
+	created, _ := repo.Create(&model.Category{Name: "Test Category", Slug: "test-category", IsActive: true})
+	_ = repo.Delete(created.ID)
+
+	if err := repo.Restore(created.ID); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	restored, err := repo.GetById(created.ID)
+	if err != nil || restored.DeletedAt.Valid {
+		t.Errorf("Expected restored category to be visible, got %+v, %v", restored, err)
+	}
+}
+
 func TestRepository_GetByUniqueFields(t *testing.T) {
 	db := setupTestDB(t)
 	repo := NewRepository(db)
@@ -9,7 +9,7 @@ import (
 
 func RegisterV1CategoryRoutes(mux *http.ServeMux, container *container.Container) {
 	repo := NewRepository(container.DB)
-	svc := NewService(repo, container.FileService, container.Ctx, container.Wg)
+	svc := NewService(repo, container.FileService, container.Queue)
 	h := NewHandler(svc)
 
 	mux.Handle("/category/create",
@@ -24,20 +24,66 @@ func RegisterV1CategoryRoutes(mux *http.ServeMux, container *container.Container
 				}
 			}),
 			middleware.DashboardRoleMiddleware("admin"),
-			middleware.DashboardAuthMiddleware(container.JWTService),
+			middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist),
 		),
 	)
 
 	mux.HandleFunc("/category", func(w http.ResponseWriter, r *http.Request) {
 		switch r.Method {
 		case http.MethodGet:
-			h.GetAll(w)
+			h.GetAll(w, r)
 		default:
 			msg := "Method not allowed. Allowed methods: GET"
 			response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
 		}
 	})
 
+	mux.HandleFunc("/category/tree", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			h.GetTree(w)
+		default:
+			msg := "Method not allowed. Allowed methods: GET"
+			response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+		}
+	})
+
+	mux.HandleFunc("/category/menu", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			h.GetMenu(w)
+		default:
+			msg := "Method not allowed. Allowed methods: GET"
+			response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+		}
+	})
+
+	mux.HandleFunc("/category/main", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			h.GetMain(w)
+		default:
+			msg := "Method not allowed. Allowed methods: GET"
+			response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+		}
+	})
+
+	mux.Handle("/category/trash",
+		middleware.ChainMiddleware(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodGet:
+					h.GetTrash(w, r)
+				default:
+					msg := "Method not allowed. Allowed methods: GET"
+					response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+				}
+			}),
+			middleware.DashboardRoleMiddleware("admin"),
+			middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist),
+		),
+	)
+
 	mux.HandleFunc("/category/{id}", func(w http.ResponseWriter, r *http.Request) {
 		switch r.Method {
 		case http.MethodGet:
@@ -48,6 +94,16 @@ func RegisterV1CategoryRoutes(mux *http.ServeMux, container *container.Container
 		}
 	})
 
+	mux.HandleFunc("/category/{id}/breadcrumbs", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			h.GetBreadcrumbs(w, r)
+		default:
+			msg := "Method not allowed. Allowed methods: GET"
+			response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+		}
+	})
+
 	mux.Handle("/category/{id}/update",
 		middleware.ChainMiddleware(
 			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -60,7 +116,39 @@ func RegisterV1CategoryRoutes(mux *http.ServeMux, container *container.Container
 				}
 			}),
 			middleware.DashboardRoleMiddleware("admin"),
-			middleware.DashboardAuthMiddleware(container.JWTService),
+			middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist),
+		),
+	)
+
+	mux.Handle("/category/{id}/move",
+		middleware.ChainMiddleware(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodPatch:
+					h.Move(w, r)
+				default:
+					msg := "Method not allowed. Allowed methods: PATCH"
+					response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+				}
+			}),
+			middleware.DashboardRoleMiddleware("admin"),
+			middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist),
+		),
+	)
+
+	mux.Handle("/category/{id}/restore",
+		middleware.ChainMiddleware(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodPatch:
+					h.Restore(w, r)
+				default:
+					msg := "Method not allowed. Allowed methods: PATCH"
+					response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+				}
+			}),
+			middleware.DashboardRoleMiddleware("admin"),
+			middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist),
 		),
 	)
 
@@ -76,7 +164,26 @@ func RegisterV1CategoryRoutes(mux *http.ServeMux, container *container.Container
 				}
 			}),
 			middleware.DashboardRoleMiddleware("admin"),
-			middleware.DashboardAuthMiddleware(container.JWTService),
+			middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist),
 		),
 	)
 }
+
+// RegisterV1CategorySlugRoutes registers the slug lookup on its own mux:
+// /category/by-slug/{slug} overlaps /category/{id}/update and friends, which
+// a single ServeMux refuses to register together.
+func RegisterV1CategorySlugRoutes(mux *http.ServeMux, container *container.Container) {
+	repo := NewRepository(container.DB)
+	svc := NewService(repo, container.FileService, container.Queue)
+	h := NewHandler(svc)
+
+	mux.HandleFunc("/category/by-slug/{slug}", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			h.GetBySlug(w, r)
+		default:
+			msg := "Method not allowed. Allowed methods: GET"
+			response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+		}
+	})
+}
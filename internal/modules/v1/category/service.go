@@ -1,37 +1,44 @@
 package category
 
 import (
-	"context"
 	"errors"
+	"gorm.io/gorm"
 	"haircompany-shop-rest/internal/modules/v1/category/dto"
 	"haircompany-shop-rest/internal/services"
+	"haircompany-shop-rest/pkg/request"
 	"haircompany-shop-rest/pkg/response"
-	"haircompany-shop-rest/pkg/utils"
 	"log"
-	"sync"
+	"time"
 )
 
 type Service interface {
 	Create(createDto dto.CreateDTO) (*dto.ResponseDTO, []response.ErrorField, error)
-	GetAll() ([]*dto.ResponseDTO, error)
+	GetAll(query request.ListQuery) ([]*dto.ResponseDTO, int64, error)
 	GetById(id uint) (*dto.ResponseDTO, error)
 	Update(id uint, updateDto dto.UpdateDTO) (*dto.ResponseDTO, []response.ErrorField, error)
 	Delete(id uint) (*dto.ResponseDTO, int64, error)
+	GetTree() ([]*dto.TreeDTO, error)
+	GetMenu() ([]*dto.TreeDTO, error)
+	GetMain() ([]*dto.ResponseDTO, error)
+	GetBreadcrumbs(id uint) ([]*dto.BreadcrumbDTO, error)
+	GetBySlug(slug string) (*dto.ResponseDTO, *dto.RedirectDTO, error)
+	Move(id uint, moveDto dto.MoveDTO) (*dto.ResponseDTO, []response.ErrorField, error)
+	GetTrash(query request.ListQuery) ([]*dto.ResponseDTO, int64, error)
+	Restore(id uint) (*dto.ResponseDTO, []response.ErrorField, error)
+	Purge(before time.Time) (int, error)
 }
 
 type service struct {
 	repo        Repository
 	fileService services.FileSystemService
-	ctx         context.Context
-	wg          *sync.WaitGroup
+	queue       services.Queue
 }
 
-func NewService(r Repository, fs services.FileSystemService, ctx context.Context, wg *sync.WaitGroup) Service {
+func NewService(r Repository, fs services.FileSystemService, queue services.Queue) Service {
 	return &service{
 		repo:        r,
 		fileService: fs,
-		ctx:         ctx,
-		wg:          wg,
+		queue:       queue,
 	}
 }
 
@@ -61,31 +68,32 @@ func (c *service) Create(createDto dto.CreateDTO) (*dto.ResponseDTO, []response.
 	}
 
 	categoryModel := dto.TransformCreateDTOToModel(createDto)
-	createdCategory, err := c.repo.Create(categoryModel)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	filenames := []string{categoryModel.Image, categoryModel.HeaderImage}
-	utils.SafeGo(c.ctx, c.wg, "MoveImageToPermanent", func(ctx context.Context) {
-		if ctx.Err() != nil {
-			log.Println("context cancelled, skipping image move")
-			return
+	err = c.repo.Transaction(func(repo Repository) error {
+		if _, err := repo.Create(categoryModel); err != nil {
+			return err
 		}
 
-		if err := c.fileService.MoveToPermanent(filenames, "images/category"); err != nil {
-			log.Printf("error moving images to permanent storage: %v", err)
+		filenames := []string{categoryModel.Image, categoryModel.HeaderImage}
+		owner := &services.FileOwner{
+			Type:   "category",
+			ID:     categoryModel.ID,
+			Fields: map[string]string{categoryModel.Image: "image", categoryModel.HeaderImage: "headerImage"},
 		}
+		payload := services.FilesPayload{Filenames: filenames, Folder: "images/category", Owner: owner}
+		return repo.Enqueue(c.queue, services.QueueMoveFiles, payload)
 	})
+	if err != nil {
+		return nil, nil, err
+	}
 
-	createdCategoryResponse := dto.TransformModelToResponseDTO(createdCategory)
+	createdCategoryResponse := dto.TransformModelToResponseDTO(categoryModel)
 
 	return createdCategoryResponse, nil, nil
 }
 
-func (c *service) GetAll() ([]*dto.ResponseDTO, error) {
+func (c *service) GetAll(query request.ListQuery) ([]*dto.ResponseDTO, int64, error) {
 	categoryDTOs := make([]*dto.ResponseDTO, 0)
-	models, err := c.repo.GetAll()
+	models, total, err := c.repo.GetAll(query)
 	if err != nil {
 		log.Printf("error retrieving categories: %v", err)
 	}
@@ -95,7 +103,7 @@ func (c *service) GetAll() ([]*dto.ResponseDTO, error) {
 		categoryDTOs = append(categoryDTOs, categoryResponse)
 	}
 
-	return categoryDTOs, err
+	return categoryDTOs, total, err
 }
 
 func (c *service) GetById(id uint) (*dto.ResponseDTO, error) {
@@ -120,6 +128,7 @@ func (c *service) Update(id uint, updateDto dto.UpdateDTO) (*dto.ResponseDTO, []
 		return nil, nil, errors.New("category not found")
 	}
 
+	oldSlug := model.Slug
 	dto.TransformUpdateDTOToModel(updateDto, model)
 	existingCategory, err := c.repo.GetByUniqueFields(model.Name, model.Slug)
 	if err != nil {
@@ -135,42 +144,54 @@ func (c *service) Update(id uint, updateDto dto.UpdateDTO) (*dto.ResponseDTO, []
 		return nil, validationErrors, nil
 	}
 
-	if updateDto.ParentID != nil {
-		existingParent, err := c.repo.GetById(*updateDto.ParentID)
-		if err != nil || existingParent == nil {
-			validationErrors = append(validationErrors, response.NewErrorField("parentId", string(response.NotFound)))
-			return nil, validationErrors, nil
+	err = c.repo.Transaction(func(repo Repository) error {
+		if updateDto.ParentID != nil {
+			if err := repo.LockTree(); err != nil {
+				return err
+			}
+			errFields, err := validateParent(repo, id, *updateDto.ParentID)
+			if err != nil || errFields != nil {
+				validationErrors = errFields
+				return err
+			}
 		}
-	}
-
-	updatedCategory, err := c.repo.Update(model)
-	if err != nil {
-		return nil, nil, err
-	}
 
-	var filenames []string
+		var err error
+		if model.Slug != oldSlug {
+			model, err = repo.UpdateWithSlugRedirect(model, oldSlug)
+		} else {
+			model, err = repo.Update(model)
+		}
+		if err != nil {
+			return err
+		}
 
-	if updateDto.Image != nil {
-		filenames = append(filenames, *updateDto.Image)
-	}
-	if updateDto.HeaderImage != nil {
-		filenames = append(filenames, *updateDto.HeaderImage)
-	}
+		var filenames []string
+		owner := &services.FileOwner{Type: "category", ID: id, Fields: map[string]string{}}
 
-	if len(filenames) != 0 {
-		utils.SafeGo(c.ctx, c.wg, "MoveImageToPermanent", func(ctx context.Context) {
-			if ctx.Err() != nil {
-				log.Println("context cancelled, skipping image move")
-				return
-			}
+		if updateDto.Image != nil {
+			filenames = append(filenames, *updateDto.Image)
+			owner.Fields[*updateDto.Image] = "image"
+		}
+		if updateDto.HeaderImage != nil {
+			filenames = append(filenames, *updateDto.HeaderImage)
+			owner.Fields[*updateDto.HeaderImage] = "headerImage"
+		}
 
-			if err := c.fileService.MoveToPermanent(filenames, "images/category"); err != nil {
-				log.Printf("error moving images to permanent storage: %v", err)
-			}
-		})
+		if len(filenames) == 0 {
+			return nil
+		}
+		payload := services.FilesPayload{Filenames: filenames, Folder: "images/category", Owner: owner}
+		return repo.Enqueue(c.queue, services.QueueMoveFiles, payload)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if validationErrors != nil {
+		return nil, validationErrors, nil
 	}
 
-	updatedCategoryResponse := dto.TransformModelToResponseDTO(updatedCategory)
+	updatedCategoryResponse := dto.TransformModelToResponseDTO(model)
 
 	return updatedCategoryResponse, nil, nil
 }
@@ -190,24 +211,245 @@ func (c *service) Delete(id uint) (*dto.ResponseDTO, int64, error) {
 	if linkedEntitiesCount > 0 {
 		return categoryDTO, linkedEntitiesCount, errors.New("category has children and cannot be deleted")
 	}
-	// TODO здесь нужно проверить на наличие товаров в категории
 
+	linkedEntitiesCount, err = c.repo.CountProductsByCategoryId(id)
+	if err != nil {
+		return categoryDTO, linkedEntitiesCount, err
+	}
+	if linkedEntitiesCount > 0 {
+		return categoryDTO, linkedEntitiesCount, errors.New("category has products and cannot be deleted")
+	}
+
+	// Категория уходит в корзину, изображения удаляются только при окончательной очистке
 	err = c.repo.Delete(id)
 	if err != nil {
 		return categoryDTO, linkedEntitiesCount, err
 	}
 
-	filenames := []string{existedCategory.Image, existedCategory.HeaderImage}
-	utils.SafeGo(c.ctx, c.wg, "DeleteImage", func(ctx context.Context) {
-		if ctx.Err() != nil {
-			log.Println("context cancelled, skipping image deletion")
-			return
+	return categoryDTO, linkedEntitiesCount, nil
+}
+
+func (c *service) GetTree() ([]*dto.TreeDTO, error) {
+	models, err := c.repo.GetTree(TreeFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.TransformModelsToTree(models), nil
+}
+
+func (c *service) GetMenu() ([]*dto.TreeDTO, error) {
+	models, err := c.repo.GetTree(TreeFilter{OnlyActive: true, OnlyVisibleInMenu: true})
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.TransformModelsToTree(models), nil
+}
+
+func (c *service) GetMain() ([]*dto.ResponseDTO, error) {
+	models, err := c.repo.GetTree(TreeFilter{OnlyActive: true})
+	if err != nil {
+		return nil, err
+	}
+
+	categoryDTOs := make([]*dto.ResponseDTO, 0)
+	for _, model := range models {
+		if model.IsVisibleOnMain {
+			categoryDTOs = append(categoryDTOs, dto.TransformModelToResponseDTO(model))
+		}
+	}
+
+	return categoryDTOs, nil
+}
+
+func (c *service) GetBreadcrumbs(id uint) ([]*dto.BreadcrumbDTO, error) {
+	models, err := c.repo.GetAncestors(id)
+	if err != nil || len(models) == 0 {
+		return nil, err
+	}
+
+	breadcrumbs := make([]*dto.BreadcrumbDTO, 0, len(models))
+	for _, model := range models {
+		breadcrumbs = append(breadcrumbs, dto.TransformModelToBreadcrumbDTO(model))
+	}
+
+	return breadcrumbs, nil
+}
+
+// GetBySlug ищет категорию по актуальному slug. Если slug устарел, вместо категории возвращается перенаправление на текущий.
+func (c *service) GetBySlug(slug string) (*dto.ResponseDTO, *dto.RedirectDTO, error) {
+	existedCategory, err := c.repo.GetBySlug(slug)
+	if err != nil {
+		return nil, nil, err
+	}
+	if existedCategory != nil {
+		return dto.TransformModelToResponseDTO(existedCategory), nil, nil
+	}
+
+	redirect, err := c.repo.GetRedirectBySlug(slug)
+	if err != nil || redirect == nil {
+		return nil, nil, err
+	}
+
+	// Категория, на которую ведёт перенаправление, может лежать в корзине
+	target, err := c.repo.GetById(redirect.CategoryID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, nil
 		}
+		return nil, nil, err
+	}
 
+	return nil, &dto.RedirectDTO{Slug: target.Slug}, nil
+}
+
+// Move переносит категорию вместе со всем поддеревом под другого родителя или в корень.
+// Потомки ссылаются на перемещаемую категорию, поэтому достаточно сменить один parent_id.
+func (c *service) Move(id uint, moveDto dto.MoveDTO) (*dto.ResponseDTO, []response.ErrorField, error) {
+	var validationErrors []response.ErrorField
+	var movedCategory *dto.ResponseDTO
+
+	err := c.repo.Transaction(func(repo Repository) error {
+		if err := repo.LockTree(); err != nil {
+			return err
+		}
+
+		model, err := repo.GetById(id)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
+			}
+			return err
+		}
+
+		if moveDto.ParentID != nil {
+			validationErrors, err = validateParent(repo, id, *moveDto.ParentID)
+			if err != nil || validationErrors != nil {
+				return err
+			}
+		}
+
+		model.ParentID = moveDto.ParentID
+		if moveDto.SortIndex != nil {
+			model.SortIndex = *moveDto.SortIndex
+		}
+
+		model, err = repo.Update(model)
+		if err != nil {
+			return err
+		}
+
+		movedCategory = dto.TransformModelToResponseDTO(model)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return movedCategory, validationErrors, nil
+}
+
+func (c *service) GetTrash(query request.ListQuery) ([]*dto.ResponseDTO, int64, error) {
+	categoryDTOs := make([]*dto.ResponseDTO, 0)
+	models, total, err := c.repo.GetDeleted(query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for _, model := range models {
+		categoryDTOs = append(categoryDTOs, dto.TransformModelToResponseDTO(model))
+	}
+
+	return categoryDTOs, total, nil
+}
+
+// Restore возвращает категорию из корзины. Родитель должен существовать и не лежать в корзине,
+// а имя и slug — оставаться свободными.
+func (c *service) Restore(id uint) (*dto.ResponseDTO, []response.ErrorField, error) {
+	var validationErrors []response.ErrorField
+	deletedCategory, err := c.repo.GetDeletedById(id)
+	if deletedCategory == nil {
+		return nil, nil, err
+	}
+
+	existingCategory, err := c.repo.GetByUniqueFields(deletedCategory.Name, deletedCategory.Slug)
+	if err != nil {
+		return nil, nil, err
+	}
+	if existingCategory != nil {
+		if existingCategory.Name == deletedCategory.Name {
+			validationErrors = append(validationErrors, response.NewErrorField("name", string(response.NotUnique)))
+		}
+		if existingCategory.Slug == deletedCategory.Slug {
+			validationErrors = append(validationErrors, response.NewErrorField("slug", string(response.NotUnique)))
+		}
+		return nil, validationErrors, nil
+	}
+
+	if deletedCategory.ParentID != nil {
+		existingParent, err := c.repo.GetById(*deletedCategory.ParentID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, err
+		}
+		if existingParent == nil {
+			validationErrors = append(validationErrors, response.NewErrorField("parentId", string(response.NotFound)))
+			return nil, validationErrors, nil
+		}
+	}
+
+	if err := c.repo.Restore(id); err != nil {
+		return nil, nil, err
+	}
+
+	restoredCategory, err := c.repo.GetById(id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return dto.TransformModelToResponseDTO(restoredCategory), nil, nil
+}
+
+// Purge окончательно удаляет категории из корзины вместе с их изображениями.
+func (c *service) Purge(before time.Time) (int, error) {
+	purged, err := c.repo.PurgeDeleted(before)
+	if err != nil {
+		return 0, err
+	}
+
+	var filenames []string
+	for _, category := range purged {
+		filenames = append(filenames, category.Image, category.HeaderImage)
+	}
+	if len(filenames) != 0 {
 		if err := c.fileService.Delete(filenames, "images/category"); err != nil {
 			log.Printf("error deleting images: %v", err)
 		}
-	})
+	}
 
-	return categoryDTO, linkedEntitiesCount, nil
+	return len(purged), nil
+}
+
+// validateParent проверяет, что категорию id можно поместить под parentId:
+// родитель существует и не является самой категорией или её потомком.
+func validateParent(repo Repository, id, parentId uint) ([]response.ErrorField, error) {
+	if parentId == id {
+		return []response.ErrorField{response.NewErrorField("parentId", string(response.CategoryCycle))}, nil
+	}
+
+	ancestors, err := repo.GetAncestors(parentId)
+	if err != nil {
+		return nil, err
+	}
+	if len(ancestors) == 0 {
+		return []response.ErrorField{response.NewErrorField("parentId", string(response.NotFound))}, nil
+	}
+
+	for _, ancestor := range ancestors {
+		if ancestor.ID == id {
+			return []response.ErrorField{response.NewErrorField("parentId", string(response.CategoryCycle))}, nil
+		}
+	}
+
+	return nil, nil
 }
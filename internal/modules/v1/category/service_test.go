@@ -1,29 +1,51 @@
 package category
 
 import (
-	"context"
 	"errors"
+	"fmt"
+	"gorm.io/gorm"
 	"haircompany-shop-rest/internal/modules/v1/category/dto"
 	"haircompany-shop-rest/internal/modules/v1/category/model"
+	"haircompany-shop-rest/internal/services"
+	"haircompany-shop-rest/pkg/database"
+	"haircompany-shop-rest/pkg/request"
 	"haircompany-shop-rest/pkg/response"
-	"mime/multipart"
-	"sync"
+	"io"
+	"sort"
 	"testing"
 	"time"
 )
 
 type mockRepository struct {
-	categories map[uint]*model.Category
-	nextID     uint
+	categories    map[uint]*model.Category
+	deleted       map[uint]*model.Category
+	redirects     map[string]uint
+	productCounts map[uint]int64
+	nextID        uint
 }
 
 func newMockRepository() *mockRepository {
 	return &mockRepository{
-		categories: make(map[uint]*model.Category),
-		nextID:     1,
+		categories:    make(map[uint]*model.Category),
+		deleted:       make(map[uint]*model.Category),
+		redirects:     make(map[string]uint),
+		productCounts: make(map[uint]int64),
+		nextID:        1,
 	}
 }
 
+func (m *mockRepository) Transaction(fn func(repo Repository) error) error {
+	return fn(m)
+}
+
+func (m *mockRepository) LockTree() error {
+	return nil
+}
+
+func (m *mockRepository) Enqueue(queue services.Queue, jobType string, payload any) error {
+	return queue.Enqueue(jobType, payload)
+}
+
 func (m *mockRepository) Create(category *model.Category) (*model.Category, error) {
 	if category == nil {
 		return nil, errors.New("category is nil")
@@ -36,19 +58,19 @@ func (m *mockRepository) Create(category *model.Category) (*model.Category, erro
 	return category, nil
 }
 
-func (m *mockRepository) GetAll() ([]*model.Category, error) {
+func (m *mockRepository) GetAll(query request.ListQuery) ([]*model.Category, int64, error) {
 	var categories []*model.Category
 	for _, cat := range m.categories {
 		categories = append(categories, cat)
 	}
-	return categories, nil
+	return categories, int64(len(categories)), nil
 }
 
 func (m *mockRepository) GetById(id uint) (*model.Category, error) {
 	if category, exists := m.categories[id]; exists {
 		return category, nil
 	}
-	return nil, errors.New("category not found")
+	return nil, gorm.ErrRecordNotFound
 }
 
 func (m *mockRepository) Update(category *model.Category) (*model.Category, error) {
@@ -64,13 +86,50 @@ func (m *mockRepository) Update(category *model.Category) (*model.Category, erro
 }
 
 func (m *mockRepository) Delete(id uint) error {
-	if _, exists := m.categories[id]; !exists {
+	category, exists := m.categories[id]
+	if !exists {
 		return errors.New("category not found")
 	}
+	category.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+	m.deleted[id] = category
 	delete(m.categories, id)
 	return nil
 }
 
+func (m *mockRepository) GetDeleted(query request.ListQuery) ([]*model.Category, int64, error) {
+	var categories []*model.Category
+	for _, cat := range m.deleted {
+		categories = append(categories, cat)
+	}
+	return categories, int64(len(categories)), nil
+}
+
+func (m *mockRepository) GetDeletedById(id uint) (*model.Category, error) {
+	return m.deleted[id], nil
+}
+
+func (m *mockRepository) Restore(id uint) error {
+	category, exists := m.deleted[id]
+	if !exists {
+		return errors.New("category not found")
+	}
+	category.DeletedAt = gorm.DeletedAt{}
+	m.categories[id] = category
+	delete(m.deleted, id)
+	return nil
+}
+
+func (m *mockRepository) PurgeDeleted(before time.Time) ([]*model.Category, error) {
+	var purged []*model.Category
+	for id, cat := range m.deleted {
+		if cat.DeletedAt.Time.Before(before) {
+			purged = append(purged, cat)
+			delete(m.deleted, id)
+		}
+	}
+	return purged, nil
+}
+
 func (m *mockRepository) GetByUniqueFields(name, slug string) (*model.Category, error) {
 	for _, cat := range m.categories {
 		if (name != "" && cat.Name == name) || (slug != "" && cat.Slug == slug) {
@@ -90,10 +149,73 @@ func (m *mockRepository) CountChildrenByParentId(parentId uint) (int64, error) {
 	return count, nil
 }
 
+func (m *mockRepository) CountProductsByCategoryId(categoryId uint) (int64, error) {
+	return m.productCounts[categoryId], nil
+}
+
+func (m *mockRepository) GetTree(filter TreeFilter) ([]*model.Category, error) {
+	var categories []*model.Category
+	for _, cat := range m.categories {
+		if filter.OnlyActive && !cat.IsActive {
+			continue
+		}
+		if filter.OnlyVisibleInMenu && !cat.IsVisibleInMenu {
+			continue
+		}
+		categories = append(categories, cat)
+	}
+	sort.Slice(categories, func(i, j int) bool {
+		if categories[i].SortIndex != categories[j].SortIndex {
+			return categories[i].SortIndex < categories[j].SortIndex
+		}
+		return categories[i].ID < categories[j].ID
+	})
+	return categories, nil
+}
+
+func (m *mockRepository) GetBySlug(slug string) (*model.Category, error) {
+	for _, cat := range m.categories {
+		if cat.Slug == slug {
+			return cat, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockRepository) GetRedirectBySlug(slug string) (*model.CategorySlugRedirect, error) {
+	if categoryID, exists := m.redirects[slug]; exists {
+		return &model.CategorySlugRedirect{OldSlug: slug, CategoryID: categoryID}, nil
+	}
+	return nil, nil
+}
+
+func (m *mockRepository) UpdateWithSlugRedirect(category *model.Category, oldSlug string) (*model.Category, error) {
+	updated, err := m.Update(category)
+	if err != nil {
+		return nil, err
+	}
+	delete(m.redirects, category.Slug)
+	m.redirects[oldSlug] = category.ID
+	return updated, nil
+}
+
+func (m *mockRepository) GetAncestors(id uint) ([]*model.Category, error) {
+	var path []*model.Category
+	for cat, ok := m.categories[id]; ok && len(path) <= maxTreeDepth; {
+		path = append([]*model.Category{cat}, path...)
+		if cat.ParentID == nil {
+			break
+		}
+		cat, ok = m.categories[*cat.ParentID]
+	}
+	return path, nil
+}
+
 // Мок файлового сервиса
 type mockFileService struct {
 	moveToPermCalled bool
 	moveToPermError  error
+	deletedFiles     []string
 }
 
 func newMockFileService() *mockFileService {
@@ -106,23 +228,57 @@ func (m *mockFileService) MoveToPermanent(filenames []string, directory string)
 }
 
 func (m *mockFileService) Delete(filenames []string, folder string) error {
+	m.deletedFiles = append(m.deletedFiles, filenames...)
 	return nil
 }
 
-func (m *mockFileService) SaveToTemp(file multipart.File, filename string) (string, error) {
+func (m *mockFileService) SaveToTemp(file io.Reader, filename string) (string, error) {
 	return "temp_" + filename, nil
 }
 
-func (m *mockFileService) CleanTemp() {
+func (m *mockFileService) WriteToTemp(file io.Reader, filename string) error {
+	return nil
+}
+
+func (m *mockFileService) CleanTemp(before time.Time) (int, error) {
+	return 0, nil
+}
+
+// Мок очереди выполняет файловые задачи сразу через мок файлового сервиса
+type mockQueue struct {
+	fs *mockFileService
+}
+
+func (m *mockQueue) Register(jobType string, handler services.JobHandler) {
+}
+
+func (m *mockQueue) Enqueue(jobType string, payload any) error {
+	files, ok := payload.(services.FilesPayload)
+	if !ok {
+		return fmt.Errorf("unexpected payload %T", payload)
+	}
+
+	switch jobType {
+	case services.QueueMoveFiles:
+		return m.fs.MoveToPermanent(files.Filenames, files.Folder)
+	case services.QueueDeleteFiles:
+		return m.fs.Delete(files.Filenames, files.Folder)
+	}
+	return fmt.Errorf("unexpected job type %s", jobType)
+}
+
+func (m *mockQueue) EnqueueTx(db *database.DB, jobType string, payload any) error {
+	return m.Enqueue(jobType, payload)
+}
+
+func (m *mockQueue) Start(workers int) {
 }
 
 func setupTestService() (Service, *mockRepository, *mockFileService) {
 	mockRepo := newMockRepository()
 	mockFS := newMockFileService()
-	ctx := context.Background()
-	wg := &sync.WaitGroup{}
 
-	service := NewService(mockRepo, mockFS, ctx, wg)
+	service := NewService(mockRepo, mockFS, &mockQueue{fs: mockFS})
 	return service, mockRepo, mockFS
 }
 
@@ -269,6 +425,24 @@ func TestService_Create_InvalidParentId(t *testing.T) {
 	}
 }
 
+func TestService_Create_MovesImages(t *testing.T) {
+	service, _, mockFS := setupTestService()
+
+	createDto := dto.CreateDTO{Name: "Images", Slug: "images", Image: "image.png", HeaderImage: "header.png"}
+	if _, _, err := service.Create(createDto); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !mockFS.moveToPermCalled {
+		t.Error("Expected images move to be queued")
+	}
+
+	mockFS.moveToPermError = errors.New("queue is unavailable")
+	createDto = dto.CreateDTO{Name: "Other", Slug: "other", Image: "other.png"}
+	if _, _, err := service.Create(createDto); err == nil {
+		t.Error("Expected error when images move cannot be queued")
+	}
+}
+
 func TestService_GetAll_Success(t *testing.T) {
 	service, mockRepo, _ := setupTestService()
 
@@ -286,13 +460,13 @@ func TestService_GetAll_Success(t *testing.T) {
 		}
 	}
 
-	result, err := service.GetAll()
+	result, total, err := service.GetAll(request.ListQuery{Page: 1, PerPage: request.DefaultPerPage})
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
 	}
 
-	if len(result) != 3 {
-		t.Errorf("Expected 3 categories, got %d", len(result))
+	if len(result) != 3 || total != 3 {
+		t.Errorf("Expected 3 categories, got %d of %d", len(result), total)
 	}
 }
 
@@ -437,3 +611,246 @@ func TestService_Delete_NotFound(t *testing.T) {
 		t.Error("Expected result to be nil for non-existent category")
 	}
 }
+
+func TestService_Delete_HasProducts(t *testing.T) {
+	service, mockRepo, _ := setupTestService()
+
+	category := &model.Category{
+		Name:     "Test Category",
+		Slug:     "test-category",
+		IsActive: true,
+	}
+	created, _ := mockRepo.Create(category)
+	mockRepo.productCounts[created.ID] = 2
+
+	result, linkedEntitiesCount, err := service.Delete(created.ID)
+	if err == nil {
+		t.Error("Expected error for category with products")
+	}
+
+	if result == nil {
+		t.Fatal("Expected result to be not nil")
+	}
+
+	if linkedEntitiesCount != 2 {
+		t.Errorf("Expected 2 linked entities, got %d", linkedEntitiesCount)
+	}
+
+	if len(mockRepo.categories) != 1 {
+		t.Errorf("Expected category to stay in repository, got %d categories", len(mockRepo.categories))
+	}
+}
+
+func TestService_GetTree(t *testing.T) {
+	service, mockRepo, _ := setupTestService()
+
+	rootID, childID := uint(1), uint(2)
+	mockRepo.categories[1] = &model.Category{ID: 1, Name: "Root", SortIndex: 200, IsActive: true, IsVisibleInMenu: true}
+	mockRepo.categories[2] = &model.Category{ID: 2, Name: "Child", ParentID: &rootID, SortIndex: 100, IsActive: true, IsVisibleInMenu: false}
+	mockRepo.categories[3] = &model.Category{ID: 3, Name: "Grandchild", ParentID: &childID, SortIndex: 100, IsActive: true, IsVisibleInMenu: true, IsVisibleOnMain: true}
+	mockRepo.categories[4] = &model.Category{ID: 4, Name: "Second Root", SortIndex: 100, IsActive: true, IsVisibleInMenu: true}
+	mockRepo.nextID = 5
+
+	tree, err := service.GetTree()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(tree) != 2 || tree[0].Name != "Second Root" || tree[1].Name != "Root" {
+		t.Fatalf("Expected roots ordered by sort index, got %+v", tree)
+	}
+	if len(tree[1].Children) != 1 || len(tree[1].Children[0].Children) != 1 {
+		t.Errorf("Expected nested children under Root, got %+v", tree[1].Children)
+	}
+
+	menu, err := service.GetMenu()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(menu) != 2 || len(menu[1].Children) != 0 {
+		t.Errorf("Expected hidden child to prune its branch from the menu, got %+v", menu)
+	}
+
+	main, err := service.GetMain()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(main) != 1 || main[0].Name != "Grandchild" {
+		t.Errorf("Expected only the main page category, got %+v", main)
+	}
+}
+
+func TestService_GetBreadcrumbs(t *testing.T) {
+	service, mockRepo, _ := setupTestService()
+
+	rootID := uint(1)
+	mockRepo.categories[1] = &model.Category{ID: 1, Name: "Root", Slug: "root"}
+	mockRepo.categories[2] = &model.Category{ID: 2, Name: "Child", Slug: "child", ParentID: &rootID}
+
+	breadcrumbs, err := service.GetBreadcrumbs(2)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(breadcrumbs) != 2 || breadcrumbs[0].Slug != "root" || breadcrumbs[1].Slug != "child" {
+		t.Errorf("Expected root-first breadcrumbs, got %+v", breadcrumbs)
+	}
+
+	breadcrumbs, err = service.GetBreadcrumbs(999)
+	if err != nil || breadcrumbs != nil {
+		t.Errorf("Expected nil breadcrumbs for unknown category, got %+v, %v", breadcrumbs, err)
+	}
+}
+
+func TestService_GetBySlug_Redirect(t *testing.T) {
+	service, mockRepo, _ := setupTestService()
+
+	created, _ := mockRepo.Create(&model.Category{Name: "Test Category", Slug: "old-slug"})
+	newSlug := "new-slug"
+	if _, errFields, err := service.Update(created.ID, dto.UpdateDTO{Slug: &newSlug}); err != nil || errFields != nil {
+		t.Fatalf("Expected no error, got %v, %v", errFields, err)
+	}
+
+	category, redirect, err := service.GetBySlug("new-slug")
+	if err != nil || category == nil || redirect != nil {
+		t.Fatalf("Expected category by current slug, got %+v, %+v, %v", category, redirect, err)
+	}
+
+	category, redirect, err = service.GetBySlug("old-slug")
+	if err != nil || category != nil || redirect == nil || redirect.Slug != "new-slug" {
+		t.Fatalf("Expected redirect to the new slug, got %+v, %+v, %v", category, redirect, err)
+	}
+
+	category, redirect, err = service.GetBySlug("unknown")
+	if err != nil || category != nil || redirect != nil {
+		t.Errorf("Expected nothing for unknown slug, got %+v, %+v, %v", category, redirect, err)
+	}
+
+	_ = mockRepo.Delete(created.ID)
+	category, redirect, err = service.GetBySlug("old-slug")
+	if err != nil || category != nil || redirect != nil {
+		t.Errorf("Expected nothing for a redirect to a deleted category, got %+v, %+v, %v", category, redirect, err)
+	}
+}
+
+func TestService_Update_RejectsCycle(t *testing.T) {
+	service, mockRepo, _ := setupTestService()
+
+	root, _ := mockRepo.Create(&model.Category{Name: "Root", Slug: "root"})
+	child, _ := mockRepo.Create(&model.Category{Name: "Child", Slug: "child", ParentID: &root.ID})
+
+	tests := []struct {
+		name     string
+		id       uint
+		parentId uint
+		code     response.ErrorCode
+	}{
+		{"self parent", root.ID, root.ID, response.CategoryCycle},
+		{"descendant parent", root.ID, child.ID, response.CategoryCycle},
+		{"missing parent", child.ID, 999, response.NotFound},
+	}
+
+	for _, tt := range tests {
+		parentId := tt.parentId
+		_, validationErrors, err := service.Update(tt.id, dto.UpdateDTO{ParentID: &parentId})
+		if err != nil {
+			t.Fatalf("%s: expected no error, got %v", tt.name, err)
+		}
+		if len(validationErrors) != 1 || validationErrors[0].Field != "parentId" || validationErrors[0].ErrorCode != string(tt.code) {
+			t.Errorf("%s: expected parentId %s, got %+v", tt.name, tt.code, validationErrors)
+		}
+	}
+}
+
+func TestService_Move(t *testing.T) {
+	service, mockRepo, _ := setupTestService()
+
+	first, _ := mockRepo.Create(&model.Category{Name: "First", Slug: "first"})
+	second, _ := mockRepo.Create(&model.Category{Name: "Second", Slug: "second", ParentID: &first.ID})
+	third, _ := mockRepo.Create(&model.Category{Name: "Third", Slug: "third", ParentID: &second.ID})
+
+	_, validationErrors, err := service.Move(first.ID, dto.MoveDTO{ParentID: &third.ID})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(validationErrors) != 1 || validationErrors[0].ErrorCode != string(response.CategoryCycle) {
+		t.Fatalf("Expected cycle error, got %+v", validationErrors)
+	}
+
+	sortIndex := 500
+	moved, validationErrors, err := service.Move(second.ID, dto.MoveDTO{SortIndex: &sortIndex})
+	if err != nil || validationErrors != nil {
+		t.Fatalf("Expected no errors, got %+v, %v", validationErrors, err)
+	}
+	if moved.ParentID != nil || moved.SortIndex != 500 {
+		t.Errorf("Expected category moved to root with new sort index, got %+v", moved)
+	}
+
+	breadcrumbs, _ := service.GetBreadcrumbs(third.ID)
+	if len(breadcrumbs) != 2 || breadcrumbs[0].Slug != "second" {
+		t.Errorf("Expected descendants to follow the moved category, got %+v", breadcrumbs)
+	}
+
+	moved, validationErrors, err = service.Move(999, dto.MoveDTO{})
+	if err != nil || validationErrors != nil || moved != nil {
+		t.Errorf("Expected nothing for unknown category, got %+v, %+v, %v", moved, validationErrors, err)
+	}
+}
+
+func TestService_Restore(t *testing.T) {
+	service, mockRepo, _ := setupTestService()
+
+	parent, _ := mockRepo.Create(&model.Category{Name: "Parent", Slug: "parent"})
+	child, _ := mockRepo.Create(&model.Category{Name: "Child", Slug: "child", ParentID: &parent.ID})
+	_ = mockRepo.Delete(child.ID)
+	_ = mockRepo.Delete(parent.ID)
+
+	_, validationErrors, err := service.Restore(child.ID)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(validationErrors) != 1 || validationErrors[0].Field != "parentId" {
+		t.Fatalf("Expected parentId error while the parent is in the trash, got %+v", validationErrors)
+	}
+
+	restored, validationErrors, err := service.Restore(parent.ID)
+	if err != nil || validationErrors != nil || restored == nil {
+		t.Fatalf("Expected parent to be restored, got %+v, %+v, %v", restored, validationErrors, err)
+	}
+	if restored.DeletedAt != nil {
+		t.Errorf("Expected restored category without deletedAt, got %v", restored.DeletedAt)
+	}
+
+	_, _ = mockRepo.Create(&model.Category{Name: "Another", Slug: "child"})
+	_, validationErrors, err = service.Restore(child.ID)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(validationErrors) != 1 || validationErrors[0].Field != "slug" || validationErrors[0].ErrorCode != string(response.NotUnique) {
+		t.Errorf("Expected taken slug to block restore, got %+v", validationErrors)
+	}
+
+	restored, validationErrors, err = service.Restore(999)
+	if err != nil || validationErrors != nil || restored != nil {
+		t.Errorf("Expected nothing for a category outside the trash, got %+v, %+v, %v", restored, validationErrors, err)
+	}
+}
+
+func TestService_Purge(t *testing.T) {
+	service, mockRepo, mockFS := setupTestService()
+
+	old, _ := mockRepo.Create(&model.Category{Name: "Old", Slug: "old", Image: "old.png", HeaderImage: "old-header.png"})
+	recent, _ := mockRepo.Create(&model.Category{Name: "Recent", Slug: "recent", Image: "recent.png"})
+	_ = mockRepo.Delete(old.ID)
+	_ = mockRepo.Delete(recent.ID)
+	old.DeletedAt.Time = time.Now().Add(-48 * time.Hour)
+
+	count, err := service.Purge(time.Now().Add(-24 * time.Hour))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if count != 1 || len(mockRepo.deleted) != 1 {
+		t.Errorf("Expected only the old category to be purged, got %d purged and %d left", count, len(mockRepo.deleted))
+	}
+	if len(mockFS.deletedFiles) != 2 || mockFS.deletedFiles[0] != "old.png" {
+		t.Errorf("Expected images of the purged category to be deleted, got %v", mockFS.deletedFiles)
+	}
+}
@@ -8,6 +8,7 @@ import (
 )
 
 type Repository interface {
+	Create(model *model.ClientUser) (*model.ClientUser, error)
 	GetByPhone(phone string) (*model.ClientUser, error)
 }
 
@@ -21,6 +22,15 @@ func NewRepository(db *database.DB) Repository {
 	}
 }
 
+func (r *repository) Create(model *model.ClientUser) (*model.ClientUser, error) {
+	result := r.DB.Create(&model)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return model, nil
+}
+
 func (r *repository) GetByPhone(phone string) (*model.ClientUser, error) {
 	var user *model.ClientUser
 	var err error
@@ -5,9 +5,11 @@ import (
 )
 
 type ResponseDTO struct {
-	Id        uint      `json:"id"`
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
-	Email     string    `json:"email"`
-	Role      string    `json:"role"`
+	Id               uint      `json:"id"`
+	CreatedAt        time.Time `json:"createdAt"`
+	UpdatedAt        time.Time `json:"updatedAt"`
+	Email            string    `json:"email"`
+	Role             string    `json:"role"`
+	IsActive         bool      `json:"isActive"`
+	TwoFactorEnabled bool      `json:"twoFactorEnabled"`
 }
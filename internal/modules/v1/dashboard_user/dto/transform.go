@@ -13,10 +13,12 @@ func TransformCreateDTOToModel(dto CreateDTO) *dashboardUserModel.DashboardUser
 
 func TransformModelToResponseDTO(model *dashboardUserModel.DashboardUser) *ResponseDTO {
 	return &ResponseDTO{
-		Id:        model.ID,
-		CreatedAt: model.CreatedAt,
-		UpdatedAt: model.UpdatedAt,
-		Email:     model.Email,
-		Role:      model.Role,
+		Id:               model.ID,
+		CreatedAt:        model.CreatedAt,
+		UpdatedAt:        model.UpdatedAt,
+		Email:            model.Email,
+		Role:             model.Role,
+		IsActive:         model.IsActive,
+		TwoFactorEnabled: model.TOTPEnabled,
 	}
 }
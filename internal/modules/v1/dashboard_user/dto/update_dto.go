@@ -0,0 +1,5 @@
+package dto
+
+type UpdateDTO struct {
+	Role *string `json:"role,omitempty" validate:"omitempty,oneof=admin manager"`
+}
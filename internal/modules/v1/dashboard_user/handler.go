@@ -1,6 +1,7 @@
 package dashboard_user
 
 import (
+	"errors"
 	"fmt"
 	_ "haircompany-shop-rest/docs/response"
 	"haircompany-shop-rest/internal/constraint"
@@ -8,6 +9,7 @@ import (
 	"haircompany-shop-rest/pkg/request"
 	"haircompany-shop-rest/pkg/response"
 	"net/http"
+	"strconv"
 )
 
 type Handler struct {
@@ -65,3 +67,279 @@ func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 
 	response.SendSuccess(w, http.StatusCreated, createdUser)
 }
+
+var listOptions = request.ListOptions{
+	SortFields: map[string]string{
+		"id":        "id",
+		"email":     "email",
+		"role":      "role",
+		"createdAt": "created_at",
+	},
+	FilterFields: map[string]request.Filter{
+		"email":            {Column: "email", Type: request.FilterContains},
+		"role":             {Column: "role", Type: request.FilterEquals},
+		"isActive":         {Column: "is_active", Type: request.FilterBool},
+		"twoFactorEnabled": {Column: "totp_enabled", Type: request.FilterBool},
+	},
+	DefaultSort: []request.Sort{{Column: "id"}},
+}
+
+// GetAll retrieves dashboard users
+//
+//	@Summary		Get dashboard users
+//	@Description	Retrieve dashboard users with pagination
+//	@Tags			Dashboard User
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			page		query		int									false	"Page number, starting from 1"
+//	@Param			perPage		query		int									false	"Items per page, up to 100 (default 20)"
+//	@Param			sort		query		string								false	"Comma-separated sort fields, prefix with - for descending: id, email, role, createdAt"
+//	@Param			email		query		string								false	"Filter by email substring"
+//	@Param			role		query		string								false	"Filter by role"	Enums(admin, manager)
+//	@Param			isActive	query		bool								false	"Filter by activity"
+//	@Success		200			{object}	docsResponse.DashboardUserList200	"List of dashboard users"
+//	@Failure		400			{object}	docsResponse.Response400			"Invalid page, perPage, sort or filter value"
+//	@Failure		401			{object}	docsResponse.Response401			"Unauthorized"
+//	@Failure		403			{object}	docsResponse.Response403			"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		500			{object}	docsResponse.Response500			"Server error"
+//	@Router			/api/v1/dashboard-user [get]
+func (h *Handler) GetAll(w http.ResponseWriter, r *http.Request) {
+	query, errFields := request.ParseListQuery(r.URL.Query(), listOptions)
+	if errFields != nil {
+		msg := "invalid list query"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	users, total, err := h.svc.GetAll(query)
+	if err != nil {
+		msg := fmt.Sprintf("failed to retrieve dashboard users: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+
+	response.SendPaginated(w, http.StatusOK, users, response.Pagination{Total: total, Page: query.Page, PerPage: query.PerPage})
+}
+
+// GetById retrieves a dashboard user by ID
+//
+//	@Summary		Get dashboard user by ID
+//	@Description	Retrieve dashboard user by its ID
+//	@Tags			Dashboard User
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			id	path		int										true	"Dashboard user ID"
+//	@Success		200	{object}	docsResponse.DashboardUserGetById200	"Dashboard user found"
+//	@Failure		400	{object}	docsResponse.Response400				"Invalid ID"
+//	@Failure		401	{object}	docsResponse.Response401				"Unauthorized"
+//	@Failure		403	{object}	docsResponse.Response403				"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		404	{object}	docsResponse.Response404				"Dashboard user not found"
+//	@Failure		500	{object}	docsResponse.Response500				"Server error"
+//	@Router			/api/v1/dashboard-user/{id} [get]
+func (h *Handler) GetById(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseID(w, r)
+	if !ok {
+		return
+	}
+
+	user, err := h.svc.GetById(id)
+	if err != nil {
+		sendServiceError(w, err, "failed to retrieve dashboard user")
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, user)
+}
+
+// Update changes the role of a dashboard user
+//
+//	@Summary		Update dashboard user
+//	@Description	Change the role of a dashboard user. The last active admin cannot be demoted
+//	@Tags			Dashboard User
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path		int									true	"Dashboard user ID"
+//	@Param			dashboardUser	body		dto.UpdateDTO						true	"Dashboard user update payload"
+//	@Success		200				{object}	docsResponse.DashboardUserUpdate200	"Dashboard user updated"
+//	@Failure		400				{object}	docsResponse.Response400			"Bad request or validation error"
+//	@Failure		401				{object}	docsResponse.Response401			"Unauthorized"
+//	@Failure		403				{object}	docsResponse.Response403			"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		404				{object}	docsResponse.Response404			"Dashboard user not found"
+//	@Failure		409				{object}	docsResponse.DashboardUser409		"Last active admin"
+//	@Failure		500				{object}	docsResponse.Response500			"Server error"
+//	@Router			/api/v1/dashboard-user/{id}/update [patch]
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseID(w, r)
+	if !ok {
+		return
+	}
+
+	updateDto, err := request.DecodeBody[dto.UpdateDTO](r.Body)
+	if err != nil {
+		msg := fmt.Sprintf("invalid request body: %v", err)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	errFields := constraint.ValidateDTO(updateDto)
+	if errFields != nil {
+		msg := "validation errors occurred"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	user, err := h.svc.Update(id, updateDto)
+	if err != nil {
+		sendServiceError(w, err, "failed to update dashboard user")
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, user)
+}
+
+// Deactivate deactivates a dashboard user
+//
+//	@Summary		Deactivate dashboard user
+//	@Description	Refuse further logins of a dashboard user and revoke their refresh token. The last active admin cannot be deactivated
+//	@Tags			Dashboard User
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			id	path		int									true	"Dashboard user ID"
+//	@Success		200	{object}	docsResponse.DashboardUserUpdate200	"Dashboard user deactivated"
+//	@Failure		400	{object}	docsResponse.Response400			"Invalid ID"
+//	@Failure		401	{object}	docsResponse.Response401			"Unauthorized"
+//	@Failure		403	{object}	docsResponse.Response403			"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		404	{object}	docsResponse.Response404			"Dashboard user not found"
+//	@Failure		409	{object}	docsResponse.DashboardUser409		"Last active admin"
+//	@Failure		500	{object}	docsResponse.Response500			"Server error"
+//	@Router			/api/v1/dashboard-user/{id}/deactivate [patch]
+func (h *Handler) Deactivate(w http.ResponseWriter, r *http.Request) {
+	h.setActive(w, r, false)
+}
+
+// Activate reactivates a dashboard user
+//
+//	@Summary		Activate dashboard user
+//	@Description	Allow a deactivated dashboard user to log in again
+//	@Tags			Dashboard User
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			id	path		int									true	"Dashboard user ID"
+//	@Success		200	{object}	docsResponse.DashboardUserUpdate200	"Dashboard user activated"
+//	@Failure		400	{object}	docsResponse.Response400			"Invalid ID"
+//	@Failure		401	{object}	docsResponse.Response401			"Unauthorized"
+//	@Failure		403	{object}	docsResponse.Response403			"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		404	{object}	docsResponse.Response404			"Dashboard user not found"
+//	@Failure		500	{object}	docsResponse.Response500			"Server error"
+//	@Router			/api/v1/dashboard-user/{id}/activate [patch]
+func (h *Handler) Activate(w http.ResponseWriter, r *http.Request) {
+	h.setActive(w, r, true)
+}
+
+// ResetTwoFactor disables two-factor authentication of a dashboard user
+//
+//	@Summary		Reset dashboard user two-factor
+//	@Description	Disable two-factor authentication of a dashboard user who lost the authenticator app and recovery codes. The user can enable it again after logging in with the password
+//	@Tags			Dashboard User
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			id	path		int									true	"Dashboard user ID"
+//	@Success		200	{object}	docsResponse.DashboardUserUpdate200	"Two-factor authentication reset"
+//	@Failure		400	{object}	docsResponse.Response400			"Invalid ID"
+//	@Failure		401	{object}	docsResponse.Response401			"Unauthorized"
+//	@Failure		403	{object}	docsResponse.Response403			"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		404	{object}	docsResponse.Response404			"Dashboard user not found"
+//	@Failure		500	{object}	docsResponse.Response500			"Server error"
+//	@Router			/api/v1/dashboard-user/{id}/reset-2fa [patch]
+func (h *Handler) ResetTwoFactor(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseID(w, r)
+	if !ok {
+		return
+	}
+
+	user, err := h.svc.ResetTwoFactor(id)
+	if err != nil {
+		sendServiceError(w, err, "failed to reset two-factor authentication")
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, user)
+}
+
+func (h *Handler) setActive(w http.ResponseWriter, r *http.Request, isActive bool) {
+	id, ok := parseID(w, r)
+	if !ok {
+		return
+	}
+
+	user, err := h.svc.SetActive(id, isActive)
+	if err != nil {
+		sendServiceError(w, err, "failed to change dashboard user activity")
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, user)
+}
+
+// Delete deletes a dashboard user
+//
+//	@Summary		Delete dashboard user
+//	@Description	Delete a dashboard user and revoke their refresh token. The last active admin cannot be deleted
+//	@Tags			Dashboard User
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			id	path		int									true	"Dashboard user ID"
+//	@Success		200	{object}	docsResponse.DashboardUserDelete200	"Dashboard user deleted"
+//	@Failure		400	{object}	docsResponse.Response400			"Invalid ID"
+//	@Failure		401	{object}	docsResponse.Response401			"Unauthorized"
+//	@Failure		403	{object}	docsResponse.Response403			"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		404	{object}	docsResponse.Response404			"Dashboard user not found"
+//	@Failure		409	{object}	docsResponse.DashboardUser409		"Last active admin"
+//	@Failure		500	{object}	docsResponse.Response500			"Server error"
+//	@Router			/api/v1/dashboard-user/{id}/delete [delete]
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	id, ok := parseID(w, r)
+	if !ok {
+		return
+	}
+
+	user, err := h.svc.Delete(id)
+	if err != nil {
+		sendServiceError(w, err, "failed to delete dashboard user")
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, user)
+}
+
+func parseID(w http.ResponseWriter, r *http.Request) (uint, bool) {
+	idStr := r.PathValue("id")
+	id, err := strconv.ParseUint(idStr, 10, 0)
+	if err != nil {
+		msg := fmt.Sprintf("invalid dashboard user id: %s", idStr)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return 0, false
+	}
+
+	return uint(id), true
+}
+
+func sendServiceError(w http.ResponseWriter, err error, action string) {
+	switch {
+	case errors.Is(err, ErrUserNotFound):
+		response.SendError(w, http.StatusNotFound, err.Error(), response.NotFound)
+	case errors.Is(err, ErrLastAdmin):
+		response.SendError(w, http.StatusConflict, err.Error(), response.LastAdmin)
+	default:
+		msg := fmt.Sprintf("%s: %v", action, err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+	}
+}
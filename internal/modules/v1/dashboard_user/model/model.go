@@ -5,10 +5,27 @@ import (
 )
 
 type DashboardUser struct {
-	ID        uint `gorm:"primarykey"`
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	Email     string `gorm:"type:varchar(255);not null;unique" json:"email"`
-	Password  string `gorm:"type:varchar(255);not null" json:"password"`
-	Role      string `gorm:"type:ENUM('admin','manager');not null" json:"role"`
+	ID          uint `gorm:"primarykey"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Email       string  `gorm:"type:varchar(255);not null;unique" json:"email"`
+	Password    string  `gorm:"type:varchar(255);not null" json:"password"`
+	Role        string  `gorm:"type:ENUM('admin','manager');not null" json:"role"`
+	IsActive    bool    `gorm:"not null;default:true" json:"isActive"`
+	TOTPSecret  *string `gorm:"column:totp_secret;type:varchar(64)" json:"-"`
+	TOTPEnabled bool    `gorm:"column:totp_enabled;not null;default:false" json:"totpEnabled"`
+}
+
+// RecoveryCode — одноразовый код для входа без TOTP-приложения. Хранится
+// только хэш кода.
+type RecoveryCode struct {
+	ID              uint `gorm:"primarykey" json:"id"`
+	CreatedAt       time.Time
+	DashboardUserID uint       `gorm:"not null" json:"dashboardUserId"`
+	CodeHash        string     `gorm:"type:varchar(64);not null" json:"-"`
+	UsedAt          *time.Time `json:"usedAt"`
+}
+
+func (RecoveryCode) TableName() string {
+	return "dashboard_user_recovery_codes"
 }
@@ -5,12 +5,28 @@ import (
 	"gorm.io/gorm"
 	"haircompany-shop-rest/internal/modules/v1/dashboard_user/model"
 	"haircompany-shop-rest/pkg/database"
+	"haircompany-shop-rest/pkg/request"
+	"time"
 )
 
+// adminsLockKey — ключ advisory-блокировки для изменений, которые могут
+// оставить панель управления без администраторов.
+const adminsLockKey = 7_202_001
+
 type Repository interface {
+	Transaction(fn func(repo Repository) error) error
+	LockAdmins() error
 	Create(model *model.DashboardUser) (*model.DashboardUser, error)
+	GetAll(query request.ListQuery) ([]*model.DashboardUser, int64, error)
 	GetByID(id uint) (*model.DashboardUser, error)
 	GetByEmail(email string) (*model.DashboardUser, error)
+	Update(model *model.DashboardUser) (*model.DashboardUser, error)
+	Delete(id uint) error
+	CountActiveAdmins() (int64, error)
+	ReplaceRecoveryCodes(userID uint, codeHashes []string) error
+	UseRecoveryCode(userID uint, codeHash string) (bool, error)
+	CountUnusedRecoveryCodes(userID uint) (int64, error)
+	DeleteRecoveryCodes(userID uint) error
 }
 
 type repository struct {
@@ -23,6 +39,23 @@ func NewRepository(db *database.DB) Repository {
 	}
 }
 
+func (r *repository) Transaction(fn func(repo Repository) error) error {
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		return fn(NewRepository(&database.DB{DB: tx}))
+	})
+}
+
+// LockAdmins сериализует смену роли, деактивацию и удаление до конца
+// транзакции: иначе два встречных запроса могут по отдельности пройти проверку
+// на последнего администратора и вместе её нарушить.
+func (r *repository) LockAdmins() error {
+	if r.DB.Dialector.Name() != "postgres" {
+		return nil
+	}
+
+	return r.DB.Exec("SELECT pg_advisory_xact_lock(?)", adminsLockKey).Error
+}
+
 func (r *repository) Create(model *model.DashboardUser) (*model.DashboardUser, error) {
 	result := r.DB.Create(&model)
 	if result.Error != nil {
@@ -32,6 +65,10 @@ func (r *repository) Create(model *model.DashboardUser) (*model.DashboardUser, e
 	return model, nil
 }
 
+func (r *repository) GetAll(query request.ListQuery) ([]*model.DashboardUser, int64, error) {
+	return database.FindPage[model.DashboardUser](r.DB.DB, query)
+}
+
 func (r *repository) GetByID(id uint) (*model.DashboardUser, error) {
 	var user *model.DashboardUser
 	var err error
@@ -61,3 +98,68 @@ func (r *repository) GetByEmail(email string) (*model.DashboardUser, error) {
 
 	return user, err
 }
+
+func (r *repository) Update(model *model.DashboardUser) (*model.DashboardUser, error) {
+	result := r.DB.Save(&model)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return model, nil
+}
+
+func (r *repository) Delete(id uint) error {
+	result := r.DB.Delete(&model.DashboardUser{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+func (r *repository) CountActiveAdmins() (int64, error) {
+	var count int64
+	result := r.DB.Model(&model.DashboardUser{}).Where("role = ? AND is_active = ?", "admin", true).Count(&count)
+
+	return count, result.Error
+}
+
+// ReplaceRecoveryCodes заменяет все коды восстановления пользователя новыми.
+func (r *repository) ReplaceRecoveryCodes(userID uint, codeHashes []string) error {
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("dashboard_user_id = ?", userID).Delete(&model.RecoveryCode{}).Error; err != nil {
+			return err
+		}
+
+		codes := make([]model.RecoveryCode, 0, len(codeHashes))
+		for _, codeHash := range codeHashes {
+			codes = append(codes, model.RecoveryCode{DashboardUserID: userID, CodeHash: codeHash})
+		}
+		if len(codes) == 0 {
+			return nil
+		}
+
+		return tx.Create(&codes).Error
+	})
+}
+
+// UseRecoveryCode отмечает код использованным. Условие на used_at делает код
+// одноразовым и при параллельных запросах.
+func (r *repository) UseRecoveryCode(userID uint, codeHash string) (bool, error) {
+	result := r.DB.Model(&model.RecoveryCode{}).
+		Where("dashboard_user_id = ? AND code_hash = ? AND used_at IS NULL", userID, codeHash).
+		Update("used_at", time.Now())
+
+	return result.RowsAffected == 1, result.Error
+}
+
+func (r *repository) CountUnusedRecoveryCodes(userID uint) (int64, error) {
+	var count int64
+	result := r.DB.Model(&model.RecoveryCode{}).Where("dashboard_user_id = ? AND used_at IS NULL", userID).Count(&count)
+
+	return count, result.Error
+}
+
+func (r *repository) DeleteRecoveryCodes(userID uint) error {
+	return r.DB.Where("dashboard_user_id = ?", userID).Delete(&model.RecoveryCode{}).Error
+}
@@ -9,7 +9,7 @@ import (
 
 func RegisterV1DashboardUserRoutes(mux *http.ServeMux, container *container.Container) {
 	repo := NewRepository(container.DB)
-	svc := NewService(repo, container.PasswordService)
+	svc := NewService(repo, container.PasswordService, container.DashboardSessions, container.TokenDenylist)
 	h := NewHandler(svc)
 
 	mux.Handle("/dashboard-user/create",
@@ -24,7 +24,119 @@ func RegisterV1DashboardUserRoutes(mux *http.ServeMux, container *container.Cont
 				}
 			}),
 			middleware.DashboardRoleMiddleware("admin"),
-			middleware.DashboardAuthMiddleware(container.JWTService),
+			middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist),
+		),
+	)
+
+	mux.Handle("/dashboard-user",
+		middleware.ChainMiddleware(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodGet:
+					h.GetAll(w, r)
+				default:
+					msg := "Method not allowed. Allowed methods: GET"
+					response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+				}
+			}),
+			middleware.DashboardRoleMiddleware("admin"),
+			middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist),
+		),
+	)
+
+	mux.Handle("/dashboard-user/{id}",
+		middleware.ChainMiddleware(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodGet:
+					h.GetById(w, r)
+				default:
+					msg := "Method not allowed. Allowed methods: GET"
+					response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+				}
+			}),
+			middleware.DashboardRoleMiddleware("admin"),
+			middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist),
+		),
+	)
+
+	mux.Handle("/dashboard-user/{id}/update",
+		middleware.ChainMiddleware(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodPatch:
+					h.Update(w, r)
+				default:
+					msg := "Method not allowed. Allowed methods: PATCH"
+					response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+				}
+			}),
+			middleware.DashboardRoleMiddleware("admin"),
+			middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist),
+		),
+	)
+
+	mux.Handle("/dashboard-user/{id}/deactivate",
+		middleware.ChainMiddleware(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodPatch:
+					h.Deactivate(w, r)
+				default:
+					msg := "Method not allowed. Allowed methods: PATCH"
+					response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+				}
+			}),
+			middleware.DashboardRoleMiddleware("admin"),
+			middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist),
+		),
+	)
+
+	mux.Handle("/dashboard-user/{id}/activate",
+		middleware.ChainMiddleware(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodPatch:
+					h.Activate(w, r)
+				default:
+					msg := "Method not allowed. Allowed methods: PATCH"
+					response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+				}
+			}),
+			middleware.DashboardRoleMiddleware("admin"),
+			middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist),
+		),
+	)
+
+	mux.Handle("/dashboard-user/{id}/reset-2fa",
+		middleware.ChainMiddleware(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodPatch:
+					h.ResetTwoFactor(w, r)
+				default:
+					msg := "Method not allowed. Allowed methods: PATCH"
+					response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+				}
+			}),
+			middleware.DashboardRoleMiddleware("admin"),
+			middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist),
+		),
+	)
+
+	mux.Handle("/dashboard-user/{id}/delete",
+		middleware.ChainMiddleware(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodDelete:
+					h.Delete(w, r)
+				default:
+					msg := "Method not allowed. Allowed methods: DELETE"
+					response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+				}
+			}),
+			middleware.DashboardRoleMiddleware("admin"),
+			middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist),
 		),
 	)
 }
@@ -1,24 +1,46 @@
 package dashboard_user
 
 import (
+	"errors"
 	"haircompany-shop-rest/internal/modules/v1/dashboard_user/dto"
+	"haircompany-shop-rest/internal/modules/v1/dashboard_user/model"
 	"haircompany-shop-rest/internal/services"
+	"haircompany-shop-rest/pkg/request"
 	"haircompany-shop-rest/pkg/response"
+	"log"
+	"time"
+)
+
+const roleAdmin = "admin"
+
+var (
+	ErrUserNotFound = errors.New("dashboard user not found")
+	ErrLastAdmin    = errors.New("the last active admin cannot be demoted, deactivated or deleted")
 )
 
 type Service interface {
 	Create(createDto dto.CreateDTO) (*dto.ResponseDTO, []response.ErrorField, error)
+	GetAll(query request.ListQuery) ([]*dto.ResponseDTO, int64, error)
+	GetById(id uint) (*dto.ResponseDTO, error)
+	Update(id uint, updateDto dto.UpdateDTO) (*dto.ResponseDTO, error)
+	SetActive(id uint, isActive bool) (*dto.ResponseDTO, error)
+	ResetTwoFactor(id uint) (*dto.ResponseDTO, error)
+	Delete(id uint) (*dto.ResponseDTO, error)
 }
 
 type service struct {
 	repo        Repository
 	passwordSvc services.PasswordService
+	sessions    services.DashboardSessionStore
+	denylist    services.TokenDenylist
 }
 
-func NewService(r Repository, passwordSvc services.PasswordService) Service {
+func NewService(r Repository, passwordSvc services.PasswordService, sessions services.DashboardSessionStore, denylist services.TokenDenylist) Service {
 	return &service{
 		repo:        r,
 		passwordSvc: passwordSvc,
+		sessions:    sessions,
+		denylist:    denylist,
 	}
 }
 
@@ -52,3 +74,193 @@ func (s *service) Create(createDto dto.CreateDTO) (*dto.ResponseDTO, []response.
 
 	return createdUserResponse, nil, nil
 }
+
+func (s *service) GetAll(query request.ListQuery) ([]*dto.ResponseDTO, int64, error) {
+	userDTOs := make([]*dto.ResponseDTO, 0)
+	models, total, err := s.repo.GetAll(query)
+	if err != nil {
+		log.Printf("error retrieving dashboard users: %v", err)
+	}
+
+	for _, model := range models {
+		userDTOs = append(userDTOs, dto.TransformModelToResponseDTO(model))
+	}
+
+	return userDTOs, total, err
+}
+
+func (s *service) GetById(id uint) (*dto.ResponseDTO, error) {
+	user, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	return dto.TransformModelToResponseDTO(user), nil
+}
+
+// Update меняет роль пользователя. Выданные access-токены отзываются, новая
+// роль попадёт в токены при обновлении: refresh берёт роль из базы.
+func (s *service) Update(id uint, updateDto dto.UpdateDTO) (*dto.ResponseDTO, error) {
+	var user *model.DashboardUser
+	roleChanged := false
+	err := s.repo.Transaction(func(repo Repository) error {
+		var err error
+		user, err = getForChange(repo, id)
+		if err != nil {
+			return err
+		}
+
+		if updateDto.Role != nil && *updateDto.Role != user.Role {
+			if err := ensureNotLastAdmin(repo, user); err != nil {
+				return err
+			}
+			user.Role = *updateDto.Role
+			roleChanged = true
+		}
+
+		user, err = repo.Update(user)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if roleChanged {
+		if err := s.denylist.RevokeIssuedBefore(user.Email, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+
+	return dto.TransformModelToResponseDTO(user), nil
+}
+
+// SetActive деактивирует или снова активирует пользователя. Деактивированный
+// пользователь не может войти, все его сессии и access-токены отзываются.
+func (s *service) SetActive(id uint, isActive bool) (*dto.ResponseDTO, error) {
+	var user *model.DashboardUser
+	err := s.repo.Transaction(func(repo Repository) error {
+		var err error
+		user, err = getForChange(repo, id)
+		if err != nil {
+			return err
+		}
+		if user.IsActive == isActive {
+			return nil
+		}
+
+		if !isActive {
+			if err := ensureNotLastAdmin(repo, user); err != nil {
+				return err
+			}
+		}
+		user.IsActive = isActive
+
+		user, err = repo.Update(user)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !isActive {
+		if err := services.RevokeDashboardUser(s.sessions, s.denylist, user.Email); err != nil {
+			return nil, err
+		}
+	}
+
+	return dto.TransformModelToResponseDTO(user), nil
+}
+
+// ResetTwoFactor отключает 2FA пользователю, потерявшему телефон и коды
+// восстановления; подключить её заново он сможет сам после входа по паролю.
+func (s *service) ResetTwoFactor(id uint) (*dto.ResponseDTO, error) {
+	var user *model.DashboardUser
+	err := s.repo.Transaction(func(repo Repository) error {
+		var err error
+		user, err = repo.GetByID(id)
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return ErrUserNotFound
+		}
+		if !user.TOTPEnabled && user.TOTPSecret == nil {
+			return nil
+		}
+
+		user.TOTPSecret = nil
+		user.TOTPEnabled = false
+		if user, err = repo.Update(user); err != nil {
+			return err
+		}
+
+		return repo.DeleteRecoveryCodes(id)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.TransformModelToResponseDTO(user), nil
+}
+
+func (s *service) Delete(id uint) (*dto.ResponseDTO, error) {
+	var user *model.DashboardUser
+	err := s.repo.Transaction(func(repo Repository) error {
+		var err error
+		user, err = getForChange(repo, id)
+		if err != nil {
+			return err
+		}
+		if err := ensureNotLastAdmin(repo, user); err != nil {
+			return err
+		}
+
+		return repo.Delete(id)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := services.RevokeDashboardUser(s.sessions, s.denylist, user.Email); err != nil {
+		return nil, err
+	}
+
+	return dto.TransformModelToResponseDTO(user), nil
+}
+
+// getForChange берёт блокировку администраторов и загружает пользователя.
+func getForChange(repo Repository, id uint) (*model.DashboardUser, error) {
+	if err := repo.LockAdmins(); err != nil {
+		return nil, err
+	}
+
+	user, err := repo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+
+	return user, nil
+}
+
+// ensureNotLastAdmin не даёт лишить панель последнего активного администратора.
+func ensureNotLastAdmin(repo Repository, user *model.DashboardUser) error {
+	if user.Role != roleAdmin || !user.IsActive {
+		return nil
+	}
+
+	count, err := repo.CountActiveAdmins()
+	if err != nil {
+		return err
+	}
+	if count <= 1 {
+		return ErrLastAdmin
+	}
+
+	return nil
+}
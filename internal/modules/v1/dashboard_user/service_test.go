@@ -0,0 +1,237 @@
+package dashboard_user
+
+import (
+	"errors"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"haircompany-shop-rest/internal/modules/v1/dashboard_user/dto"
+	"haircompany-shop-rest/internal/modules/v1/dashboard_user/model"
+	"haircompany-shop-rest/internal/services"
+	"haircompany-shop-rest/pkg/database"
+	"haircompany-shop-rest/pkg/request"
+	"testing"
+	"time"
+)
+
+// mockSessionStore запоминает, чьи сессии были завершены
+type mockSessionStore struct {
+	services.DashboardSessionStore
+	revoked []string
+}
+
+func (m *mockSessionStore) RevokeAll(email string) ([]string, error) {
+	m.revoked = append(m.revoked, email)
+	return nil, nil
+}
+
+// mockTokenDenylist запоминает, чьи access-токены были отозваны
+type mockTokenDenylist struct {
+	services.TokenDenylist
+	revoked []string
+}
+
+func (m *mockTokenDenylist) RevokeIssuedBefore(email string, _ time.Time) error {
+	m.revoked = append(m.revoked, email)
+	return nil
+}
+
+func setupTestService(t *testing.T) (Service, *mockSessionStore, *mockTokenDenylist) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatal("Failed to connect to test database:", err)
+	}
+
+	// Тип ENUM из модели SQLite не понимает, таблица создаётся вручную
+	err = db.Exec(`CREATE TABLE dashboard_users (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		created_at DATETIME,
+		updated_at DATETIME,
+		email VARCHAR(255) NOT NULL UNIQUE,
+		password VARCHAR(255) NOT NULL,
+		role VARCHAR(16) NOT NULL,
+		is_active BOOLEAN NOT NULL DEFAULT TRUE,
+		totp_secret VARCHAR(64),
+		totp_enabled BOOLEAN NOT NULL DEFAULT FALSE
+	)`).Error
+	if err != nil {
+		t.Fatal("Failed to migrate test database:", err)
+	}
+	err = db.AutoMigrate(&model.RecoveryCode{})
+	if err != nil {
+		t.Fatal("Failed to migrate test database:", err)
+	}
+
+	sessions := &mockSessionStore{}
+	denylist := &mockTokenDenylist{}
+	svc := NewService(NewRepository(&database.DB{DB: db}), services.NewPasswordService(), sessions, denylist)
+
+	return svc, sessions, denylist
+}
+
+func createUser(t *testing.T, svc Service, email, role string) *dto.ResponseDTO {
+	t.Helper()
+
+	user, errFields, err := svc.Create(dto.CreateDTO{Email: email, Password: "password123", Role: role})
+	if err != nil || errFields != nil {
+		t.Fatalf("Failed to create user: %v, %v", errFields, err)
+	}
+	if !user.IsActive {
+		t.Fatalf("Expected new user to be active")
+	}
+
+	return user
+}
+
+func TestService_LastAdminIsProtected(t *testing.T) {
+	svc, _, _ := setupTestService(t)
+	admin := createUser(t, svc, "admin@example.com", "admin")
+	manager := createUser(t, svc, "manager@example.com", "manager")
+
+	role := "manager"
+	if _, err := svc.Update(admin.Id, dto.UpdateDTO{Role: &role}); !errors.Is(err, ErrLastAdmin) {
+		t.Errorf("Expected ErrLastAdmin on demotion, got %v", err)
+	}
+	if _, err := svc.SetActive(admin.Id, false); !errors.Is(err, ErrLastAdmin) {
+		t.Errorf("Expected ErrLastAdmin on deactivation, got %v", err)
+	}
+	if _, err := svc.Delete(admin.Id); !errors.Is(err, ErrLastAdmin) {
+		t.Errorf("Expected ErrLastAdmin on deletion, got %v", err)
+	}
+
+	// Менеджера можно удалить и без второго администратора
+	if _, err := svc.SetActive(manager.Id, false); err != nil {
+		t.Errorf("Expected manager to be deactivated, got %v", err)
+	}
+
+	second := createUser(t, svc, "second@example.com", "admin")
+	updated, err := svc.Update(admin.Id, dto.UpdateDTO{Role: &role})
+	if err != nil {
+		t.Fatalf("Expected demotion to succeed, got %v", err)
+	}
+	if updated.Role != "manager" {
+		t.Errorf("Expected role manager, got %s", updated.Role)
+	}
+
+	if _, err := svc.Delete(second.Id); !errors.Is(err, ErrLastAdmin) {
+		t.Errorf("Expected ErrLastAdmin for the remaining admin, got %v", err)
+	}
+	if _, err := svc.Delete(admin.Id); err != nil {
+		t.Errorf("Expected manager to be deleted, got %v", err)
+	}
+	if _, err := svc.GetById(admin.Id); !errors.Is(err, ErrUserNotFound) {
+		t.Errorf("Expected ErrUserNotFound, got %v", err)
+	}
+}
+
+func TestService_DeactivateRevokesSessions(t *testing.T) {
+	svc, sessions, denylist := setupTestService(t)
+	createUser(t, svc, "admin@example.com", "admin")
+	manager := createUser(t, svc, "manager@example.com", "manager")
+
+	deactivated, err := svc.SetActive(manager.Id, false)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if deactivated.IsActive {
+		t.Error("Expected user to be deactivated")
+	}
+	if len(sessions.revoked) != 1 || sessions.revoked[0] != manager.Email {
+		t.Errorf("Expected sessions of %s to be revoked, got %v", manager.Email, sessions.revoked)
+	}
+	if len(denylist.revoked) != 1 || denylist.revoked[0] != manager.Email {
+		t.Errorf("Expected access tokens of %s to be revoked, got %v", manager.Email, denylist.revoked)
+	}
+
+	activated, err := svc.SetActive(manager.Id, true)
+	if err != nil || !activated.IsActive {
+		t.Errorf("Expected user to be activated, got %+v, %v", activated, err)
+	}
+
+	users, total, err := svc.GetAll(request.ListQuery{Page: 1, PerPage: 20})
+	if err != nil || total != 2 || len(users) != 2 {
+		t.Errorf("Expected 2 users, got %d, %v", total, err)
+	}
+}
+
+func TestService_NotFound(t *testing.T) {
+	svc, _, _ := setupTestService(t)
+
+	if _, err := svc.SetActive(42, false); !errors.Is(err, ErrUserNotFound) {
+		t.Errorf("Expected ErrUserNotFound, got %v", err)
+	}
+	if _, err := svc.Delete(42); !errors.Is(err, ErrUserNotFound) {
+		t.Errorf("Expected ErrUserNotFound, got %v", err)
+	}
+	if _, err := svc.ResetTwoFactor(42); !errors.Is(err, ErrUserNotFound) {
+		t.Errorf("Expected ErrUserNotFound, got %v", err)
+	}
+}
+
+func TestService_ResetTwoFactor(t *testing.T) {
+	svc, _, _ := setupTestService(t)
+	created := createUser(t, svc, "manager@example.com", "manager")
+	repo := svc.(*service).repo
+
+	user, err := repo.GetByID(created.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	secret := "JBSWY3DPEHPK3PXP"
+	user.TOTPSecret = &secret
+	user.TOTPEnabled = true
+	if _, err := repo.Update(user); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.ReplaceRecoveryCodes(user.ID, []string{"hash-1", "hash-2"}); err != nil {
+		t.Fatal(err)
+	}
+
+	reset, err := svc.ResetTwoFactor(user.ID)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if reset.TwoFactorEnabled {
+		t.Error("Expected two-factor authentication to be disabled")
+	}
+
+	user, err = repo.GetByID(user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.TOTPEnabled || user.TOTPSecret != nil {
+		t.Errorf("Expected TOTP secret to be cleared, got %+v", user)
+	}
+	left, err := repo.CountUnusedRecoveryCodes(user.ID)
+	if err != nil || left != 0 {
+		t.Errorf("Expected recovery codes to be deleted, got %d, %v", left, err)
+	}
+	if used, err := repo.UseRecoveryCode(user.ID, "hash-1"); err != nil || used {
+		t.Errorf("Expected deleted recovery code to be rejected, got %v, %v", used, err)
+	}
+}
+
+func TestService_RoleChangeRevokesAccessTokens(t *testing.T) {
+	svc, sessions, denylist := setupTestService(t)
+	createUser(t, svc, "admin@example.com", "admin")
+	manager := createUser(t, svc, "manager@example.com", "manager")
+
+	role := "manager"
+	if _, err := svc.Update(manager.Id, dto.UpdateDTO{Role: &role}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(denylist.revoked) != 0 {
+		t.Errorf("Expected tokens to stay valid when role is unchanged, got %v", denylist.revoked)
+	}
+
+	role = "admin"
+	if _, err := svc.Update(manager.Id, dto.UpdateDTO{Role: &role}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(denylist.revoked) != 1 || denylist.revoked[0] != manager.Email {
+		t.Errorf("Expected access tokens of %s to be revoked, got %v", manager.Email, denylist.revoked)
+	}
+	// Сессии остаются: новая роль придёт с обновлением токенов
+	if len(sessions.revoked) != 0 {
+		t.Errorf("Expected sessions to stay, got %v", sessions.revoked)
+	}
+}
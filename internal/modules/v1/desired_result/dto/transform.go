@@ -16,10 +16,16 @@ func TransformUpdateDTOToModel(dto UpdateDTO, model *model.DesiredResult) *model
 }
 
 func TransformModelToResponseDTO(model *model.DesiredResult) *ResponseDTO {
-	return &ResponseDTO{
+	responseDTO := &ResponseDTO{
 		Id:        model.ID,
 		CreatedAt: model.CreatedAt,
 		UpdatedAt: model.UpdatedAt,
 		Name:      model.Name,
 	}
+	if model.DeletedAt.Valid {
+		deletedAt := model.DeletedAt.Time
+		responseDTO.DeletedAt = &deletedAt
+	}
+
+	return responseDTO
 }
@@ -66,6 +66,19 @@ func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 	response.SendSuccess(w, http.StatusCreated, createdDesiredResult)
 }
 
+var listOptions = request.ListOptions{
+	SortFields: map[string]string{
+		"id":        "id",
+		"name":      "name",
+		"createdAt": "created_at",
+		"deletedAt": "deleted_at",
+	},
+	FilterFields: map[string]request.Filter{
+		"name": {Column: "name", Type: request.FilterContains},
+	},
+	DefaultSort: []request.Sort{{Column: "id"}},
+}
+
 // GetAll retrieves all desiredResults
 //
 //	@Summary		Get all desiredResults
@@ -73,19 +86,31 @@ func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 //	@Tags			DesiredResult
 //	@Security		AppAuth
 //	@Produce		json
-//	@Success		200	{object}	docsResponse.DesiredResultList200	"List of desiredResults"
-//	@Failure		403	{object}	docsResponse.Response403			"Forbidden - Invalid X-AUTH-APP"
-//	@Failure		500	{object}	docsResponse.Response500			"Server error"
+//	@Param			page	query		int									false	"Page number, starting from 1"
+//	@Param			perPage	query		int									false	"Items per page, up to 100 (default 20)"
+//	@Param			sort	query		string								false	"Comma-separated sort fields, prefix with - for descending: id, name, createdAt"
+//	@Param			name	query		string								false	"Filter by name substring"
+//	@Success		200		{object}	docsResponse.DesiredResultList200	"List of desiredResults"
+//	@Failure		400		{object}	docsResponse.Response400			"Invalid page, perPage, sort or filter value"
+//	@Failure		403		{object}	docsResponse.Response403			"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		500		{object}	docsResponse.Response500			"Server error"
 //	@Router			/api/v1/desired-result [get]
-func (h *Handler) GetAll(w http.ResponseWriter) {
-	desiredResults, err := h.svc.GetAll()
+func (h *Handler) GetAll(w http.ResponseWriter, r *http.Request) {
+	query, errFields := request.ParseListQuery(r.URL.Query(), listOptions)
+	if errFields != nil {
+		msg := "invalid list query"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	desiredResults, total, err := h.svc.GetAll(query)
 	if err != nil {
 		msg := fmt.Sprintf("failed to retrieve desired results: %v", err)
 		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
 		return
 	}
 
-	response.SendSuccess(w, http.StatusOK, desiredResults)
+	response.SendPaginated(w, http.StatusOK, desiredResults, response.Pagination{Total: total, Page: query.Page, PerPage: query.PerPage})
 }
 
 // GetById retrieves a desiredResult by its ID
@@ -225,12 +250,17 @@ func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	desiredResult, err := h.svc.Delete(uint(id))
+	desiredResult, linkedEntitiesCount, err := h.svc.Delete(uint(id))
 	if desiredResult == nil {
 		msg := fmt.Sprintf("desired result with id %d not found", id)
 		response.SendError(w, http.StatusNotFound, msg, response.NotFound)
 		return
 	}
+	if linkedEntitiesCount > 0 {
+		msg := fmt.Sprintf("desired result with id %d cannot be deleted because it is used by %d products", id, linkedEntitiesCount)
+		response.SendError(w, http.StatusConflict, msg, response.HasLinkedEntities)
+		return
+	}
 	if err != nil {
 		msg := fmt.Sprintf("failed to delete desired result: %v", err)
 		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
@@ -239,3 +269,89 @@ func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
 
 	response.SendSuccess(w, http.StatusOK, desiredResult)
 }
+
+// GetTrash retrieves deleted desired results
+//
+//	@Summary		Get deleted desired results
+//	@Description	Retrieve desired results in the trash. They are purged permanently after the retention period
+//	@Tags			DesiredResult
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			page	query		int									false	"Page number, starting from 1"
+//	@Param			perPage	query		int									false	"Items per page, up to 100 (default 20)"
+//	@Param			sort	query		string								false	"Comma-separated sort fields, prefix with - for descending, e.g. -deletedAt"
+//	@Success		200		{object}	docsResponse.DesiredResultList200	"Deleted desired results"
+//	@Failure		400		{object}	docsResponse.Response400			"Invalid page, perPage, sort or filter value"
+//	@Failure		401		{object}	docsResponse.Response401			"Unauthorized"
+//	@Failure		403		{object}	docsResponse.Response403			"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		500		{object}	docsResponse.Response500			"Server error"
+//	@Router			/api/v1/desired-result/trash [get]
+func (h *Handler) GetTrash(w http.ResponseWriter, r *http.Request) {
+	query, errFields := request.ParseListQuery(r.URL.Query(), listOptions)
+	if errFields != nil {
+		msg := "invalid list query"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	desiredResultList, total, err := h.svc.GetTrash(query)
+	if err != nil {
+		msg := fmt.Sprintf("failed to retrieve deleted desired results: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+
+	response.SendPaginated(w, http.StatusOK, desiredResultList, response.Pagination{Total: total, Page: query.Page, PerPage: query.PerPage})
+}
+
+// Restore restores a deleted desired result
+//
+//	@Summary		Restore desired result
+//	@Description	Move a desired result out of the trash
+//	@Tags			DesiredResult
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			id	path		int										true	"DesiredResult ID"
+//	@Success		200	{object}	docsResponse.DesiredResultGetById200	"DesiredResult restored"
+//	@Failure		400	{object}	docsResponse.DesiredResultCreate400		"Invalid ID or the restored values are taken"
+//	@Failure		401	{object}	docsResponse.Response401				"Unauthorized"
+//	@Failure		403	{object}	docsResponse.Response403				"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		404	{object}	docsResponse.Response404				"DesiredResult not found in the trash"
+//	@Failure		500	{object}	docsResponse.Response500				"Server error"
+//	@Router			/api/v1/desired-result/{id}/restore [patch]
+func (h *Handler) Restore(w http.ResponseWriter, r *http.Request) {
+	idStr := r.PathValue("id")
+	if idStr == "" {
+		msg := "missing desired result id"
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	id, err := strconv.Atoi(idStr)
+	if err != nil || id < 0 {
+		msg := fmt.Sprintf("invalid desired result id: %s", idStr)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	desiredResult, errFields, err := h.svc.Restore(uint(id))
+	if err != nil {
+		msg := fmt.Sprintf("failed to restore desired result: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+	if errFields != nil {
+		msg := "validation errors occurred"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+	if desiredResult == nil {
+		msg := fmt.Sprintf("deleted desired result with id %d not found", id)
+		response.SendError(w, http.StatusNotFound, msg, response.NotFound)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, desiredResult)
+}
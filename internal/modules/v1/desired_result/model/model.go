@@ -1,10 +1,14 @@
 package model
 
-import "time"
+import (
+	"gorm.io/gorm"
+	"time"
+)
 
 type DesiredResult struct {
 	ID        uint `gorm:"primarykey" json:"id"`
 	CreatedAt time.Time
 	UpdatedAt time.Time
-	Name      string `gorm:"type:varchar(255);not null;unique" json:"name"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"deletedAt"`
+	Name      string         `gorm:"type:varchar(255);not null;index:idx_desired_results_name,unique,where:deleted_at IS NULL" json:"name"`
 }
@@ -5,15 +5,23 @@ import (
 	"gorm.io/gorm"
 	"haircompany-shop-rest/internal/modules/v1/desired_result/model"
 	"haircompany-shop-rest/pkg/database"
+	"haircompany-shop-rest/pkg/request"
+	"time"
 )
 
 type Repository interface {
 	Create(model *model.DesiredResult) (*model.DesiredResult, error)
-	GetAll() ([]*model.DesiredResult, error)
+	GetAll(query request.ListQuery) ([]*model.DesiredResult, int64, error)
 	GetById(id uint) (*model.DesiredResult, error)
+	GetByIds(ids []uint) ([]*model.DesiredResult, error)
 	Update(model *model.DesiredResult) (*model.DesiredResult, error)
 	Delete(id uint) error
 	GetByUniqueFields(name string) (*model.DesiredResult, error)
+	GetDeleted(query request.ListQuery) ([]*model.DesiredResult, int64, error)
+	GetDeletedById(id uint) (*model.DesiredResult, error)
+	Restore(id uint) error
+	PurgeDeleted(before time.Time) ([]*model.DesiredResult, error)
+	CountProductsByDesiredResultId(desiredResultId uint) (int64, error)
 }
 
 type repository struct {
@@ -35,16 +43,8 @@ func (r *repository) Create(model *model.DesiredResult) (*model.DesiredResult, e
 	return model, nil
 }
 
-func (r *repository) GetAll() ([]*model.DesiredResult, error) {
-	var desiredResults []*model.DesiredResult
-	var err error
-
-	result := r.DB.Find(&desiredResults)
-	if result.Error != nil {
-		err = result.Error
-	}
-
-	return desiredResults, err
+func (r *repository) GetAll(query request.ListQuery) ([]*model.DesiredResult, int64, error) {
+	return database.FindPage[model.DesiredResult](r.DB.DB, query)
 }
 
 func (r *repository) GetById(id uint) (*model.DesiredResult, error) {
@@ -62,6 +62,20 @@ func (r *repository) GetById(id uint) (*model.DesiredResult, error) {
 	return desiredResult, err
 }
 
+func (r *repository) GetByIds(ids []uint) ([]*model.DesiredResult, error) {
+	var desiredResults []*model.DesiredResult
+	if len(ids) == 0 {
+		return desiredResults, nil
+	}
+
+	result := r.DB.Find(&desiredResults, ids)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return desiredResults, nil
+}
+
 func (r *repository) Update(model *model.DesiredResult) (*model.DesiredResult, error) {
 	result := r.DB.Save(&model)
 	if result.Error != nil {
@@ -94,3 +108,29 @@ func (r *repository) GetByUniqueFields(name string) (*model.DesiredResult, error
 
 	return desiredResult, err
 }
+
+func (r *repository) GetDeleted(query request.ListQuery) ([]*model.DesiredResult, int64, error) {
+	return database.FindDeletedPage[model.DesiredResult](r.DB.DB, query)
+}
+
+func (r *repository) GetDeletedById(id uint) (*model.DesiredResult, error) {
+	return database.FindDeleted[model.DesiredResult](r.DB.DB, id)
+}
+
+func (r *repository) Restore(id uint) error {
+	return database.Restore[model.DesiredResult](r.DB.DB, id)
+}
+
+func (r *repository) PurgeDeleted(before time.Time) ([]*model.DesiredResult, error) {
+	return database.PurgeDeleted[model.DesiredResult](r.DB.DB, before)
+}
+
+func (r *repository) CountProductsByDesiredResultId(desiredResultId uint) (int64, error) {
+	var count int64
+	result := r.DB.Table("product_desired_results").Where("desired_result_id = ?", desiredResultId).Count(&count)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return count, nil
+}
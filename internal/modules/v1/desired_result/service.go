@@ -3,16 +3,21 @@ package desired_result
 import (
 	"errors"
 	"haircompany-shop-rest/internal/modules/v1/desired_result/dto"
+	"haircompany-shop-rest/pkg/request"
 	"haircompany-shop-rest/pkg/response"
 	"log"
+	"time"
 )
 
 type Service interface {
 	Create(createDto dto.CreateDTO) (*dto.ResponseDTO, []response.ErrorField, error)
-	GetAll() ([]*dto.ResponseDTO, error)
+	GetAll(query request.ListQuery) ([]*dto.ResponseDTO, int64, error)
 	GetById(id uint) (*dto.ResponseDTO, error)
 	Update(id uint, updateDto dto.UpdateDTO) (*dto.ResponseDTO, []response.ErrorField, error)
-	Delete(id uint) (*dto.ResponseDTO, error)
+	Delete(id uint) (*dto.ResponseDTO, int64, error)
+	GetTrash(query request.ListQuery) ([]*dto.ResponseDTO, int64, error)
+	Restore(id uint) (*dto.ResponseDTO, []response.ErrorField, error)
+	Purge(before time.Time) (int, error)
 }
 
 type service struct {
@@ -50,9 +55,9 @@ func (c *service) Create(createDto dto.CreateDTO) (*dto.ResponseDTO, []response.
 	return createdDesiredResultResponse, nil, nil
 }
 
-func (c *service) GetAll() ([]*dto.ResponseDTO, error) {
+func (c *service) GetAll(query request.ListQuery) ([]*dto.ResponseDTO, int64, error) {
 	desiredResultDTOs := make([]*dto.ResponseDTO, 0)
-	models, err := c.repo.GetAll()
+	models, total, err := c.repo.GetAll(query)
 	if err != nil {
 		log.Printf("error retrieving desired results: %v", err)
 	}
@@ -62,7 +67,7 @@ func (c *service) GetAll() ([]*dto.ResponseDTO, error) {
 		desiredResultDTOs = append(desiredResultDTOs, desiredResultResponse)
 	}
 
-	return desiredResultDTOs, err
+	return desiredResultDTOs, total, err
 }
 
 func (c *service) GetById(id uint) (*dto.ResponseDTO, error) {
@@ -110,18 +115,75 @@ func (c *service) Update(id uint, updateDto dto.UpdateDTO) (*dto.ResponseDTO, []
 
 }
 
-func (c *service) Delete(id uint) (*dto.ResponseDTO, error) {
+func (c *service) Delete(id uint) (*dto.ResponseDTO, int64, error) {
+	var linkedEntitiesCount int64
 	existedDesiredResult, err := c.repo.GetById(id)
 	if existedDesiredResult == nil {
-		return nil, err
+		return nil, linkedEntitiesCount, err
 	}
 
 	desiredResultDTO := dto.TransformModelToResponseDTO(existedDesiredResult)
+	linkedEntitiesCount, err = c.repo.CountProductsByDesiredResultId(id)
+	if err != nil {
+		return desiredResultDTO, linkedEntitiesCount, err
+	}
+	if linkedEntitiesCount > 0 {
+		return desiredResultDTO, linkedEntitiesCount, errors.New("desired result has products and cannot be deleted")
+	}
 
 	err = c.repo.Delete(id)
 	if err != nil {
-		return desiredResultDTO, err
+		return desiredResultDTO, linkedEntitiesCount, err
+	}
+
+	return desiredResultDTO, linkedEntitiesCount, nil
+}
+
+func (c *service) GetTrash(query request.ListQuery) ([]*dto.ResponseDTO, int64, error) {
+	desiredResultDTOs := make([]*dto.ResponseDTO, 0)
+	models, total, err := c.repo.GetDeleted(query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for _, model := range models {
+		desiredResultDTOs = append(desiredResultDTOs, dto.TransformModelToResponseDTO(model))
+	}
+
+	return desiredResultDTOs, total, nil
+}
+
+func (c *service) Restore(id uint) (*dto.ResponseDTO, []response.ErrorField, error) {
+	deletedDesiredResult, err := c.repo.GetDeletedById(id)
+	if deletedDesiredResult == nil {
+		return nil, nil, err
+	}
+
+	existingDesiredResult, err := c.repo.GetByUniqueFields(deletedDesiredResult.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+	if existingDesiredResult != nil {
+		return nil, []response.ErrorField{response.NewErrorField("name", string(response.NotUnique))}, nil
+	}
+
+	if err := c.repo.Restore(id); err != nil {
+		return nil, nil, err
+	}
+
+	restoredDesiredResult, err := c.repo.GetById(id)
+	if restoredDesiredResult == nil {
+		return nil, nil, err
+	}
+
+	return dto.TransformModelToResponseDTO(restoredDesiredResult), nil, nil
+}
+
+func (c *service) Purge(before time.Time) (int, error) {
+	purged, err := c.repo.PurgeDeleted(before)
+	if err != nil {
+		return 0, err
 	}
 
-	return desiredResultDTO, nil
+	return len(purged), nil
 }
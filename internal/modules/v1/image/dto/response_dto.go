@@ -1,5 +1,18 @@
 package dto
 
 type ResponseDTO struct {
-	Image string `json:"image"`
+	Image    string       `json:"image" example:"photo_1a2b3c4d5e6f7a8b.png"`
+	URL      string       `json:"url" example:"/uploads/images/category/photo_1a2b3c4d5e6f7a8b.png"`
+	WebP     string       `json:"webp" example:"/uploads/images/category/photo_1a2b3c4d5e6f7a8b.webp"`
+	Variants []VariantDTO `json:"variants"`
+}
+
+// VariantDTO — уменьшенная копия изображения. Width и Height — максимальные
+// размеры варианта, изображение меньше них не увеличивается.
+type VariantDTO struct {
+	Name   string `json:"name" example:"thumb"`
+	Width  int    `json:"width" example:"400"`
+	Height int    `json:"height" example:"400"`
+	URL    string `json:"url" example:"/uploads/images/category/photo_1a2b3c4d5e6f7a8b@thumb.png"`
+	WebP   string `json:"webp" example:"/uploads/images/category/photo_1a2b3c4d5e6f7a8b@thumb.webp"`
 }
@@ -1,7 +1,53 @@
 package dto
 
-func TransformImageToResponseDTO(fileName string) *ResponseDTO {
-	return &ResponseDTO{
-		Image: fileName,
+import (
+	"haircompany-shop-rest/internal/services"
+	"net/url"
+	"strings"
+)
+
+// baseURL — публичный адрес хранилища загрузок, задаётся при запуске приложения.
+var baseURL = "/uploads"
+
+func SetBaseURL(u string) {
+	baseURL = strings.TrimSuffix(u, "/")
+}
+
+// TransformImageToResponseDTO строит ссылки на изображение и его варианты.
+// Ссылки указывают на постоянный каталог типа изображения: файл появляется там
+// после сохранения сущности, к которой он привязан.
+func TransformImageToResponseDTO(fileName, imageType string) *ResponseDTO {
+	folder := "images/" + imageType
+	variants := services.ImageVariants[imageType]
+
+	responseDTO := &ResponseDTO{
+		Image:    fileName,
+		URL:      fileURL(folder, fileName),
+		WebP:     fileURL(folder, services.VariantFilename(fileName, "", ".webp")),
+		Variants: make([]VariantDTO, 0, len(variants)),
+	}
+	for _, variant := range variants {
+		responseDTO.Variants = append(responseDTO.Variants, VariantDTO{
+			Name:   variant.Name,
+			Width:  variant.Width,
+			Height: variant.Height,
+			URL:    fileURL(folder, services.VariantFilename(fileName, variant.Name, "")),
+			WebP:   fileURL(folder, services.VariantFilename(fileName, variant.Name, ".webp")),
+		})
 	}
+
+	return responseDTO
+}
+
+// TransformFilenameToResponseDTO — то же для сохранённой сущности; для пустого
+// имени файла возвращает nil.
+func TransformFilenameToResponseDTO(fileName, imageType string) *ResponseDTO {
+	if fileName == "" {
+		return nil
+	}
+	return TransformImageToResponseDTO(fileName, imageType)
+}
+
+func fileURL(folder, fileName string) string {
+	return baseURL + "/" + folder + "/" + url.PathEscape(fileName)
 }
@@ -1,10 +1,13 @@
 package image
 
 import (
+	"errors"
 	"fmt"
 	"haircompany-shop-rest/internal/constraint"
 	"haircompany-shop-rest/internal/modules/v1/image/dto"
+	"haircompany-shop-rest/internal/services"
 	"haircompany-shop-rest/pkg/response"
+	"log"
 	"mime/multipart"
 	"net/http"
 	"strings"
@@ -24,6 +27,9 @@ func NewHandler(s Service) *Handler {
 //
 //	@Summary		Upload images
 //	@Description	Upload one or more images with a specific imageType. Requires JWT authentication.
+//	@Description	EXIF metadata is removed and the orientation is applied. Besides the original, a WebP copy and the size variants configured for the imageType are generated.
+//	@Description	The file type is detected by its content. Validation error codes per file: FILE_TOO_LARGE, INVALID_FILE_TYPE, CONTENT_TYPE_MISMATCH, INVALID_IMAGE, IMAGE_TOO_SMALL, IMAGE_TOO_LARGE, INVALID_ASPECT_RATIO.
+//	@Description	Returned URLs point to the permanent folder of the imageType and become available once the image is saved to an entity.
 //	@Tags			Image
 //	@Security		BearerAuth
 //	@Security		AppAuth
@@ -79,31 +85,37 @@ func (h *Handler) Upload(w http.ResponseWriter, r *http.Request) {
 	var uploadedImages []*dto.ResponseDTO
 
 	for _, fileHeader := range files {
-		func(fileHeader *multipart.FileHeader) {
+		sent := func(fileHeader *multipart.FileHeader) bool {
 			file, err := fileHeader.Open()
 			if err != nil {
 				msg := fmt.Sprintf("failed to open file: %v", err)
 				response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
-				return
+				return true
 			}
 			defer func(file multipart.File) {
-				err := file.Close()
-				if err != nil {
-					msg := fmt.Sprintf("failed to close file: %v", err)
-					response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
-					return
+				if err := file.Close(); err != nil {
+					log.Printf("failed to close file: %v", err)
 				}
 			}(file)
 
-			imageDTO, err := h.svc.UploadImage(file, fileHeader.Filename)
+			imageDTO, err := h.svc.UploadImage(file, fileHeader.Filename, imageType[0])
+			if errors.Is(err, services.ErrInvalidImage) {
+				msg := fmt.Sprintf("failed to decode image %s", fileHeader.Filename)
+				response.SendError(w, http.StatusBadRequest, msg, response.InvalidFileType)
+				return true
+			}
 			if err != nil {
 				msg := fmt.Sprintf("failed to upload image: %v", err)
 				response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
-				return
+				return true
 			}
 
 			uploadedImages = append(uploadedImages, imageDTO)
+			return false
 		}(fileHeader)
+		if sent {
+			return
+		}
 	}
 	if len(uploadedImages) == 0 {
 		msg := "no images were uploaded"
@@ -3,12 +3,14 @@ package image
 import (
 	"haircompany-shop-rest/internal/container"
 	"haircompany-shop-rest/internal/middleware"
+	"haircompany-shop-rest/internal/modules/v1/upload"
 	"haircompany-shop-rest/pkg/response"
 	"net/http"
 )
 
 func RegisterV1ImageRoutes(mux *http.ServeMux, container *container.Container) {
-	svc := NewService(container.FileService)
+	uploads := upload.NewService(upload.NewRepository(container.DB), container.FileService, container.Storage)
+	svc := NewService(container.FileService, uploads)
 	h := NewHandler(svc)
 
 	mux.Handle("/image/upload",
@@ -23,7 +25,7 @@ func RegisterV1ImageRoutes(mux *http.ServeMux, container *container.Container) {
 				}
 			}),
 			middleware.DashboardRoleMiddleware("admin"),
-			middleware.DashboardAuthMiddleware(container.JWTService),
+			middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist),
 		),
 	)
 }
@@ -1,35 +1,64 @@
 package image
 
 import (
+	"bytes"
 	"haircompany-shop-rest/internal/modules/v1/image/dto"
+	"haircompany-shop-rest/internal/modules/v1/upload"
 	"haircompany-shop-rest/internal/services"
+	"io"
 	"log"
-	"mime/multipart"
+	"path/filepath"
 )
 
 type Service interface {
-	UploadImage(file multipart.File, filename string) (*dto.ResponseDTO, error)
+	UploadImage(file io.Reader, filename, imageType string) (*dto.ResponseDTO, error)
 }
 
 type service struct {
 	fileService services.FileSystemService
+	uploads     upload.Service
 }
 
-func NewService(fs services.FileSystemService) Service {
+func NewService(fs services.FileSystemService, uploads upload.Service) Service {
 	return &service{
 		fileService: fs,
+		uploads:     uploads,
 	}
 }
 
-func (s *service) UploadImage(file multipart.File, filename string) (*dto.ResponseDTO, error) {
-	var imageDTO *dto.ResponseDTO
-	newFilename, err := s.fileService.SaveToTemp(file, filename)
+// UploadImage сохраняет во временный каталог очищенный от EXIF оригинал, его
+// WebP-копию и варианты, настроенные для типа изображения.
+func (s *service) UploadImage(file io.Reader, filename, imageType string) (*dto.ResponseDTO, error) {
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, err
+	}
+
+	renditions, err := services.ProcessImage(data, filepath.Ext(filename), services.ImageVariants[imageType])
+	if err != nil {
+		log.Printf("failed to process image: %v", err)
+		return nil, err
+	}
+
+	// Первая версия — сам оригинал, под его именем сохраняются остальные
+	newFilename, err := s.fileService.SaveToTemp(bytes.NewReader(renditions[0].Data), filename)
 	if err != nil {
 		log.Printf("failed to upload image: %v", err)
 		return nil, err
 	}
 
-	imageDTO = dto.TransformImageToResponseDTO(newFilename)
+	for _, rendition := range renditions[1:] {
+		variantFilename := services.VariantFilename(newFilename, rendition.Variant, rendition.Ext)
+		if err := s.fileService.WriteToTemp(bytes.NewReader(rendition.Data), variantFilename); err != nil {
+			log.Printf("failed to upload image variant: %v", err)
+			return nil, err
+		}
+	}
+
+	if err := s.uploads.Track(newFilename, imageType); err != nil {
+		log.Printf("failed to track uploaded image: %v", err)
+		return nil, err
+	}
 
-	return imageDTO, nil
+	return dto.TransformImageToResponseDTO(newFilename, imageType), nil
 }
@@ -0,0 +1,6 @@
+package dto
+
+type AdjustDTO struct {
+	Delta  int    `json:"delta" validate:"required" example:"10"`
+	Reason string `json:"reason" validate:"required,min=3,max=255" example:"Поступление на склад"`
+}
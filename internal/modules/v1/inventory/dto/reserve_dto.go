@@ -0,0 +1,6 @@
+package dto
+
+type ReserveItemDTO struct {
+	ProductVariantID uint
+	Quantity         int
+}
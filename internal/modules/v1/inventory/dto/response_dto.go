@@ -0,0 +1,23 @@
+package dto
+
+import "time"
+
+type StockResponseDTO struct {
+	ProductVariantID uint      `json:"productVariantId" example:"1"`
+	Quantity         int       `json:"quantity" example:"25"`
+	Reserved         int       `json:"reserved" example:"3"`
+	Available        int       `json:"available" example:"22"`
+	UpdatedAt        time.Time `json:"updatedAt" example:"2023-10-01T12:00:00Z"`
+}
+
+type MovementResponseDTO struct {
+	Id               uint      `json:"id" example:"1"`
+	CreatedAt        time.Time `json:"createdAt" example:"2023-10-01T12:00:00Z"`
+	ProductVariantID uint      `json:"productVariantId" example:"1"`
+	Type             string    `json:"type" enums:"adjustment,reserve,release,commit"`
+	QuantityDelta    int       `json:"quantityDelta" example:"10"`
+	ReservedDelta    int       `json:"reservedDelta" example:"0"`
+	Reason           string    `json:"reason" example:"Поступление на склад"`
+	Reference        string    `json:"reference" example:"order-15"`
+	CreatedBy        string    `json:"createdBy" example:"admin@example.com"`
+}
@@ -0,0 +1,27 @@
+package dto
+
+import "haircompany-shop-rest/internal/modules/v1/inventory/model"
+
+func TransformStockToResponseDTO(stock *model.Stock) *StockResponseDTO {
+	return &StockResponseDTO{
+		ProductVariantID: stock.ProductVariantID,
+		Quantity:         stock.Quantity,
+		Reserved:         stock.Reserved,
+		Available:        stock.Available(),
+		UpdatedAt:        stock.UpdatedAt,
+	}
+}
+
+func TransformMovementToResponseDTO(movement *model.StockMovement) *MovementResponseDTO {
+	return &MovementResponseDTO{
+		Id:               movement.ID,
+		CreatedAt:        movement.CreatedAt,
+		ProductVariantID: movement.ProductVariantID,
+		Type:             movement.Type,
+		QuantityDelta:    movement.QuantityDelta,
+		ReservedDelta:    movement.ReservedDelta,
+		Reason:           movement.Reason,
+		Reference:        movement.Reference,
+		CreatedBy:        movement.CreatedBy,
+	}
+}
@@ -0,0 +1,165 @@
+package inventory
+
+import (
+	"errors"
+	"fmt"
+	"haircompany-shop-rest/internal/constraint"
+	"haircompany-shop-rest/internal/modules/v1/inventory/dto"
+	"haircompany-shop-rest/internal/services"
+	"haircompany-shop-rest/pkg/request"
+	"haircompany-shop-rest/pkg/response"
+	"net/http"
+	"strconv"
+)
+
+type Handler struct {
+	svc Service
+}
+
+func NewHandler(s Service) *Handler {
+	return &Handler{
+		svc: s,
+	}
+}
+
+// GetByVariantId retrieves stock of a product variant
+//
+//	@Summary		Get stock of product variant
+//	@Description	Retrieve quantity, reserved and available stock of a product variant
+//	@Tags			Inventory
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			variantId	path		int							true	"Product variant ID"
+//	@Success		200			{object}	docsResponse.StockGet200	"Stock found"
+//	@Failure		400			{object}	docsResponse.Response400	"Invalid ID"
+//	@Failure		401			{object}	docsResponse.Response401	"Unauthorized"
+//	@Failure		403			{object}	docsResponse.Response403	"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		404			{object}	docsResponse.Response404	"Product variant not found"
+//	@Failure		500			{object}	docsResponse.Response500	"Server error"
+//	@Router			/api/v1/inventory/{variantId} [get]
+func (h *Handler) GetByVariantId(w http.ResponseWriter, r *http.Request) {
+	variantIdStr := r.PathValue("variantId")
+	variantId, err := strconv.Atoi(variantIdStr)
+	if err != nil || variantId < 0 {
+		msg := fmt.Sprintf("invalid product variant id: %s", variantIdStr)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	stock, errFields, err := h.svc.GetByVariantId(uint(variantId))
+	if err != nil {
+		msg := fmt.Sprintf("failed to retrieve stock: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+	if errFields != nil {
+		msg := fmt.Sprintf("product variant with id %d not found", variantId)
+		response.SendError(w, http.StatusNotFound, msg, response.NotFound)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, stock)
+}
+
+// Adjust changes stock quantity of a product variant
+//
+//	@Summary		Adjust stock
+//	@Description	Increase or decrease stock of a product variant. Every adjustment is written to the stock movement ledger.
+//	@Tags			Inventory
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			variantId	path		int							true	"Product variant ID"
+//	@Param			adjustment	body		dto.AdjustDTO				true	"Stock adjustment"
+//	@Success		200			{object}	docsResponse.StockGet200	"Stock adjusted"
+//	@Failure		400			{object}	docsResponse.StockAdjust400	"Bad request or validation error"
+//	@Failure		401			{object}	docsResponse.Response401	"Unauthorized"
+//	@Failure		403			{object}	docsResponse.Response403	"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		404			{object}	docsResponse.Response404	"Product variant not found"
+//	@Failure		409			{object}	docsResponse.StockAdjust409	"Not enough stock"
+//	@Failure		500			{object}	docsResponse.Response500	"Server error"
+//	@Router			/api/v1/inventory/{variantId}/adjust [post]
+func (h *Handler) Adjust(w http.ResponseWriter, r *http.Request) {
+	variantIdStr := r.PathValue("variantId")
+	variantId, err := strconv.Atoi(variantIdStr)
+	if err != nil || variantId < 0 {
+		msg := fmt.Sprintf("invalid product variant id: %s", variantIdStr)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	adjustDto, err := request.DecodeBody[dto.AdjustDTO](r.Body)
+	if err != nil {
+		msg := fmt.Sprintf("invalid request body: %v", err)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	errFields := constraint.ValidateDTO(adjustDto)
+	if errFields != nil {
+		msg := "validation errors occurred"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	var createdBy string
+	if claims, ok := r.Context().Value("dashboardClaims").(*services.DashboardClaims); ok && claims != nil {
+		createdBy = claims.Email
+	}
+
+	stock, errFields, err := h.svc.Adjust(uint(variantId), adjustDto, createdBy)
+	if err != nil {
+		var stockErr *InsufficientStockError
+		if errors.As(err, &stockErr) {
+			response.SendError(w, http.StatusConflict, stockErr.Error(), response.InsufficientStock)
+			return
+		}
+
+		msg := fmt.Sprintf("failed to adjust stock: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+	if errFields != nil {
+		msg := fmt.Sprintf("product variant with id %d not found", variantId)
+		response.SendError(w, http.StatusNotFound, msg, response.NotFound)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, stock)
+}
+
+// GetMovements retrieves the stock movement ledger of a product variant
+//
+//	@Summary		Get stock movements
+//	@Description	Retrieve stock movements of a product variant, newest first
+//	@Tags			Inventory
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			variantId	path		int									true	"Product variant ID"
+//	@Success		200			{object}	docsResponse.StockMovementList200	"List of stock movements"
+//	@Failure		400			{object}	docsResponse.Response400			"Invalid ID"
+//	@Failure		401			{object}	docsResponse.Response401			"Unauthorized"
+//	@Failure		403			{object}	docsResponse.Response403			"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		500			{object}	docsResponse.Response500			"Server error"
+//	@Router			/api/v1/inventory/{variantId}/movements [get]
+func (h *Handler) GetMovements(w http.ResponseWriter, r *http.Request) {
+	variantIdStr := r.PathValue("variantId")
+	variantId, err := strconv.Atoi(variantIdStr)
+	if err != nil || variantId < 0 {
+		msg := fmt.Sprintf("invalid product variant id: %s", variantIdStr)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	movements, err := h.svc.GetMovements(uint(variantId))
+	if err != nil {
+		msg := fmt.Sprintf("failed to retrieve stock movements: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, movements)
+}
@@ -0,0 +1,50 @@
+package model
+
+import "time"
+
+const (
+	MovementTypeAdjustment = "adjustment"
+	MovementTypeReserve    = "reserve"
+	MovementTypeRelease    = "release"
+	MovementTypeCommit     = "commit"
+)
+
+const (
+	ReservationStatusActive    = "active"
+	ReservationStatusReleased  = "released"
+	ReservationStatusCommitted = "committed"
+)
+
+type Stock struct {
+	ProductVariantID uint `gorm:"primarykey;autoIncrement:false" json:"productVariantId"`
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	Quantity         int `gorm:"not null;default:0" json:"quantity"`
+	Reserved         int `gorm:"not null;default:0" json:"reserved"`
+}
+
+func (s *Stock) Available() int {
+	return s.Quantity - s.Reserved
+}
+
+type StockMovement struct {
+	ID               uint `gorm:"primarykey" json:"id"`
+	CreatedAt        time.Time
+	ProductVariantID uint   `gorm:"not null;index" json:"productVariantId"`
+	Type             string `gorm:"type:varchar(16);not null" json:"type"`
+	QuantityDelta    int    `gorm:"not null;default:0" json:"quantityDelta"`
+	ReservedDelta    int    `gorm:"not null;default:0" json:"reservedDelta"`
+	Reason           string `gorm:"type:varchar(255)" json:"reason"`
+	Reference        string `gorm:"type:varchar(64)" json:"reference"`
+	CreatedBy        string `gorm:"type:varchar(255)" json:"createdBy"`
+}
+
+type StockReservation struct {
+	ID               uint `gorm:"primarykey" json:"id"`
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	Reference        string `gorm:"type:varchar(64);not null;index" json:"reference"`
+	ProductVariantID uint   `gorm:"not null" json:"productVariantId"`
+	Quantity         int    `gorm:"not null" json:"quantity"`
+	Status           string `gorm:"type:varchar(16);not null;default:active" json:"status"`
+}
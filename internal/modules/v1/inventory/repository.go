@@ -0,0 +1,188 @@
+package inventory
+
+import (
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"haircompany-shop-rest/internal/modules/v1/inventory/model"
+	"haircompany-shop-rest/pkg/database"
+	"time"
+)
+
+type Repository interface {
+	Transaction(fn func(repo Repository) error) error
+	GetByVariantId(variantId uint) (*model.Stock, error)
+	GetByVariantIds(variantIds []uint) ([]*model.Stock, error)
+	EnsureStock(variantId uint) error
+	ChangeQuantity(variantId uint, delta int) (bool, error)
+	Reserve(variantId uint, quantity int) (bool, error)
+	Release(variantId uint, quantity int) error
+	Commit(variantId uint, quantity int) error
+	CreateMovement(movement *model.StockMovement) error
+	GetMovementsByVariantId(variantId uint) ([]*model.StockMovement, error)
+	CreateReservation(reservation *model.StockReservation) error
+	GetActiveReservations(reference string) ([]*model.StockReservation, error)
+	ChangeReservationStatus(id uint, status string) (bool, error)
+}
+
+type repository struct {
+	DB *database.DB
+}
+
+func NewRepository(db *database.DB) Repository {
+	return &repository{
+		DB: db,
+	}
+}
+
+// Transaction runs fn against a repository bound to a single database transaction.
+// When the repository is already bound to a transaction a savepoint is used instead.
+func (r *repository) Transaction(fn func(repo Repository) error) error {
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		return fn(NewRepository(&database.DB{DB: tx}))
+	})
+}
+
+func (r *repository) GetByVariantId(variantId uint) (*model.Stock, error) {
+	var stock *model.Stock
+	var err error
+
+	result := r.DB.First(&stock, "product_variant_id = ?", variantId)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		err = result.Error
+	}
+
+	return stock, err
+}
+
+func (r *repository) GetByVariantIds(variantIds []uint) ([]*model.Stock, error) {
+	var stocks []*model.Stock
+	if len(variantIds) == 0 {
+		return stocks, nil
+	}
+
+	result := r.DB.Where("product_variant_id IN ?", variantIds).Find(&stocks)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return stocks, nil
+}
+
+func (r *repository) EnsureStock(variantId uint) error {
+	result := r.DB.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.Stock{ProductVariantID: variantId})
+
+	return result.Error
+}
+
+// ChangeQuantity atomically adds delta to the stock quantity. It reports false
+// when the result would drop below the amount that is already reserved.
+func (r *repository) ChangeQuantity(variantId uint, delta int) (bool, error) {
+	result := r.DB.Model(&model.Stock{}).
+		Where("product_variant_id = ? AND quantity + ? >= reserved", variantId, delta).
+		Updates(map[string]interface{}{
+			"quantity":   gorm.Expr("quantity + ?", delta),
+			"updated_at": time.Now(),
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// Reserve atomically moves quantity into the reserved pool. It reports false
+// when there is not enough available stock.
+func (r *repository) Reserve(variantId uint, quantity int) (bool, error) {
+	result := r.DB.Model(&model.Stock{}).
+		Where("product_variant_id = ? AND quantity - reserved >= ?", variantId, quantity).
+		Updates(map[string]interface{}{
+			"reserved":   gorm.Expr("reserved + ?", quantity),
+			"updated_at": time.Now(),
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+func (r *repository) Release(variantId uint, quantity int) error {
+	result := r.DB.Model(&model.Stock{}).
+		Where("product_variant_id = ?", variantId).
+		Updates(map[string]interface{}{
+			"reserved":   gorm.Expr("reserved - ?", quantity),
+			"updated_at": time.Now(),
+		})
+
+	return result.Error
+}
+
+func (r *repository) Commit(variantId uint, quantity int) error {
+	result := r.DB.Model(&model.Stock{}).
+		Where("product_variant_id = ?", variantId).
+		Updates(map[string]interface{}{
+			"quantity":   gorm.Expr("quantity - ?", quantity),
+			"reserved":   gorm.Expr("reserved - ?", quantity),
+			"updated_at": time.Now(),
+		})
+
+	return result.Error
+}
+
+func (r *repository) CreateMovement(movement *model.StockMovement) error {
+	result := r.DB.Create(&movement)
+
+	return result.Error
+}
+
+func (r *repository) GetMovementsByVariantId(variantId uint) ([]*model.StockMovement, error) {
+	var movements []*model.StockMovement
+	var err error
+
+	result := r.DB.Where("product_variant_id = ?", variantId).Order("id DESC").Find(&movements)
+	if result.Error != nil {
+		err = result.Error
+	}
+
+	return movements, err
+}
+
+func (r *repository) CreateReservation(reservation *model.StockReservation) error {
+	result := r.DB.Create(&reservation)
+
+	return result.Error
+}
+
+func (r *repository) GetActiveReservations(reference string) ([]*model.StockReservation, error) {
+	var reservations []*model.StockReservation
+
+	result := r.DB.
+		Where("reference = ? AND status = ?", reference, model.ReservationStatusActive).
+		Order("product_variant_id").
+		Find(&reservations)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return reservations, nil
+}
+
+// ChangeReservationStatus moves an active reservation to the given status. It
+// reports false when the reservation has already been released or committed.
+func (r *repository) ChangeReservationStatus(id uint, status string) (bool, error) {
+	result := r.DB.Model(&model.StockReservation{}).
+		Where("id = ? AND status = ?", id, model.ReservationStatusActive).
+		Updates(map[string]interface{}{
+			"status":     status,
+			"updated_at": time.Now(),
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
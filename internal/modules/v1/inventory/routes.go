@@ -0,0 +1,64 @@
+package inventory
+
+import (
+	"haircompany-shop-rest/internal/container"
+	"haircompany-shop-rest/internal/middleware"
+	"haircompany-shop-rest/internal/modules/v1/product_variant"
+	"haircompany-shop-rest/pkg/response"
+	"net/http"
+)
+
+func RegisterV1InventoryRoutes(mux *http.ServeMux, container *container.Container) {
+	repo := NewRepository(container.DB)
+	variantRepo := product_variant.NewRepository(container.DB)
+	svc := NewService(repo, variantRepo)
+	h := NewHandler(svc)
+
+	mux.Handle("/inventory/{variantId}",
+		middleware.ChainMiddleware(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodGet:
+					h.GetByVariantId(w, r)
+				default:
+					msg := "Method not allowed. Allowed methods: GET"
+					response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+				}
+			}),
+			middleware.DashboardRoleMiddleware("admin"),
+			middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist),
+		),
+	)
+
+	mux.Handle("/inventory/{variantId}/adjust",
+		middleware.ChainMiddleware(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodPost:
+					h.Adjust(w, r)
+				default:
+					msg := "Method not allowed. Allowed methods: POST"
+					response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+				}
+			}),
+			middleware.DashboardRoleMiddleware("admin"),
+			middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist),
+		),
+	)
+
+	mux.Handle("/inventory/{variantId}/movements",
+		middleware.ChainMiddleware(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodGet:
+					h.GetMovements(w, r)
+				default:
+					msg := "Method not allowed. Allowed methods: GET"
+					response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+				}
+			}),
+			middleware.DashboardRoleMiddleware("admin"),
+			middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist),
+		),
+	)
+}
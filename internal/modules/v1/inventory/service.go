@@ -0,0 +1,291 @@
+package inventory
+
+import (
+	"fmt"
+	"haircompany-shop-rest/internal/modules/v1/inventory/dto"
+	"haircompany-shop-rest/internal/modules/v1/inventory/model"
+	"haircompany-shop-rest/internal/modules/v1/product_variant"
+	"haircompany-shop-rest/pkg/response"
+	"log"
+	"sort"
+)
+
+// InsufficientStockError is returned when a stock change would oversell a variant.
+type InsufficientStockError struct {
+	ProductVariantID uint
+	Requested        int
+	Available        int
+}
+
+func (e *InsufficientStockError) Error() string {
+	return fmt.Sprintf("insufficient stock for product variant %d: requested %d, available %d", e.ProductVariantID, e.Requested, e.Available)
+}
+
+type Service interface {
+	GetByVariantId(variantId uint) (*dto.StockResponseDTO, []response.ErrorField, error)
+	GetAvailable(variantIds []uint) (map[uint]int, error)
+	Adjust(variantId uint, adjustDto dto.AdjustDTO, createdBy string) (*dto.StockResponseDTO, []response.ErrorField, error)
+	GetMovements(variantId uint) ([]*dto.MovementResponseDTO, error)
+	Reserve(reference string, items []dto.ReserveItemDTO) error
+	Release(reference string) error
+	Commit(reference string) error
+}
+
+type service struct {
+	repo        Repository
+	variantRepo product_variant.Repository
+}
+
+func NewService(r Repository, variantRepo product_variant.Repository) Service {
+	return &service{
+		repo:        r,
+		variantRepo: variantRepo,
+	}
+}
+
+func (s *service) GetByVariantId(variantId uint) (*dto.StockResponseDTO, []response.ErrorField, error) {
+	errFields, err := s.validateVariant(variantId)
+	if err != nil || errFields != nil {
+		return nil, errFields, err
+	}
+
+	stock, err := s.repo.GetByVariantId(variantId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if stock == nil {
+		stock = &model.Stock{ProductVariantID: variantId}
+	}
+
+	return dto.TransformStockToResponseDTO(stock), nil, nil
+}
+
+func (s *service) GetAvailable(variantIds []uint) (map[uint]int, error) {
+	available := make(map[uint]int, len(variantIds))
+	for _, variantId := range variantIds {
+		available[variantId] = 0
+	}
+
+	stocks, err := s.repo.GetByVariantIds(variantIds)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, stock := range stocks {
+		available[stock.ProductVariantID] = stock.Available()
+	}
+
+	return available, nil
+}
+
+func (s *service) Adjust(variantId uint, adjustDto dto.AdjustDTO, createdBy string) (*dto.StockResponseDTO, []response.ErrorField, error) {
+	errFields, err := s.validateVariant(variantId)
+	if err != nil || errFields != nil {
+		return nil, errFields, err
+	}
+
+	var stock *model.Stock
+	err = s.repo.Transaction(func(repo Repository) error {
+		if err := repo.EnsureStock(variantId); err != nil {
+			return err
+		}
+
+		changed, err := repo.ChangeQuantity(variantId, adjustDto.Delta)
+		if err != nil {
+			return err
+		}
+		if !changed {
+			return s.insufficientStockError(repo, variantId, -adjustDto.Delta)
+		}
+
+		movement := &model.StockMovement{
+			ProductVariantID: variantId,
+			Type:             model.MovementTypeAdjustment,
+			QuantityDelta:    adjustDto.Delta,
+			Reason:           adjustDto.Reason,
+			CreatedBy:        createdBy,
+		}
+		if err := repo.CreateMovement(movement); err != nil {
+			return err
+		}
+
+		stock, err = repo.GetByVariantId(variantId)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return dto.TransformStockToResponseDTO(stock), nil, nil
+}
+
+func (s *service) GetMovements(variantId uint) ([]*dto.MovementResponseDTO, error) {
+	movementDTOs := make([]*dto.MovementResponseDTO, 0)
+	movements, err := s.repo.GetMovementsByVariantId(variantId)
+	if err != nil {
+		log.Printf("error retrieving stock movements: %v", err)
+	}
+
+	for _, movement := range movements {
+		movementDTOs = append(movementDTOs, dto.TransformMovementToResponseDTO(movement))
+	}
+
+	return movementDTOs, err
+}
+
+// Reserve holds stock for every item under the given reference. Either all
+// items are reserved or none of them are.
+func (s *service) Reserve(reference string, items []dto.ReserveItemDTO) error {
+	quantities := make(map[uint]int, len(items))
+	for _, item := range items {
+		quantities[item.ProductVariantID] += item.Quantity
+	}
+
+	// Lock rows in a stable order so concurrent checkouts cannot deadlock.
+	variantIds := make([]uint, 0, len(quantities))
+	for variantId := range quantities {
+		variantIds = append(variantIds, variantId)
+	}
+	sort.Slice(variantIds, func(i, j int) bool { return variantIds[i] < variantIds[j] })
+
+	return s.repo.Transaction(func(repo Repository) error {
+		for _, variantId := range variantIds {
+			quantity := quantities[variantId]
+			if quantity <= 0 {
+				continue
+			}
+
+			reserved, err := repo.Reserve(variantId, quantity)
+			if err != nil {
+				return err
+			}
+			if !reserved {
+				return s.insufficientStockError(repo, variantId, quantity)
+			}
+
+			reservation := &model.StockReservation{
+				Reference:        reference,
+				ProductVariantID: variantId,
+				Quantity:         quantity,
+				Status:           model.ReservationStatusActive,
+			}
+			if err := repo.CreateReservation(reservation); err != nil {
+				return err
+			}
+
+			movement := &model.StockMovement{
+				ProductVariantID: variantId,
+				Type:             model.MovementTypeReserve,
+				ReservedDelta:    quantity,
+				Reference:        reference,
+			}
+			if err := repo.CreateMovement(movement); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// Release returns every active reservation under the reference back to available stock.
+func (s *service) Release(reference string) error {
+	return s.repo.Transaction(func(repo Repository) error {
+		reservations, err := repo.GetActiveReservations(reference)
+		if err != nil {
+			return err
+		}
+
+		for _, reservation := range reservations {
+			changed, err := repo.ChangeReservationStatus(reservation.ID, model.ReservationStatusReleased)
+			if err != nil {
+				return err
+			}
+			if !changed {
+				continue
+			}
+
+			if err := repo.Release(reservation.ProductVariantID, reservation.Quantity); err != nil {
+				return err
+			}
+
+			movement := &model.StockMovement{
+				ProductVariantID: reservation.ProductVariantID,
+				Type:             model.MovementTypeRelease,
+				ReservedDelta:    -reservation.Quantity,
+				Reference:        reference,
+			}
+			if err := repo.CreateMovement(movement); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// Commit writes off every active reservation under the reference from the stock.
+func (s *service) Commit(reference string) error {
+	return s.repo.Transaction(func(repo Repository) error {
+		reservations, err := repo.GetActiveReservations(reference)
+		if err != nil {
+			return err
+		}
+
+		for _, reservation := range reservations {
+			changed, err := repo.ChangeReservationStatus(reservation.ID, model.ReservationStatusCommitted)
+			if err != nil {
+				return err
+			}
+			if !changed {
+				continue
+			}
+
+			if err := repo.Commit(reservation.ProductVariantID, reservation.Quantity); err != nil {
+				return err
+			}
+
+			movement := &model.StockMovement{
+				ProductVariantID: reservation.ProductVariantID,
+				Type:             model.MovementTypeCommit,
+				QuantityDelta:    -reservation.Quantity,
+				ReservedDelta:    -reservation.Quantity,
+				Reference:        reference,
+			}
+			if err := repo.CreateMovement(movement); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (s *service) validateVariant(variantId uint) ([]response.ErrorField, error) {
+	variant, err := s.variantRepo.GetById(variantId)
+	if err != nil {
+		return nil, err
+	}
+	if variant == nil {
+		return []response.ErrorField{response.NewErrorField("productVariantId", string(response.NotFound))}, nil
+	}
+
+	return nil, nil
+}
+
+func (s *service) insufficientStockError(repo Repository, variantId uint, requested int) error {
+	available := 0
+	stock, err := repo.GetByVariantId(variantId)
+	if err != nil {
+		return err
+	}
+	if stock != nil {
+		available = stock.Available()
+	}
+
+	return &InsufficientStockError{
+		ProductVariantID: variantId,
+		Requested:        requested,
+		Available:        available,
+	}
+}
@@ -0,0 +1,148 @@
+package inventory
+
+import (
+	"errors"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"haircompany-shop-rest/internal/modules/v1/inventory/dto"
+	"haircompany-shop-rest/internal/modules/v1/inventory/model"
+	"haircompany-shop-rest/internal/modules/v1/product_variant"
+	variantModel "haircompany-shop-rest/internal/modules/v1/product_variant/model"
+	"haircompany-shop-rest/pkg/database"
+	"testing"
+)
+
+func setupTestService(t *testing.T) (Service, *variantModel.ProductVariant) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatal("Failed to connect to test database:", err)
+	}
+
+	err = db.AutoMigrate(&variantModel.ProductVariant{}, &model.Stock{}, &model.StockMovement{}, &model.StockReservation{})
+	if err != nil {
+		t.Fatal("Failed to migrate test database:", err)
+	}
+
+	variant := &variantModel.ProductVariant{
+		ProductID: 1,
+		Article:   "HC-001",
+		Barcode:   "4607010590017",
+		Volume:    250,
+		Price:     99000,
+	}
+	if err := db.Create(variant).Error; err != nil {
+		t.Fatalf("Failed to create test variant: %v", err)
+	}
+
+	testDB := &database.DB{DB: db}
+	svc := NewService(NewRepository(testDB), product_variant.NewRepository(testDB))
+
+	return svc, variant
+}
+
+func adjust(t *testing.T, svc Service, variantId uint, delta int) {
+	_, errFields, err := svc.Adjust(variantId, dto.AdjustDTO{Delta: delta, Reason: "initial stock"}, "admin@example.com")
+	if err != nil || errFields != nil {
+		t.Fatalf("Failed to adjust stock: %v %v", err, errFields)
+	}
+}
+
+func TestService_Adjust_VariantNotFound(t *testing.T) {
+	svc, _ := setupTestService(t)
+
+	_, errFields, err := svc.Adjust(99999, dto.AdjustDTO{Delta: 5, Reason: "initial stock"}, "")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(errFields) == 0 {
+		t.Fatal("Expected NOT_FOUND error field for non-existent variant")
+	}
+}
+
+func TestService_Adjust_BelowReserved(t *testing.T) {
+	svc, variant := setupTestService(t)
+	adjust(t, svc, variant.ID, 5)
+
+	if err := svc.Reserve("order-1", []dto.ReserveItemDTO{{ProductVariantID: variant.ID, Quantity: 3}}); err != nil {
+		t.Fatalf("Failed to reserve stock: %v", err)
+	}
+
+	_, _, err := svc.Adjust(variant.ID, dto.AdjustDTO{Delta: -3, Reason: "write-off"}, "")
+	var stockErr *InsufficientStockError
+	if !errors.As(err, &stockErr) {
+		t.Fatalf("Expected InsufficientStockError, got %v", err)
+	}
+
+	stock, _, _ := svc.GetByVariantId(variant.ID)
+	if stock.Quantity != 5 {
+		t.Errorf("Expected quantity 5 after failed adjustment, got %d", stock.Quantity)
+	}
+}
+
+func TestService_Reserve_Insufficient(t *testing.T) {
+	svc, variant := setupTestService(t)
+	adjust(t, svc, variant.ID, 2)
+
+	err := svc.Reserve("order-1", []dto.ReserveItemDTO{{ProductVariantID: variant.ID, Quantity: 3}})
+	var stockErr *InsufficientStockError
+	if !errors.As(err, &stockErr) {
+		t.Fatalf("Expected InsufficientStockError, got %v", err)
+	}
+	if stockErr.Available != 2 {
+		t.Errorf("Expected available 2, got %d", stockErr.Available)
+	}
+
+	available, err := svc.GetAvailable([]uint{variant.ID})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if available[variant.ID] != 2 {
+		t.Errorf("Expected available 2 after failed reservation, got %d", available[variant.ID])
+	}
+}
+
+func TestService_ReserveAndCommit(t *testing.T) {
+	svc, variant := setupTestService(t)
+	adjust(t, svc, variant.ID, 5)
+
+	if err := svc.Reserve("order-1", []dto.ReserveItemDTO{{ProductVariantID: variant.ID, Quantity: 2}}); err != nil {
+		t.Fatalf("Failed to reserve stock: %v", err)
+	}
+	if err := svc.Commit("order-1"); err != nil {
+		t.Fatalf("Failed to commit reservation: %v", err)
+	}
+	// Повторный коммит не должен списывать остаток ещё раз
+	if err := svc.Commit("order-1"); err != nil {
+		t.Fatalf("Failed to commit reservation twice: %v", err)
+	}
+
+	stock, _, _ := svc.GetByVariantId(variant.ID)
+	if stock.Quantity != 3 || stock.Reserved != 0 {
+		t.Errorf("Expected quantity 3 and reserved 0, got %d and %d", stock.Quantity, stock.Reserved)
+	}
+
+	movements, err := svc.GetMovements(variant.ID)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(movements) != 3 {
+		t.Errorf("Expected 3 movements, got %d", len(movements))
+	}
+}
+
+func TestService_ReserveAndRelease(t *testing.T) {
+	svc, variant := setupTestService(t)
+	adjust(t, svc, variant.ID, 5)
+
+	if err := svc.Reserve("order-1", []dto.ReserveItemDTO{{ProductVariantID: variant.ID, Quantity: 4}}); err != nil {
+		t.Fatalf("Failed to reserve stock: %v", err)
+	}
+	if err := svc.Release("order-1"); err != nil {
+		t.Fatalf("Failed to release reservation: %v", err)
+	}
+
+	stock, _, _ := svc.GetByVariantId(variant.ID)
+	if stock.Quantity != 5 || stock.Available != 5 {
+		t.Errorf("Expected quantity 5 and available 5, got %d and %d", stock.Quantity, stock.Available)
+	}
+}
@@ -0,0 +1,36 @@
+package dto
+
+import "time"
+
+type RunDTO struct {
+	Id         uint       `json:"id" example:"1"`
+	JobName    string     `json:"jobName" example:"PurgeTrash"`
+	Trigger    string     `json:"trigger" enums:"schedule,manual" example:"schedule"`
+	Instance   string     `json:"instance" example:"api-7f9c-1-a1b2c3d4"`
+	StartedAt  time.Time  `json:"startedAt" example:"2023-10-01T04:00:00Z"`
+	FinishedAt *time.Time `json:"finishedAt" example:"2023-10-01T04:00:02Z"`
+	DurationMs *int64     `json:"durationMs" example:"2150"`
+	Error      *string    `json:"error" example:"failed to purge shades: connection refused"`
+	Panicked   bool       `json:"panicked" example:"false"`
+}
+
+type JobDTO struct {
+	Name     string     `json:"name" example:"PurgeTrash"`
+	Schedule string     `json:"schedule" example:"0 4 * * *"`
+	NextRun  *time.Time `json:"nextRun" example:"2023-10-02T04:00:00Z"`
+	Running  bool       `json:"running" example:"false"`
+	LastRun  *RunDTO    `json:"lastRun"`
+}
+
+type QueueJobDTO struct {
+	Id          uint      `json:"id" example:"1"`
+	CreatedAt   time.Time `json:"createdAt" example:"2023-10-01T12:00:00Z"`
+	UpdatedAt   time.Time `json:"updatedAt" example:"2023-10-01T13:05:00Z"`
+	Type        string    `json:"type" example:"files.move"`
+	Payload     string    `json:"payload" example:"{\"filenames\":[\"image_1a2b.png\"],\"folder\":\"images/category\"}"`
+	Status      string    `json:"status" enums:"pending,running,dead" example:"dead"`
+	Attempts    int       `json:"attempts" example:"8"`
+	MaxAttempts int       `json:"maxAttempts" example:"8"`
+	RunAt       time.Time `json:"runAt" example:"2023-10-01T13:00:00Z"`
+	LastError   *string   `json:"lastError" example:"file image_1a2b.png not found in temporary storage"`
+}
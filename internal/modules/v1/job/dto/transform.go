@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"haircompany-shop-rest/internal/modules/v1/job/model"
+	"haircompany-shop-rest/internal/services"
+)
+
+func TransformModelToRunDTO(model *model.JobRun) *RunDTO {
+	return &RunDTO{
+		Id:         model.ID,
+		JobName:    model.JobName,
+		Trigger:    model.Trigger,
+		Instance:   model.Instance,
+		StartedAt:  model.StartedAt,
+		FinishedAt: model.FinishedAt,
+		DurationMs: model.DurationMs,
+		Error:      model.Error,
+		Panicked:   model.Panicked,
+	}
+}
+
+func TransformJobInfoToDTO(info services.JobInfo, lastRun *model.JobRun) *JobDTO {
+	jobDTO := &JobDTO{
+		Name:     info.Name,
+		Schedule: info.Schedule,
+		Running:  info.Running,
+	}
+	if !info.NextRun.IsZero() {
+		nextRun := info.NextRun
+		jobDTO.NextRun = &nextRun
+	}
+	if lastRun != nil {
+		jobDTO.LastRun = TransformModelToRunDTO(lastRun)
+	}
+
+	return jobDTO
+}
+
+func TransformQueueJobToDTO(job *services.QueueJob) *QueueJobDTO {
+	return &QueueJobDTO{
+		Id:          job.ID,
+		CreatedAt:   job.CreatedAt,
+		UpdatedAt:   job.UpdatedAt,
+		Type:        job.Type,
+		Payload:     job.Payload,
+		Status:      job.Status,
+		Attempts:    job.Attempts,
+		MaxAttempts: job.MaxAttempts,
+		RunAt:       job.RunAt,
+		LastError:   job.LastError,
+	}
+}
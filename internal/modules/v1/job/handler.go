@@ -0,0 +1,217 @@
+package job
+
+import (
+	"errors"
+	"fmt"
+	"haircompany-shop-rest/internal/services"
+	"haircompany-shop-rest/pkg/request"
+	"haircompany-shop-rest/pkg/response"
+	"net/http"
+	"strconv"
+)
+
+type Handler struct {
+	svc Service
+}
+
+func NewHandler(s Service) *Handler {
+	return &Handler{
+		svc: s,
+	}
+}
+
+// GetAll retrieves scheduled jobs
+//
+//	@Summary		Get scheduled jobs
+//	@Description	Retrieve background jobs with their schedule, last run and next run
+//	@Tags			Job
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Produce		json
+//	@Success		200	{object}	docsResponse.JobList200		"List of jobs"
+//	@Failure		401	{object}	docsResponse.Response401	"Unauthorized"
+//	@Failure		403	{object}	docsResponse.Response403	"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		500	{object}	docsResponse.Response500	"Server error"
+//	@Router			/api/v1/job [get]
+func (h *Handler) GetAll(w http.ResponseWriter, r *http.Request) {
+	jobs, err := h.svc.GetAll()
+	if err != nil {
+		msg := fmt.Sprintf("failed to retrieve jobs: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, jobs)
+}
+
+var listOptions = request.ListOptions{
+	SortFields: map[string]string{
+		"id":        "id",
+		"startedAt": "started_at",
+	},
+	FilterFields: map[string]request.Filter{
+		"trigger":  {Column: "trigger", Type: request.FilterEquals},
+		"panicked": {Column: "panicked", Type: request.FilterBool},
+	},
+	DefaultSort: []request.Sort{{Column: "started_at", Desc: true}, {Column: "id", Desc: true}},
+}
+
+// GetRuns retrieves the run history of a job
+//
+//	@Summary		Get job runs
+//	@Description	Retrieve the run history of a job, newest first
+//	@Tags			Job
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			name		path		string						true	"Job name"
+//	@Param			page		query		int							false	"Page number, starting from 1"
+//	@Param			perPage		query		int							false	"Items per page, up to 100 (default 20)"
+//	@Param			sort		query		string						false	"Comma-separated sort fields, prefix with - for descending: id, startedAt"
+//	@Param			trigger		query		string						false	"Filter by trigger: schedule, manual"
+//	@Param			panicked	query		bool						false	"Filter by panicked runs"
+//	@Success		200			{object}	docsResponse.JobRuns200		"List of runs"
+//	@Failure		400			{object}	docsResponse.Response400	"Invalid page, perPage, sort or filter value"
+//	@Failure		401			{object}	docsResponse.Response401	"Unauthorized"
+//	@Failure		403			{object}	docsResponse.Response403	"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		404			{object}	docsResponse.Response404	"Job not found"
+//	@Failure		500			{object}	docsResponse.Response500	"Server error"
+//	@Router			/api/v1/job/{name}/runs [get]
+func (h *Handler) GetRuns(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+
+	query, errFields := request.ParseListQuery(r.URL.Query(), listOptions)
+	if errFields != nil {
+		msg := "invalid list query"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	runs, total, err := h.svc.GetRuns(name, query)
+	if err != nil {
+		msg := fmt.Sprintf("failed to retrieve job runs: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+	if runs == nil {
+		msg := fmt.Sprintf("job %s not found", name)
+		response.SendError(w, http.StatusNotFound, msg, response.NotFound)
+		return
+	}
+
+	response.SendPaginated(w, http.StatusOK, runs, response.Pagination{Total: total, Page: query.Page, PerPage: query.PerPage})
+}
+
+// Trigger starts a job immediately
+//
+//	@Summary		Run job now
+//	@Description	Start a job in the background without waiting for its schedule. The run appears in the job history.
+//	@Tags			Job
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			name	path		string						true	"Job name"
+//	@Success		202		{object}	docsResponse.JobTrigger202	"Job started"
+//	@Failure		401		{object}	docsResponse.Response401	"Unauthorized"
+//	@Failure		403		{object}	docsResponse.Response403	"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		404		{object}	docsResponse.Response404	"Job not found"
+//	@Failure		409		{object}	docsResponse.Job409			"Job is already running"
+//	@Failure		500		{object}	docsResponse.Response500	"Server error"
+//	@Router			/api/v1/job/{name}/run [post]
+func (h *Handler) Trigger(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+
+	err := h.svc.Trigger(name)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrJobNotFound):
+			msg := fmt.Sprintf("job %s not found", name)
+			response.SendError(w, http.StatusNotFound, msg, response.NotFound)
+		case errors.Is(err, services.ErrJobRunning):
+			msg := fmt.Sprintf("job %s is already running", name)
+			response.SendError(w, http.StatusConflict, msg, response.JobRunning)
+		default:
+			msg := fmt.Sprintf("failed to start job: %v", err)
+			response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		}
+		return
+	}
+
+	response.SendSuccess(w, http.StatusAccepted, nil)
+}
+
+// GetDeadLetters retrieves queue jobs that ran out of attempts
+//
+//	@Summary		Get dead letters
+//	@Description	Retrieve background queue jobs that failed on every attempt
+//	@Tags			Job
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			page	query		int								false	"Page number, starting from 1"
+//	@Param			perPage	query		int								false	"Items per page, up to 100 (default 20)"
+//	@Param			sort	query		string							false	"Comma-separated sort fields, prefix with - for descending: id, updatedAt"
+//	@Param			type	query		string							false	"Filter by job type"
+//	@Success		200		{object}	docsResponse.JobDeadLetters200	"List of dead letters"
+//	@Failure		400		{object}	docsResponse.Response400		"Invalid page, perPage, sort or filter value"
+//	@Failure		401		{object}	docsResponse.Response401		"Unauthorized"
+//	@Failure		403		{object}	docsResponse.Response403		"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		500		{object}	docsResponse.Response500		"Server error"
+//	@Router			/api/v1/job/dead-letters [get]
+func (h *Handler) GetDeadLetters(w http.ResponseWriter, r *http.Request) {
+	query, errFields := request.ParseListQuery(r.URL.Query(), deadJobListOptions)
+	if errFields != nil {
+		msg := "invalid list query"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	jobs, total, err := h.svc.GetDeadLetters(query)
+	if err != nil {
+		msg := fmt.Sprintf("failed to retrieve dead letters: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+
+	response.SendPaginated(w, http.StatusOK, jobs, response.Pagination{Total: total, Page: query.Page, PerPage: query.PerPage})
+}
+
+// RetryDeadLetter returns a dead letter to the queue
+//
+//	@Summary		Retry dead letter
+//	@Description	Return a failed queue job to the queue with a fresh set of attempts
+//	@Tags			Job
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			id	path		int									true	"Queue job ID"
+//	@Success		200	{object}	docsResponse.JobDeadLetterRetry200	"Job requeued"
+//	@Failure		400	{object}	docsResponse.Response400			"Invalid ID"
+//	@Failure		401	{object}	docsResponse.Response401			"Unauthorized"
+//	@Failure		403	{object}	docsResponse.Response403			"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		404	{object}	docsResponse.Response404			"Dead letter not found"
+//	@Failure		500	{object}	docsResponse.Response500			"Server error"
+//	@Router			/api/v1/job/dead-letters/{id}/retry [post]
+func (h *Handler) RetryDeadLetter(w http.ResponseWriter, r *http.Request) {
+	idStr := r.PathValue("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil || id < 0 {
+		msg := fmt.Sprintf("invalid queue job id: %s", idStr)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	job, err := h.svc.RetryDeadLetter(uint(id))
+	if err != nil {
+		msg := fmt.Sprintf("failed to requeue job: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+	if job == nil {
+		msg := fmt.Sprintf("dead letter with id %d not found", id)
+		response.SendError(w, http.StatusNotFound, msg, response.NotFound)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, job)
+}
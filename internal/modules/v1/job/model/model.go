@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+// JobRun is a single execution of a scheduled job.
+type JobRun struct {
+	ID         uint       `gorm:"primarykey" json:"id"`
+	JobName    string     `gorm:"type:varchar(128);not null;index:idx_job_runs_job_name_started_at" json:"jobName"`
+	Trigger    string     `gorm:"type:varchar(16);not null" json:"trigger"`
+	Instance   string     `gorm:"type:varchar(255);not null" json:"instance"`
+	StartedAt  time.Time  `gorm:"not null;index:idx_job_runs_job_name_started_at" json:"startedAt"`
+	FinishedAt *time.Time `json:"finishedAt"`
+	DurationMs *int64     `json:"durationMs"`
+	Error      *string    `gorm:"type:text" json:"error"`
+	Panicked   bool       `gorm:"not null;default:false" json:"panicked"`
+}
@@ -0,0 +1,136 @@
+package job
+
+import (
+	"errors"
+	"gorm.io/gorm"
+	"haircompany-shop-rest/internal/modules/v1/job/model"
+	"haircompany-shop-rest/internal/services"
+	"haircompany-shop-rest/pkg/database"
+	"haircompany-shop-rest/pkg/request"
+	"time"
+)
+
+type Repository interface {
+	StartRun(name, trigger, instance string, startedAt time.Time) (uint, error)
+	FinishRun(id uint, finishedAt time.Time, duration time.Duration, runErr string, panicked bool) error
+	GetLastRuns(names []string) (map[string]*model.JobRun, error)
+	GetRuns(name string, query request.ListQuery) ([]*model.JobRun, int64, error)
+	GetDeadJobs(query request.ListQuery) ([]*services.QueueJob, int64, error)
+	RequeueDeadJob(id uint) (*services.QueueJob, error)
+}
+
+type repository struct {
+	DB *database.DB
+}
+
+func NewRepository(db *database.DB) Repository {
+	return &repository{
+		DB: db,
+	}
+}
+
+func (r *repository) StartRun(name, trigger, instance string, startedAt time.Time) (uint, error) {
+	run := &model.JobRun{
+		JobName:   name,
+		Trigger:   trigger,
+		Instance:  instance,
+		StartedAt: startedAt,
+	}
+
+	result := r.DB.Create(run)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return run.ID, nil
+}
+
+func (r *repository) FinishRun(id uint, finishedAt time.Time, duration time.Duration, runErr string, panicked bool) error {
+	updates := map[string]interface{}{
+		"finished_at": finishedAt,
+		"duration_ms": duration.Milliseconds(),
+		"panicked":    panicked,
+	}
+	if runErr != "" {
+		updates["error"] = runErr
+	}
+
+	return r.DB.Model(&model.JobRun{}).Where("id = ?", id).Updates(updates).Error
+}
+
+// GetLastRuns returns the latest run of every given job keyed by job name.
+func (r *repository) GetLastRuns(names []string) (map[string]*model.JobRun, error) {
+	runs := make(map[string]*model.JobRun, len(names))
+	if len(names) == 0 {
+		return runs, nil
+	}
+
+	var models []*model.JobRun
+	latest := r.DB.Model(&model.JobRun{}).
+		Select("MAX(id)").
+		Where("job_name IN ?", names).
+		Group("job_name")
+	result := r.DB.Where("id IN (?)", latest).Find(&models)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	for _, run := range models {
+		runs[run.JobName] = run
+	}
+
+	return runs, nil
+}
+
+func (r *repository) GetRuns(name string, query request.ListQuery) ([]*model.JobRun, int64, error) {
+	runs := r.DB.Where("job_name = ?", name).Session(&gorm.Session{})
+
+	return database.FindPage[model.JobRun](runs, query)
+}
+
+// deadJobListOptions declares the query parameters GetDeadJobs can be sorted and filtered by.
+var deadJobListOptions = request.ListOptions{
+	SortFields: map[string]string{
+		"id":        "id",
+		"updatedAt": "updated_at",
+	},
+	FilterFields: map[string]request.Filter{
+		"type": {Column: "type", Type: request.FilterEquals},
+	},
+	DefaultSort: []request.Sort{{Column: "updated_at", Desc: true}, {Column: "id", Desc: true}},
+}
+
+func (r *repository) GetDeadJobs(query request.ListQuery) ([]*services.QueueJob, int64, error) {
+	dead := r.DB.Where("status = ?", services.QueueJobDead).Session(&gorm.Session{})
+
+	return database.FindPage[services.QueueJob](dead, query)
+}
+
+// RequeueDeadJob returns a dead job to the queue with a fresh set of attempts,
+// or nil if there is no dead job with the given id.
+func (r *repository) RequeueDeadJob(id uint) (*services.QueueJob, error) {
+	var job *services.QueueJob
+
+	result := r.DB.Where("status = ?", services.QueueJobDead).First(&job, id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+
+	job.Status = services.QueueJobPending
+	job.Attempts = 0
+	job.RunAt = time.Now()
+
+	result = r.DB.Model(job).Updates(map[string]interface{}{
+		"status":   job.Status,
+		"attempts": job.Attempts,
+		"run_at":   job.RunAt,
+	})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return job, nil
+}
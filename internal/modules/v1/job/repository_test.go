@@ -0,0 +1,112 @@
+package job
+
+import (
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"haircompany-shop-rest/internal/modules/v1/job/model"
+	"haircompany-shop-rest/internal/services"
+	"haircompany-shop-rest/pkg/database"
+	"haircompany-shop-rest/pkg/request"
+	"testing"
+	"time"
+)
+
+func setupTestDB(t *testing.T) *database.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatal("Failed to connect to test database:", err)
+	}
+
+	err = db.AutoMigrate(&model.JobRun{}, &services.QueueJob{})
+	if err != nil {
+		t.Fatal("Failed to migrate test database:", err)
+	}
+
+	return &database.DB{DB: db}
+}
+
+func TestRepository_RunHistory(t *testing.T) {
+	repo := NewRepository(setupTestDB(t))
+	var _ services.JobRecorder = repo
+
+	start := time.Date(2024, time.January, 1, 4, 0, 0, 0, time.UTC)
+	firstId, err := repo.StartRun("PurgeTrash", services.JobTriggerSchedule, "a", start)
+	if err != nil {
+		t.Fatalf("Failed to start run: %v", err)
+	}
+	if err := repo.FinishRun(firstId, start.Add(2*time.Second), 2*time.Second, "", false); err != nil {
+		t.Fatalf("Failed to finish run: %v", err)
+	}
+
+	secondId, _ := repo.StartRun("PurgeTrash", services.JobTriggerManual, "b", start.Add(time.Hour))
+	if err := repo.FinishRun(secondId, start.Add(time.Hour+time.Second), time.Second, "boom", true); err != nil {
+		t.Fatalf("Failed to finish run: %v", err)
+	}
+	if _, err := repo.StartRun("CleanTempFiles", services.JobTriggerSchedule, "a", start); err != nil {
+		t.Fatalf("Failed to start run: %v", err)
+	}
+
+	lastRuns, err := repo.GetLastRuns([]string{"PurgeTrash", "CleanTempFiles", "Unknown"})
+	if err != nil {
+		t.Fatalf("Failed to get last runs: %v", err)
+	}
+	if len(lastRuns) != 2 {
+		t.Fatalf("Expected 2 last runs, got %d", len(lastRuns))
+	}
+
+	purge := lastRuns["PurgeTrash"]
+	if purge.ID != secondId || purge.Error == nil || *purge.Error != "boom" || !purge.Panicked {
+		t.Errorf("Unexpected last PurgeTrash run: %+v", purge)
+	}
+	if purge.DurationMs == nil || *purge.DurationMs != 1000 || purge.FinishedAt == nil {
+		t.Errorf("Expected finished run with duration, got %+v", purge)
+	}
+	if clean := lastRuns["CleanTempFiles"]; clean.FinishedAt != nil {
+		t.Errorf("Expected unfinished CleanTempFiles run, got %+v", clean)
+	}
+
+	runs, total, err := repo.GetRuns("PurgeTrash", request.ListQuery{Page: 1, PerPage: 20, Sort: listOptions.DefaultSort})
+	if err != nil {
+		t.Fatalf("Failed to get runs: %v", err)
+	}
+	if total != 2 || len(runs) != 2 || runs[0].ID != secondId {
+		t.Errorf("Expected 2 runs newest first, got total %d: %+v", total, runs)
+	}
+}
+
+func TestRepository_DeadLetters(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepository(db)
+
+	lastError := "file a.png not found in temporary storage"
+	jobs := []*services.QueueJob{
+		{Type: services.QueueMoveFiles, Payload: "{}", Status: services.QueueJobDead, Attempts: 8, MaxAttempts: 8, RunAt: time.Now(), LastError: &lastError},
+		{Type: services.QueueMoveFiles, Payload: "{}", Status: services.QueueJobPending, MaxAttempts: 8, RunAt: time.Now()},
+	}
+	if err := db.Create(&jobs).Error; err != nil {
+		t.Fatalf("Failed to create jobs: %v", err)
+	}
+
+	dead, total, err := repo.GetDeadJobs(request.ListQuery{Page: 1, PerPage: 20, Sort: deadJobListOptions.DefaultSort})
+	if err != nil {
+		t.Fatalf("Failed to get dead jobs: %v", err)
+	}
+	if total != 1 || len(dead) != 1 || dead[0].ID != jobs[0].ID {
+		t.Fatalf("Expected only the dead job, got total %d: %+v", total, dead)
+	}
+
+	if job, err := repo.RequeueDeadJob(jobs[1].ID); err != nil || job != nil {
+		t.Errorf("Expected pending job not to be requeued, got %+v, %v", job, err)
+	}
+
+	job, err := repo.RequeueDeadJob(jobs[0].ID)
+	if err != nil || job == nil {
+		t.Fatalf("Expected dead job to be requeued, got %v", err)
+	}
+
+	var stored services.QueueJob
+	db.First(&stored, jobs[0].ID)
+	if stored.Status != services.QueueJobPending || stored.Attempts != 0 {
+		t.Errorf("Expected requeued job to be pending with no attempts, got %+v", stored)
+	}
+}
@@ -0,0 +1,107 @@
+package job
+
+import (
+	"haircompany-shop-rest/internal/modules/v1/job/dto"
+	"haircompany-shop-rest/internal/services"
+	"haircompany-shop-rest/pkg/request"
+)
+
+type Service interface {
+	GetAll() ([]*dto.JobDTO, error)
+	GetRuns(name string, query request.ListQuery) ([]*dto.RunDTO, int64, error)
+	Trigger(name string) error
+	GetDeadLetters(query request.ListQuery) ([]*dto.QueueJobDTO, int64, error)
+	RetryDeadLetter(id uint) (*dto.QueueJobDTO, error)
+}
+
+type service struct {
+	repo      Repository
+	scheduler services.Scheduler
+}
+
+func NewService(r Repository, scheduler services.Scheduler) Service {
+	return &service{
+		repo:      r,
+		scheduler: scheduler,
+	}
+}
+
+// GetAll returns the jobs registered in this instance's scheduler. The last
+// run is shared by all instances, while the next run is computed locally.
+func (s *service) GetAll() ([]*dto.JobDTO, error) {
+	jobs := s.scheduler.Jobs()
+
+	names := make([]string, 0, len(jobs))
+	for _, job := range jobs {
+		names = append(names, job.Name)
+	}
+
+	lastRuns, err := s.repo.GetLastRuns(names)
+	if err != nil {
+		return nil, err
+	}
+
+	jobDTOs := make([]*dto.JobDTO, 0, len(jobs))
+	for _, job := range jobs {
+		jobDTOs = append(jobDTOs, dto.TransformJobInfoToDTO(job, lastRuns[job.Name]))
+	}
+
+	return jobDTOs, nil
+}
+
+// GetRuns returns nil when no job with the given name is registered.
+func (s *service) GetRuns(name string, query request.ListQuery) ([]*dto.RunDTO, int64, error) {
+	if !s.exists(name) {
+		return nil, 0, nil
+	}
+
+	models, total, err := s.repo.GetRuns(name, query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	runDTOs := make([]*dto.RunDTO, 0, len(models))
+	for _, model := range models {
+		runDTOs = append(runDTOs, dto.TransformModelToRunDTO(model))
+	}
+
+	return runDTOs, total, nil
+}
+
+// Trigger returns services.ErrJobNotFound or services.ErrJobRunning when the
+// job cannot be started.
+func (s *service) Trigger(name string) error {
+	return s.scheduler.Trigger(name)
+}
+
+func (s *service) GetDeadLetters(query request.ListQuery) ([]*dto.QueueJobDTO, int64, error) {
+	jobs, total, err := s.repo.GetDeadJobs(query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	jobDTOs := make([]*dto.QueueJobDTO, 0, len(jobs))
+	for _, job := range jobs {
+		jobDTOs = append(jobDTOs, dto.TransformQueueJobToDTO(job))
+	}
+
+	return jobDTOs, total, nil
+}
+
+func (s *service) RetryDeadLetter(id uint) (*dto.QueueJobDTO, error) {
+	job, err := s.repo.RequeueDeadJob(id)
+	if job == nil {
+		return nil, err
+	}
+
+	return dto.TransformQueueJobToDTO(job), nil
+}
+
+func (s *service) exists(name string) bool {
+	for _, job := range s.scheduler.Jobs() {
+		if job.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -20,11 +20,17 @@ func TransformUpdateDTOToModel(dto UpdateDTO, model *model.Line) *model.Line {
 }
 
 func TransformModelToResponseDTO(model *model.Line) *ResponseDTO {
-	return &ResponseDTO{
+	responseDTO := &ResponseDTO{
 		Id:        model.ID,
 		CreatedAt: model.CreatedAt,
 		UpdatedAt: model.UpdatedAt,
 		Name:      model.Name,
 		Color:     model.Color,
 	}
+	if model.DeletedAt.Valid {
+		deletedAt := model.DeletedAt.Time
+		responseDTO.DeletedAt = &deletedAt
+	}
+
+	return responseDTO
 }
@@ -66,6 +66,20 @@ func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 	response.SendSuccess(w, http.StatusCreated, createdLine)
 }
 
+var listOptions = request.ListOptions{
+	SortFields: map[string]string{
+		"id":        "id",
+		"name":      "name",
+		"createdAt": "created_at",
+		"deletedAt": "deleted_at",
+	},
+	FilterFields: map[string]request.Filter{
+		"name":  {Column: "name", Type: request.FilterContains},
+		"color": {Column: "color", Type: request.FilterEquals},
+	},
+	DefaultSort: []request.Sort{{Column: "id"}},
+}
+
 // GetAll retrieves all lines
 //
 //	@Summary		Get all lines
@@ -73,19 +87,32 @@ func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 //	@Tags			Line
 //	@Security		AppAuth
 //	@Produce		json
-//	@Success		200	{object}	docsResponse.LineList200	"List of lines"
-//	@Failure		403	{object}	docsResponse.Response403	"Forbidden - Invalid X-AUTH-APP"
-//	@Failure		500	{object}	docsResponse.Response500	"Server error"
+//	@Param			page	query		int							false	"Page number, starting from 1"
+//	@Param			perPage	query		int							false	"Items per page, up to 100 (default 20)"
+//	@Param			sort	query		string						false	"Comma-separated sort fields, prefix with - for descending: id, name, createdAt"
+//	@Param			name	query		string						false	"Filter by name substring"
+//	@Param			color	query		string						false	"Filter by exact hex color"
+//	@Success		200		{object}	docsResponse.LineList200	"List of lines"
+//	@Failure		400		{object}	docsResponse.Response400	"Invalid page, perPage, sort or filter value"
+//	@Failure		403		{object}	docsResponse.Response403	"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		500		{object}	docsResponse.Response500	"Server error"
 //	@Router			/api/v1/line [get]
-func (h *Handler) GetAll(w http.ResponseWriter) {
-	lines, err := h.svc.GetAll()
+func (h *Handler) GetAll(w http.ResponseWriter, r *http.Request) {
+	query, errFields := request.ParseListQuery(r.URL.Query(), listOptions)
+	if errFields != nil {
+		msg := "invalid list query"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	lines, total, err := h.svc.GetAll(query)
 	if err != nil {
 		msg := fmt.Sprintf("failed to retrieve lines: %v", err)
 		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
 		return
 	}
 
-	response.SendSuccess(w, http.StatusOK, lines)
+	response.SendPaginated(w, http.StatusOK, lines, response.Pagination{Total: total, Page: query.Page, PerPage: query.PerPage})
 }
 
 // GetById retrieves a line by its ID
@@ -225,12 +252,17 @@ func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	line, err := h.svc.Delete(uint(id))
+	line, linkedEntitiesCount, err := h.svc.Delete(uint(id))
 	if line == nil {
 		msg := fmt.Sprintf("line with id %d not found", id)
 		response.SendError(w, http.StatusNotFound, msg, response.NotFound)
 		return
 	}
+	if linkedEntitiesCount > 0 {
+		msg := fmt.Sprintf("line with id %d cannot be deleted because it is used by %d products", id, linkedEntitiesCount)
+		response.SendError(w, http.StatusConflict, msg, response.HasLinkedEntities)
+		return
+	}
 	if err != nil {
 		msg := fmt.Sprintf("failed to delete line: %v", err)
 		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
@@ -239,3 +271,89 @@ func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
 
 	response.SendSuccess(w, http.StatusOK, line)
 }
+
+// GetTrash retrieves deleted lines
+//
+//	@Summary		Get deleted lines
+//	@Description	Retrieve lines in the trash. They are purged permanently after the retention period
+//	@Tags			Line
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			page	query		int							false	"Page number, starting from 1"
+//	@Param			perPage	query		int							false	"Items per page, up to 100 (default 20)"
+//	@Param			sort	query		string						false	"Comma-separated sort fields, prefix with - for descending, e.g. -deletedAt"
+//	@Success		200		{object}	docsResponse.LineList200	"Deleted lines"
+//	@Failure		400		{object}	docsResponse.Response400	"Invalid page, perPage, sort or filter value"
+//	@Failure		401		{object}	docsResponse.Response401	"Unauthorized"
+//	@Failure		403		{object}	docsResponse.Response403	"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		500		{object}	docsResponse.Response500	"Server error"
+//	@Router			/api/v1/line/trash [get]
+func (h *Handler) GetTrash(w http.ResponseWriter, r *http.Request) {
+	query, errFields := request.ParseListQuery(r.URL.Query(), listOptions)
+	if errFields != nil {
+		msg := "invalid list query"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	lineList, total, err := h.svc.GetTrash(query)
+	if err != nil {
+		msg := fmt.Sprintf("failed to retrieve deleted lines: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+
+	response.SendPaginated(w, http.StatusOK, lineList, response.Pagination{Total: total, Page: query.Page, PerPage: query.PerPage})
+}
+
+// Restore restores a deleted line
+//
+//	@Summary		Restore line
+//	@Description	Move a line out of the trash
+//	@Tags			Line
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			id	path		int							true	"Line ID"
+//	@Success		200	{object}	docsResponse.LineGetById200	"Line restored"
+//	@Failure		400	{object}	docsResponse.LineCreate400	"Invalid ID or the restored values are taken"
+//	@Failure		401	{object}	docsResponse.Response401	"Unauthorized"
+//	@Failure		403	{object}	docsResponse.Response403	"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		404	{object}	docsResponse.Response404	"Line not found in the trash"
+//	@Failure		500	{object}	docsResponse.Response500	"Server error"
+//	@Router			/api/v1/line/{id}/restore [patch]
+func (h *Handler) Restore(w http.ResponseWriter, r *http.Request) {
+	idStr := r.PathValue("id")
+	if idStr == "" {
+		msg := "missing line id"
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	id, err := strconv.Atoi(idStr)
+	if err != nil || id < 0 {
+		msg := fmt.Sprintf("invalid line id: %s", idStr)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	line, errFields, err := h.svc.Restore(uint(id))
+	if err != nil {
+		msg := fmt.Sprintf("failed to restore line: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+	if errFields != nil {
+		msg := "validation errors occurred"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+	if line == nil {
+		msg := fmt.Sprintf("deleted line with id %d not found", id)
+		response.SendError(w, http.StatusNotFound, msg, response.NotFound)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, line)
+}
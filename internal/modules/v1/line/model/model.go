@@ -1,11 +1,15 @@
 package model
 
-import "time"
+import (
+	"gorm.io/gorm"
+	"time"
+)
 
 type Line struct {
 	ID        uint `gorm:"primarykey" json:"id"`
 	CreatedAt time.Time
 	UpdatedAt time.Time
-	Name      string `gorm:"type:varchar(255);not null;unique" json:"name"`
-	Color     string `gorm:"type:varchar(7);not null" json:"color"` // Hex color code
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"deletedAt"`
+	Name      string         `gorm:"type:varchar(255);not null;index:idx_lines_name,unique,where:deleted_at IS NULL" json:"name"`
+	Color     string         `gorm:"type:varchar(7);not null" json:"color"` // Hex color code
 }
@@ -5,15 +5,22 @@ import (
 	"gorm.io/gorm"
 	"haircompany-shop-rest/internal/modules/v1/line/model"
 	"haircompany-shop-rest/pkg/database"
+	"haircompany-shop-rest/pkg/request"
+	"time"
 )
 
 type Repository interface {
 	Create(model *model.Line) (*model.Line, error)
-	GetAll() ([]*model.Line, error)
+	GetAll(query request.ListQuery) ([]*model.Line, int64, error)
 	GetById(id uint) (*model.Line, error)
 	Update(model *model.Line) (*model.Line, error)
 	Delete(id uint) error
 	GetByUniqueFields(name string) (*model.Line, error)
+	GetDeleted(query request.ListQuery) ([]*model.Line, int64, error)
+	GetDeletedById(id uint) (*model.Line, error)
+	Restore(id uint) error
+	PurgeDeleted(before time.Time) ([]*model.Line, error)
+	CountProductsByLineId(lineId uint) (int64, error)
 }
 
 type repository struct {
@@ -35,16 +42,8 @@ func (r *repository) Create(model *model.Line) (*model.Line, error) {
 	return model, nil
 }
 
-func (r *repository) GetAll() ([]*model.Line, error) {
-	var lines []*model.Line
-	var err error
-
-	result := r.DB.Find(&lines)
-	if result.Error != nil {
-		err = result.Error
-	}
-
-	return lines, err
+func (r *repository) GetAll(query request.ListQuery) ([]*model.Line, int64, error) {
+	return database.FindPage[model.Line](r.DB.DB, query)
 }
 
 func (r *repository) GetById(id uint) (*model.Line, error) {
@@ -94,3 +93,29 @@ func (r *repository) GetByUniqueFields(name string) (*model.Line, error) {
 
 	return line, err
 }
+
+func (r *repository) GetDeleted(query request.ListQuery) ([]*model.Line, int64, error) {
+	return database.FindDeletedPage[model.Line](r.DB.DB, query)
+}
+
+func (r *repository) GetDeletedById(id uint) (*model.Line, error) {
+	return database.FindDeleted[model.Line](r.DB.DB, id)
+}
+
+func (r *repository) Restore(id uint) error {
+	return database.Restore[model.Line](r.DB.DB, id)
+}
+
+func (r *repository) PurgeDeleted(before time.Time) ([]*model.Line, error) {
+	return database.PurgeDeleted[model.Line](r.DB.DB, before)
+}
+
+func (r *repository) CountProductsByLineId(lineId uint) (int64, error) {
+	var count int64
+	result := r.DB.Table("products").Where("line_id = ?", lineId).Count(&count)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return count, nil
+}
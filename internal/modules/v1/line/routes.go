@@ -24,20 +24,36 @@ func RegisterV1LineRoutes(mux *http.ServeMux, container *container.Container) {
 				}
 			}),
 			middleware.DashboardRoleMiddleware("admin"),
-			middleware.DashboardAuthMiddleware(container.JWTService),
+			middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist),
 		),
 	)
 
 	mux.HandleFunc("/line", func(w http.ResponseWriter, r *http.Request) {
 		switch r.Method {
 		case http.MethodGet:
-			h.GetAll(w)
+			h.GetAll(w, r)
 		default:
 			msg := "Method not allowed. Allowed methods: GET"
 			response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
 		}
 	})
 
+	mux.Handle("/line/trash",
+		middleware.ChainMiddleware(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodGet:
+					h.GetTrash(w, r)
+				default:
+					msg := "Method not allowed. Allowed methods: GET"
+					response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+				}
+			}),
+			middleware.DashboardRoleMiddleware("admin"),
+			middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist),
+		),
+	)
+
 	mux.HandleFunc("/line/{id}", func(w http.ResponseWriter, r *http.Request) {
 		switch r.Method {
 		case http.MethodGet:
@@ -60,7 +76,23 @@ func RegisterV1LineRoutes(mux *http.ServeMux, container *container.Container) {
 				}
 			}),
 			middleware.DashboardRoleMiddleware("admin"),
-			middleware.DashboardAuthMiddleware(container.JWTService),
+			middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist),
+		),
+	)
+
+	mux.Handle("/line/{id}/restore",
+		middleware.ChainMiddleware(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodPatch:
+					h.Restore(w, r)
+				default:
+					msg := "Method not allowed. Allowed methods: PATCH"
+					response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+				}
+			}),
+			middleware.DashboardRoleMiddleware("admin"),
+			middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist),
 		),
 	)
 
@@ -76,7 +108,7 @@ func RegisterV1LineRoutes(mux *http.ServeMux, container *container.Container) {
 				}
 			}),
 			middleware.DashboardRoleMiddleware("admin"),
-			middleware.DashboardAuthMiddleware(container.JWTService),
+			middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist),
 		),
 	)
 }
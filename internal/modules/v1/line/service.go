@@ -3,16 +3,21 @@ package line
 import (
 	"errors"
 	"haircompany-shop-rest/internal/modules/v1/line/dto"
+	"haircompany-shop-rest/pkg/request"
 	"haircompany-shop-rest/pkg/response"
 	"log"
+	"time"
 )
 
 type Service interface {
 	Create(createDto dto.CreateDTO) (*dto.ResponseDTO, []response.ErrorField, error)
-	GetAll() ([]*dto.ResponseDTO, error)
+	GetAll(query request.ListQuery) ([]*dto.ResponseDTO, int64, error)
 	GetById(id uint) (*dto.ResponseDTO, error)
 	Update(id uint, updateDto dto.UpdateDTO) (*dto.ResponseDTO, []response.ErrorField, error)
-	Delete(id uint) (*dto.ResponseDTO, error)
+	Delete(id uint) (*dto.ResponseDTO, int64, error)
+	GetTrash(query request.ListQuery) ([]*dto.ResponseDTO, int64, error)
+	Restore(id uint) (*dto.ResponseDTO, []response.ErrorField, error)
+	Purge(before time.Time) (int, error)
 }
 
 type service struct {
@@ -50,9 +55,9 @@ func (c *service) Create(createDto dto.CreateDTO) (*dto.ResponseDTO, []response.
 	return createdLineResponse, nil, nil
 }
 
-func (c *service) GetAll() ([]*dto.ResponseDTO, error) {
+func (c *service) GetAll(query request.ListQuery) ([]*dto.ResponseDTO, int64, error) {
 	lineDTOs := make([]*dto.ResponseDTO, 0)
-	models, err := c.repo.GetAll()
+	models, total, err := c.repo.GetAll(query)
 	if err != nil {
 		log.Printf("error retrieving lines: %v", err)
 	}
@@ -62,7 +67,7 @@ func (c *service) GetAll() ([]*dto.ResponseDTO, error) {
 		lineDTOs = append(lineDTOs, lineResponse)
 	}
 
-	return lineDTOs, err
+	return lineDTOs, total, err
 }
 
 func (c *service) GetById(id uint) (*dto.ResponseDTO, error) {
@@ -110,18 +115,75 @@ func (c *service) Update(id uint, updateDto dto.UpdateDTO) (*dto.ResponseDTO, []
 
 }
 
-func (c *service) Delete(id uint) (*dto.ResponseDTO, error) {
+func (c *service) Delete(id uint) (*dto.ResponseDTO, int64, error) {
+	var linkedEntitiesCount int64
 	existedLine, err := c.repo.GetById(id)
 	if existedLine == nil {
-		return nil, err
+		return nil, linkedEntitiesCount, err
 	}
 
 	lineDTO := dto.TransformModelToResponseDTO(existedLine)
+	linkedEntitiesCount, err = c.repo.CountProductsByLineId(id)
+	if err != nil {
+		return lineDTO, linkedEntitiesCount, err
+	}
+	if linkedEntitiesCount > 0 {
+		return lineDTO, linkedEntitiesCount, errors.New("line has products and cannot be deleted")
+	}
 
 	err = c.repo.Delete(id)
 	if err != nil {
-		return lineDTO, err
+		return lineDTO, linkedEntitiesCount, err
+	}
+
+	return lineDTO, linkedEntitiesCount, nil
+}
+
+func (c *service) GetTrash(query request.ListQuery) ([]*dto.ResponseDTO, int64, error) {
+	lineDTOs := make([]*dto.ResponseDTO, 0)
+	models, total, err := c.repo.GetDeleted(query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for _, model := range models {
+		lineDTOs = append(lineDTOs, dto.TransformModelToResponseDTO(model))
+	}
+
+	return lineDTOs, total, nil
+}
+
+func (c *service) Restore(id uint) (*dto.ResponseDTO, []response.ErrorField, error) {
+	deletedLine, err := c.repo.GetDeletedById(id)
+	if deletedLine == nil {
+		return nil, nil, err
+	}
+
+	existingLine, err := c.repo.GetByUniqueFields(deletedLine.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+	if existingLine != nil {
+		return nil, []response.ErrorField{response.NewErrorField("name", string(response.NotUnique))}, nil
+	}
+
+	if err := c.repo.Restore(id); err != nil {
+		return nil, nil, err
+	}
+
+	restoredLine, err := c.repo.GetById(id)
+	if restoredLine == nil {
+		return nil, nil, err
+	}
+
+	return dto.TransformModelToResponseDTO(restoredLine), nil, nil
+}
+
+func (c *service) Purge(before time.Time) (int, error) {
+	purged, err := c.repo.PurgeDeleted(before)
+	if err != nil {
+		return 0, err
 	}
 
-	return lineDTO, nil
+	return len(purged), nil
 }
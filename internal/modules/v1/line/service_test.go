@@ -0,0 +1,115 @@
+package line
+
+import (
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"haircompany-shop-rest/internal/modules/v1/line/model"
+	"haircompany-shop-rest/pkg/database"
+	"haircompany-shop-rest/pkg/response"
+	"testing"
+	"time"
+)
+
+// Корзина проверяется на настоящей базе: конфликт имени держит частичный уникальный индекс
+func setupTestDB(t *testing.T) *database.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatal("Failed to connect to test database:", err)
+	}
+
+	err = db.AutoMigrate(&model.Line{})
+	if err != nil {
+		t.Fatal("Failed to migrate test database:", err)
+	}
+
+	return &database.DB{DB: db}
+}
+
+func deleteLine(t *testing.T, db *database.DB, repo Repository, name string, deletedAt time.Time) *model.Line {
+	line, err := repo.Create(&model.Line{Name: name, Color: "#000000"})
+	if err != nil {
+		t.Fatalf("Failed to create test line: %v", err)
+	}
+	if err := repo.Delete(line.ID); err != nil {
+		t.Fatalf("Failed to delete test line: %v", err)
+	}
+	if err := db.Unscoped().Model(&model.Line{}).Where("id = ?", line.ID).Update("deleted_at", deletedAt).Error; err != nil {
+		t.Fatalf("Failed to age test line: %v", err)
+	}
+	return line
+}
+
+func TestService_Restore(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepository(db)
+	service := NewService(repo)
+
+	deleted := deleteLine(t, db, repo, "Color Care", time.Now())
+
+	restored, validationErrors, err := service.Restore(deleted.ID)
+	if err != nil || validationErrors != nil || restored == nil {
+		t.Fatalf("Expected line to be restored, got %+v, %+v, %v", restored, validationErrors, err)
+	}
+	if restored.DeletedAt != nil {
+		t.Errorf("Expected restored line without deletedAt, got %v", restored.DeletedAt)
+	}
+
+	restored, validationErrors, err = service.Restore(999)
+	if err != nil || validationErrors != nil || restored != nil {
+		t.Errorf("Expected nothing for a line outside the trash, got %+v, %+v, %v", restored, validationErrors, err)
+	}
+}
+
+func TestService_Restore_NameTaken(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepository(db)
+	service := NewService(repo)
+
+	deleted := deleteLine(t, db, repo, "Color Care", time.Now())
+	if _, err := repo.Create(&model.Line{Name: "Color Care", Color: "#ffffff"}); err != nil {
+		t.Fatalf("Expected trashed name to be reusable, got %v", err)
+	}
+
+	restored, validationErrors, err := service.Restore(deleted.ID)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if restored != nil || len(validationErrors) != 1 || validationErrors[0].Field != "name" || validationErrors[0].ErrorCode != string(response.NotUnique) {
+		t.Errorf("Expected taken name to block restore, got %+v, %+v", restored, validationErrors)
+	}
+
+	// Без проверки в сервисе восстановление упирается в индекс
+	if err := repo.Restore(deleted.ID); err == nil {
+		t.Error("Expected unique index to reject restoring a taken name")
+	}
+	if line, _ := repo.GetDeletedById(deleted.ID); line == nil {
+		t.Error("Expected line to stay in the trash")
+	}
+}
+
+func TestService_Purge(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepository(db)
+	service := NewService(repo)
+
+	old := deleteLine(t, db, repo, "Old Line", time.Now().Add(-48*time.Hour))
+	recent := deleteLine(t, db, repo, "Recent Line", time.Now())
+	live, _ := repo.Create(&model.Line{Name: "Live Line", Color: "#ffffff"})
+
+	count, err := service.Purge(time.Now().Add(-24 * time.Hour))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if count != 1 {
+		t.Errorf("Expected only the old line to be purged, got %d", count)
+	}
+	if line, _ := repo.GetDeletedById(old.ID); line != nil {
+		t.Error("Expected old line to be gone")
+	}
+	if line, _ := repo.GetDeletedById(recent.ID); line == nil {
+		t.Error("Expected recently deleted line to stay in the trash")
+	}
+	if line, _ := repo.GetById(live.ID); line == nil {
+		t.Error("Expected live line to be untouched")
+	}
+}
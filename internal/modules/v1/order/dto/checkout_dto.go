@@ -0,0 +1,10 @@
+package dto
+
+type CheckoutDTO struct {
+	CustomerName    string  `json:"customerName" validate:"required,min=2,max=255" example:"Анна Иванова"`
+	CustomerPhone   string  `json:"customerPhone" validate:"required,e164" example:"+79991234567"`
+	CustomerEmail   *string `json:"customerEmail" validate:"omitempty,email,max=255" example:"anna@example.com"`
+	DeliveryMethod  string  `json:"deliveryMethod" validate:"required,oneof=courier pickup post" example:"courier"`
+	DeliveryAddress string  `json:"deliveryAddress" validate:"required_unless=DeliveryMethod pickup,max=1000" example:"Москва, ул. Тверская, 1"`
+	Comment         string  `json:"comment" validate:"max=1000"`
+}
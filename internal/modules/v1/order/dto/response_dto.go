@@ -0,0 +1,44 @@
+package dto
+
+import "time"
+
+type ItemResponseDTO struct {
+	Id               uint   `json:"id" example:"1"`
+	ProductVariantID *uint  `json:"productVariantId" example:"1"`
+	ProductName      string `json:"productName" example:"Шампунь для окрашенных волос"`
+	Article          string `json:"article" example:"HC-1000-250"`
+	Price            int64  `json:"price" example:"129000"`
+	Quantity         int    `json:"quantity" example:"2"`
+	Subtotal         int64  `json:"subtotal" example:"258000"`
+	Discount         int64  `json:"discount" example:"25800"`
+}
+
+type HistoryResponseDTO struct {
+	FromStatus *string   `json:"fromStatus" example:"new"`
+	ToStatus   string    `json:"toStatus" example:"confirmed"`
+	Comment    string    `json:"comment" example:"Подтверждено по телефону"`
+	ChangedBy  string    `json:"changedBy" example:"manager@example.com"`
+	CreatedAt  time.Time `json:"createdAt" example:"2023-10-01T12:00:00Z"`
+}
+
+type ResponseDTO struct {
+	Id              uint                  `json:"id" example:"1"`
+	CreatedAt       time.Time             `json:"createdAt" example:"2023-10-01T12:00:00Z"`
+	UpdatedAt       time.Time             `json:"updatedAt" example:"2023-10-01T12:00:00Z"`
+	ClientUserID    *uint                 `json:"clientUserId" example:"1"`
+	Status          string                `json:"status" example:"new"`
+	PaymentStatus   string                `json:"paymentStatus" example:"unpaid"`
+	CustomerName    string                `json:"customerName" example:"Анна Иванова"`
+	CustomerPhone   string                `json:"customerPhone" example:"+79991234567"`
+	CustomerEmail   *string               `json:"customerEmail" example:"anna@example.com"`
+	DeliveryMethod  string                `json:"deliveryMethod" example:"courier"`
+	DeliveryAddress string                `json:"deliveryAddress" example:"Москва, ул. Тверская, 1"`
+	Comment         string                `json:"comment"`
+	ItemsCount      int                   `json:"itemsCount" example:"2"`
+	Subtotal        int64                 `json:"subtotal" example:"258000"`
+	Discount        int64                 `json:"discount" example:"25800"`
+	PromoCode       *string               `json:"promoCode" example:"SPRING10"`
+	Total           int64                 `json:"total" example:"232200"`
+	Items           []*ItemResponseDTO    `json:"items"`
+	History         []*HistoryResponseDTO `json:"history"`
+}
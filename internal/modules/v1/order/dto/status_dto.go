@@ -0,0 +1,6 @@
+package dto
+
+type ChangeStatusDTO struct {
+	Status  string `json:"status" validate:"required,oneof=new confirmed paid shipped delivered cancelled returned" example:"confirmed"`
+	Comment string `json:"comment" validate:"max=1000" example:"Подтверждено по телефону"`
+}
@@ -0,0 +1,70 @@
+package dto
+
+import "haircompany-shop-rest/internal/modules/v1/order/model"
+
+func TransformModelToResponseDTO(order *model.Order) *ResponseDTO {
+	orderDto := &ResponseDTO{
+		Id:              order.ID,
+		CreatedAt:       order.CreatedAt,
+		UpdatedAt:       order.UpdatedAt,
+		ClientUserID:    order.ClientUserID,
+		Status:          order.Status,
+		PaymentStatus:   order.PaymentStatus,
+		CustomerName:    order.CustomerName,
+		CustomerPhone:   order.CustomerPhone,
+		CustomerEmail:   order.CustomerEmail,
+		DeliveryMethod:  order.DeliveryMethod,
+		DeliveryAddress: order.DeliveryAddress,
+		Comment:         order.Comment,
+		ItemsCount:      order.ItemsCount,
+		Subtotal:        order.Subtotal,
+		Discount:        order.Discount,
+		PromoCode:       order.PromoCode,
+		Total:           order.Total,
+		Items:           make([]*ItemResponseDTO, 0, len(order.Items)),
+		History:         make([]*HistoryResponseDTO, 0, len(order.History)),
+	}
+
+	for _, item := range order.Items {
+		orderDto.Items = append(orderDto.Items, &ItemResponseDTO{
+			Id:               item.ID,
+			ProductVariantID: item.ProductVariantID,
+			ProductName:      item.ProductName,
+			Article:          item.Article,
+			Price:            item.Price,
+			Quantity:         item.Quantity,
+			Subtotal:         item.Subtotal,
+			Discount:         item.Discount,
+		})
+	}
+
+	for _, history := range order.History {
+		orderDto.History = append(orderDto.History, &HistoryResponseDTO{
+			FromStatus: history.FromStatus,
+			ToStatus:   history.ToStatus,
+			Comment:    history.Comment,
+			ChangedBy:  history.ChangedBy,
+			CreatedAt:  history.CreatedAt,
+		})
+	}
+
+	return orderDto
+}
+
+func TransformCheckoutDTOToModel(dto CheckoutDTO) *model.Order {
+	order := &model.Order{
+		Status:          model.StatusNew,
+		PaymentStatus:   model.PaymentStatusUnpaid,
+		CustomerName:    dto.CustomerName,
+		CustomerPhone:   dto.CustomerPhone,
+		CustomerEmail:   dto.CustomerEmail,
+		DeliveryMethod:  dto.DeliveryMethod,
+		DeliveryAddress: dto.DeliveryAddress,
+		Comment:         dto.Comment,
+	}
+	if dto.DeliveryMethod == model.DeliveryPickup {
+		order.DeliveryAddress = ""
+	}
+
+	return order
+}
@@ -0,0 +1,296 @@
+package order
+
+import (
+	"errors"
+	"fmt"
+	"haircompany-shop-rest/internal/constraint"
+	"haircompany-shop-rest/internal/modules/v1/cart"
+	"haircompany-shop-rest/internal/modules/v1/inventory"
+	"haircompany-shop-rest/internal/modules/v1/order/dto"
+	"haircompany-shop-rest/internal/services"
+	"haircompany-shop-rest/pkg/request"
+	"haircompany-shop-rest/pkg/response"
+	"net/http"
+	"strconv"
+)
+
+type Handler struct {
+	svc Service
+}
+
+func NewHandler(s Service) *Handler {
+	return &Handler{
+		svc: s,
+	}
+}
+
+// Checkout places an order from the current cart
+//
+//	@Summary		Checkout
+//	@Description	Convert the cart of the authenticated client or of the guest identified by X-Cart-Token into an order. Prices are snapshotted, the applied promo code is checked again and spent, and stock is reserved. A promo code that no longer applies is reported in the promoCode field with its PROMO_CODE_* reason.
+//	@Tags			Order
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			X-Cart-Token	header		string						false	"Guest cart token"
+//	@Param			order			body		dto.CheckoutDTO				true	"Customer and delivery data"
+//	@Success		201				{object}	docsResponse.OrderGet200	"Order placed"
+//	@Failure		400				{object}	docsResponse.Checkout400	"Bad request, validation error or empty cart"
+//	@Failure		401				{object}	docsResponse.Response401	"Unauthorized"
+//	@Failure		403				{object}	docsResponse.Response403	"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		409				{object}	docsResponse.StockAdjust409	"Not enough stock"
+//	@Failure		500				{object}	docsResponse.Response500	"Server error"
+//	@Router			/api/v1/order/checkout [post]
+func (h *Handler) Checkout(w http.ResponseWriter, r *http.Request) {
+	checkoutDto, err := request.DecodeBody[dto.CheckoutDTO](r.Body)
+	if err != nil {
+		msg := fmt.Sprintf("invalid request body: %v", err)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	errFields := constraint.ValidateDTO(checkoutDto)
+	if errFields != nil {
+		msg := "validation errors occurred"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	owner := cart.Owner{Token: r.Header.Get("X-Cart-Token")}
+	if claims, ok := r.Context().Value("clientClaims").(*services.ClientClaims); ok && claims != nil {
+		owner = cart.Owner{ClientPhone: claims.Phone}
+	}
+
+	order, errFields, err := h.svc.Checkout(owner, checkoutDto)
+	if err != nil {
+		var stockErr *inventory.InsufficientStockError
+		if errors.As(err, &stockErr) {
+			response.SendError(w, http.StatusConflict, stockErr.Error(), response.InsufficientStock)
+			return
+		}
+
+		msg := fmt.Sprintf("failed to place order: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+	if errFields != nil {
+		msg := "cart cannot be checked out"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusCreated, order)
+}
+
+// GetAll retrieves all orders
+//
+//	@Summary		Get all orders
+//	@Description	Retrieve all orders, newest first, optionally filtered by status
+//	@Tags			Order
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			status	query		string						false	"Order status"	Enums(new, confirmed, paid, shipped, delivered, cancelled, returned)
+//	@Success		200		{object}	docsResponse.OrderList200	"List of orders"
+//	@Failure		400		{object}	docsResponse.Response400	"Invalid status"
+//	@Failure		401		{object}	docsResponse.Response401	"Unauthorized"
+//	@Failure		403		{object}	docsResponse.Response403	"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		500		{object}	docsResponse.Response500	"Server error"
+//	@Router			/api/v1/order [get]
+func (h *Handler) GetAll(w http.ResponseWriter, r *http.Request) {
+	status := r.URL.Query().Get("status")
+	if status != "" {
+		errFields := constraint.ValidateDTO(dto.ChangeStatusDTO{Status: status})
+		if errFields != nil {
+			msg := fmt.Sprintf("invalid order status: %s", status)
+			response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+			return
+		}
+	}
+
+	orders, err := h.svc.GetAll(status)
+	if err != nil {
+		msg := fmt.Sprintf("failed to retrieve orders: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, orders)
+}
+
+// GetById retrieves an order by ID
+//
+//	@Summary		Get order by ID
+//	@Description	Retrieve an order with its lines and status history
+//	@Tags			Order
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			id	path		int							true	"Order ID"
+//	@Success		200	{object}	docsResponse.OrderGet200	"Order found"
+//	@Failure		400	{object}	docsResponse.Response400	"Invalid ID"
+//	@Failure		401	{object}	docsResponse.Response401	"Unauthorized"
+//	@Failure		403	{object}	docsResponse.Response403	"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		404	{object}	docsResponse.Response404	"Order not found"
+//	@Failure		500	{object}	docsResponse.Response500	"Server error"
+//	@Router			/api/v1/order/{id} [get]
+func (h *Handler) GetById(w http.ResponseWriter, r *http.Request) {
+	idStr := r.PathValue("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil || id < 0 {
+		msg := fmt.Sprintf("invalid order id: %s", idStr)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	order, err := h.svc.GetById(uint(id))
+	if err != nil {
+		msg := fmt.Sprintf("failed to retrieve order: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+	if order == nil {
+		msg := fmt.Sprintf("order with id %d not found", id)
+		response.SendError(w, http.StatusNotFound, msg, response.NotFound)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, order)
+}
+
+// ChangeStatus moves an order to another status
+//
+//	@Summary		Change order status
+//	@Description	Move an order along its lifecycle: new → confirmed → paid → shipped → delivered, with cancelled and returned as exits. Invalid transitions are rejected.
+//	@Tags			Order
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int							true	"Order ID"
+//	@Param			status	body		dto.ChangeStatusDTO			true	"New status"
+//	@Success		200		{object}	docsResponse.OrderGet200	"Status changed"
+//	@Failure		400		{object}	docsResponse.Response400	"Bad request or validation error"
+//	@Failure		401		{object}	docsResponse.Response401	"Unauthorized"
+//	@Failure		403		{object}	docsResponse.Response403	"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		404		{object}	docsResponse.Response404	"Order not found"
+//	@Failure		409		{object}	docsResponse.OrderStatus409	"Transition not allowed"
+//	@Failure		500		{object}	docsResponse.Response500	"Server error"
+//	@Router			/api/v1/order/{id}/status [patch]
+func (h *Handler) ChangeStatus(w http.ResponseWriter, r *http.Request) {
+	idStr := r.PathValue("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil || id < 0 {
+		msg := fmt.Sprintf("invalid order id: %s", idStr)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	changeDto, err := request.DecodeBody[dto.ChangeStatusDTO](r.Body)
+	if err != nil {
+		msg := fmt.Sprintf("invalid request body: %v", err)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	errFields := constraint.ValidateDTO(changeDto)
+	if errFields != nil {
+		msg := "validation errors occurred"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	var changedBy string
+	if claims, ok := r.Context().Value("dashboardClaims").(*services.DashboardClaims); ok && claims != nil {
+		changedBy = claims.Email
+	}
+
+	order, errFields, err := h.svc.ChangeStatus(uint(id), changeDto, changedBy)
+	if err != nil {
+		var transitionErr *InvalidTransitionError
+		if errors.As(err, &transitionErr) {
+			response.SendError(w, http.StatusConflict, transitionErr.Error(), response.InvalidTransition)
+			return
+		}
+
+		msg := fmt.Sprintf("failed to change order status: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+	if errFields != nil {
+		msg := fmt.Sprintf("order with id %d not found", id)
+		response.SendError(w, http.StatusNotFound, msg, response.NotFound)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, order)
+}
+
+// GetAllForClient retrieves orders of the authenticated client
+//
+//	@Summary		Get my orders
+//	@Description	Retrieve orders of the authenticated client, newest first
+//	@Tags			Order
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Produce		json
+//	@Success		200	{object}	docsResponse.OrderList200	"List of orders"
+//	@Failure		401	{object}	docsResponse.Response401	"Unauthorized"
+//	@Failure		403	{object}	docsResponse.Response403	"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		500	{object}	docsResponse.Response500	"Server error"
+//	@Router			/api/v1/account/order [get]
+func (h *Handler) GetAllForClient(w http.ResponseWriter, r *http.Request) {
+	claims := r.Context().Value("clientClaims").(*services.ClientClaims)
+
+	orders, err := h.svc.GetAllByClient(claims.Phone)
+	if err != nil {
+		msg := fmt.Sprintf("failed to retrieve orders: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, orders)
+}
+
+// GetByIdForClient retrieves an order of the authenticated client
+//
+//	@Summary		Get my order by ID
+//	@Description	Retrieve an order of the authenticated client with its lines and status history
+//	@Tags			Order
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			id	path		int							true	"Order ID"
+//	@Success		200	{object}	docsResponse.OrderGet200	"Order found"
+//	@Failure		400	{object}	docsResponse.Response400	"Invalid ID"
+//	@Failure		401	{object}	docsResponse.Response401	"Unauthorized"
+//	@Failure		403	{object}	docsResponse.Response403	"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		404	{object}	docsResponse.Response404	"Order not found"
+//	@Failure		500	{object}	docsResponse.Response500	"Server error"
+//	@Router			/api/v1/account/order/{id} [get]
+func (h *Handler) GetByIdForClient(w http.ResponseWriter, r *http.Request) {
+	idStr := r.PathValue("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil || id < 0 {
+		msg := fmt.Sprintf("invalid order id: %s", idStr)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	claims := r.Context().Value("clientClaims").(*services.ClientClaims)
+
+	order, err := h.svc.GetByIdForClient(claims.Phone, uint(id))
+	if err != nil {
+		msg := fmt.Sprintf("failed to retrieve order: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+	if order == nil {
+		msg := fmt.Sprintf("order with id %d not found", id)
+		response.SendError(w, http.StatusNotFound, msg, response.NotFound)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, order)
+}
@@ -0,0 +1,106 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	StatusNew       = "new"
+	StatusConfirmed = "confirmed"
+	StatusPaid      = "paid"
+	StatusShipped   = "shipped"
+	StatusDelivered = "delivered"
+	StatusCancelled = "cancelled"
+	StatusReturned  = "returned"
+)
+
+const (
+	PaymentStatusUnpaid   = "unpaid"
+	PaymentStatusPaid     = "paid"
+	PaymentStatusRefunded = "refunded"
+)
+
+const (
+	DeliveryCourier = "courier"
+	DeliveryPickup  = "pickup"
+	DeliveryPost    = "post"
+)
+
+// transitions lists statuses an order may move to from each status.
+// Cancelled and returned are terminal. A new order may become paid directly
+// when it is paid online before a manager confirms it.
+var transitions = map[string][]string{
+	StatusNew:       {StatusConfirmed, StatusPaid, StatusCancelled},
+	StatusConfirmed: {StatusPaid, StatusCancelled},
+	StatusPaid:      {StatusShipped, StatusCancelled},
+	StatusShipped:   {StatusDelivered, StatusReturned},
+	StatusDelivered: {StatusReturned},
+}
+
+// CanTransition reports whether an order may move from one status to another.
+func CanTransition(from, to string) bool {
+	for _, status := range transitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+type Order struct {
+	ID              uint `gorm:"primarykey" json:"id"`
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	ClientUserID    *uint                 `gorm:"index" json:"clientUserId"`
+	Status          string                `gorm:"type:varchar(16);not null;default:new;index" json:"status"`
+	PaymentStatus   string                `gorm:"type:varchar(16);not null;default:unpaid" json:"paymentStatus"`
+	CustomerName    string                `gorm:"type:varchar(255);not null" json:"customerName"`
+	CustomerPhone   string                `gorm:"type:varchar(32);not null" json:"customerPhone"`
+	CustomerEmail   *string               `gorm:"type:varchar(255)" json:"customerEmail"`
+	DeliveryMethod  string                `gorm:"type:varchar(16);not null" json:"deliveryMethod"`
+	DeliveryAddress string                `gorm:"type:text" json:"deliveryAddress"`
+	Comment         string                `gorm:"type:text" json:"comment"`
+	ItemsCount      int                   `gorm:"not null" json:"itemsCount"`
+	Subtotal        int64                 `gorm:"not null;default:0" json:"subtotal"` // Sum of lines before discount, in kopecks
+	Discount        int64                 `gorm:"not null;default:0" json:"discount"`
+	PromoCode       *string               `gorm:"type:varchar(64)" json:"promoCode"`
+	Total           int64                 `gorm:"not null" json:"total"` // Amount to pay in kopecks
+	Items           []*OrderItem          `gorm:"constraint:OnDelete:CASCADE" json:"items"`
+	History         []*OrderStatusHistory `gorm:"constraint:OnDelete:CASCADE" json:"history"`
+}
+
+// Reference identifies the order in stock reservations and payments.
+func (o *Order) Reference() string {
+	return fmt.Sprintf("order-%d", o.ID)
+}
+
+// OrderItem is a snapshot of a cart line at checkout: later changes of the
+// product or its price do not affect placed orders.
+type OrderItem struct {
+	ID               uint `gorm:"primarykey" json:"id"`
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	OrderID          uint   `gorm:"not null;index" json:"orderId"`
+	ProductVariantID *uint  `json:"productVariantId"`
+	ProductName      string `gorm:"type:varchar(255);not null" json:"productName"`
+	Article          string `gorm:"type:varchar(64);not null" json:"article"`
+	Price            int64  `gorm:"not null" json:"price"` // Price in kopecks
+	Quantity         int    `gorm:"not null" json:"quantity"`
+	Subtotal         int64  `gorm:"not null" json:"subtotal"`
+	Discount         int64  `gorm:"not null;default:0" json:"discount"` // Promo code discount of the line
+}
+
+type OrderStatusHistory struct {
+	ID         uint `gorm:"primarykey" json:"id"`
+	CreatedAt  time.Time
+	OrderID    uint    `gorm:"not null;index" json:"orderId"`
+	FromStatus *string `gorm:"type:varchar(16)" json:"fromStatus"`
+	ToStatus   string  `gorm:"type:varchar(16);not null" json:"toStatus"`
+	Comment    string  `gorm:"type:text" json:"comment"`
+	ChangedBy  string  `gorm:"type:varchar(255)" json:"changedBy"`
+}
+
+func (OrderStatusHistory) TableName() string {
+	return "order_status_history"
+}
@@ -0,0 +1,169 @@
+package order
+
+import (
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"haircompany-shop-rest/internal/modules/v1/cart"
+	"haircompany-shop-rest/internal/modules/v1/inventory"
+	"haircompany-shop-rest/internal/modules/v1/order/model"
+	productModel "haircompany-shop-rest/internal/modules/v1/product/model"
+	"haircompany-shop-rest/internal/modules/v1/promo_code"
+	"haircompany-shop-rest/pkg/database"
+)
+
+type Repository interface {
+	Transaction(fn func(repo Repository) error) error
+	// Carts, Stock and PromoCodes share the connection of the repository, so
+	// inside Transaction the cart, stock and promo code changes join it.
+	Carts() cart.Repository
+	Stock() inventory.Repository
+	PromoCodes() promo_code.Repository
+	Create(model *model.Order) (*model.Order, error)
+	GetAll(status string) ([]*model.Order, error)
+	GetAllByClientUserId(clientUserId uint) ([]*model.Order, error)
+	GetById(id uint) (*model.Order, error)
+	GetByIdForUpdate(id uint) (*model.Order, error)
+	UpdateStatus(id uint, from, to string) (bool, error)
+	UpdatePaymentStatus(id uint, paymentStatus string) error
+	CreateHistory(history *model.OrderStatusHistory) error
+	GetProductNames(productIds []uint) (map[uint]string, error)
+}
+
+type repository struct {
+	DB *database.DB
+}
+
+func NewRepository(db *database.DB) Repository {
+	return &repository{
+		DB: db,
+	}
+}
+
+// Transaction runs fn against a repository bound to a single database transaction.
+// When the repository is already bound to a transaction a savepoint is used instead.
+func (r *repository) Transaction(fn func(repo Repository) error) error {
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		return fn(NewRepository(&database.DB{DB: tx}))
+	})
+}
+
+func (r *repository) Carts() cart.Repository {
+	return cart.NewRepository(r.DB)
+}
+
+func (r *repository) Stock() inventory.Repository {
+	return inventory.NewRepository(r.DB)
+}
+
+func (r *repository) PromoCodes() promo_code.Repository {
+	return promo_code.NewRepository(r.DB)
+}
+
+func (r *repository) Create(model *model.Order) (*model.Order, error) {
+	result := r.DB.Create(&model)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return model, nil
+}
+
+func (r *repository) GetAll(status string) ([]*model.Order, error) {
+	var orders []*model.Order
+
+	query := r.withRelations().Order("id DESC")
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	result := query.Find(&orders)
+	return orders, result.Error
+}
+
+func (r *repository) GetAllByClientUserId(clientUserId uint) ([]*model.Order, error) {
+	var orders []*model.Order
+
+	result := r.withRelations().Where("client_user_id = ?", clientUserId).Order("id DESC").Find(&orders)
+	return orders, result.Error
+}
+
+func (r *repository) GetById(id uint) (*model.Order, error) {
+	var order *model.Order
+	var err error
+
+	result := r.withRelations().First(&order, id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		err = result.Error
+	}
+
+	return order, err
+}
+
+// GetByIdForUpdate locks the order row until the end of the transaction.
+func (r *repository) GetByIdForUpdate(id uint) (*model.Order, error) {
+	var order *model.Order
+	var err error
+
+	query := r.withRelations()
+	if r.DB.Dialector.Name() == "postgres" {
+		query = query.Clauses(clause.Locking{Strength: "UPDATE", Table: clause.Table{Name: clause.CurrentTable}})
+	}
+
+	result := query.First(&order, id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		err = result.Error
+	}
+
+	return order, err
+}
+
+// UpdateStatus moves the order to a new status only if it is still in the
+// expected one, so concurrent transitions cannot both succeed.
+func (r *repository) UpdateStatus(id uint, from, to string) (bool, error) {
+	result := r.DB.Model(&model.Order{}).
+		Where("id = ? AND status = ?", id, from).
+		Update("status", to)
+
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *repository) UpdatePaymentStatus(id uint, paymentStatus string) error {
+	return r.DB.Model(&model.Order{}).Where("id = ?", id).Update("payment_status", paymentStatus).Error
+}
+
+func (r *repository) CreateHistory(history *model.OrderStatusHistory) error {
+	return r.DB.Create(history).Error
+}
+
+func (r *repository) GetProductNames(productIds []uint) (map[uint]string, error) {
+	var products []*productModel.Product
+
+	result := r.DB.Select("id", "name").Where("id IN ?", productIds).Find(&products)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	names := make(map[uint]string, len(products))
+	for _, product := range products {
+		names[product.ID] = product.Name
+	}
+
+	return names, nil
+}
+
+func (r *repository) withRelations() *gorm.DB {
+	return r.DB.
+		Preload("Items", func(db *gorm.DB) *gorm.DB {
+			return db.Order("order_items.id")
+		}).
+		Preload("History", func(db *gorm.DB) *gorm.DB {
+			return db.Order("order_status_history.id")
+		})
+}
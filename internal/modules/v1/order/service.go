@@ -0,0 +1,389 @@
+package order
+
+import (
+	"errors"
+	"fmt"
+	"haircompany-shop-rest/internal/modules/v1/cart"
+	cartModel "haircompany-shop-rest/internal/modules/v1/cart/model"
+	"haircompany-shop-rest/internal/modules/v1/client_user"
+	clientUserModel "haircompany-shop-rest/internal/modules/v1/client_user/model"
+	"haircompany-shop-rest/internal/modules/v1/inventory"
+	inventoryDto "haircompany-shop-rest/internal/modules/v1/inventory/dto"
+	"haircompany-shop-rest/internal/modules/v1/order/dto"
+	"haircompany-shop-rest/internal/modules/v1/order/model"
+	"haircompany-shop-rest/internal/modules/v1/product_variant"
+	"haircompany-shop-rest/internal/modules/v1/promo_code"
+	"haircompany-shop-rest/pkg/response"
+)
+
+// InvalidTransitionError is returned when the requested status change is not
+// allowed by the order state machine.
+type InvalidTransitionError struct {
+	From string
+	To   string
+}
+
+func (e *InvalidTransitionError) Error() string {
+	return fmt.Sprintf("order cannot move from status %s to %s", e.From, e.To)
+}
+
+type Service interface {
+	Checkout(owner cart.Owner, checkoutDto dto.CheckoutDTO) (*dto.ResponseDTO, []response.ErrorField, error)
+	GetAll(status string) ([]*dto.ResponseDTO, error)
+	GetById(id uint) (*dto.ResponseDTO, error)
+	GetAllByClient(phone string) ([]*dto.ResponseDTO, error)
+	GetByIdForClient(phone string, id uint) (*dto.ResponseDTO, error)
+	ChangeStatus(id uint, changeDto dto.ChangeStatusDTO, changedBy string) (*dto.ResponseDTO, []response.ErrorField, error)
+	ChangePaymentStatus(id uint, paymentStatus string, changedBy string) error
+	// WithRepository returns the service working through repo, so another
+	// module can change orders inside its own transaction.
+	WithRepository(repo Repository) Service
+}
+
+type service struct {
+	repo           Repository
+	cartRepo       cart.Repository
+	clientUserRepo client_user.Repository
+	variantRepo    product_variant.Repository
+	inventorySvc   inventory.Service
+	promoCodeSvc   promo_code.Service
+}
+
+func NewService(r Repository, clientUserRepo client_user.Repository, variantRepo product_variant.Repository) Service {
+	return newService(r, clientUserRepo, variantRepo)
+}
+
+// newService takes the cart, stock and promo code repositories from r:
+// checkout and status changes span them, and a service built inside
+// Transaction keeps all of that work in the one transaction.
+func newService(r Repository, clientUserRepo client_user.Repository, variantRepo product_variant.Repository) *service {
+	return &service{
+		repo:           r,
+		cartRepo:       r.Carts(),
+		clientUserRepo: clientUserRepo,
+		variantRepo:    variantRepo,
+		inventorySvc:   inventory.NewService(r.Stock(), variantRepo),
+		promoCodeSvc:   promo_code.NewService(r.PromoCodes()),
+	}
+}
+
+func (s *service) WithRepository(repo Repository) Service {
+	return newService(repo, s.clientUserRepo, s.variantRepo)
+}
+
+func (s *service) transaction(fn func(txSvc *service) error) error {
+	return s.repo.Transaction(func(repo Repository) error {
+		return fn(newService(repo, s.clientUserRepo, s.variantRepo))
+	})
+}
+
+// Checkout converts the owner's cart into an order: line prices and product
+// names are snapshotted, the promo code is applied and spent, stock is
+// reserved and the cart is removed. Either all of it happens or nothing does.
+func (s *service) Checkout(owner cart.Owner, checkoutDto dto.CheckoutDTO) (*dto.ResponseDTO, []response.ErrorField, error) {
+	var clientUser *clientUserModel.ClientUser
+	if owner.ClientPhone != "" {
+		var err error
+		clientUser, err = s.clientUserRepo.GetByPhone(owner.ClientPhone)
+		if err != nil {
+			return nil, nil, err
+		}
+		if clientUser == nil {
+			return nil, nil, fmt.Errorf("client user not found")
+		}
+	}
+
+	var orderId uint
+	var errFields []response.ErrorField
+
+	err := s.transaction(func(txSvc *service) error {
+		var clientUserId *uint
+		var userCart *cartModel.Cart
+		var err error
+
+		if clientUser != nil {
+			clientUserId = &clientUser.ID
+			userCart, err = txSvc.cartRepo.GetByClientUserIdForUpdate(clientUser.ID)
+		} else if owner.Token != "" {
+			userCart, err = txSvc.cartRepo.GetByTokenForUpdate(owner.Token)
+		}
+		if err != nil {
+			return err
+		}
+		if userCart == nil || len(userCart.Items) == 0 {
+			errFields = []response.ErrorField{response.NewErrorField("cart", string(response.EmptyCart))}
+			return nil
+		}
+
+		order := dto.TransformCheckoutDTOToModel(checkoutDto)
+		order.ClientUserID = clientUserId
+
+		order.Items, errFields, err = txSvc.snapshotItems(userCart)
+		if err != nil || errFields != nil {
+			return err
+		}
+
+		// Покупатель без входа ограничен по телефону из формы заказа
+		promoPhone := owner.ClientPhone
+		if promoPhone == "" {
+			promoPhone = checkoutDto.CustomerPhone
+		}
+		if userCart.PromoCode != nil {
+			errFields, err = txSvc.applyPromoCode(order, userCart, promoPhone)
+			if err != nil || errFields != nil {
+				return err
+			}
+		}
+
+		for _, item := range order.Items {
+			order.ItemsCount += item.Quantity
+			order.Subtotal += item.Subtotal
+			order.Discount += item.Discount
+		}
+		order.Total = order.Subtotal - order.Discount
+		order.History = []*model.OrderStatusHistory{{ToStatus: model.StatusNew, ChangedBy: checkoutDto.CustomerPhone}}
+
+		order, err = txSvc.repo.Create(order)
+		if err != nil {
+			return err
+		}
+
+		if order.PromoCode != nil {
+			if err := txSvc.promoCodeSvc.RecordUsage(*order.PromoCode, order.ID, promoPhone); err != nil {
+				return err
+			}
+		}
+
+		reserveItems := make([]inventoryDto.ReserveItemDTO, 0, len(order.Items))
+		for _, item := range order.Items {
+			reserveItems = append(reserveItems, inventoryDto.ReserveItemDTO{
+				ProductVariantID: *item.ProductVariantID,
+				Quantity:         item.Quantity,
+			})
+		}
+		if err := txSvc.inventorySvc.Reserve(order.Reference(), reserveItems); err != nil {
+			return err
+		}
+
+		orderId = order.ID
+		return txSvc.cartRepo.Delete(userCart.ID)
+	})
+	var rejection *promo_code.RejectionError
+	if errors.As(err, &rejection) {
+		return nil, []response.ErrorField{response.NewErrorField("promoCode", string(rejection.Reason))}, nil
+	}
+	if err != nil || errFields != nil {
+		return nil, errFields, err
+	}
+
+	orderDto, err := s.GetById(orderId)
+	return orderDto, nil, err
+}
+
+// applyPromoCode evaluates the cart promo code once more with the buyer's
+// phone and spreads the discount over the order lines.
+func (s *service) applyPromoCode(order *model.Order, userCart *cartModel.Cart, clientPhone string) ([]response.ErrorField, error) {
+	evaluation, err := s.promoCodeSvc.Evaluate(*userCart.PromoCode, clientPhone, cart.PromoCodeItems(userCart))
+	if err != nil {
+		return nil, err
+	}
+	if rejection := promo_code.NewRejectionError(evaluation); rejection != nil {
+		return []response.ErrorField{response.NewErrorField("promoCode", string(rejection.Reason))}, nil
+	}
+
+	discounts := make(map[uint]int64, len(evaluation.Lines))
+	for _, line := range evaluation.Lines {
+		discounts[line.ProductVariantID] = line.Discount
+	}
+	for _, item := range order.Items {
+		item.Discount = discounts[*item.ProductVariantID]
+	}
+	order.PromoCode = &evaluation.Code
+
+	return nil, nil
+}
+
+// snapshotItems copies current variant prices and product names into order lines.
+func (s *service) snapshotItems(userCart *cartModel.Cart) ([]*model.OrderItem, []response.ErrorField, error) {
+	productIds := make([]uint, 0, len(userCart.Items))
+	for _, item := range userCart.Items {
+		if item.ProductVariant == nil || !item.ProductVariant.IsActive {
+			return nil, []response.ErrorField{response.NewErrorField("productVariantId", string(response.NotFound))}, nil
+		}
+		productIds = append(productIds, item.ProductVariant.ProductID)
+	}
+
+	productNames, err := s.repo.GetProductNames(productIds)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	items := make([]*model.OrderItem, 0, len(userCart.Items))
+	for _, item := range userCart.Items {
+		variantId := item.ProductVariantID
+		items = append(items, &model.OrderItem{
+			ProductVariantID: &variantId,
+			ProductName:      productNames[item.ProductVariant.ProductID],
+			Article:          item.ProductVariant.Article,
+			Price:            item.ProductVariant.Price,
+			Quantity:         item.Quantity,
+			Subtotal:         item.ProductVariant.Price * int64(item.Quantity),
+		})
+	}
+
+	return items, nil, nil
+}
+
+func (s *service) GetAll(status string) ([]*dto.ResponseDTO, error) {
+	orders, err := s.repo.GetAll(status)
+	if err != nil {
+		return nil, err
+	}
+
+	return transformOrders(orders), nil
+}
+
+func (s *service) GetById(id uint) (*dto.ResponseDTO, error) {
+	order, err := s.repo.GetById(id)
+	if err != nil || order == nil {
+		return nil, err
+	}
+
+	return dto.TransformModelToResponseDTO(order), nil
+}
+
+func (s *service) GetAllByClient(phone string) ([]*dto.ResponseDTO, error) {
+	clientUser, err := s.clientUserRepo.GetByPhone(phone)
+	if err != nil {
+		return nil, err
+	}
+	if clientUser == nil {
+		return make([]*dto.ResponseDTO, 0), nil
+	}
+
+	orders, err := s.repo.GetAllByClientUserId(clientUser.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return transformOrders(orders), nil
+}
+
+// GetByIdForClient returns the order only if it belongs to the client.
+func (s *service) GetByIdForClient(phone string, id uint) (*dto.ResponseDTO, error) {
+	clientUser, err := s.clientUserRepo.GetByPhone(phone)
+	if err != nil || clientUser == nil {
+		return nil, err
+	}
+
+	order, err := s.repo.GetById(id)
+	if err != nil || order == nil {
+		return nil, err
+	}
+	if order.ClientUserID == nil || *order.ClientUserID != clientUser.ID {
+		return nil, nil
+	}
+
+	return dto.TransformModelToResponseDTO(order), nil
+}
+
+// ChangeStatus moves the order along the state machine and records the change
+// in the status history. Stock reserved at checkout is written off when the
+// order ships and returned to sale when it is cancelled.
+func (s *service) ChangeStatus(id uint, changeDto dto.ChangeStatusDTO, changedBy string) (*dto.ResponseDTO, []response.ErrorField, error) {
+	var notFound bool
+
+	err := s.transaction(func(txSvc *service) error {
+		order, err := txSvc.repo.GetById(id)
+		if err != nil {
+			return err
+		}
+		if order == nil {
+			notFound = true
+			return nil
+		}
+
+		return txSvc.moveTo(order, changeDto.Status, changeDto.Comment, changedBy)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if notFound {
+		return nil, []response.ErrorField{response.NewErrorField("id", string(response.NotFound))}, nil
+	}
+
+	orderDto, err := s.GetById(id)
+	return orderDto, nil, err
+}
+
+// ChangePaymentStatus records the payment outcome on the order. A successful
+// payment also moves the order to paid when the state machine allows it.
+func (s *service) ChangePaymentStatus(id uint, paymentStatus string, changedBy string) error {
+	return s.transaction(func(txSvc *service) error {
+		order, err := txSvc.repo.GetById(id)
+		if err != nil {
+			return err
+		}
+		if order == nil {
+			return fmt.Errorf("order with id %d not found", id)
+		}
+
+		if err := txSvc.repo.UpdatePaymentStatus(order.ID, paymentStatus); err != nil {
+			return err
+		}
+
+		if paymentStatus == model.PaymentStatusPaid && model.CanTransition(order.Status, model.StatusPaid) {
+			return txSvc.moveTo(order, model.StatusPaid, "Оплачено онлайн", changedBy)
+		}
+
+		return nil
+	})
+}
+
+// moveTo validates the transition, records it in the status history and
+// applies its effect on reserved stock.
+func (s *service) moveTo(order *model.Order, to, comment, changedBy string) error {
+	from := order.Status
+	if !model.CanTransition(from, to) {
+		return &InvalidTransitionError{From: from, To: to}
+	}
+
+	changed, err := s.repo.UpdateStatus(order.ID, from, to)
+	if err != nil {
+		return err
+	}
+	if !changed {
+		return fmt.Errorf("order status was changed concurrently, try again")
+	}
+
+	history := &model.OrderStatusHistory{
+		OrderID:    order.ID,
+		FromStatus: &from,
+		ToStatus:   to,
+		Comment:    comment,
+		ChangedBy:  changedBy,
+	}
+	if err := s.repo.CreateHistory(history); err != nil {
+		return err
+	}
+
+	switch to {
+	case model.StatusShipped:
+		return s.inventorySvc.Commit(order.Reference())
+	case model.StatusCancelled, model.StatusReturned:
+		// После отгрузки резерв уже списан, и Release ничего не вернёт:
+		// возвращённый товар приходуется на склад отдельной корректировкой
+		return s.inventorySvc.Release(order.Reference())
+	}
+
+	return nil
+}
+
+func transformOrders(orders []*model.Order) []*dto.ResponseDTO {
+	orderDTOs := make([]*dto.ResponseDTO, 0, len(orders))
+	for _, order := range orders {
+		orderDTOs = append(orderDTOs, dto.TransformModelToResponseDTO(order))
+	}
+
+	return orderDTOs
+}
@@ -0,0 +1,292 @@
+package order
+
+import (
+	"errors"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"haircompany-shop-rest/internal/modules/v1/cart"
+	cartDto "haircompany-shop-rest/internal/modules/v1/cart/dto"
+	cartModel "haircompany-shop-rest/internal/modules/v1/cart/model"
+	"haircompany-shop-rest/internal/modules/v1/client_user"
+	clientUserModel "haircompany-shop-rest/internal/modules/v1/client_user/model"
+	"haircompany-shop-rest/internal/modules/v1/inventory"
+	inventoryModel "haircompany-shop-rest/internal/modules/v1/inventory/model"
+	"haircompany-shop-rest/internal/modules/v1/order/dto"
+	"haircompany-shop-rest/internal/modules/v1/order/model"
+	productModel "haircompany-shop-rest/internal/modules/v1/product/model"
+	"haircompany-shop-rest/internal/modules/v1/product_variant"
+	variantModel "haircompany-shop-rest/internal/modules/v1/product_variant/model"
+	"haircompany-shop-rest/internal/modules/v1/promo_code"
+	promoCodeModel "haircompany-shop-rest/internal/modules/v1/promo_code/model"
+	"haircompany-shop-rest/pkg/database"
+	"haircompany-shop-rest/pkg/response"
+	"testing"
+)
+
+type testEnv struct {
+	db        *gorm.DB
+	svc       Service
+	cartSvc   cart.Service
+	variantId uint
+}
+
+func setupTestEnv(t *testing.T, quantity int) *testEnv {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatal("Failed to connect to test database:", err)
+	}
+
+	err = db.AutoMigrate(
+		&clientUserModel.ClientUser{},
+		&productModel.Product{},
+		&variantModel.ProductVariant{},
+		&inventoryModel.Stock{},
+		&inventoryModel.StockMovement{},
+		&inventoryModel.StockReservation{},
+		&cartModel.Cart{},
+		&cartModel.CartItem{},
+		&model.Order{},
+		&model.OrderItem{},
+		&model.OrderStatusHistory{},
+		&promoCodeModel.PromoCode{},
+		&promoCodeModel.PromoCodeUsage{},
+	)
+	if err != nil {
+		t.Fatal("Failed to migrate test database:", err)
+	}
+
+	product := &productModel.Product{Name: "Шампунь", Slug: "shampoo", CategoryID: 1, LineID: 1, ProductTypeID: 1}
+	if err := db.Omit("DesiredResults.*", "Shades.*", "Variants").Create(product).Error; err != nil {
+		t.Fatalf("Failed to create test product: %v", err)
+	}
+	variant := &variantModel.ProductVariant{ProductID: product.ID, Article: "HC-001", Barcode: "4607010590017", Volume: 250, Price: 1000, IsActive: true}
+	if err := db.Create(variant).Error; err != nil {
+		t.Fatalf("Failed to create test variant: %v", err)
+	}
+	if err := db.Create(&inventoryModel.Stock{ProductVariantID: variant.ID, Quantity: quantity}).Error; err != nil {
+		t.Fatalf("Failed to create test stock: %v", err)
+	}
+
+	testDB := &database.DB{DB: db}
+	variantRepo := product_variant.NewRepository(testDB)
+	inventorySvc := inventory.NewService(inventory.NewRepository(testDB), variantRepo)
+	cartSvc := cart.NewService(cart.NewRepository(testDB), variantRepo, client_user.NewRepository(testDB), inventorySvc, promo_code.NewService(promo_code.NewRepository(testDB)))
+
+	return &testEnv{
+		db:        db,
+		svc:       NewService(NewRepository(testDB), client_user.NewRepository(testDB), variantRepo),
+		cartSvc:   cartSvc,
+		variantId: variant.ID,
+	}
+}
+
+func (e *testEnv) stock(t *testing.T) *inventoryModel.Stock {
+	var stock inventoryModel.Stock
+	if err := e.db.First(&stock, "product_variant_id = ?", e.variantId).Error; err != nil {
+		t.Fatalf("Failed to load stock: %v", err)
+	}
+	return &stock
+}
+
+func (e *testEnv) checkout(t *testing.T, quantity int) *dto.ResponseDTO {
+	guestCart, _, err := e.cartSvc.AddItem(cart.Owner{}, cartDto.AddItemDTO{ProductVariantID: e.variantId, Quantity: quantity})
+	if err != nil {
+		t.Fatalf("Failed to add item to cart: %v", err)
+	}
+
+	order, errFields, err := e.svc.Checkout(cart.Owner{Token: *guestCart.Token}, dto.CheckoutDTO{
+		CustomerName:   "Анна",
+		CustomerPhone:  "+79991234567",
+		DeliveryMethod: model.DeliveryPickup,
+	})
+	if err != nil || errFields != nil {
+		t.Fatalf("Expected no error, got %v %v", err, errFields)
+	}
+
+	return order
+}
+
+func TestService_Checkout(t *testing.T) {
+	env := setupTestEnv(t, 5)
+	order := env.checkout(t, 2)
+
+	if order.Status != model.StatusNew || order.Total != 2000 || order.ItemsCount != 2 {
+		t.Errorf("Unexpected order: status %s, total %d, items %d", order.Status, order.Total, order.ItemsCount)
+	}
+	if len(order.Items) != 1 || order.Items[0].ProductName != "Шампунь" || order.Items[0].Price != 1000 {
+		t.Errorf("Expected product name and price to be snapshotted, got %+v", order.Items)
+	}
+	if len(order.History) != 1 || order.History[0].ToStatus != model.StatusNew {
+		t.Errorf("Expected initial status history entry, got %+v", order.History)
+	}
+
+	if stock := env.stock(t); stock.Reserved != 2 {
+		t.Errorf("Expected 2 reserved, got %d", stock.Reserved)
+	}
+
+	var cartsCount int64
+	env.db.Model(&cartModel.Cart{}).Count(&cartsCount)
+	if cartsCount != 0 {
+		t.Error("Expected cart to be removed after checkout")
+	}
+}
+
+func TestService_Checkout_ClientCart(t *testing.T) {
+	env := setupTestEnv(t, 5)
+	clientUser := &clientUserModel.ClientUser{Phone: "+79990000001"}
+	if err := env.db.Create(clientUser).Error; err != nil {
+		t.Fatalf("Failed to create test client: %v", err)
+	}
+
+	owner := cart.Owner{ClientPhone: clientUser.Phone}
+	if _, _, err := env.cartSvc.AddItem(owner, cartDto.AddItemDTO{ProductVariantID: env.variantId, Quantity: 1}); err != nil {
+		t.Fatalf("Failed to add item to cart: %v", err)
+	}
+
+	order, errFields, err := env.svc.Checkout(owner, dto.CheckoutDTO{
+		CustomerName:   "Анна",
+		CustomerPhone:  clientUser.Phone,
+		DeliveryMethod: model.DeliveryPickup,
+	})
+	if err != nil || errFields != nil {
+		t.Fatalf("Expected no error, got %v %v", err, errFields)
+	}
+	if order.ClientUserID == nil || *order.ClientUserID != clientUser.ID {
+		t.Errorf("Expected order of client %d, got %v", clientUser.ID, order.ClientUserID)
+	}
+}
+
+func TestService_Checkout_EmptyCart(t *testing.T) {
+	env := setupTestEnv(t, 5)
+
+	_, errFields, err := env.svc.Checkout(cart.Owner{Token: "unknown"}, dto.CheckoutDTO{
+		CustomerName:   "Анна",
+		CustomerPhone:  "+79991234567",
+		DeliveryMethod: model.DeliveryPickup,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(errFields) == 0 {
+		t.Error("Expected EMPTY_CART error field")
+	}
+}
+
+func TestService_Checkout_InsufficientStockRollsBack(t *testing.T) {
+	env := setupTestEnv(t, 5)
+
+	guestCart, _, err := env.cartSvc.AddItem(cart.Owner{}, cartDto.AddItemDTO{ProductVariantID: env.variantId, Quantity: 3})
+	if err != nil {
+		t.Fatalf("Failed to add item to cart: %v", err)
+	}
+	env.db.Model(&inventoryModel.Stock{}).Where("product_variant_id = ?", env.variantId).Update("quantity", 2)
+
+	_, _, err = env.svc.Checkout(cart.Owner{Token: *guestCart.Token}, dto.CheckoutDTO{
+		CustomerName:   "Анна",
+		CustomerPhone:  "+79991234567",
+		DeliveryMethod: model.DeliveryPickup,
+	})
+	var stockErr *inventory.InsufficientStockError
+	if !errors.As(err, &stockErr) {
+		t.Fatalf("Expected InsufficientStockError, got %v", err)
+	}
+
+	var ordersCount int64
+	env.db.Model(&model.Order{}).Count(&ordersCount)
+	if ordersCount != 0 {
+		t.Error("Expected order creation to be rolled back")
+	}
+}
+
+func TestService_Checkout_PromoCode(t *testing.T) {
+	env := setupTestEnv(t, 10)
+	perClient := 1
+	env.db.Create(&promoCodeModel.PromoCode{Code: "MINUS500", DiscountType: promoCodeModel.DiscountFixed, DiscountValue: 500, UsageLimitPerClient: &perClient, IsActive: true})
+
+	checkoutWithCode := func() (*dto.ResponseDTO, []response.ErrorField, error) {
+		guestCart, _, err := env.cartSvc.AddItem(cart.Owner{}, cartDto.AddItemDTO{ProductVariantID: env.variantId, Quantity: 2})
+		if err != nil {
+			t.Fatalf("Failed to add item to cart: %v", err)
+		}
+		owner := cart.Owner{Token: *guestCart.Token}
+		if _, err := env.cartSvc.ApplyPromoCode(owner, cartDto.PromoCodeDTO{Code: "minus500"}); err != nil {
+			t.Fatalf("Failed to apply promo code: %v", err)
+		}
+
+		return env.svc.Checkout(owner, dto.CheckoutDTO{
+			CustomerName:   "Анна",
+			CustomerPhone:  "+79991234567",
+			DeliveryMethod: model.DeliveryPickup,
+		})
+	}
+
+	order, errFields, err := checkoutWithCode()
+	if err != nil || errFields != nil {
+		t.Fatalf("Expected no error, got %v %v", err, errFields)
+	}
+	if order.Subtotal != 2000 || order.Discount != 500 || order.Total != 1500 || order.Items[0].Discount != 500 {
+		t.Errorf("Expected 500 off 2000, got subtotal %d, discount %d, total %d", order.Subtotal, order.Discount, order.Total)
+	}
+
+	var promoCode promoCodeModel.PromoCode
+	env.db.First(&promoCode, "code = ?", "MINUS500")
+	if promoCode.UsedCount != 1 {
+		t.Errorf("Expected promo code usage to be counted, got %d", promoCode.UsedCount)
+	}
+
+	_, errFields, err = checkoutWithCode()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(errFields) != 1 || errFields[0].ErrorCode != string(response.PromoCodeClientLimit) {
+		t.Fatalf("Expected PROMO_CODE_CLIENT_LIMIT_REACHED, got %+v", errFields)
+	}
+	if stock := env.stock(t); stock.Reserved != 2 {
+		t.Errorf("Expected rejected checkout to reserve nothing, got %d reserved", stock.Reserved)
+	}
+}
+
+func TestService_ChangeStatus(t *testing.T) {
+	env := setupTestEnv(t, 5)
+	order := env.checkout(t, 2)
+
+	_, _, err := env.svc.ChangeStatus(order.Id, dto.ChangeStatusDTO{Status: model.StatusShipped}, "manager@example.com")
+	var transitionErr *InvalidTransitionError
+	if !errors.As(err, &transitionErr) {
+		t.Fatalf("Expected InvalidTransitionError, got %v", err)
+	}
+
+	for _, status := range []string{model.StatusConfirmed, model.StatusPaid, model.StatusShipped} {
+		order, _, err = env.svc.ChangeStatus(order.Id, dto.ChangeStatusDTO{Status: status}, "manager@example.com")
+		if err != nil {
+			t.Fatalf("Expected no error moving to %s, got %v", status, err)
+		}
+	}
+
+	if len(order.History) != 4 || order.History[3].ChangedBy != "manager@example.com" {
+		t.Errorf("Expected 4 history entries, got %+v", order.History)
+	}
+	if stock := env.stock(t); stock.Quantity != 3 || stock.Reserved != 0 {
+		t.Errorf("Expected stock to be written off on shipping, got quantity %d reserved %d", stock.Quantity, stock.Reserved)
+	}
+}
+
+func TestService_ChangeStatus_CancelReleasesStock(t *testing.T) {
+	env := setupTestEnv(t, 5)
+	order := env.checkout(t, 2)
+
+	_, _, err := env.svc.ChangeStatus(order.Id, dto.ChangeStatusDTO{Status: model.StatusCancelled}, "manager@example.com")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if stock := env.stock(t); stock.Quantity != 5 || stock.Reserved != 0 {
+		t.Errorf("Expected reservation to be released, got quantity %d reserved %d", stock.Quantity, stock.Reserved)
+	}
+
+	_, _, err = env.svc.ChangeStatus(order.Id, dto.ChangeStatusDTO{Status: model.StatusConfirmed}, "manager@example.com")
+	var transitionErr *InvalidTransitionError
+	if !errors.As(err, &transitionErr) {
+		t.Errorf("Expected cancelled to be terminal, got %v", err)
+	}
+}
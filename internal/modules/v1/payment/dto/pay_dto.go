@@ -0,0 +1,6 @@
+package dto
+
+type PayDTO struct {
+	// Телефон из формы заказа: подтверждает, что гостевой заказ оплачивает его автор
+	CustomerPhone string `json:"customerPhone" validate:"omitempty,e164" example:"+79991234567"`
+}
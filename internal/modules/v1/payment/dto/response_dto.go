@@ -0,0 +1,15 @@
+package dto
+
+import "time"
+
+type ResponseDTO struct {
+	Id              uint      `json:"id" example:"1"`
+	CreatedAt       time.Time `json:"createdAt" example:"2023-10-01T12:00:00Z"`
+	UpdatedAt       time.Time `json:"updatedAt" example:"2023-10-01T12:00:00Z"`
+	OrderID         uint      `json:"orderId" example:"1"`
+	Provider        string    `json:"provider" example:"fake"`
+	ExternalID      string    `json:"externalId" example:"fake_9f86d081884c7d65"`
+	Amount          int64     `json:"amount" example:"258000"`
+	Status          string    `json:"status" example:"pending"`
+	ConfirmationURL string    `json:"confirmationUrl" example:"https://fake-payments.local/pay/fake_9f86d081884c7d65"`
+}
@@ -0,0 +1,17 @@
+package dto
+
+import "haircompany-shop-rest/internal/modules/v1/payment/model"
+
+func TransformModelToResponseDTO(payment *model.Payment) *ResponseDTO {
+	return &ResponseDTO{
+		Id:              payment.ID,
+		CreatedAt:       payment.CreatedAt,
+		UpdatedAt:       payment.UpdatedAt,
+		OrderID:         payment.OrderID,
+		Provider:        payment.Provider,
+		ExternalID:      payment.ExternalID,
+		Amount:          payment.Amount,
+		Status:          payment.Status,
+		ConfirmationURL: payment.ConfirmationURL,
+	}
+}
@@ -0,0 +1,223 @@
+package payment
+
+import (
+	"errors"
+	"fmt"
+	"haircompany-shop-rest/internal/constraint"
+	"haircompany-shop-rest/internal/modules/v1/payment/dto"
+	"haircompany-shop-rest/internal/services"
+	"haircompany-shop-rest/pkg/request"
+	"haircompany-shop-rest/pkg/response"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+const maxWebhookBodySize = 1 << 20 // 1 MB
+
+type Handler struct {
+	svc Service
+}
+
+func NewHandler(s Service) *Handler {
+	return &Handler{
+		svc: s,
+	}
+}
+
+// CreateForOrder starts an online payment for an order
+//
+//	@Summary		Pay for order
+//	@Description	Create an online payment for the order and return the provider confirmation URL. Orders placed by a client can be paid only by that client; guest orders require the customer phone from the order form. A new payment is refused while the previous one is pending.
+//	@Tags			Payment
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int							true	"Order ID"
+//	@Param			payment	body		dto.PayDTO					false	"Customer phone for guest orders"
+//	@Success		201		{object}	docsResponse.PaymentGet200	"Payment created"
+//	@Failure		400		{object}	docsResponse.Response400	"Invalid ID or request body"
+//	@Failure		401		{object}	docsResponse.Response401	"Unauthorized"
+//	@Failure		403		{object}	docsResponse.Response403	"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		404		{object}	docsResponse.Response404	"Order not found"
+//	@Failure		409		{object}	docsResponse.Payment409		"Order cannot be paid or has a pending payment"
+//	@Failure		500		{object}	docsResponse.Response500	"Server error"
+//	@Router			/api/v1/order/{id}/pay [post]
+func (h *Handler) CreateForOrder(w http.ResponseWriter, r *http.Request) {
+	idStr := r.PathValue("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil || id < 0 {
+		msg := fmt.Sprintf("invalid order id: %s", idStr)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	var clientPhone string
+	if claims, ok := r.Context().Value("clientClaims").(*services.ClientClaims); ok && claims != nil {
+		clientPhone = claims.Phone
+	}
+
+	// Тело нужно только для гостевых заказов
+	var payDto dto.PayDTO
+	if r.ContentLength != 0 {
+		payDto, err = request.DecodeBody[dto.PayDTO](r.Body)
+		if err != nil {
+			msg := fmt.Sprintf("invalid request body: %v", err)
+			response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+			return
+		}
+
+		errFields := constraint.ValidateDTO(payDto)
+		if errFields != nil {
+			msg := "validation errors occurred"
+			response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+			return
+		}
+	}
+
+	payment, errFields, err := h.svc.CreateForOrder(uint(id), clientPhone, payDto)
+	if err != nil {
+		if errors.Is(err, ErrOrderNotPayable) || errors.Is(err, ErrPaymentPending) {
+			response.SendError(w, http.StatusConflict, err.Error(), response.PaymentNotAllowed)
+			return
+		}
+
+		msg := fmt.Sprintf("failed to create payment: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+	if errFields != nil {
+		msg := fmt.Sprintf("order with id %d not found", id)
+		response.SendError(w, http.StatusNotFound, msg, response.NotFound)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusCreated, payment)
+}
+
+// GetAllByOrderId retrieves payments of an order
+//
+//	@Summary		Get order payments
+//	@Description	Retrieve all payment attempts of an order
+//	@Tags			Payment
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			id	path		int							true	"Order ID"
+//	@Success		200	{object}	docsResponse.PaymentList200	"List of payments"
+//	@Failure		400	{object}	docsResponse.Response400	"Invalid ID"
+//	@Failure		401	{object}	docsResponse.Response401	"Unauthorized"
+//	@Failure		403	{object}	docsResponse.Response403	"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		500	{object}	docsResponse.Response500	"Server error"
+//	@Router			/api/v1/order/{id}/payments [get]
+func (h *Handler) GetAllByOrderId(w http.ResponseWriter, r *http.Request) {
+	idStr := r.PathValue("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil || id < 0 {
+		msg := fmt.Sprintf("invalid order id: %s", idStr)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	payments, err := h.svc.GetAllByOrderId(uint(id))
+	if err != nil {
+		msg := fmt.Sprintf("failed to retrieve payments: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, payments)
+}
+
+// Refund refunds a succeeded payment
+//
+//	@Summary		Refund payment
+//	@Description	Refund a succeeded payment in full and mark the order payment as refunded
+//	@Tags			Payment
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			id	path		int							true	"Payment ID"
+//	@Success		200	{object}	docsResponse.PaymentGet200	"Payment refunded"
+//	@Failure		400	{object}	docsResponse.Response400	"Invalid ID"
+//	@Failure		401	{object}	docsResponse.Response401	"Unauthorized"
+//	@Failure		403	{object}	docsResponse.Response403	"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		404	{object}	docsResponse.Response404	"Payment not found"
+//	@Failure		409	{object}	docsResponse.Payment409		"Payment cannot be refunded"
+//	@Failure		500	{object}	docsResponse.Response500	"Server error"
+//	@Router			/api/v1/payment/{id}/refund [post]
+func (h *Handler) Refund(w http.ResponseWriter, r *http.Request) {
+	idStr := r.PathValue("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil || id < 0 {
+		msg := fmt.Sprintf("invalid payment id: %s", idStr)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	var changedBy string
+	if claims, ok := r.Context().Value("dashboardClaims").(*services.DashboardClaims); ok && claims != nil {
+		changedBy = claims.Email
+	}
+
+	payment, errFields, err := h.svc.Refund(uint(id), changedBy)
+	if err != nil {
+		if errors.Is(err, ErrPaymentNotRefundable) {
+			response.SendError(w, http.StatusConflict, err.Error(), response.PaymentNotAllowed)
+			return
+		}
+
+		msg := fmt.Sprintf("failed to refund payment: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+	if errFields != nil {
+		msg := fmt.Sprintf("payment with id %d not found", id)
+		response.SendError(w, http.StatusNotFound, msg, response.NotFound)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, payment)
+}
+
+// Webhook receives payment notifications from the provider
+//
+//	@Summary		Payment webhook
+//	@Description	Receive a signed payment notification from the provider. Repeated notifications are acknowledged without side effects. Not covered by X-AUTH-APP.
+//	@Tags			Payment
+//	@Accept			json
+//	@Produce		json
+//	@Param			X-Payment-Signature	header		string						true	"HMAC-SHA256 of the body (fake provider)"
+//	@Success		200					{object}	docsResponse.Webhook200		"Notification processed"
+//	@Failure		400					{object}	docsResponse.Response400	"Invalid notification"
+//	@Failure		401					{object}	docsResponse.Webhook401		"Invalid signature"
+//	@Failure		404					{object}	docsResponse.Response404	"Payment not found"
+//	@Failure		500					{object}	docsResponse.Response500	"Server error"
+//	@Router			/webhooks/v1/payment [post]
+func (h *Handler) Webhook(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBodySize))
+	if err != nil {
+		msg := fmt.Sprintf("invalid request body: %v", err)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	err = h.svc.HandleWebhook(body, r.Header)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidPaymentSignature):
+			response.SendError(w, http.StatusUnauthorized, err.Error(), response.InvalidSignature)
+		case errors.Is(err, ErrPaymentNotFound):
+			response.SendError(w, http.StatusNotFound, err.Error(), response.NotFound)
+		case errors.Is(err, ErrAmountMismatch):
+			response.SendError(w, http.StatusBadRequest, err.Error(), response.BadRequest)
+		default:
+			msg := fmt.Sprintf("failed to process payment notification: %v", err)
+			response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		}
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, nil)
+}
@@ -0,0 +1,25 @@
+package model
+
+import "time"
+
+type Payment struct {
+	ID              uint `gorm:"primarykey" json:"id"`
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	OrderID         uint   `gorm:"not null;index" json:"orderId"`
+	Provider        string `gorm:"type:varchar(32);not null;uniqueIndex:idx_payments_provider_external" json:"provider"`
+	ExternalID      string `gorm:"type:varchar(128);not null;uniqueIndex:idx_payments_provider_external" json:"externalId"`
+	Amount          int64  `gorm:"not null" json:"amount"` // Amount in kopecks
+	Status          string `gorm:"type:varchar(16);not null;default:pending" json:"status"`
+	ConfirmationURL string `gorm:"type:text" json:"confirmationUrl"`
+}
+
+// PaymentEvent marks a provider notification as processed.
+type PaymentEvent struct {
+	ID        uint `gorm:"primarykey" json:"id"`
+	CreatedAt time.Time
+	Provider  string `gorm:"type:varchar(32);not null;uniqueIndex:idx_payment_events_provider_event" json:"provider"`
+	EventID   string `gorm:"type:varchar(128);not null;uniqueIndex:idx_payment_events_provider_event" json:"eventId"`
+	PaymentID uint   `gorm:"not null" json:"paymentId"`
+	Status    string `gorm:"type:varchar(16);not null" json:"status"`
+}
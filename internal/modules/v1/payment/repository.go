@@ -0,0 +1,111 @@
+package payment
+
+import (
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"haircompany-shop-rest/internal/modules/v1/order"
+	"haircompany-shop-rest/internal/modules/v1/payment/model"
+	"haircompany-shop-rest/internal/services"
+	"haircompany-shop-rest/pkg/database"
+)
+
+type Repository interface {
+	Transaction(fn func(repo Repository) error) error
+	// Orders shares the connection of the repository, so inside Transaction
+	// the order changes join it.
+	Orders() order.Repository
+	Create(model *model.Payment) (*model.Payment, error)
+	GetById(id uint) (*model.Payment, error)
+	GetByExternalId(provider, externalId string) (*model.Payment, error)
+	GetAllByOrderId(orderId uint) ([]*model.Payment, error)
+	HasPending(orderId uint) (bool, error)
+	UpdateStatus(id uint, from, to string) (bool, error)
+	CreateEvent(event *model.PaymentEvent) (bool, error)
+}
+
+type repository struct {
+	DB *database.DB
+}
+
+func NewRepository(db *database.DB) Repository {
+	return &repository{
+		DB: db,
+	}
+}
+
+// Transaction runs fn against a repository bound to a single database transaction.
+// When the repository is already bound to a transaction a savepoint is used instead.
+func (r *repository) Transaction(fn func(repo Repository) error) error {
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		return fn(NewRepository(&database.DB{DB: tx}))
+	})
+}
+
+func (r *repository) Orders() order.Repository {
+	return order.NewRepository(r.DB)
+}
+
+func (r *repository) Create(model *model.Payment) (*model.Payment, error) {
+	result := r.DB.Create(&model)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return model, nil
+}
+
+func (r *repository) GetById(id uint) (*model.Payment, error) {
+	return r.first("id = ?", id)
+}
+
+func (r *repository) GetByExternalId(provider, externalId string) (*model.Payment, error) {
+	return r.first("provider = ? AND external_id = ?", provider, externalId)
+}
+
+func (r *repository) first(query string, args ...interface{}) (*model.Payment, error) {
+	var payment *model.Payment
+	var err error
+
+	result := r.DB.Where(query, args...).First(&payment)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		err = result.Error
+	}
+
+	return payment, err
+}
+
+func (r *repository) GetAllByOrderId(orderId uint) ([]*model.Payment, error) {
+	var payments []*model.Payment
+
+	result := r.DB.Where("order_id = ?", orderId).Order("id").Find(&payments)
+	return payments, result.Error
+}
+
+func (r *repository) HasPending(orderId uint) (bool, error) {
+	var count int64
+
+	result := r.DB.Model(&model.Payment{}).
+		Where("order_id = ? AND status = ?", orderId, services.PaymentStatusPending).
+		Count(&count)
+	return count > 0, result.Error
+}
+
+// UpdateStatus changes the payment status only if it is still in the expected one.
+func (r *repository) UpdateStatus(id uint, from, to string) (bool, error) {
+	result := r.DB.Model(&model.Payment{}).
+		Where("id = ? AND status = ?", id, from).
+		Update("status", to)
+
+	return result.RowsAffected > 0, result.Error
+}
+
+// CreateEvent stores a provider notification. It returns false when the
+// notification has already been processed.
+func (r *repository) CreateEvent(event *model.PaymentEvent) (bool, error) {
+	result := r.DB.Clauses(clause.OnConflict{DoNothing: true}).Create(event)
+	return result.RowsAffected > 0, result.Error
+}
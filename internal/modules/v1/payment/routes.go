@@ -0,0 +1,87 @@
+package payment
+
+import (
+	"haircompany-shop-rest/internal/container"
+	"haircompany-shop-rest/internal/middleware"
+	"haircompany-shop-rest/internal/modules/v1/client_user"
+	"haircompany-shop-rest/internal/modules/v1/order"
+	"haircompany-shop-rest/internal/modules/v1/product_variant"
+	"haircompany-shop-rest/pkg/response"
+	"net/http"
+)
+
+func serviceFromContainer(container *container.Container) Service {
+	clientUserRepo := client_user.NewRepository(container.DB)
+	orderSvc := order.NewService(order.NewRepository(container.DB), clientUserRepo, product_variant.NewRepository(container.DB))
+	return NewService(NewRepository(container.DB), orderSvc, clientUserRepo, container.PaymentProvider)
+}
+
+func RegisterV1PaymentRoutes(mux *http.ServeMux, container *container.Container) {
+	svc := serviceFromContainer(container)
+	h := NewHandler(svc)
+
+	mux.Handle("/order/{id}/pay",
+		middleware.ChainMiddleware(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodPost:
+					h.CreateForOrder(w, r)
+				default:
+					msg := "Method not allowed. Allowed methods: POST"
+					response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+				}
+			}),
+			middleware.OptionalClientAuthMiddleware(container.JWTService),
+		),
+	)
+
+	mux.Handle("/order/{id}/payments",
+		middleware.ChainMiddleware(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodGet:
+					h.GetAllByOrderId(w, r)
+				default:
+					msg := "Method not allowed. Allowed methods: GET"
+					response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+				}
+			}),
+			middleware.DashboardRoleMiddleware("admin", "manager"),
+			middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist),
+		),
+	)
+
+	mux.Handle("/payment/{id}/refund",
+		middleware.ChainMiddleware(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodPost:
+					h.Refund(w, r)
+				default:
+					msg := "Method not allowed. Allowed methods: POST"
+					response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+				}
+			}),
+			middleware.DashboardRoleMiddleware("admin", "manager"),
+			middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist),
+		),
+	)
+}
+
+// RegisterV1PaymentWebhookRoutes registers provider callbacks. They are mounted
+// outside the API mux because providers cannot send X-AUTH-APP; requests are
+// authenticated by their signature instead.
+func RegisterV1PaymentWebhookRoutes(mux *http.ServeMux, container *container.Container) {
+	svc := serviceFromContainer(container)
+	h := NewHandler(svc)
+
+	mux.HandleFunc("/payment", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			h.Webhook(w, r)
+		default:
+			msg := "Method not allowed. Allowed methods: POST"
+			response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+		}
+	})
+}
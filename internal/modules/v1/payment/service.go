@@ -0,0 +1,277 @@
+package payment
+
+import (
+	"errors"
+	"fmt"
+	"haircompany-shop-rest/internal/modules/v1/client_user"
+	clientUserModel "haircompany-shop-rest/internal/modules/v1/client_user/model"
+	"haircompany-shop-rest/internal/modules/v1/order"
+	orderModel "haircompany-shop-rest/internal/modules/v1/order/model"
+	"haircompany-shop-rest/internal/modules/v1/payment/dto"
+	"haircompany-shop-rest/internal/modules/v1/payment/model"
+	"haircompany-shop-rest/internal/services"
+	"haircompany-shop-rest/pkg/response"
+	"log"
+	"net/http"
+)
+
+var (
+	ErrOrderNotPayable      = errors.New("order cannot be paid in its current status")
+	ErrPaymentNotRefundable = errors.New("only succeeded payments can be refunded")
+	ErrPaymentNotFound      = errors.New("payment not found")
+	ErrAmountMismatch       = errors.New("payment amount does not match")
+	ErrPaymentPending       = errors.New("order already has a pending payment")
+)
+
+type Service interface {
+	CreateForOrder(orderId uint, clientPhone string, payDto dto.PayDTO) (*dto.ResponseDTO, []response.ErrorField, error)
+	GetAllByOrderId(orderId uint) ([]*dto.ResponseDTO, error)
+	HandleWebhook(body []byte, header http.Header) error
+	Refund(id uint, changedBy string) (*dto.ResponseDTO, []response.ErrorField, error)
+}
+
+type service struct {
+	repo           Repository
+	orderRepo      order.Repository
+	orderSvc       order.Service
+	clientUserRepo client_user.Repository
+	provider       services.PaymentProvider
+}
+
+func NewService(r Repository, orderSvc order.Service, clientUserRepo client_user.Repository, provider services.PaymentProvider) Service {
+	return newService(r, orderSvc, clientUserRepo, provider)
+}
+
+// newService takes the order repository from r and binds the order service to
+// it: a webhook updates the payment and its order in one transaction.
+func newService(r Repository, orderSvc order.Service, clientUserRepo client_user.Repository, provider services.PaymentProvider) *service {
+	orderRepo := r.Orders()
+
+	return &service{
+		repo:           r,
+		orderRepo:      orderRepo,
+		orderSvc:       orderSvc.WithRepository(orderRepo),
+		clientUserRepo: clientUserRepo,
+		provider:       provider,
+	}
+}
+
+func (s *service) transaction(fn func(txSvc *service) error) error {
+	return s.repo.Transaction(func(repo Repository) error {
+		return fn(newService(repo, s.orderSvc, s.clientUserRepo, s.provider))
+	})
+}
+
+// CreateForOrder starts an online payment. Orders placed by a client can be
+// paid only by that client, guest orders only with the phone from the order
+// form. A new payment is refused while the previous one is still pending.
+func (s *service) CreateForOrder(orderId uint, clientPhone string, payDto dto.PayDTO) (*dto.ResponseDTO, []response.ErrorField, error) {
+	notFound := []response.ErrorField{response.NewErrorField("orderId", string(response.NotFound))}
+
+	clientUser, err := s.getClientUser(clientPhone)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var payment *model.Payment
+	var errFields []response.ErrorField
+
+	// Заказ заблокирован до конца транзакции, поэтому два параллельных запроса
+	// не создадут два платежа; блокировка держится и на время запроса к провайдеру
+	err = s.transaction(func(txSvc *service) error {
+		o, err := txSvc.orderRepo.GetByIdForUpdate(orderId)
+		if err != nil {
+			return err
+		}
+		if o == nil {
+			errFields = notFound
+			return nil
+		}
+
+		if !isOrderOwner(o, clientUser, payDto.CustomerPhone) {
+			errFields = notFound
+			return nil
+		}
+
+		if o.PaymentStatus != orderModel.PaymentStatusUnpaid || !orderModel.CanTransition(o.Status, orderModel.StatusPaid) {
+			return ErrOrderNotPayable
+		}
+
+		pending, err := txSvc.repo.HasPending(o.ID)
+		if err != nil {
+			return err
+		}
+		if pending {
+			return ErrPaymentPending
+		}
+
+		result, err := txSvc.provider.CreatePayment(services.PaymentRequest{
+			Reference:   o.Reference(),
+			Amount:      o.Total,
+			Description: fmt.Sprintf("Оплата заказа №%d", o.ID),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create payment: %w", err)
+		}
+
+		payment, err = txSvc.repo.Create(&model.Payment{
+			OrderID:         o.ID,
+			Provider:        txSvc.provider.Name(),
+			ExternalID:      result.ExternalID,
+			Amount:          o.Total,
+			Status:          result.Status,
+			ConfirmationURL: result.ConfirmationURL,
+		})
+		return err
+	})
+	if err != nil || errFields != nil {
+		return nil, errFields, err
+	}
+
+	return dto.TransformModelToResponseDTO(payment), nil, nil
+}
+
+// getClientUser returns the signed-in client, or nil for a guest.
+func (s *service) getClientUser(clientPhone string) (*clientUserModel.ClientUser, error) {
+	if clientPhone == "" {
+		return nil, nil
+	}
+
+	return s.clientUserRepo.GetByPhone(clientPhone)
+}
+
+// isOrderOwner checks the signed-in client for client orders and the phone
+// from the order form for guest orders.
+func isOrderOwner(o *orderModel.Order, clientUser *clientUserModel.ClientUser, customerPhone string) bool {
+	if o.ClientUserID == nil {
+		return customerPhone != "" && customerPhone == o.CustomerPhone
+	}
+
+	return clientUser != nil && clientUser.ID == *o.ClientUserID
+}
+
+func (s *service) GetAllByOrderId(orderId uint) ([]*dto.ResponseDTO, error) {
+	payments, err := s.repo.GetAllByOrderId(orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	paymentDTOs := make([]*dto.ResponseDTO, 0, len(payments))
+	for _, payment := range payments {
+		paymentDTOs = append(paymentDTOs, dto.TransformModelToResponseDTO(payment))
+	}
+
+	return paymentDTOs, nil
+}
+
+// HandleWebhook applies a provider notification. Every notification is stored
+// by its event ID, so repeated deliveries are acknowledged without side effects.
+func (s *service) HandleWebhook(body []byte, header http.Header) error {
+	event, err := s.provider.HandleCallback(body, header)
+	if err != nil {
+		return err
+	}
+
+	changedBy := fmt.Sprintf("payment:%s", s.provider.Name())
+
+	return s.transaction(func(txSvc *service) error {
+		payment, err := txSvc.repo.GetByExternalId(txSvc.provider.Name(), event.ExternalID)
+		if err != nil {
+			return err
+		}
+		if payment == nil {
+			return ErrPaymentNotFound
+		}
+
+		created, err := txSvc.repo.CreateEvent(&model.PaymentEvent{
+			Provider:  txSvc.provider.Name(),
+			EventID:   event.EventID,
+			PaymentID: payment.ID,
+			Status:    event.Status,
+		})
+		if err != nil {
+			return err
+		}
+		if !created {
+			log.Printf("[Payment] Event %s for payment %d has already been processed", event.EventID, payment.ID)
+			return nil
+		}
+
+		switch event.Status {
+		case services.PaymentStatusSucceeded:
+			if event.Amount != payment.Amount {
+				return ErrAmountMismatch
+			}
+			changed, err := txSvc.repo.UpdateStatus(payment.ID, services.PaymentStatusPending, services.PaymentStatusSucceeded)
+			if err != nil || !changed {
+				return err
+			}
+			return txSvc.orderSvc.ChangePaymentStatus(payment.OrderID, orderModel.PaymentStatusPaid, changedBy)
+		case services.PaymentStatusCancelled:
+			_, err := txSvc.repo.UpdateStatus(payment.ID, services.PaymentStatusPending, services.PaymentStatusCancelled)
+			return err
+		case services.PaymentStatusRefunded:
+			return txSvc.markRefunded(payment, changedBy)
+		}
+
+		return nil
+	})
+}
+
+// Refund claims the payment before calling the provider, so of two concurrent
+// refunds only one reaches it. If the provider fails, the claim is released.
+func (s *service) Refund(id uint, changedBy string) (*dto.ResponseDTO, []response.ErrorField, error) {
+	payment, err := s.repo.GetById(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if payment == nil {
+		return nil, []response.ErrorField{response.NewErrorField("id", string(response.NotFound))}, nil
+	}
+
+	claimed, err := s.repo.UpdateStatus(payment.ID, services.PaymentStatusSucceeded, services.PaymentStatusRefunding)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !claimed {
+		return nil, nil, ErrPaymentNotRefundable
+	}
+
+	if err := s.provider.Refund(payment.ExternalID, payment.Amount); err != nil {
+		if _, releaseErr := s.repo.UpdateStatus(payment.ID, services.PaymentStatusRefunding, services.PaymentStatusSucceeded); releaseErr != nil {
+			log.Printf("[Payment] Failed to release refund of payment %d: %v", payment.ID, releaseErr)
+		}
+		return nil, nil, fmt.Errorf("failed to refund payment: %w", err)
+	}
+
+	err = s.transaction(func(txSvc *service) error {
+		return txSvc.markRefunded(payment, changedBy)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	payment, err = s.repo.GetById(id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return dto.TransformModelToResponseDTO(payment), nil, nil
+}
+
+// markRefunded finishes a refund started here or in the provider dashboard;
+// whichever of the refund call and the webhook comes second changes nothing.
+func (s *service) markRefunded(payment *model.Payment, changedBy string) error {
+	changed, err := s.repo.UpdateStatus(payment.ID, services.PaymentStatusRefunding, services.PaymentStatusRefunded)
+	if err != nil {
+		return err
+	}
+	if !changed {
+		changed, err = s.repo.UpdateStatus(payment.ID, services.PaymentStatusSucceeded, services.PaymentStatusRefunded)
+		if err != nil || !changed {
+			return err
+		}
+	}
+
+	return s.orderSvc.ChangePaymentStatus(payment.OrderID, orderModel.PaymentStatusRefunded, changedBy)
+}
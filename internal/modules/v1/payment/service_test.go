@@ -0,0 +1,267 @@
+package payment
+
+import (
+	"errors"
+	"fmt"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"haircompany-shop-rest/internal/modules/v1/client_user"
+	clientUserModel "haircompany-shop-rest/internal/modules/v1/client_user/model"
+	inventoryModel "haircompany-shop-rest/internal/modules/v1/inventory/model"
+	"haircompany-shop-rest/internal/modules/v1/order"
+	orderModel "haircompany-shop-rest/internal/modules/v1/order/model"
+	"haircompany-shop-rest/internal/modules/v1/payment/dto"
+	"haircompany-shop-rest/internal/modules/v1/payment/model"
+	"haircompany-shop-rest/internal/modules/v1/product_variant"
+	"haircompany-shop-rest/internal/services"
+	"haircompany-shop-rest/pkg/database"
+	"net/http"
+	"testing"
+)
+
+const testSecret = "webhook-secret"
+
+// guestPayment подтверждает владение гостевым заказом из setupTestService
+var guestPayment = dto.PayDTO{CustomerPhone: "+79991234567"}
+
+func setupTestService(t *testing.T) (Service, *gorm.DB, *orderModel.Order) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatal("Failed to connect to test database:", err)
+	}
+
+	err = db.AutoMigrate(
+		&clientUserModel.ClientUser{},
+		&inventoryModel.Stock{},
+		&inventoryModel.StockMovement{},
+		&inventoryModel.StockReservation{},
+		&orderModel.Order{},
+		&orderModel.OrderItem{},
+		&orderModel.OrderStatusHistory{},
+		&model.Payment{},
+		&model.PaymentEvent{},
+	)
+	if err != nil {
+		t.Fatal("Failed to migrate test database:", err)
+	}
+
+	svc := newTestService(db, services.NewFakePaymentProvider(testSecret, ""))
+
+	order := &orderModel.Order{
+		Status:         orderModel.StatusNew,
+		PaymentStatus:  orderModel.PaymentStatusUnpaid,
+		CustomerName:   "Анна",
+		CustomerPhone:  "+79991234567",
+		DeliveryMethod: orderModel.DeliveryPickup,
+		ItemsCount:     2,
+		Total:          2000,
+	}
+	if err := db.Create(order).Error; err != nil {
+		t.Fatalf("Failed to create test order: %v", err)
+	}
+
+	return svc, db, order
+}
+
+func newTestService(db *gorm.DB, provider services.PaymentProvider) Service {
+	testDB := &database.DB{DB: db}
+	clientUserRepo := client_user.NewRepository(testDB)
+	orderSvc := order.NewService(order.NewRepository(testDB), clientUserRepo, product_variant.NewRepository(testDB))
+
+	return NewService(NewRepository(testDB), orderSvc, clientUserRepo, provider)
+}
+
+func sendWebhook(svc Service, eventId, externalId, status string, amount int64) error {
+	body := []byte(fmt.Sprintf(`{"eventId":%q,"paymentId":%q,"status":%q,"amount":%d}`, eventId, externalId, status, amount))
+	header := http.Header{}
+	header.Set("X-Payment-Signature", services.SignFakePaymentCallback(testSecret, body))
+
+	return svc.HandleWebhook(body, header)
+}
+
+func loadOrder(t *testing.T, db *gorm.DB, id uint) *orderModel.Order {
+	var order orderModel.Order
+	if err := db.Preload("History").First(&order, id).Error; err != nil {
+		t.Fatalf("Failed to load order: %v", err)
+	}
+	return &order
+}
+
+func TestService_Webhook_Succeeded(t *testing.T) {
+	svc, db, order := setupTestService(t)
+
+	payment, errFields, err := svc.CreateForOrder(order.ID, "", guestPayment)
+	if err != nil || errFields != nil {
+		t.Fatalf("Expected no error, got %v %v", err, errFields)
+	}
+	if payment.Amount != 2000 || payment.Status != services.PaymentStatusPending {
+		t.Errorf("Unexpected payment: %+v", payment)
+	}
+
+	// Повторная доставка того же уведомления не должна менять заказ дважды
+	for i := 0; i < 2; i++ {
+		if err := sendWebhook(svc, "evt_1", payment.ExternalID, services.PaymentStatusSucceeded, 2000); err != nil {
+			t.Fatalf("Expected no error on delivery %d, got %v", i+1, err)
+		}
+	}
+
+	updated := loadOrder(t, db, order.ID)
+	if updated.Status != orderModel.StatusPaid || updated.PaymentStatus != orderModel.PaymentStatusPaid {
+		t.Errorf("Expected order to be paid, got status %s payment %s", updated.Status, updated.PaymentStatus)
+	}
+	if len(updated.History) != 1 {
+		t.Errorf("Expected exactly one status change, got %d", len(updated.History))
+	}
+
+	if _, _, err := svc.CreateForOrder(order.ID, "", guestPayment); !errors.Is(err, ErrOrderNotPayable) {
+		t.Errorf("Expected ErrOrderNotPayable for paid order, got %v", err)
+	}
+}
+
+func TestService_Webhook_InvalidSignature(t *testing.T) {
+	svc, _, order := setupTestService(t)
+
+	payment, _, err := svc.CreateForOrder(order.ID, "", guestPayment)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	body := []byte(fmt.Sprintf(`{"eventId":"evt_1","paymentId":%q,"status":"succeeded","amount":2000}`, payment.ExternalID))
+	header := http.Header{}
+	header.Set("X-Payment-Signature", services.SignFakePaymentCallback("wrong-secret", body))
+
+	if err := svc.HandleWebhook(body, header); !errors.Is(err, services.ErrInvalidPaymentSignature) {
+		t.Errorf("Expected ErrInvalidPaymentSignature, got %v", err)
+	}
+}
+
+func TestService_Webhook_AmountMismatch(t *testing.T) {
+	svc, db, order := setupTestService(t)
+
+	payment, _, err := svc.CreateForOrder(order.ID, "", guestPayment)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	err = sendWebhook(svc, "evt_1", payment.ExternalID, services.PaymentStatusSucceeded, 1)
+	if !errors.Is(err, ErrAmountMismatch) {
+		t.Fatalf("Expected ErrAmountMismatch, got %v", err)
+	}
+
+	if updated := loadOrder(t, db, order.ID); updated.PaymentStatus != orderModel.PaymentStatusUnpaid {
+		t.Errorf("Expected order to stay unpaid, got %s", updated.PaymentStatus)
+	}
+}
+
+func TestService_CreateForOrder_OtherClient(t *testing.T) {
+	svc, db, order := setupTestService(t)
+
+	owner := &clientUserModel.ClientUser{Phone: "+79990000001"}
+	other := &clientUserModel.ClientUser{Phone: "+79990000002"}
+	db.Create(owner)
+	db.Create(other)
+	db.Model(order).Update("client_user_id", owner.ID)
+
+	_, errFields, err := svc.CreateForOrder(order.ID, other.Phone, dto.PayDTO{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if errFields == nil {
+		t.Error("Expected order of another client to be reported as not found")
+	}
+
+	if _, errFields, err := svc.CreateForOrder(order.ID, owner.Phone, dto.PayDTO{}); err != nil || errFields != nil {
+		t.Errorf("Expected owner to pay the order, got %v %v", err, errFields)
+	}
+}
+
+func TestService_Refund(t *testing.T) {
+	svc, db, order := setupTestService(t)
+
+	payment, _, err := svc.CreateForOrder(order.ID, "", guestPayment)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if _, _, err := svc.Refund(payment.Id, "manager@example.com"); !errors.Is(err, ErrPaymentNotRefundable) {
+		t.Fatalf("Expected ErrPaymentNotRefundable for pending payment, got %v", err)
+	}
+
+	if err := sendWebhook(svc, "evt_1", payment.ExternalID, services.PaymentStatusSucceeded, 2000); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	refunded, _, err := svc.Refund(payment.Id, "manager@example.com")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if refunded.Status != services.PaymentStatusRefunded {
+		t.Errorf("Expected payment to be refunded, got %s", refunded.Status)
+	}
+	if updated := loadOrder(t, db, order.ID); updated.PaymentStatus != orderModel.PaymentStatusRefunded {
+		t.Errorf("Expected order payment to be refunded, got %s", updated.PaymentStatus)
+	}
+	if _, _, err := svc.Refund(payment.Id, "manager@example.com"); !errors.Is(err, ErrPaymentNotRefundable) {
+		t.Errorf("Expected ErrPaymentNotRefundable for refunded payment, got %v", err)
+	}
+	// Уведомление провайдера о том же возврате ничего не меняет
+	if err := sendWebhook(svc, "evt_2", payment.ExternalID, services.PaymentStatusRefunded, 2000); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+func TestService_CreateForOrder_Guest(t *testing.T) {
+	svc, _, order := setupTestService(t)
+
+	for _, payDto := range []dto.PayDTO{{}, {CustomerPhone: "+79990000009"}} {
+		_, errFields, err := svc.CreateForOrder(order.ID, "", payDto)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if errFields == nil {
+			t.Errorf("Expected guest order to be reported as not found for phone %q", payDto.CustomerPhone)
+		}
+	}
+
+	if _, errFields, err := svc.CreateForOrder(order.ID, "", guestPayment); err != nil || errFields != nil {
+		t.Fatalf("Expected guest to pay the order, got %v %v", err, errFields)
+	}
+	if _, _, err := svc.CreateForOrder(order.ID, "", guestPayment); !errors.Is(err, ErrPaymentPending) {
+		t.Errorf("Expected ErrPaymentPending, got %v", err)
+	}
+}
+
+// failingRefundProvider отказывает в возврате, как недоступный провайдер
+type failingRefundProvider struct {
+	services.PaymentProvider
+}
+
+func (p *failingRefundProvider) Refund(string, int64) error {
+	return errors.New("provider is unavailable")
+}
+
+func TestService_Refund_ReleasesClaimOnProviderError(t *testing.T) {
+	_, db, order := setupTestService(t)
+	provider := &failingRefundProvider{services.NewFakePaymentProvider(testSecret, "")}
+	svc := newTestService(db, provider)
+
+	payment, _, err := svc.CreateForOrder(order.ID, "", guestPayment)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if err := sendWebhook(svc, "evt_1", payment.ExternalID, services.PaymentStatusSucceeded, 2000); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if _, _, err := svc.Refund(payment.Id, "manager@example.com"); err == nil {
+		t.Fatal("Expected provider error")
+	}
+
+	var stored model.Payment
+	if err := db.First(&stored, payment.Id).Error; err != nil {
+		t.Fatal(err)
+	}
+	if stored.Status != services.PaymentStatusSucceeded {
+		t.Errorf("Expected payment to stay succeeded for a retry, got %s", stored.Status)
+	}
+}
@@ -0,0 +1,20 @@
+package dto
+
+type CreateDTO struct {
+	Name             string `json:"name" validate:"required,min=3,max=255"`
+	Slug             string `json:"slug" validate:"required,min=3,max=255"`
+	Description      string `json:"description"`
+	Composition      string `json:"composition"`
+	Application      string `json:"application"`
+	Image            string `json:"image" validate:"required,max=255"`
+	CategoryID       uint   `json:"categoryId" validate:"required"`
+	LineID           uint   `json:"lineId" validate:"required"`
+	ProductTypeID    uint   `json:"productTypeId" validate:"required"`
+	DesiredResultIDs []uint `json:"desiredResultIds"`
+	ShadeIDs         []uint `json:"shadeIds"`
+	SortIndex        int    `json:"sortIndex" validate:"required,gte=0,lte=9999"`
+	SeoTitle         string `json:"seoTitle" validate:"max=255"`
+	SeoDescription   string `json:"seoDescription"`
+	SeoKeys          string `json:"seoKeys"`
+	IsActive         bool   `json:"isActive"`
+}
@@ -0,0 +1,31 @@
+package dto
+
+import (
+	desiredResultDto "haircompany-shop-rest/internal/modules/v1/desired_result/dto"
+	productVariantDto "haircompany-shop-rest/internal/modules/v1/product_variant/dto"
+	shadeDto "haircompany-shop-rest/internal/modules/v1/shade/dto"
+	"time"
+)
+
+type ResponseDTO struct {
+	Id             uint                             `json:"id"`
+	CreatedAt      time.Time                        `json:"createdAt"`
+	UpdatedAt      time.Time                        `json:"updatedAt"`
+	Name           string                           `json:"name"`
+	Slug           string                           `json:"slug"`
+	Description    string                           `json:"description"`
+	Composition    string                           `json:"composition"`
+	Application    string                           `json:"application"`
+	Image          string                           `json:"image"`
+	CategoryID     uint                             `json:"categoryId"`
+	LineID         uint                             `json:"lineId"`
+	ProductTypeID  uint                             `json:"productTypeId"`
+	DesiredResults []*desiredResultDto.ResponseDTO  `json:"desiredResults"`
+	Shades         []*shadeDto.ResponseDTO          `json:"shades"`
+	Variants       []*productVariantDto.ResponseDTO `json:"variants"`
+	SortIndex      int                              `json:"sortIndex"`
+	SeoTitle       string                           `json:"seoTitle"`
+	SeoDescription string                           `json:"seoDescription"`
+	SeoKeys        string                           `json:"seoKeys"`
+	IsActive       bool                             `json:"isActive"`
+}
@@ -0,0 +1,114 @@
+package dto
+
+import (
+	desiredResultDto "haircompany-shop-rest/internal/modules/v1/desired_result/dto"
+	productModel "haircompany-shop-rest/internal/modules/v1/product/model"
+	productVariantDto "haircompany-shop-rest/internal/modules/v1/product_variant/dto"
+	shadeDto "haircompany-shop-rest/internal/modules/v1/shade/dto"
+)
+
+func TransformCreateDTOToModel(dto CreateDTO) *productModel.Product {
+	return &productModel.Product{
+		Name:           dto.Name,
+		Slug:           dto.Slug,
+		Description:    dto.Description,
+		Composition:    dto.Composition,
+		Application:    dto.Application,
+		Image:          dto.Image,
+		CategoryID:     dto.CategoryID,
+		LineID:         dto.LineID,
+		ProductTypeID:  dto.ProductTypeID,
+		SortIndex:      dto.SortIndex,
+		SeoTitle:       dto.SeoTitle,
+		SeoDescription: dto.SeoDescription,
+		SeoKeys:        dto.SeoKeys,
+		IsActive:       dto.IsActive,
+	}
+}
+
+func TransformUpdateDTOToModel(dto UpdateDTO, model *productModel.Product) *productModel.Product {
+	if dto.Name != nil && *dto.Name != "" {
+		model.Name = *dto.Name
+	}
+	if dto.Slug != nil && *dto.Slug != "" {
+		model.Slug = *dto.Slug
+	}
+	if dto.Description != nil {
+		model.Description = *dto.Description
+	}
+	if dto.Composition != nil {
+		model.Composition = *dto.Composition
+	}
+	if dto.Application != nil {
+		model.Application = *dto.Application
+	}
+	if dto.Image != nil && *dto.Image != "" {
+		model.Image = *dto.Image
+	}
+	if dto.CategoryID != nil {
+		model.CategoryID = *dto.CategoryID
+	}
+	if dto.LineID != nil {
+		model.LineID = *dto.LineID
+	}
+	if dto.ProductTypeID != nil {
+		model.ProductTypeID = *dto.ProductTypeID
+	}
+	if dto.SortIndex != nil {
+		model.SortIndex = *dto.SortIndex
+	}
+	if dto.SeoTitle != nil {
+		model.SeoTitle = *dto.SeoTitle
+	}
+	if dto.SeoDescription != nil {
+		model.SeoDescription = *dto.SeoDescription
+	}
+	if dto.SeoKeys != nil {
+		model.SeoKeys = *dto.SeoKeys
+	}
+	if dto.IsActive != nil {
+		model.IsActive = *dto.IsActive
+	}
+
+	return model
+}
+
+func TransformModelToResponseDTO(model *productModel.Product) *ResponseDTO {
+	desiredResults := make([]*desiredResultDto.ResponseDTO, 0, len(model.DesiredResults))
+	for _, desiredResult := range model.DesiredResults {
+		desiredResults = append(desiredResults, desiredResultDto.TransformModelToResponseDTO(desiredResult))
+	}
+
+	shades := make([]*shadeDto.ResponseDTO, 0, len(model.Shades))
+	for _, shade := range model.Shades {
+		shades = append(shades, shadeDto.TransformModelToResponseDTO(shade))
+	}
+
+	variants := make([]*productVariantDto.ResponseDTO, 0, len(model.Variants))
+	for _, variant := range model.Variants {
+		variants = append(variants, productVariantDto.TransformModelToResponseDTO(variant))
+	}
+
+	return &ResponseDTO{
+		Id:             model.ID,
+		CreatedAt:      model.CreatedAt,
+		UpdatedAt:      model.UpdatedAt,
+		Name:           model.Name,
+		Slug:           model.Slug,
+		Description:    model.Description,
+		Composition:    model.Composition,
+		Application:    model.Application,
+		Image:          model.Image,
+		CategoryID:     model.CategoryID,
+		LineID:         model.LineID,
+		ProductTypeID:  model.ProductTypeID,
+		DesiredResults: desiredResults,
+		Shades:         shades,
+		Variants:       variants,
+		SortIndex:      model.SortIndex,
+		SeoTitle:       model.SeoTitle,
+		SeoDescription: model.SeoDescription,
+		SeoKeys:        model.SeoKeys,
+		IsActive:       model.IsActive,
+	}
+}
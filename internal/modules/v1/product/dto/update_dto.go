@@ -0,0 +1,20 @@
+package dto
+
+type UpdateDTO struct {
+	Name             *string `json:"name" validate:"omitempty,min=3,max=255"`
+	Slug             *string `json:"slug" validate:"omitempty,min=3,max=255"`
+	Description      *string `json:"description" validate:"omitempty"`
+	Composition      *string `json:"composition" validate:"omitempty"`
+	Application      *string `json:"application" validate:"omitempty"`
+	Image            *string `json:"image" validate:"omitempty"`
+	CategoryID       *uint   `json:"categoryId" validate:"omitempty"`
+	LineID           *uint   `json:"lineId" validate:"omitempty"`
+	ProductTypeID    *uint   `json:"productTypeId" validate:"omitempty"`
+	DesiredResultIDs *[]uint `json:"desiredResultIds" validate:"omitempty"`
+	ShadeIDs         *[]uint `json:"shadeIds" validate:"omitempty"`
+	SortIndex        *int    `json:"sortIndex" validate:"omitempty,gte=0"`
+	SeoTitle         *string `json:"seoTitle" validate:"omitempty"`
+	SeoDescription   *string `json:"seoDescription" validate:"omitempty"`
+	SeoKeys          *string `json:"seoKeys" validate:"omitempty"`
+	IsActive         *bool   `json:"isActive" validate:"omitempty"`
+}
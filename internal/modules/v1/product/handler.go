@@ -0,0 +1,284 @@
+package product
+
+import (
+	"fmt"
+	"haircompany-shop-rest/internal/constraint"
+	"haircompany-shop-rest/internal/modules/v1/product/dto"
+	"haircompany-shop-rest/pkg/request"
+	"haircompany-shop-rest/pkg/response"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type Handler struct {
+	svc Service
+}
+
+func NewHandler(s Service) *Handler {
+	return &Handler{
+		svc: s,
+	}
+}
+
+// Create creates a new product
+//
+//	@Summary		Create a new product
+//	@Description	Create a new product
+//	@Tags			Product
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			product	body		dto.CreateDTO					true	"Product to create"
+//	@Success		201		{object}	docsResponse.ProductCreate201	"Product created successfully"
+//	@Failure		400		{object}	docsResponse.ProductCreate400	"Bad Request or Validation Error"
+//	@Failure		401		{object}	docsResponse.Response401		"Unauthorized"
+//	@Failure		403		{object}	docsResponse.Response403		"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		500		{object}	docsResponse.Response500		"Server Error"
+//	@Router			/api/v1/product/create [post]
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	createDto, err := request.DecodeBody[dto.CreateDTO](r.Body)
+	if err != nil {
+		msg := fmt.Sprintf("invalid request body: %v", err)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	errFields := constraint.ValidateDTO(createDto)
+	if errFields != nil {
+		msg := "validation errors occurred"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	createdProduct, errFields, err := h.svc.Create(createDto)
+	if err != nil {
+		msg := fmt.Sprintf("failed to create product: %v", err)
+		response.SendError(w, http.StatusBadRequest, msg, response.ServerError)
+		return
+	}
+	if errFields != nil {
+		msg := "validation errors occurred"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusCreated, createdProduct)
+}
+
+// GetAll retrieves all products
+//
+//	@Summary		Get all products
+//	@Description	Retrieve all products
+//	@Tags			Product
+//	@Security		AppAuth
+//	@Produce		json
+//	@Success		200	{object}	docsResponse.ProductList200	"List of products"
+//	@Failure		403	{object}	docsResponse.Response403	"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		500	{object}	docsResponse.Response500	"Server error"
+//	@Router			/api/v1/product [get]
+func (h *Handler) GetAll(w http.ResponseWriter) {
+	products, err := h.svc.GetAll()
+	if err != nil {
+		msg := fmt.Sprintf("failed to retrieve products: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, products)
+}
+
+// GetById retrieves a product by its ID
+//
+//	@Summary		Get product by ID
+//	@Description	Retrieve product by its ID
+//	@Tags			Product
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			id	path		int								true	"Product ID"
+//	@Success		200	{object}	docsResponse.ProductGetById200	"Product found"
+//	@Failure		400	{object}	docsResponse.Response400		"Invalid ID"
+//	@Failure		403	{object}	docsResponse.Response403		"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		404	{object}	docsResponse.Response404		"Product not found"
+//	@Failure		500	{object}	docsResponse.Response500		"Server error"
+//	@Router			/api/v1/product/{id} [get]
+func (h *Handler) GetById(w http.ResponseWriter, r *http.Request) {
+	idStr := r.PathValue("id")
+	if idStr == "" {
+		msg := "missing product id"
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	id, err := strconv.Atoi(idStr)
+	if err != nil || id < 0 {
+		msg := fmt.Sprintf("invalid product id: %s", idStr)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	product, err := h.svc.GetById(uint(id))
+	if product == nil {
+		msg := fmt.Sprintf("product with id %d not found", id)
+		response.SendError(w, http.StatusNotFound, msg, response.NotFound)
+		return
+	}
+	if err != nil {
+		msg := fmt.Sprintf("failed to retrieve product: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, product)
+}
+
+// Update updates a product by its ID
+//
+//	@Summary		Update product
+//	@Description	Update product by ID
+//	@Tags			Product
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int								true	"Product ID"
+//	@Param			product	body		dto.UpdateDTO					true	"Product update payload"
+//	@Success		200		{object}	docsResponse.ProductUpdate200	"Product updated"
+//	@Failure		400		{object}	docsResponse.ProductUpdate400	"Bad request or validation error"
+//	@Failure		401		{object}	docsResponse.Response401		"Unauthorized"
+//	@Failure		403		{object}	docsResponse.Response403		"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		500		{object}	docsResponse.Response500		"Server error"
+//	@Router			/api/v1/product/{id}/update [patch]
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	idStr := r.PathValue("id")
+	if idStr == "" {
+		msg := "missing product id"
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	id, err := strconv.Atoi(idStr)
+	if err != nil || id < 0 {
+		msg := fmt.Sprintf("invalid product id: %s", idStr)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	updateDto, err := request.DecodeBody[dto.UpdateDTO](r.Body)
+	if err != nil {
+		msg := fmt.Sprintf("invalid request body: %v", err)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	errFields := constraint.ValidateDTO(updateDto)
+	if errFields != nil {
+		msg := "validation errors occurred"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	updatedProduct, errFields, err := h.svc.Update(uint(id), updateDto)
+	if err != nil {
+		msg := fmt.Sprintf("failed to update product: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+	if errFields != nil {
+		msg := "validation errors occurred"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, updatedProduct)
+}
+
+// Delete deletes a product by its ID
+//
+//	@Summary		Delete product
+//	@Description	Delete product by ID
+//	@Tags			Product
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			id	path		int								true	"Product ID"
+//	@Success		200	{object}	docsResponse.ProductDelete200	"Product deleted"
+//	@Failure		400	{object}	docsResponse.Response400		"Invalid ID"
+//	@Failure		401	{object}	docsResponse.Response401		"Unauthorized"
+//	@Failure		403	{object}	docsResponse.Response403		"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		404	{object}	docsResponse.Response404		"Product not found"
+//	@Failure		500	{object}	docsResponse.Response500		"Server error"
+//	@Router			/api/v1/product/{id}/delete [delete]
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	idStr := r.PathValue("id")
+	if idStr == "" {
+		msg := "missing product id"
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	id, err := strconv.Atoi(idStr)
+	if err != nil || id < 0 {
+		msg := fmt.Sprintf("invalid product id: %s", idStr)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	product, err := h.svc.Delete(uint(id))
+	if product == nil {
+		msg := fmt.Sprintf("product with id %d not found", id)
+		response.SendError(w, http.StatusNotFound, msg, response.NotFound)
+		return
+	}
+	if err != nil {
+		msg := fmt.Sprintf("failed to delete product: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, product)
+}
+
+// GetBySlug retrieves a product by its slug
+//
+//	@Summary		Get product by slug
+//	@Description	Retrieve product by its current slug. An old slug answers 301 with the current slug in data and a Location header pointing at it
+//	@Tags			Product
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			slug	path		string								true	"Product slug"
+//	@Success		200		{object}	docsResponse.ProductGetById200		"Product found"
+//	@Success		301		{object}	docsResponse.ProductSlugRedirect301	"Slug has changed"
+//	@Failure		400		{object}	docsResponse.Response400			"Missing slug"
+//	@Failure		403		{object}	docsResponse.Response403			"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		404		{object}	docsResponse.Response404			"Product not found"
+//	@Failure		500		{object}	docsResponse.Response500			"Server error"
+//	@Router			/api/v1/product/by-slug/{slug} [get]
+func (h *Handler) GetBySlug(w http.ResponseWriter, r *http.Request) {
+	slug := r.PathValue("slug")
+	if slug == "" {
+		msg := "missing product slug"
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	product, redirect, err := h.svc.GetBySlug(slug)
+	if err != nil {
+		msg := fmt.Sprintf("failed to retrieve product: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+	if redirect != nil {
+		w.Header().Set("Location", "/api/v1/product/by-slug/"+url.PathEscape(redirect.Slug))
+		response.SendSuccess(w, http.StatusMovedPermanently, redirect)
+		return
+	}
+	if product == nil {
+		msg := fmt.Sprintf("product with slug %s not found", slug)
+		response.SendError(w, http.StatusNotFound, msg, response.NotFound)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, product)
+}
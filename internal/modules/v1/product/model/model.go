@@ -0,0 +1,39 @@
+package model
+
+import (
+	desiredResultModel "haircompany-shop-rest/internal/modules/v1/desired_result/model"
+	productVariantModel "haircompany-shop-rest/internal/modules/v1/product_variant/model"
+	shadeModel "haircompany-shop-rest/internal/modules/v1/shade/model"
+	"time"
+)
+
+type Product struct {
+	ID             uint `gorm:"primarykey"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	Name           string                                `gorm:"type:varchar(255);not null;unique" json:"name"`
+	Slug           string                                `gorm:"type:varchar(255);not null;unique" json:"slug"`
+	Description    string                                `gorm:"type:text" json:"description"`
+	Composition    string                                `gorm:"type:text" json:"composition"`
+	Application    string                                `gorm:"type:text" json:"application"`
+	Image          string                                `gorm:"type:varchar(255)" json:"image"`
+	CategoryID     uint                                  `gorm:"not null;index" json:"categoryId"`
+	LineID         uint                                  `gorm:"not null;index" json:"lineId"`
+	ProductTypeID  uint                                  `gorm:"not null;index" json:"productTypeId"`
+	SortIndex      int                                   `gorm:"default:100" json:"sortIndex"`
+	SeoTitle       string                                `gorm:"type:varchar(255)" json:"seoTitle"`
+	SeoDescription string                                `gorm:"type:text" json:"seoDescription"`
+	SeoKeys        string                                `gorm:"type:text" json:"seoKeys"`
+	IsActive       bool                                  `gorm:"default:true" json:"isActive"`
+	DesiredResults []*desiredResultModel.DesiredResult   `gorm:"many2many:product_desired_results;" json:"desiredResults"`
+	Shades         []*shadeModel.Shade                   `gorm:"many2many:product_shades;" json:"shades"`
+	Variants       []*productVariantModel.ProductVariant `gorm:"foreignKey:ProductID" json:"variants"`
+}
+
+// ProductSlugRedirect хранит прежний slug товара, чтобы старые ссылки вели на актуальный.
+type ProductSlugRedirect struct {
+	ID        uint `gorm:"primarykey"`
+	CreatedAt time.Time
+	OldSlug   string `gorm:"type:varchar(255);not null;unique" json:"oldSlug"`
+	ProductID uint   `gorm:"not null;index" json:"productId"`
+}
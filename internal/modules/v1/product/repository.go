@@ -0,0 +1,189 @@
+package product
+
+import (
+	"errors"
+	"gorm.io/gorm"
+	"haircompany-shop-rest/internal/modules/v1/product/model"
+	"haircompany-shop-rest/internal/services"
+	"haircompany-shop-rest/pkg/database"
+)
+
+type Repository interface {
+	Transaction(fn func(repo Repository) error) error
+	Enqueue(queue services.Queue, jobType string, payload any) error
+	Create(model *model.Product) (*model.Product, error)
+	GetAll() ([]*model.Product, error)
+	GetById(id uint) (*model.Product, error)
+	Update(model *model.Product) (*model.Product, error)
+	Delete(id uint) error
+	GetByUniqueFields(name, slug string) (*model.Product, error)
+	GetBySlug(slug string) (*model.Product, error)
+	GetRedirectBySlug(slug string) (*model.ProductSlugRedirect, error)
+	UpdateWithSlugRedirect(model *model.Product, oldSlug string) (*model.Product, error)
+}
+
+type repository struct {
+	DB *database.DB
+}
+
+func NewRepository(db *database.DB) Repository {
+	return &repository{
+		DB: db,
+	}
+}
+
+// Transaction runs fn against a repository bound to a single database transaction.
+func (r *repository) Transaction(fn func(repo Repository) error) error {
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		return fn(NewRepository(&database.DB{DB: tx}))
+	})
+}
+
+// Enqueue добавляет задачу в очередь тем же соединением, что и остальные запросы репозитория.
+func (r *repository) Enqueue(queue services.Queue, jobType string, payload any) error {
+	return queue.EnqueueTx(r.DB, jobType, payload)
+}
+
+func (r *repository) Create(model *model.Product) (*model.Product, error) {
+	result := r.DB.Omit("DesiredResults.*", "Shades.*", "Variants").Create(&model)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return model, nil
+}
+
+func (r *repository) GetAll() ([]*model.Product, error) {
+	var products []*model.Product
+	var err error
+
+	result := r.withRelations().Find(&products)
+	if result.Error != nil {
+		err = result.Error
+	}
+
+	return products, err
+}
+
+func (r *repository) GetById(id uint) (*model.Product, error) {
+	var product *model.Product
+	var err error
+
+	result := r.withRelations().First(&product, id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		err = result.Error
+	}
+
+	return product, err
+}
+
+func (r *repository) Update(model *model.Product) (*model.Product, error) {
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		return save(tx, model)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return model, nil
+}
+
+func (r *repository) Delete(id uint) error {
+	result := r.DB.Select("DesiredResults", "Shades").Delete(&model.Product{ID: id})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+func (r *repository) GetByUniqueFields(name, slug string) (*model.Product, error) {
+	var product *model.Product
+	var err error
+
+	result := r.DB.First(&product, "name = ? OR slug = ?", name, slug)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		err = result.Error
+	}
+
+	return product, err
+}
+
+func (r *repository) GetBySlug(slug string) (*model.Product, error) {
+	var product *model.Product
+	var err error
+
+	result := r.withRelations().First(&product, "slug = ?", slug)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		err = result.Error
+	}
+
+	return product, err
+}
+
+func (r *repository) GetRedirectBySlug(slug string) (*model.ProductSlugRedirect, error) {
+	var redirect *model.ProductSlugRedirect
+	var err error
+
+	result := r.DB.First(&redirect, "old_slug = ?", slug)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		err = result.Error
+	}
+
+	return redirect, err
+}
+
+// UpdateWithSlugRedirect сохраняет товар и запоминает его прежний slug.
+// Перенаправления ссылаются на товар, а не на slug, поэтому цепочки переименований не образуются.
+func (r *repository) UpdateWithSlugRedirect(product *model.Product, oldSlug string) (*model.Product, error) {
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := save(tx, product); err != nil {
+			return err
+		}
+		if err := tx.Where("old_slug IN ?", []string{oldSlug, product.Slug}).Delete(&model.ProductSlugRedirect{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(&model.ProductSlugRedirect{OldSlug: oldSlug, ProductID: product.ID}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return product, nil
+}
+
+func save(tx *gorm.DB, model *model.Product) error {
+	if err := tx.Omit("DesiredResults", "Shades", "Variants").Save(&model).Error; err != nil {
+		return err
+	}
+	if err := tx.Model(&model).Association("DesiredResults").Replace(model.DesiredResults); err != nil {
+		return err
+	}
+	if err := tx.Model(&model).Association("Shades").Replace(model.Shades); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repository) withRelations() *gorm.DB {
+	return r.DB.
+		Preload("DesiredResults").
+		Preload("Shades").
+		Preload("Variants", func(db *gorm.DB) *gorm.DB {
+			return db.Order("sort_index, id")
+		})
+}
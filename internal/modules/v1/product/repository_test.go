@@ -0,0 +1,204 @@
+package product
+
+import (
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	desiredResultModel "haircompany-shop-rest/internal/modules/v1/desired_result/model"
+	"haircompany-shop-rest/internal/modules/v1/product/model"
+	productVariantModel "haircompany-shop-rest/internal/modules/v1/product_variant/model"
+	shadeModel "haircompany-shop-rest/internal/modules/v1/shade/model"
+	"haircompany-shop-rest/pkg/database"
+	"testing"
+)
+
+func setupTestDB(t *testing.T) *database.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatal("Failed to connect to test database:", err)
+	}
+
+	err = db.AutoMigrate(&desiredResultModel.DesiredResult{}, &shadeModel.Shade{}, &model.Product{}, &model.ProductSlugRedirect{}, &productVariantModel.ProductVariant{})
+	if err != nil {
+		t.Fatal("Failed to migrate test database:", err)
+	}
+
+	return &database.DB{DB: db}
+}
+
+func createTestRelations(t *testing.T, db *database.DB) ([]*desiredResultModel.DesiredResult, []*shadeModel.Shade) {
+	desiredResults := []*desiredResultModel.DesiredResult{{Name: "Volume"}, {Name: "Shine"}}
+	if err := db.Create(&desiredResults).Error; err != nil {
+		t.Fatalf("Failed to create desired results: %v", err)
+	}
+
+	shades := []*shadeModel.Shade{{Name: "Blond", Image: "blond.png"}, {Name: "Brown", Image: "brown.png"}}
+	if err := db.Create(&shades).Error; err != nil {
+		t.Fatalf("Failed to create shades: %v", err)
+	}
+
+	return desiredResults, shades
+}
+
+func TestRepository_CreateWithRelations(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepository(db)
+	desiredResults, shades := createTestRelations(t, db)
+
+	product := &model.Product{
+		Name:           "Test Product",
+		Slug:           "test-product",
+		CategoryID:     1,
+		LineID:         1,
+		ProductTypeID:  1,
+		DesiredResults: desiredResults,
+		Shades:         shades[:1],
+	}
+
+	created, err := repo.Create(product)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	result, err := repo.GetById(created.ID)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(result.DesiredResults) != 2 {
+		t.Errorf("Expected 2 desired results, got %d", len(result.DesiredResults))
+	}
+
+	if len(result.Shades) != 1 {
+		t.Errorf("Expected 1 shade, got %d", len(result.Shades))
+	}
+}
+
+func TestRepository_UpdateReplacesRelations(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepository(db)
+	desiredResults, shades := createTestRelations(t, db)
+
+	product := &model.Product{
+		Name:           "Test Product",
+		Slug:           "test-product",
+		CategoryID:     1,
+		LineID:         1,
+		ProductTypeID:  1,
+		DesiredResults: desiredResults,
+		Shades:         shades[:1],
+	}
+	created, err := repo.Create(product)
+	if err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+
+	created.Name = "Updated Product"
+	created.DesiredResults = nil
+	created.Shades = shades[1:]
+
+	if _, err := repo.Update(created); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	result, err := repo.GetById(created.ID)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if result.Name != "Updated Product" {
+		t.Errorf("Expected name 'Updated Product', got %s", result.Name)
+	}
+
+	if len(result.DesiredResults) != 0 {
+		t.Errorf("Expected desired results to be cleared, got %d", len(result.DesiredResults))
+	}
+
+	if len(result.Shades) != 1 || result.Shades[0].ID != shades[1].ID {
+		t.Errorf("Expected only shade %d to be linked", shades[1].ID)
+	}
+}
+
+func TestRepository_GetBySlug_WithRedirect(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepository(db)
+	_, shades := createTestRelations(t, db)
+
+	product := &model.Product{
+		Name:          "Test Product",
+		Slug:          "old-product",
+		CategoryID:    1,
+		LineID:        1,
+		ProductTypeID: 1,
+		Shades:        shades[:1],
+	}
+	created, err := repo.Create(product)
+	if err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+
+	created.Slug = "new-product"
+	if _, err := repo.UpdateWithSlugRedirect(created, "old-product"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	result, err := repo.GetBySlug("new-product")
+	if err != nil || result == nil {
+		t.Fatalf("Expected product by new slug, got %+v, %v", result, err)
+	}
+	if len(result.Shades) != 1 {
+		t.Errorf("Expected relations to be preserved, got %d shades", len(result.Shades))
+	}
+
+	result, err = repo.GetBySlug("old-product")
+	if err != nil || result != nil {
+		t.Errorf("Expected no product by old slug, got %+v, %v", result, err)
+	}
+
+	redirect, err := repo.GetRedirectBySlug("old-product")
+	if err != nil || redirect == nil || redirect.ProductID != created.ID {
+		t.Errorf("Expected redirect to the product, got %+v, %v", redirect, err)
+	}
+}
+
+func TestRepository_GetById_NotFound(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepository(db)
+
+	result, err := repo.GetById(999)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if result != nil {
+		t.Error("Expected nil result for non-existent product")
+	}
+}
+
+func TestRepository_Delete(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepository(db)
+	desiredResults, shades := createTestRelations(t, db)
+
+	created, err := repo.Create(&model.Product{
+		Name:           "Test Product",
+		Slug:           "test-product",
+		CategoryID:     1,
+		LineID:         1,
+		ProductTypeID:  1,
+		DesiredResults: desiredResults,
+		Shades:         shades,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create product: %v", err)
+	}
+
+	if err := repo.Delete(created.ID); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var linksCount int64
+	db.Table("product_shades").Where("product_id = ?", created.ID).Count(&linksCount)
+	if linksCount != 0 {
+		t.Errorf("Expected shade links to be removed, got %d", linksCount)
+	}
+}
@@ -0,0 +1,115 @@
+package product
+
+import (
+	"haircompany-shop-rest/internal/container"
+	"haircompany-shop-rest/internal/middleware"
+	"haircompany-shop-rest/internal/modules/v1/category"
+	"haircompany-shop-rest/internal/modules/v1/desired_result"
+	"haircompany-shop-rest/internal/modules/v1/line"
+	"haircompany-shop-rest/internal/modules/v1/product_type"
+	"haircompany-shop-rest/internal/modules/v1/shade"
+	"haircompany-shop-rest/pkg/response"
+	"net/http"
+)
+
+func RegisterV1ProductRoutes(mux *http.ServeMux, container *container.Container) {
+	repo := NewRepository(container.DB)
+	categoryRepo := category.NewRepository(container.DB)
+	lineRepo := line.NewRepository(container.DB)
+	productTypeRepo := product_type.NewRepository(container.DB)
+	desiredResultRepo := desired_result.NewRepository(container.DB)
+	shadeRepo := shade.NewRepository(container.DB)
+	svc := NewService(repo, categoryRepo, lineRepo, productTypeRepo, desiredResultRepo, shadeRepo, container.Queue)
+	h := NewHandler(svc)
+
+	mux.Handle("/product/create",
+		middleware.ChainMiddleware(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodPost:
+					h.Create(w, r)
+				default:
+					msg := "Method not allowed. Allowed methods: POST"
+					response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+				}
+			}),
+			middleware.DashboardRoleMiddleware("admin"),
+			middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist),
+		),
+	)
+
+	mux.HandleFunc("/product", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			h.GetAll(w)
+		default:
+			msg := "Method not allowed. Allowed methods: GET"
+			response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+		}
+	})
+
+	mux.HandleFunc("/product/{id}", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			h.GetById(w, r)
+		default:
+			msg := "Method not allowed. Allowed methods: GET"
+			response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+		}
+	})
+
+	mux.Handle("/product/{id}/update",
+		middleware.ChainMiddleware(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodPatch:
+					h.Update(w, r)
+				default:
+					msg := "Method not allowed. Allowed methods: PATCH"
+					response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+				}
+			}),
+			middleware.DashboardRoleMiddleware("admin"),
+			middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist),
+		),
+	)
+
+	mux.Handle("/product/{id}/delete",
+		middleware.ChainMiddleware(
+			http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodDelete:
+					h.Delete(w, r)
+				default:
+					msg := "Method not allowed. Allowed methods: PATCH"
+					response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+				}
+			}),
+			middleware.DashboardRoleMiddleware("admin"),
+			middleware.DashboardAuthMiddleware(container.JWTService, container.TokenDenylist),
+		),
+	)
+}
+
+// RegisterV1ProductSlugRoutes registers the slug lookup on its own mux, see
+// category.RegisterV1CategorySlugRoutes.
+func RegisterV1ProductSlugRoutes(mux *http.ServeMux, container *container.Container) {
+	repo := NewRepository(container.DB)
+	categoryRepo := category.NewRepository(container.DB)
+	lineRepo := line.NewRepository(container.DB)
+	productTypeRepo := product_type.NewRepository(container.DB)
+	desiredResultRepo := desired_result.NewRepository(container.DB)
+	shadeRepo := shade.NewRepository(container.DB)
+	svc := NewService(repo, categoryRepo, lineRepo, productTypeRepo, desiredResultRepo, shadeRepo, container.Queue)
+	h := NewHandler(svc)
+
+	mux.HandleFunc("/product/by-slug/{slug}", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			h.GetBySlug(w, r)
+		default:
+			msg := "Method not allowed. Allowed methods: GET"
+			response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+		}
+	})
+}
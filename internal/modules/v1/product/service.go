@@ -0,0 +1,307 @@
+package product
+
+import (
+	"errors"
+	"haircompany-shop-rest/internal/modules/v1/category"
+	"haircompany-shop-rest/internal/modules/v1/desired_result"
+	desiredResultModel "haircompany-shop-rest/internal/modules/v1/desired_result/model"
+	"haircompany-shop-rest/internal/modules/v1/line"
+	"haircompany-shop-rest/internal/modules/v1/product/dto"
+	"haircompany-shop-rest/internal/modules/v1/product/model"
+	"haircompany-shop-rest/internal/modules/v1/product_type"
+	"haircompany-shop-rest/internal/modules/v1/shade"
+	shadeModel "haircompany-shop-rest/internal/modules/v1/shade/model"
+	"haircompany-shop-rest/internal/services"
+	"haircompany-shop-rest/pkg/response"
+	"haircompany-shop-rest/pkg/utils"
+	"log"
+)
+
+type Service interface {
+	Create(createDto dto.CreateDTO) (*dto.ResponseDTO, []response.ErrorField, error)
+	GetAll() ([]*dto.ResponseDTO, error)
+	GetById(id uint) (*dto.ResponseDTO, error)
+	Update(id uint, updateDto dto.UpdateDTO) (*dto.ResponseDTO, []response.ErrorField, error)
+	Delete(id uint) (*dto.ResponseDTO, error)
+	GetBySlug(slug string) (*dto.ResponseDTO, *dto.RedirectDTO, error)
+}
+
+type service struct {
+	repo              Repository
+	categoryRepo      category.Repository
+	lineRepo          line.Repository
+	productTypeRepo   product_type.Repository
+	desiredResultRepo desired_result.Repository
+	shadeRepo         shade.Repository
+	queue             services.Queue
+}
+
+func NewService(r Repository, categoryRepo category.Repository, lineRepo line.Repository, productTypeRepo product_type.Repository, desiredResultRepo desired_result.Repository, shadeRepo shade.Repository, queue services.Queue) Service {
+	return &service{
+		repo:              r,
+		categoryRepo:      categoryRepo,
+		lineRepo:          lineRepo,
+		productTypeRepo:   productTypeRepo,
+		desiredResultRepo: desiredResultRepo,
+		shadeRepo:         shadeRepo,
+		queue:             queue,
+	}
+}
+
+func (c *service) Create(createDto dto.CreateDTO) (*dto.ResponseDTO, []response.ErrorField, error) {
+	var validationErrors []response.ErrorField
+	existingProduct, err := c.repo.GetByUniqueFields(createDto.Name, createDto.Slug)
+	if err != nil {
+		return nil, nil, err
+	}
+	if existingProduct != nil {
+		if existingProduct.Name == createDto.Name {
+			validationErrors = append(validationErrors, response.NewErrorField("name", string(response.NotUnique)))
+		}
+		if existingProduct.Slug == createDto.Slug {
+			validationErrors = append(validationErrors, response.NewErrorField("slug", string(response.NotUnique)))
+		}
+
+		return nil, validationErrors, nil
+	}
+
+	productModel := dto.TransformCreateDTOToModel(createDto)
+	validationErrors, err = c.loadRelations(productModel, createDto.DesiredResultIDs, createDto.ShadeIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	if validationErrors != nil {
+		return nil, validationErrors, nil
+	}
+
+	err = c.repo.Transaction(func(repo Repository) error {
+		if _, err := repo.Create(productModel); err != nil {
+			return err
+		}
+
+		filenames := []string{productModel.Image}
+		owner := &services.FileOwner{Type: "product", ID: productModel.ID, Fields: map[string]string{productModel.Image: "image"}}
+		payload := services.FilesPayload{Filenames: filenames, Folder: "images/product", Owner: owner}
+		return repo.Enqueue(c.queue, services.QueueMoveFiles, payload)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	createdProductResponse := dto.TransformModelToResponseDTO(productModel)
+
+	return createdProductResponse, nil, nil
+}
+
+func (c *service) GetAll() ([]*dto.ResponseDTO, error) {
+	productDTOs := make([]*dto.ResponseDTO, 0)
+	models, err := c.repo.GetAll()
+	if err != nil {
+		log.Printf("error retrieving products: %v", err)
+	}
+
+	for _, model := range models {
+		productResponse := dto.TransformModelToResponseDTO(model)
+		productDTOs = append(productDTOs, productResponse)
+	}
+
+	return productDTOs, err
+}
+
+func (c *service) GetById(id uint) (*dto.ResponseDTO, error) {
+	model, err := c.repo.GetById(id)
+	if model == nil {
+		return nil, err
+	}
+
+	productDTO := dto.TransformModelToResponseDTO(model)
+
+	return productDTO, err
+}
+
+func (c *service) Update(id uint, updateDto dto.UpdateDTO) (*dto.ResponseDTO, []response.ErrorField, error) {
+	var validationErrors []response.ErrorField
+	model, err := c.repo.GetById(id)
+	if err != nil {
+		return nil, nil, err
+
+	}
+	if model == nil {
+		return nil, nil, errors.New("product not found")
+	}
+
+	oldSlug := model.Slug
+	dto.TransformUpdateDTOToModel(updateDto, model)
+	existingProduct, err := c.repo.GetByUniqueFields(model.Name, model.Slug)
+	if err != nil {
+		return nil, nil, err
+	}
+	if existingProduct != nil && existingProduct.ID != id {
+		if existingProduct.Name == model.Name {
+			validationErrors = append(validationErrors, response.NewErrorField("name", string(response.NotUnique)))
+		}
+		if existingProduct.Slug == model.Slug {
+			validationErrors = append(validationErrors, response.NewErrorField("slug", string(response.NotUnique)))
+		}
+		return nil, validationErrors, nil
+	}
+
+	desiredResultIds := c.getDesiredResultIds(model)
+	if updateDto.DesiredResultIDs != nil {
+		desiredResultIds = *updateDto.DesiredResultIDs
+	}
+	shadeIds := c.getShadeIds(model)
+	if updateDto.ShadeIDs != nil {
+		shadeIds = *updateDto.ShadeIDs
+	}
+
+	validationErrors, err = c.loadRelations(model, desiredResultIds, shadeIds)
+	if err != nil {
+		return nil, nil, err
+	}
+	if validationErrors != nil {
+		return nil, validationErrors, nil
+	}
+
+	err = c.repo.Transaction(func(repo Repository) error {
+		var err error
+		if model.Slug != oldSlug {
+			model, err = repo.UpdateWithSlugRedirect(model, oldSlug)
+		} else {
+			model, err = repo.Update(model)
+		}
+		if err != nil {
+			return err
+		}
+
+		var filenames []string
+		owner := &services.FileOwner{Type: "product", ID: id, Fields: map[string]string{}}
+
+		if updateDto.Image != nil {
+			filenames = append(filenames, *updateDto.Image)
+			owner.Fields[*updateDto.Image] = "image"
+		}
+
+		if len(filenames) == 0 {
+			return nil
+		}
+		payload := services.FilesPayload{Filenames: filenames, Folder: "images/product", Owner: owner}
+		return repo.Enqueue(c.queue, services.QueueMoveFiles, payload)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updatedProductResponse := dto.TransformModelToResponseDTO(model)
+
+	return updatedProductResponse, nil, nil
+}
+
+func (c *service) Delete(id uint) (*dto.ResponseDTO, error) {
+	existedProduct, err := c.repo.GetById(id)
+	if existedProduct == nil {
+		return nil, err
+	}
+
+	productDTO := dto.TransformModelToResponseDTO(existedProduct)
+
+	err = c.repo.Transaction(func(repo Repository) error {
+		if err := repo.Delete(id); err != nil {
+			return err
+		}
+
+		filenames := []string{existedProduct.Image}
+		payload := services.FilesPayload{Filenames: filenames, Folder: "images/product"}
+		return repo.Enqueue(c.queue, services.QueueDeleteFiles, payload)
+	})
+	if err != nil {
+		return productDTO, err
+	}
+
+	return productDTO, nil
+}
+
+// loadRelations checks that every entity referenced by the product exists and
+// attaches the desired results and shades to the model.
+func (c *service) loadRelations(product *model.Product, desiredResultIds, shadeIds []uint) ([]response.ErrorField, error) {
+	var validationErrors []response.ErrorField
+
+	existingCategory, err := c.categoryRepo.GetById(product.CategoryID)
+	if err != nil || existingCategory == nil {
+		validationErrors = append(validationErrors, response.NewErrorField("categoryId", string(response.NotFound)))
+	}
+
+	existingLine, err := c.lineRepo.GetById(product.LineID)
+	if err != nil {
+		return nil, err
+	}
+	if existingLine == nil {
+		validationErrors = append(validationErrors, response.NewErrorField("lineId", string(response.NotFound)))
+	}
+
+	existingProductType, err := c.productTypeRepo.GetById(product.ProductTypeID)
+	if err != nil {
+		return nil, err
+	}
+	if existingProductType == nil {
+		validationErrors = append(validationErrors, response.NewErrorField("productTypeId", string(response.NotFound)))
+	}
+
+	desiredResultIds = utils.UniqueIDs(desiredResultIds)
+	desiredResults, err := c.desiredResultRepo.GetByIds(desiredResultIds)
+	if err != nil {
+		return nil, err
+	}
+	if len(desiredResults) != len(desiredResultIds) {
+		validationErrors = append(validationErrors, response.NewErrorField("desiredResultIds", string(response.NotFound)))
+	}
+
+	shadeIds = utils.UniqueIDs(shadeIds)
+	shades, err := c.shadeRepo.GetByIds(shadeIds)
+	if err != nil {
+		return nil, err
+	}
+	if len(shades) != len(shadeIds) {
+		validationErrors = append(validationErrors, response.NewErrorField("shadeIds", string(response.NotFound)))
+	}
+
+	if validationErrors != nil {
+		return validationErrors, nil
+	}
+
+	product.DesiredResults = desiredResults
+	product.Shades = shades
+
+	return nil, nil
+}
+
+func (c *service) getDesiredResultIds(product *model.Product) []uint {
+	return utils.IDs(product.DesiredResults, func(desiredResult *desiredResultModel.DesiredResult) uint { return desiredResult.ID })
+}
+
+func (c *service) getShadeIds(product *model.Product) []uint {
+	return utils.IDs(product.Shades, func(shade *shadeModel.Shade) uint { return shade.ID })
+}
+
+// GetBySlug ищет товар по актуальному slug. Если slug устарел, вместо товара возвращается перенаправление на текущий.
+func (c *service) GetBySlug(slug string) (*dto.ResponseDTO, *dto.RedirectDTO, error) {
+	existedProduct, err := c.repo.GetBySlug(slug)
+	if err != nil {
+		return nil, nil, err
+	}
+	if existedProduct != nil {
+		return dto.TransformModelToResponseDTO(existedProduct), nil, nil
+	}
+
+	redirect, err := c.repo.GetRedirectBySlug(slug)
+	if err != nil || redirect == nil {
+		return nil, nil, err
+	}
+
+	target, err := c.repo.GetById(redirect.ProductID)
+	if err != nil || target == nil {
+		return nil, nil, err
+	}
+
+	return nil, &dto.RedirectDTO{Slug: target.Slug}, nil
+}
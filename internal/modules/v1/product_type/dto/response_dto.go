@@ -3,8 +3,9 @@ package dto
 import "time"
 
 type ResponseDTO struct {
-	Id        uint      `json:"id" example:"1"`
-	CreatedAt time.Time `json:"createdAt" example:"2023-10-01T12:00:00Z"`
-	UpdatedAt time.Time `json:"updatedAt" example:"2023-10-01T12:00:00Z"`
-	Name      string    `json:"name" example:"Product type Name"`
+	Id        uint       `json:"id" example:"1"`
+	CreatedAt time.Time  `json:"createdAt" example:"2023-10-01T12:00:00Z"`
+	UpdatedAt time.Time  `json:"updatedAt" example:"2023-10-01T12:00:00Z"`
+	DeletedAt *time.Time `json:"deletedAt,omitempty" example:"2023-10-02T12:00:00Z"`
+	Name      string     `json:"name" example:"Product type Name"`
 }
@@ -16,10 +16,16 @@ func TransformUpdateDTOToModel(dto UpdateDTO, model *model.ProductType) *model.P
 }
 
 func TransformModelToResponseDTO(model *model.ProductType) *ResponseDTO {
-	return &ResponseDTO{
+	responseDTO := &ResponseDTO{
 		Id:        model.ID,
 		CreatedAt: model.CreatedAt,
 		UpdatedAt: model.UpdatedAt,
 		Name:      model.Name,
 	}
+	if model.DeletedAt.Valid {
+		deletedAt := model.DeletedAt.Time
+		responseDTO.DeletedAt = &deletedAt
+	}
+
+	return responseDTO
 }
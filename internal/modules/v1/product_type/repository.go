@@ -5,15 +5,22 @@ import (
 	"gorm.io/gorm"
 	"haircompany-shop-rest/internal/modules/v1/product_type/model"
 	"haircompany-shop-rest/pkg/database"
+	"haircompany-shop-rest/pkg/request"
+	"time"
 )
 
 type Repository interface {
 	Create(model *model.ProductType) (*model.ProductType, error)
-	GetAll() ([]*model.ProductType, error)
+	GetAll(query request.ListQuery) ([]*model.ProductType, int64, error)
 	GetById(id uint) (*model.ProductType, error)
 	Update(model *model.ProductType) (*model.ProductType, error)
 	Delete(id uint) error
 	GetByUniqueFields(name string) (*model.ProductType, error)
+	GetDeleted(query request.ListQuery) ([]*model.ProductType, int64, error)
+	GetDeletedById(id uint) (*model.ProductType, error)
+	Restore(id uint) error
+	PurgeDeleted(before time.Time) ([]*model.ProductType, error)
+	CountProductsByProductTypeId(productTypeId uint) (int64, error)
 }
 
 type repository struct {
@@ -35,16 +42,8 @@ func (r *repository) Create(model *model.ProductType) (*model.ProductType, error
 	return model, nil
 }
 
-func (r *repository) GetAll() ([]*model.ProductType, error) {
-	var productTypes []*model.ProductType
-	var err error
-
-	result := r.DB.Find(&productTypes)
-	if result.Error != nil {
-		err = result.Error
-	}
-
-	return productTypes, err
+func (r *repository) GetAll(query request.ListQuery) ([]*model.ProductType, int64, error) {
+	return database.FindPage[model.ProductType](r.DB.DB, query)
 }
 
 func (r *repository) GetById(id uint) (*model.ProductType, error) {
@@ -94,3 +93,29 @@ func (r *repository) GetByUniqueFields(name string) (*model.ProductType, error)
 
 	return productType, err
 }
+
+func (r *repository) GetDeleted(query request.ListQuery) ([]*model.ProductType, int64, error) {
+	return database.FindDeletedPage[model.ProductType](r.DB.DB, query)
+}
+
+func (r *repository) GetDeletedById(id uint) (*model.ProductType, error) {
+	return database.FindDeleted[model.ProductType](r.DB.DB, id)
+}
+
+func (r *repository) Restore(id uint) error {
+	return database.Restore[model.ProductType](r.DB.DB, id)
+}
+
+func (r *repository) PurgeDeleted(before time.Time) ([]*model.ProductType, error) {
+	return database.PurgeDeleted[model.ProductType](r.DB.DB, before)
+}
+
+func (r *repository) CountProductsByProductTypeId(productTypeId uint) (int64, error) {
+	var count int64
+	result := r.DB.Table("products").Where("product_type_id = ?", productTypeId).Count(&count)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return count, nil
+}
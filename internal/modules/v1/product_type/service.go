@@ -3,16 +3,21 @@ package product_type
 import (
 	"errors"
 	"haircompany-shop-rest/internal/modules/v1/product_type/dto"
+	"haircompany-shop-rest/pkg/request"
 	"haircompany-shop-rest/pkg/response"
 	"log"
+	"time"
 )
 
 type Service interface {
 	Create(createDto dto.CreateDTO) (*dto.ResponseDTO, []response.ErrorField, error)
-	GetAll() ([]*dto.ResponseDTO, error)
+	GetAll(query request.ListQuery) ([]*dto.ResponseDTO, int64, error)
 	GetById(id uint) (*dto.ResponseDTO, error)
 	Update(id uint, updateDto dto.UpdateDTO) (*dto.ResponseDTO, []response.ErrorField, error)
-	Delete(id uint) (*dto.ResponseDTO, error)
+	Delete(id uint) (*dto.ResponseDTO, int64, error)
+	GetTrash(query request.ListQuery) ([]*dto.ResponseDTO, int64, error)
+	Restore(id uint) (*dto.ResponseDTO, []response.ErrorField, error)
+	Purge(before time.Time) (int, error)
 }
 
 type service struct {
@@ -50,9 +55,9 @@ func (c *service) Create(createDto dto.CreateDTO) (*dto.ResponseDTO, []response.
 	return createdProductTypeResponse, nil, nil
 }
 
-func (c *service) GetAll() ([]*dto.ResponseDTO, error) {
+func (c *service) GetAll(query request.ListQuery) ([]*dto.ResponseDTO, int64, error) {
 	productTypeDTOs := make([]*dto.ResponseDTO, 0)
-	models, err := c.repo.GetAll()
+	models, total, err := c.repo.GetAll(query)
 	if err != nil {
 		log.Printf("error retrieving product types: %v", err)
 	}
@@ -62,7 +67,7 @@ func (c *service) GetAll() ([]*dto.ResponseDTO, error) {
 		productTypeDTOs = append(productTypeDTOs, productTypeResponse)
 	}
 
-	return productTypeDTOs, err
+	return productTypeDTOs, total, err
 }
 
 func (c *service) GetById(id uint) (*dto.ResponseDTO, error) {
@@ -110,18 +115,75 @@ func (c *service) Update(id uint, updateDto dto.UpdateDTO) (*dto.ResponseDTO, []
 
 }
 
-func (c *service) Delete(id uint) (*dto.ResponseDTO, error) {
+func (c *service) Delete(id uint) (*dto.ResponseDTO, int64, error) {
+	var linkedEntitiesCount int64
 	existedProductType, err := c.repo.GetById(id)
 	if existedProductType == nil {
-		return nil, err
+		return nil, linkedEntitiesCount, err
 	}
 
 	productTypeDTO := dto.TransformModelToResponseDTO(existedProductType)
+	linkedEntitiesCount, err = c.repo.CountProductsByProductTypeId(id)
+	if err != nil {
+		return productTypeDTO, linkedEntitiesCount, err
+	}
+	if linkedEntitiesCount > 0 {
+		return productTypeDTO, linkedEntitiesCount, errors.New("product type has products and cannot be deleted")
+	}
 
 	err = c.repo.Delete(id)
 	if err != nil {
-		return productTypeDTO, err
+		return productTypeDTO, linkedEntitiesCount, err
+	}
+
+	return productTypeDTO, linkedEntitiesCount, nil
+}
+
+func (c *service) GetTrash(query request.ListQuery) ([]*dto.ResponseDTO, int64, error) {
+	productTypeDTOs := make([]*dto.ResponseDTO, 0)
+	models, total, err := c.repo.GetDeleted(query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for _, model := range models {
+		productTypeDTOs = append(productTypeDTOs, dto.TransformModelToResponseDTO(model))
+	}
+
+	return productTypeDTOs, total, nil
+}
+
+func (c *service) Restore(id uint) (*dto.ResponseDTO, []response.ErrorField, error) {
+	deletedProductType, err := c.repo.GetDeletedById(id)
+	if deletedProductType == nil {
+		return nil, nil, err
+	}
+
+	existingProductType, err := c.repo.GetByUniqueFields(deletedProductType.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+	if existingProductType != nil {
+		return nil, []response.ErrorField{response.NewErrorField("name", string(response.NotUnique))}, nil
+	}
+
+	if err := c.repo.Restore(id); err != nil {
+		return nil, nil, err
+	}
+
+	restoredProductType, err := c.repo.GetById(id)
+	if restoredProductType == nil {
+		return nil, nil, err
+	}
+
+	return dto.TransformModelToResponseDTO(restoredProductType), nil, nil
+}
+
+func (c *service) Purge(before time.Time) (int, error) {
+	purged, err := c.repo.PurgeDeleted(before)
+	if err != nil {
+		return 0, err
 	}
 
-	return productTypeDTO, nil
+	return len(purged), nil
 }
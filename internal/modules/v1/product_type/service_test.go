@@ -0,0 +1,115 @@
+package product_type
+
+import (
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"haircompany-shop-rest/internal/modules/v1/product_type/model"
+	"haircompany-shop-rest/pkg/database"
+	"haircompany-shop-rest/pkg/response"
+	"testing"
+	"time"
+)
+
+// Корзина проверяется на настоящей базе: конфликт имени держит частичный уникальный индекс
+func setupTestDB(t *testing.T) *database.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatal("Failed to connect to test database:", err)
+	}
+
+	err = db.AutoMigrate(&model.ProductType{})
+	if err != nil {
+		t.Fatal("Failed to migrate test database:", err)
+	}
+
+	return &database.DB{DB: db}
+}
+
+func deleteProductType(t *testing.T, db *database.DB, repo Repository, name string, deletedAt time.Time) *model.ProductType {
+	productType, err := repo.Create(&model.ProductType{Name: name})
+	if err != nil {
+		t.Fatalf("Failed to create test product type: %v", err)
+	}
+	if err := repo.Delete(productType.ID); err != nil {
+		t.Fatalf("Failed to delete test product type: %v", err)
+	}
+	if err := db.Unscoped().Model(&model.ProductType{}).Where("id = ?", productType.ID).Update("deleted_at", deletedAt).Error; err != nil {
+		t.Fatalf("Failed to age test product type: %v", err)
+	}
+	return productType
+}
+
+func TestService_Restore(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepository(db)
+	service := NewService(repo)
+
+	deleted := deleteProductType(t, db, repo, "Shampoo", time.Now())
+
+	restored, validationErrors, err := service.Restore(deleted.ID)
+	if err != nil || validationErrors != nil || restored == nil {
+		t.Fatalf("Expected product type to be restored, got %+v, %+v, %v", restored, validationErrors, err)
+	}
+	if restored.DeletedAt != nil {
+		t.Errorf("Expected restored product type without deletedAt, got %v", restored.DeletedAt)
+	}
+
+	restored, validationErrors, err = service.Restore(999)
+	if err != nil || validationErrors != nil || restored != nil {
+		t.Errorf("Expected nothing for a product type outside the trash, got %+v, %+v, %v", restored, validationErrors, err)
+	}
+}
+
+func TestService_Restore_NameTaken(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepository(db)
+	service := NewService(repo)
+
+	deleted := deleteProductType(t, db, repo, "Shampoo", time.Now())
+	if _, err := repo.Create(&model.ProductType{Name: "Shampoo"}); err != nil {
+		t.Fatalf("Expected trashed name to be reusable, got %v", err)
+	}
+
+	restored, validationErrors, err := service.Restore(deleted.ID)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if restored != nil || len(validationErrors) != 1 || validationErrors[0].Field != "name" || validationErrors[0].ErrorCode != string(response.NotUnique) {
+		t.Errorf("Expected taken name to block restore, got %+v, %+v", restored, validationErrors)
+	}
+
+	// Без проверки в сервисе восстановление упирается в индекс
+	if err := repo.Restore(deleted.ID); err == nil {
+		t.Error("Expected unique index to reject restoring a taken name")
+	}
+	if productType, _ := repo.GetDeletedById(deleted.ID); productType == nil {
+		t.Error("Expected product type to stay in the trash")
+	}
+}
+
+func TestService_Purge(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepository(db)
+	service := NewService(repo)
+
+	old := deleteProductType(t, db, repo, "Mask", time.Now().Add(-48*time.Hour))
+	recent := deleteProductType(t, db, repo, "Conditioner", time.Now())
+	live, _ := repo.Create(&model.ProductType{Name: "Serum"})
+
+	count, err := service.Purge(time.Now().Add(-24 * time.Hour))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if count != 1 {
+		t.Errorf("Expected only the old product type to be purged, got %d", count)
+	}
+	if productType, _ := repo.GetDeletedById(old.ID); productType != nil {
+		t.Error("Expected old product type to be gone")
+	}
+	if productType, _ := repo.GetDeletedById(recent.ID); productType == nil {
+		t.Error("Expected recently deleted product type to stay in the trash")
+	}
+	if productType, _ := repo.GetById(live.ID); productType == nil {
+		t.Error("Expected live product type to be untouched")
+	}
+}
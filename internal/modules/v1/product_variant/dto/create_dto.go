@@ -0,0 +1,13 @@
+package dto
+
+type CreateDTO struct {
+	ShadeID   *uint  `json:"shadeId" validate:"omitempty"`
+	Article   string `json:"article" validate:"required,min=1,max=64"`
+	Barcode   string `json:"barcode" validate:"required,ean"`
+	Volume    int    `json:"volume" validate:"required,gt=0"`
+	Weight    int    `json:"weight" validate:"gte=0"`
+	Price     int64  `json:"price" validate:"required,gt=0"`
+	OldPrice  *int64 `json:"oldPrice" validate:"omitempty,gt=0"`
+	SortIndex int    `json:"sortIndex" validate:"required,gte=0,lte=9999"`
+	IsActive  bool   `json:"isActive"`
+}
@@ -0,0 +1,19 @@
+package dto
+
+import "time"
+
+type ResponseDTO struct {
+	Id        uint      `json:"id" example:"1"`
+	CreatedAt time.Time `json:"createdAt" example:"2023-10-01T12:00:00Z"`
+	UpdatedAt time.Time `json:"updatedAt" example:"2023-10-01T12:00:00Z"`
+	ProductID uint      `json:"productId" example:"1"`
+	ShadeID   *uint     `json:"shadeId" example:"1"`
+	Article   string    `json:"article" example:"HC-1000-250"`
+	Barcode   string    `json:"barcode" example:"4607010590017"`
+	Volume    int       `json:"volume" example:"250"`
+	Weight    int       `json:"weight" example:"280"`
+	Price     int64     `json:"price" example:"129000"`
+	OldPrice  *int64    `json:"oldPrice" example:"149000"`
+	SortIndex int       `json:"sortIndex" example:"100"`
+	IsActive  bool      `json:"isActive" example:"true"`
+}
@@ -0,0 +1,68 @@
+package dto
+
+import "haircompany-shop-rest/internal/modules/v1/product_variant/model"
+
+func TransformCreateDTOToModel(productId uint, dto CreateDTO) *model.ProductVariant {
+	return &model.ProductVariant{
+		ProductID: productId,
+		ShadeID:   dto.ShadeID,
+		Article:   dto.Article,
+		Barcode:   dto.Barcode,
+		Volume:    dto.Volume,
+		Weight:    dto.Weight,
+		Price:     dto.Price,
+		OldPrice:  dto.OldPrice,
+		SortIndex: dto.SortIndex,
+		IsActive:  dto.IsActive,
+	}
+}
+
+func TransformUpdateDTOToModel(dto UpdateDTO, model *model.ProductVariant) *model.ProductVariant {
+	if dto.ShadeID != nil {
+		model.ShadeID = dto.ShadeID
+	}
+	if dto.Article != nil && *dto.Article != "" {
+		model.Article = *dto.Article
+	}
+	if dto.Barcode != nil && *dto.Barcode != "" {
+		model.Barcode = *dto.Barcode
+	}
+	if dto.Volume != nil {
+		model.Volume = *dto.Volume
+	}
+	if dto.Weight != nil {
+		model.Weight = *dto.Weight
+	}
+	if dto.Price != nil {
+		model.Price = *dto.Price
+	}
+	if dto.OldPrice != nil {
+		model.OldPrice = dto.OldPrice
+	}
+	if dto.SortIndex != nil {
+		model.SortIndex = *dto.SortIndex
+	}
+	if dto.IsActive != nil {
+		model.IsActive = *dto.IsActive
+	}
+
+	return model
+}
+
+func TransformModelToResponseDTO(model *model.ProductVariant) *ResponseDTO {
+	return &ResponseDTO{
+		Id:        model.ID,
+		CreatedAt: model.CreatedAt,
+		UpdatedAt: model.UpdatedAt,
+		ProductID: model.ProductID,
+		ShadeID:   model.ShadeID,
+		Article:   model.Article,
+		Barcode:   model.Barcode,
+		Volume:    model.Volume,
+		Weight:    model.Weight,
+		Price:     model.Price,
+		OldPrice:  model.OldPrice,
+		SortIndex: model.SortIndex,
+		IsActive:  model.IsActive,
+	}
+}
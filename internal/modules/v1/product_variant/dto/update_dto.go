@@ -0,0 +1,13 @@
+package dto
+
+type UpdateDTO struct {
+	ShadeID   *uint   `json:"shadeId" validate:"omitempty"`
+	Article   *string `json:"article" validate:"omitempty,min=1,max=64"`
+	Barcode   *string `json:"barcode" validate:"omitempty,ean"`
+	Volume    *int    `json:"volume" validate:"omitempty,gt=0"`
+	Weight    *int    `json:"weight" validate:"omitempty,gte=0"`
+	Price     *int64  `json:"price" validate:"omitempty,gt=0"`
+	OldPrice  *int64  `json:"oldPrice" validate:"omitempty,gt=0"`
+	SortIndex *int    `json:"sortIndex" validate:"omitempty,gte=0"`
+	IsActive  *bool   `json:"isActive" validate:"omitempty"`
+}
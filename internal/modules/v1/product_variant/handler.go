@@ -0,0 +1,268 @@
+package product_variant
+
+import (
+	"fmt"
+	"haircompany-shop-rest/internal/constraint"
+	"haircompany-shop-rest/internal/modules/v1/product_variant/dto"
+	"haircompany-shop-rest/pkg/request"
+	"haircompany-shop-rest/pkg/response"
+	"net/http"
+	"strconv"
+)
+
+type Handler struct {
+	svc Service
+}
+
+func NewHandler(s Service) *Handler {
+	return &Handler{
+		svc: s,
+	}
+}
+
+// Create creates a new product variant
+//
+//	@Summary		Create a new product variant
+//	@Description	Create a new variant (SKU) of the product
+//	@Tags			ProductVariant
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			productId	path		int										true	"Product ID"
+//	@Param			variant		body		dto.CreateDTO							true	"Product variant to create"
+//	@Success		201			{object}	docsResponse.ProductVariantCreate201	"Product variant created successfully"
+//	@Failure		400			{object}	docsResponse.ProductVariantCreate400	"Bad Request or Validation Error"
+//	@Failure		401			{object}	docsResponse.Response401				"Unauthorized"
+//	@Failure		403			{object}	docsResponse.Response403				"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		500			{object}	docsResponse.Response500				"Server Error"
+//	@Router			/api/v1/product/{productId}/variant/create [post]
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	productIdStr := r.PathValue("productId")
+	productId, err := strconv.Atoi(productIdStr)
+	if err != nil || productId < 0 {
+		msg := fmt.Sprintf("invalid product id: %s", productIdStr)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	createDto, err := request.DecodeBody[dto.CreateDTO](r.Body)
+	if err != nil {
+		msg := fmt.Sprintf("invalid request body: %v", err)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	errFields := constraint.ValidateDTO(createDto)
+	if errFields != nil {
+		msg := "validation errors occurred"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	createdVariant, errFields, err := h.svc.Create(uint(productId), createDto)
+	if err != nil {
+		msg := fmt.Sprintf("failed to create product variant: %v", err)
+		response.SendError(w, http.StatusBadRequest, msg, response.ServerError)
+		return
+	}
+	if errFields != nil {
+		msg := "validation errors occurred"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusCreated, createdVariant)
+}
+
+// GetAll retrieves all variants of the product
+//
+//	@Summary		Get all product variants
+//	@Description	Retrieve all variants of the product ordered by sort index
+//	@Tags			ProductVariant
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			productId	path		int									true	"Product ID"
+//	@Success		200			{object}	docsResponse.ProductVariantList200	"List of product variants"
+//	@Failure		400			{object}	docsResponse.Response400			"Invalid ID"
+//	@Failure		403			{object}	docsResponse.Response403			"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		500			{object}	docsResponse.Response500			"Server error"
+//	@Router			/api/v1/product/{productId}/variant [get]
+func (h *Handler) GetAll(w http.ResponseWriter, r *http.Request) {
+	productIdStr := r.PathValue("productId")
+	productId, err := strconv.Atoi(productIdStr)
+	if err != nil || productId < 0 {
+		msg := fmt.Sprintf("invalid product id: %s", productIdStr)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	variants, err := h.svc.GetAllByProductId(uint(productId))
+	if err != nil {
+		msg := fmt.Sprintf("failed to retrieve product variants: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, variants)
+}
+
+// GetById retrieves a product variant by its ID
+//
+//	@Summary		Get product variant by ID
+//	@Description	Retrieve product variant by its ID
+//	@Tags			ProductVariant
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			productId	path		int										true	"Product ID"
+//	@Param			id			path		int										true	"Product variant ID"
+//	@Success		200			{object}	docsResponse.ProductVariantGetById200	"Product variant found"
+//	@Failure		400			{object}	docsResponse.Response400				"Invalid ID"
+//	@Failure		403			{object}	docsResponse.Response403				"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		404			{object}	docsResponse.Response404				"Product variant not found"
+//	@Failure		500			{object}	docsResponse.Response500				"Server error"
+//	@Router			/api/v1/product/{productId}/variant/{id} [get]
+func (h *Handler) GetById(w http.ResponseWriter, r *http.Request) {
+	productIdStr := r.PathValue("productId")
+	productId, err := strconv.Atoi(productIdStr)
+	if err != nil || productId < 0 {
+		msg := fmt.Sprintf("invalid product id: %s", productIdStr)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	idStr := r.PathValue("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil || id < 0 {
+		msg := fmt.Sprintf("invalid product variant id: %s", idStr)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	variant, err := h.svc.GetById(uint(productId), uint(id))
+	if variant == nil {
+		msg := fmt.Sprintf("product variant with id %d not found", id)
+		response.SendError(w, http.StatusNotFound, msg, response.NotFound)
+		return
+	}
+	if err != nil {
+		msg := fmt.Sprintf("failed to retrieve product variant: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, variant)
+}
+
+// Update updates a product variant by its ID
+//
+//	@Summary		Update product variant
+//	@Description	Update product variant by ID
+//	@Tags			ProductVariant
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			productId	path		int										true	"Product ID"
+//	@Param			id			path		int										true	"Product variant ID"
+//	@Param			variant		body		dto.UpdateDTO							true	"Product variant update payload"
+//	@Success		200			{object}	docsResponse.ProductVariantUpdate200	"Product variant updated"
+//	@Failure		400			{object}	docsResponse.ProductVariantUpdate400	"Bad request or validation error"
+//	@Failure		401			{object}	docsResponse.Response401				"Unauthorized"
+//	@Failure		403			{object}	docsResponse.Response403				"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		500			{object}	docsResponse.Response500				"Server error"
+//	@Router			/api/v1/product/{productId}/variant/{id}/update [patch]
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	productIdStr := r.PathValue("productId")
+	productId, err := strconv.Atoi(productIdStr)
+	if err != nil || productId < 0 {
+		msg := fmt.Sprintf("invalid product id: %s", productIdStr)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	idStr := r.PathValue("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil || id < 0 {
+		msg := fmt.Sprintf("invalid product variant id: %s", idStr)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	updateDto, err := request.DecodeBody[dto.UpdateDTO](r.Body)
+	if err != nil {
+		msg := fmt.Sprintf("invalid request body: %v", err)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	errFields := constraint.ValidateDTO(updateDto)
+	if errFields != nil {
+		msg := "validation errors occurred"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	updatedVariant, errFields, err := h.svc.Update(uint(productId), uint(id), updateDto)
+	if err != nil {
+		msg := fmt.Sprintf("failed to update product variant: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+	if errFields != nil {
+		msg := "validation errors occurred"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, updatedVariant)
+}
+
+// Delete deletes a product variant by its ID
+//
+//	@Summary		Delete product variant
+//	@Description	Delete product variant by ID
+//	@Tags			ProductVariant
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			productId	path		int										true	"Product ID"
+//	@Param			id			path		int										true	"Product variant ID"
+//	@Success		200			{object}	docsResponse.ProductVariantDelete200	"Product variant deleted"
+//	@Failure		400			{object}	docsResponse.Response400				"Invalid ID"
+//	@Failure		401			{object}	docsResponse.Response401				"Unauthorized"
+//	@Failure		403			{object}	docsResponse.Response403				"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		404			{object}	docsResponse.Response404				"Product variant not found"
+//	@Failure		500			{object}	docsResponse.Response500				"Server error"
+//	@Router			/api/v1/product/{productId}/variant/{id}/delete [delete]
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	productIdStr := r.PathValue("productId")
+	productId, err := strconv.Atoi(productIdStr)
+	if err != nil || productId < 0 {
+		msg := fmt.Sprintf("invalid product id: %s", productIdStr)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	idStr := r.PathValue("id")
+	id, err := strconv.Atoi(idStr)
+	if err != nil || id < 0 {
+		msg := fmt.Sprintf("invalid product variant id: %s", idStr)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	variant, err := h.svc.Delete(uint(productId), uint(id))
+	if variant == nil {
+		msg := fmt.Sprintf("product variant with id %d not found", id)
+		response.SendError(w, http.StatusNotFound, msg, response.NotFound)
+		return
+	}
+	if err != nil {
+		msg := fmt.Sprintf("failed to delete product variant: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, variant)
+}
@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+type ProductVariant struct {
+	ID        uint `gorm:"primarykey" json:"id"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	ProductID uint   `gorm:"not null;index" json:"productId"`
+	ShadeID   *uint  `gorm:"index" json:"shadeId"`
+	Article   string `gorm:"type:varchar(64);not null;unique" json:"article"`
+	Barcode   string `gorm:"type:varchar(13);not null;unique" json:"barcode"` // EAN-8 or EAN-13
+	Volume    int    `gorm:"not null" json:"volume"`                          // Volume in ml
+	Weight    int    `gorm:"not null;default:0" json:"weight"`                // Weight in grams
+	Price     int64  `gorm:"not null" json:"price"`                           // Price in kopecks
+	OldPrice  *int64 `json:"oldPrice"`                                        // Price before discount in kopecks
+	SortIndex int    `gorm:"default:100" json:"sortIndex"`
+	IsActive  bool   `gorm:"default:true" json:"isActive"`
+}
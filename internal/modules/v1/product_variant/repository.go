@@ -0,0 +1,96 @@
+package product_variant
+
+import (
+	"errors"
+	"gorm.io/gorm"
+	"haircompany-shop-rest/internal/modules/v1/product_variant/model"
+	"haircompany-shop-rest/pkg/database"
+)
+
+type Repository interface {
+	Create(model *model.ProductVariant) (*model.ProductVariant, error)
+	GetAllByProductId(productId uint) ([]*model.ProductVariant, error)
+	GetById(id uint) (*model.ProductVariant, error)
+	Update(model *model.ProductVariant) (*model.ProductVariant, error)
+	Delete(id uint) error
+	GetByUniqueFields(article, barcode string) (*model.ProductVariant, error)
+}
+
+type repository struct {
+	DB *database.DB
+}
+
+func NewRepository(db *database.DB) Repository {
+	return &repository{
+		DB: db,
+	}
+}
+
+func (r *repository) Create(model *model.ProductVariant) (*model.ProductVariant, error) {
+	result := r.DB.Create(&model)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return model, nil
+}
+
+func (r *repository) GetAllByProductId(productId uint) ([]*model.ProductVariant, error) {
+	var variants []*model.ProductVariant
+	var err error
+
+	result := r.DB.Where("product_id = ?", productId).Order("sort_index, id").Find(&variants)
+	if result.Error != nil {
+		err = result.Error
+	}
+
+	return variants, err
+}
+
+func (r *repository) GetById(id uint) (*model.ProductVariant, error) {
+	var variant *model.ProductVariant
+	var err error
+
+	result := r.DB.First(&variant, id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		err = result.Error
+	}
+
+	return variant, err
+}
+
+func (r *repository) Update(model *model.ProductVariant) (*model.ProductVariant, error) {
+	result := r.DB.Save(&model)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return model, nil
+}
+
+func (r *repository) Delete(id uint) error {
+	result := r.DB.Delete(&model.ProductVariant{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+func (r *repository) GetByUniqueFields(article, barcode string) (*model.ProductVariant, error) {
+	var variant *model.ProductVariant
+	var err error
+
+	result := r.DB.First(&variant, "article = ? OR barcode = ?", article, barcode)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		err = result.Error
+	}
+
+	return variant, err
+}
@@ -0,0 +1,156 @@
+package product_variant
+
+import (
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"haircompany-shop-rest/internal/modules/v1/product_variant/model"
+	"haircompany-shop-rest/pkg/database"
+	"testing"
+)
+
+func setupTestDB(t *testing.T) *database.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatal("Failed to connect to test database:", err)
+	}
+
+	err = db.AutoMigrate(&model.ProductVariant{})
+	if err != nil {
+		t.Fatal("Failed to migrate test database:", err)
+	}
+
+	return &database.DB{DB: db}
+}
+
+func TestRepository_Create(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepository(db)
+
+	result, err := repo.Create(&model.ProductVariant{ProductID: 1, Article: "HC-1000-250", Barcode: "4607010590017", Volume: 250, Price: 129000})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.ID == 0 {
+		t.Fatal("Expected variant ID to be set")
+	}
+
+	// Уникальность держится и на уровне базы, если проверку в сервисе обошли
+	_, err = repo.Create(&model.ProductVariant{ProductID: 2, Article: "HC-2000-250", Barcode: "4607010590017", Volume: 250, Price: 129000})
+	if err == nil {
+		t.Error("Expected error for duplicate barcode")
+	}
+}
+
+func TestRepository_GetAllByProductId(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepository(db)
+
+	variants := []*model.ProductVariant{
+		{ProductID: 1, Article: "HC-1", Barcode: "4607010590017", Volume: 250, Price: 100, SortIndex: 200},
+		{ProductID: 1, Article: "HC-2", Barcode: "4006381333931", Volume: 500, Price: 200, SortIndex: 100},
+		{ProductID: 2, Article: "HC-3", Barcode: "96385074", Volume: 100, Price: 300, SortIndex: 100},
+	}
+	for _, variant := range variants {
+		if _, err := repo.Create(variant); err != nil {
+			t.Fatalf("Failed to create test variant: %v", err)
+		}
+	}
+
+	result, err := repo.GetAllByProductId(1)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(result) != 2 {
+		t.Fatalf("Expected 2 variants, got %d", len(result))
+	}
+	if result[0].Article != "HC-2" {
+		t.Errorf("Expected variants sorted by sort index, got %s first", result[0].Article)
+	}
+}
+
+func TestRepository_GetById(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepository(db)
+
+	created, _ := repo.Create(&model.ProductVariant{ProductID: 1, Article: "HC-1", Barcode: "4607010590017", Volume: 250, Price: 100})
+
+	result, err := repo.GetById(created.ID)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result == nil || result.Article != "HC-1" {
+		t.Errorf("Expected variant HC-1, got %v", result)
+	}
+
+	result, err = repo.GetById(99999)
+	if err != nil {
+		t.Fatalf("Expected no error for non-existent ID, got %v", err)
+	}
+	if result != nil {
+		t.Error("Expected nil result for non-existent ID")
+	}
+}
+
+func TestRepository_Update(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepository(db)
+
+	created, _ := repo.Create(&model.ProductVariant{ProductID: 1, Article: "HC-1", Barcode: "4607010590017", Volume: 250, Price: 100})
+	created.Price = 150
+
+	if _, err := repo.Update(created); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	result, _ := repo.GetById(created.ID)
+	if result.Price != 150 {
+		t.Errorf("Expected price 150, got %d", result.Price)
+	}
+}
+
+func TestRepository_Delete(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepository(db)
+
+	created, _ := repo.Create(&model.ProductVariant{ProductID: 1, Article: "HC-1", Barcode: "4607010590017", Volume: 250, Price: 100})
+
+	if err := repo.Delete(created.ID); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	result, _ := repo.GetById(created.ID)
+	if result != nil {
+		t.Error("Expected variant to be deleted")
+	}
+}
+
+func TestRepository_GetByUniqueFields(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRepository(db)
+
+	if _, err := repo.Create(&model.ProductVariant{ProductID: 1, Article: "HC-1", Barcode: "4607010590017", Volume: 250, Price: 100}); err != nil {
+		t.Fatalf("Failed to create test variant: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		article string
+		barcode string
+		found   bool
+	}{
+		{"by article", "HC-1", "4006381333931", true},
+		{"by barcode", "HC-2", "4607010590017", true},
+		{"no match", "HC-2", "4006381333931", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := repo.GetByUniqueFields(tt.article, tt.barcode)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if (result != nil) != tt.found {
+				t.Errorf("Expected found=%v, got %v", tt.found, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package product_variant
+
+import (
+	"errors"
+	"haircompany-shop-rest/internal/modules/v1/product"
+	"haircompany-shop-rest/internal/modules/v1/product_variant/dto"
+	"haircompany-shop-rest/internal/modules/v1/product_variant/model"
+	"haircompany-shop-rest/internal/modules/v1/shade"
+	"haircompany-shop-rest/pkg/response"
+	"log"
+)
+
+type Service interface {
+	Create(productId uint, createDto dto.CreateDTO) (*dto.ResponseDTO, []response.ErrorField, error)
+	GetAllByProductId(productId uint) ([]*dto.ResponseDTO, error)
+	GetById(productId, id uint) (*dto.ResponseDTO, error)
+	Update(productId, id uint, updateDto dto.UpdateDTO) (*dto.ResponseDTO, []response.ErrorField, error)
+	Delete(productId, id uint) (*dto.ResponseDTO, error)
+}
+
+type service struct {
+	repo        Repository
+	productRepo product.Repository
+	shadeRepo   shade.Repository
+}
+
+func NewService(r Repository, productRepo product.Repository, shadeRepo shade.Repository) Service {
+	return &service{
+		repo:        r,
+		productRepo: productRepo,
+		shadeRepo:   shadeRepo,
+	}
+}
+
+func (c *service) Create(productId uint, createDto dto.CreateDTO) (*dto.ResponseDTO, []response.ErrorField, error) {
+	var validationErrors []response.ErrorField
+	existingProduct, err := c.productRepo.GetById(productId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if existingProduct == nil {
+		validationErrors = append(validationErrors, response.NewErrorField("productId", string(response.NotFound)))
+		return nil, validationErrors, nil
+	}
+
+	existingVariant, err := c.repo.GetByUniqueFields(createDto.Article, createDto.Barcode)
+	if err != nil {
+		return nil, nil, err
+	}
+	if existingVariant != nil {
+		if existingVariant.Article == createDto.Article {
+			validationErrors = append(validationErrors, response.NewErrorField("article", string(response.NotUnique)))
+		}
+		if existingVariant.Barcode == createDto.Barcode {
+			validationErrors = append(validationErrors, response.NewErrorField("barcode", string(response.NotUnique)))
+		}
+
+		return nil, validationErrors, nil
+	}
+
+	variantModel := dto.TransformCreateDTOToModel(productId, createDto)
+	validationErrors, err = c.validateShade(variantModel)
+	if err != nil {
+		return nil, nil, err
+	}
+	if validationErrors != nil {
+		return nil, validationErrors, nil
+	}
+
+	createdVariant, err := c.repo.Create(variantModel)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	createdVariantResponse := dto.TransformModelToResponseDTO(createdVariant)
+
+	return createdVariantResponse, nil, nil
+}
+
+func (c *service) GetAllByProductId(productId uint) ([]*dto.ResponseDTO, error) {
+	variantDTOs := make([]*dto.ResponseDTO, 0)
+	models, err := c.repo.GetAllByProductId(productId)
+	if err != nil {
+		log.Printf("error retrieving product variants: %v", err)
+	}
+
+	for _, model := range models {
+		variantResponse := dto.TransformModelToResponseDTO(model)
+		variantDTOs = append(variantDTOs, variantResponse)
+	}
+
+	return variantDTOs, err
+}
+
+func (c *service) GetById(productId, id uint) (*dto.ResponseDTO, error) {
+	model, err := c.repo.GetById(id)
+	if model == nil || model.ProductID != productId {
+		return nil, err
+	}
+
+	variantDTO := dto.TransformModelToResponseDTO(model)
+
+	return variantDTO, err
+}
+
+func (c *service) Update(productId, id uint, updateDto dto.UpdateDTO) (*dto.ResponseDTO, []response.ErrorField, error) {
+	var validationErrors []response.ErrorField
+	model, err := c.repo.GetById(id)
+	if err != nil {
+		return nil, nil, err
+
+	}
+	if model == nil || model.ProductID != productId {
+		return nil, nil, errors.New("product variant not found")
+	}
+
+	dto.TransformUpdateDTOToModel(updateDto, model)
+	existingVariant, err := c.repo.GetByUniqueFields(model.Article, model.Barcode)
+	if err != nil {
+		return nil, nil, err
+	}
+	if existingVariant != nil && existingVariant.ID != id {
+		if existingVariant.Article == model.Article {
+			validationErrors = append(validationErrors, response.NewErrorField("article", string(response.NotUnique)))
+		}
+		if existingVariant.Barcode == model.Barcode {
+			validationErrors = append(validationErrors, response.NewErrorField("barcode", string(response.NotUnique)))
+		}
+		return nil, validationErrors, nil
+	}
+
+	if updateDto.ShadeID != nil {
+		validationErrors, err = c.validateShade(model)
+		if err != nil {
+			return nil, nil, err
+		}
+		if validationErrors != nil {
+			return nil, validationErrors, nil
+		}
+	}
+
+	updatedVariant, err := c.repo.Update(model)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	updatedVariantResponse := dto.TransformModelToResponseDTO(updatedVariant)
+
+	return updatedVariantResponse, nil, nil
+}
+
+func (c *service) Delete(productId, id uint) (*dto.ResponseDTO, error) {
+	existedVariant, err := c.repo.GetById(id)
+	if existedVariant == nil || existedVariant.ProductID != productId {
+		return nil, err
+	}
+
+	variantDTO := dto.TransformModelToResponseDTO(existedVariant)
+
+	err = c.repo.Delete(id)
+	if err != nil {
+		return variantDTO, err
+	}
+
+	return variantDTO, nil
+}
+
+func (c *service) validateShade(variant *model.ProductVariant) ([]response.ErrorField, error) {
+	if variant.ShadeID == nil {
+		return nil, nil
+	}
+
+	existingShade, err := c.shadeRepo.GetById(*variant.ShadeID)
+	if err != nil {
+		return nil, err
+	}
+	if existingShade == nil {
+		return []response.ErrorField{response.NewErrorField("shadeId", string(response.NotFound))}, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,332 @@
+package product_variant
+
+import (
+	"errors"
+	"haircompany-shop-rest/internal/constraint"
+	"haircompany-shop-rest/internal/modules/v1/product"
+	productModel "haircompany-shop-rest/internal/modules/v1/product/model"
+	"haircompany-shop-rest/internal/modules/v1/product_variant/dto"
+	"haircompany-shop-rest/internal/modules/v1/product_variant/model"
+	"haircompany-shop-rest/internal/modules/v1/shade"
+	shadeModel "haircompany-shop-rest/internal/modules/v1/shade/model"
+	"haircompany-shop-rest/pkg/response"
+	"testing"
+	"time"
+)
+
+type mockRepository struct {
+	variants map[uint]*model.ProductVariant
+	nextID   uint
+}
+
+func newMockRepository() *mockRepository {
+	return &mockRepository{
+		variants: make(map[uint]*model.ProductVariant),
+		nextID:   1,
+	}
+}
+
+func (m *mockRepository) Create(variant *model.ProductVariant) (*model.ProductVariant, error) {
+	if variant == nil {
+		return nil, errors.New("product variant is nil")
+	}
+	variant.ID = m.nextID
+	variant.CreatedAt = time.Now()
+	variant.UpdatedAt = time.Now()
+	m.variants[m.nextID] = variant
+	m.nextID++
+	return variant, nil
+}
+
+func (m *mockRepository) GetAllByProductId(productId uint) ([]*model.ProductVariant, error) {
+	var variants []*model.ProductVariant
+	for _, variant := range m.variants {
+		if variant.ProductID == productId {
+			variants = append(variants, variant)
+		}
+	}
+	return variants, nil
+}
+
+// Модели отдаются копиями, как из базы: сервис меняет полученную модель до проверок
+func (m *mockRepository) GetById(id uint) (*model.ProductVariant, error) {
+	variant, exists := m.variants[id]
+	if !exists {
+		return nil, nil
+	}
+	found := *variant
+	return &found, nil
+}
+
+func (m *mockRepository) Update(variant *model.ProductVariant) (*model.ProductVariant, error) {
+	if _, exists := m.variants[variant.ID]; !exists {
+		return nil, errors.New("product variant not found")
+	}
+	variant.UpdatedAt = time.Now()
+	m.variants[variant.ID] = variant
+	return variant, nil
+}
+
+func (m *mockRepository) Delete(id uint) error {
+	delete(m.variants, id)
+	return nil
+}
+
+func (m *mockRepository) GetByUniqueFields(article, barcode string) (*model.ProductVariant, error) {
+	for _, variant := range m.variants {
+		if variant.Article == article || variant.Barcode == barcode {
+			found := *variant
+			return &found, nil
+		}
+	}
+	return nil, nil
+}
+
+// mockProductRepository отвечает только на GetById, остальное сервису вариантов не нужно
+type mockProductRepository struct {
+	product.Repository
+	products map[uint]*productModel.Product
+}
+
+func (m *mockProductRepository) GetById(id uint) (*productModel.Product, error) {
+	return m.products[id], nil
+}
+
+type mockShadeRepository struct {
+	shade.Repository
+	shades map[uint]*shadeModel.Shade
+}
+
+func (m *mockShadeRepository) GetById(id uint) (*shadeModel.Shade, error) {
+	return m.shades[id], nil
+}
+
+func setupTestService() (Service, *mockRepository) {
+	mockRepo := newMockRepository()
+	productRepo := &mockProductRepository{products: map[uint]*productModel.Product{
+		1: {ID: 1, Name: "Shampoo", Slug: "shampoo"},
+		2: {ID: 2, Name: "Conditioner", Slug: "conditioner"},
+	}}
+	shadeRepo := &mockShadeRepository{shades: map[uint]*shadeModel.Shade{
+		1: {ID: 1, Name: "Blond"},
+	}}
+
+	return NewService(mockRepo, productRepo, shadeRepo), mockRepo
+}
+
+func validCreateDTO() dto.CreateDTO {
+	return dto.CreateDTO{
+		Article:   "HC-1000-250",
+		Barcode:   "4607010590017",
+		Volume:    250,
+		Price:     129000,
+		SortIndex: 100,
+		IsActive:  true,
+	}
+}
+
+func hasErrorField(errFields []response.ErrorField, field string, code response.ErrorCode) bool {
+	for _, errField := range errFields {
+		if errField.Field == field && errField.ErrorCode == string(code) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestService_Create_Success(t *testing.T) {
+	service, _ := setupTestService()
+
+	shadeId := uint(1)
+	createDto := validCreateDTO()
+	createDto.ShadeID = &shadeId
+
+	result, validationErrors, err := service.Create(1, createDto)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if validationErrors != nil {
+		t.Fatalf("Expected no validation errors, got %v", validationErrors)
+	}
+	if result.Id == 0 || result.ProductID != 1 {
+		t.Errorf("Expected variant of product 1 to be created, got %+v", result)
+	}
+	if result.ShadeID == nil || *result.ShadeID != 1 {
+		t.Errorf("Expected shade 1, got %v", result.ShadeID)
+	}
+}
+
+func TestService_Create_DuplicateArticle(t *testing.T) {
+	service, _ := setupTestService()
+	if _, _, err := service.Create(1, validCreateDTO()); err != nil {
+		t.Fatalf("Failed to create test variant: %v", err)
+	}
+
+	// Артикул уникален во всём каталоге, а не только внутри продукта
+	createDto := validCreateDTO()
+	createDto.Barcode = "4006381333931"
+	result, validationErrors, err := service.Create(2, createDto)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result != nil {
+		t.Error("Expected result to be nil due to validation error")
+	}
+	if !hasErrorField(validationErrors, "article", response.NotUnique) {
+		t.Errorf("Expected NOT_UNIQUE error for article, got %v", validationErrors)
+	}
+	if hasErrorField(validationErrors, "barcode", response.NotUnique) {
+		t.Errorf("Expected no error for unique barcode, got %v", validationErrors)
+	}
+}
+
+func TestService_Create_DuplicateBarcode(t *testing.T) {
+	service, _ := setupTestService()
+	if _, _, err := service.Create(1, validCreateDTO()); err != nil {
+		t.Fatalf("Failed to create test variant: %v", err)
+	}
+
+	createDto := validCreateDTO()
+	createDto.Article = "HC-1000-500"
+	result, validationErrors, err := service.Create(1, createDto)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result != nil {
+		t.Error("Expected result to be nil due to validation error")
+	}
+	if !hasErrorField(validationErrors, "barcode", response.NotUnique) {
+		t.Errorf("Expected NOT_UNIQUE error for barcode, got %v", validationErrors)
+	}
+	if hasErrorField(validationErrors, "article", response.NotUnique) {
+		t.Errorf("Expected no error for unique article, got %v", validationErrors)
+	}
+}
+
+func TestService_Create_ProductNotFound(t *testing.T) {
+	service, mockRepo := setupTestService()
+
+	result, validationErrors, err := service.Create(99, validCreateDTO())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result != nil {
+		t.Error("Expected result to be nil due to validation error")
+	}
+	if !hasErrorField(validationErrors, "productId", response.NotFound) {
+		t.Errorf("Expected NOT_FOUND error for productId, got %v", validationErrors)
+	}
+	if len(mockRepo.variants) != 0 {
+		t.Errorf("Expected no variants to be created, got %d", len(mockRepo.variants))
+	}
+}
+
+func TestService_Create_ShadeNotFound(t *testing.T) {
+	service, mockRepo := setupTestService()
+
+	shadeId := uint(99)
+	createDto := validCreateDTO()
+	createDto.ShadeID = &shadeId
+
+	result, validationErrors, err := service.Create(1, createDto)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result != nil {
+		t.Error("Expected result to be nil due to validation error")
+	}
+	if !hasErrorField(validationErrors, "shadeId", response.NotFound) {
+		t.Errorf("Expected NOT_FOUND error for shadeId, got %v", validationErrors)
+	}
+	if len(mockRepo.variants) != 0 {
+		t.Errorf("Expected no variants to be created, got %d", len(mockRepo.variants))
+	}
+}
+
+func TestService_Update_DuplicateBarcode(t *testing.T) {
+	service, mockRepo := setupTestService()
+	first, _, _ := service.Create(1, validCreateDTO())
+	createDto := validCreateDTO()
+	createDto.Article = "HC-1000-500"
+	createDto.Barcode = "4006381333931"
+	second, _, _ := service.Create(1, createDto)
+
+	barcode := first.Barcode
+	result, validationErrors, err := service.Update(1, second.Id, dto.UpdateDTO{Barcode: &barcode})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result != nil {
+		t.Error("Expected result to be nil due to validation error")
+	}
+	if !hasErrorField(validationErrors, "barcode", response.NotUnique) {
+		t.Errorf("Expected NOT_UNIQUE error for barcode, got %v", validationErrors)
+	}
+	if mockRepo.variants[second.Id].Barcode != "4006381333931" {
+		t.Errorf("Expected barcode to stay unchanged, got %s", mockRepo.variants[second.Id].Barcode)
+	}
+}
+
+func TestService_Update_KeepsOwnArticleAndBarcode(t *testing.T) {
+	service, _ := setupTestService()
+	created, _, _ := service.Create(1, validCreateDTO())
+
+	// Свои артикул и штрихкод не считаются дублями
+	article := created.Article
+	barcode := created.Barcode
+	price := int64(99000)
+	result, validationErrors, err := service.Update(1, created.Id, dto.UpdateDTO{Article: &article, Barcode: &barcode, Price: &price})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if validationErrors != nil {
+		t.Fatalf("Expected no validation errors, got %v", validationErrors)
+	}
+	if result.Price != 99000 {
+		t.Errorf("Expected price 99000, got %d", result.Price)
+	}
+}
+
+func TestService_Update_ShadeNotFound(t *testing.T) {
+	service, _ := setupTestService()
+	created, _, _ := service.Create(1, validCreateDTO())
+
+	shadeId := uint(99)
+	result, validationErrors, err := service.Update(1, created.Id, dto.UpdateDTO{ShadeID: &shadeId})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result != nil {
+		t.Error("Expected result to be nil due to validation error")
+	}
+	if !hasErrorField(validationErrors, "shadeId", response.NotFound) {
+		t.Errorf("Expected NOT_FOUND error for shadeId, got %v", validationErrors)
+	}
+}
+
+func TestService_Update_OtherProduct(t *testing.T) {
+	service, _ := setupTestService()
+	created, _, _ := service.Create(1, validCreateDTO())
+
+	price := int64(99000)
+	if _, _, err := service.Update(2, created.Id, dto.UpdateDTO{Price: &price}); err == nil {
+		t.Error("Expected error for variant of another product")
+	}
+}
+
+func TestCreateDTO_InvalidBarcode(t *testing.T) {
+	createDto := validCreateDTO()
+	// Последняя цифра не совпадает с контрольной
+	createDto.Barcode = "4607010590018"
+
+	errFields := constraint.ValidateDTO(createDto)
+	if !hasErrorField(errFields, "Barcode", response.InvalidBarcode) {
+		t.Errorf("Expected INVALID_BARCODE error for barcode, got %v", errFields)
+	}
+
+	barcode := "96385075"
+	errFields = constraint.ValidateDTO(dto.UpdateDTO{Barcode: &barcode})
+	if !hasErrorField(errFields, "Barcode", response.InvalidBarcode) {
+		t.Errorf("Expected INVALID_BARCODE error for EAN-8 barcode, got %v", errFields)
+	}
+}
@@ -0,0 +1,19 @@
+package dto
+
+import "time"
+
+type CreateDTO struct {
+	Code                string     `json:"code" validate:"required,min=3,max=64" example:"SPRING10"`
+	Description         string     `json:"description" validate:"max=1000" example:"Весенняя скидка"`
+	DiscountType        string     `json:"discountType" validate:"required,oneof=percent fixed" example:"percent"`
+	DiscountValue       int64      `json:"discountValue" validate:"required,gt=0" example:"10"` // Percent for percent codes, kopecks for fixed ones
+	MinOrderAmount      int64      `json:"minOrderAmount" validate:"gte=0" example:"300000"`
+	StartsAt            *time.Time `json:"startsAt" example:"2024-03-01T00:00:00Z"`
+	EndsAt              *time.Time `json:"endsAt" example:"2024-04-01T00:00:00Z"`
+	UsageLimit          *int       `json:"usageLimit" validate:"omitempty,gt=0" example:"1000"`
+	UsageLimitPerClient *int       `json:"usageLimitPerClient" validate:"omitempty,gt=0" example:"1"`
+	IsActive            bool       `json:"isActive" example:"true"`
+	CategoryIDs         []uint     `json:"categoryIds"`
+	LineIDs             []uint     `json:"lineIds"`
+	ProductTypeIDs      []uint     `json:"productTypeIds"`
+}
@@ -0,0 +1,30 @@
+package dto
+
+// ItemDTO is a cart line passed to the discount rules.
+type ItemDTO struct {
+	ProductVariantID uint
+	ProductID        uint
+	Price            int64
+	Quantity         int
+}
+
+type LineEvaluationDTO struct {
+	ProductVariantID uint    `json:"productVariantId" example:"1"`
+	Subtotal         int64   `json:"subtotal" example:"258000"`
+	Discount         int64   `json:"discount" example:"25800"`
+	Total            int64   `json:"total" example:"232200"`
+	Eligible         bool    `json:"eligible" example:"true"`
+	Reason           *string `json:"reason" example:"CATEGORY_NOT_ELIGIBLE"` // Why the line is not discounted
+}
+
+// EvaluationDTO is the result of applying a promo code to a set of lines.
+// When Applied is false Reason holds the rejection error code and no line is discounted.
+type EvaluationDTO struct {
+	Code     string               `json:"code" example:"SPRING10"`
+	Applied  bool                 `json:"applied" example:"true"`
+	Reason   *string              `json:"reason" example:"PROMO_CODE_EXPIRED"`
+	Subtotal int64                `json:"subtotal" example:"258000"`
+	Discount int64                `json:"discount" example:"25800"`
+	Total    int64                `json:"total" example:"232200"`
+	Lines    []*LineEvaluationDTO `json:"lines"`
+}
@@ -0,0 +1,23 @@
+package dto
+
+import "time"
+
+type ResponseDTO struct {
+	Id                  uint       `json:"id" example:"1"`
+	CreatedAt           time.Time  `json:"createdAt" example:"2023-10-01T12:00:00Z"`
+	UpdatedAt           time.Time  `json:"updatedAt" example:"2023-10-01T12:00:00Z"`
+	Code                string     `json:"code" example:"SPRING10"`
+	Description         string     `json:"description" example:"Весенняя скидка"`
+	DiscountType        string     `json:"discountType" example:"percent"`
+	DiscountValue       int64      `json:"discountValue" example:"10"`
+	MinOrderAmount      int64      `json:"minOrderAmount" example:"300000"`
+	StartsAt            *time.Time `json:"startsAt" example:"2024-03-01T00:00:00Z"`
+	EndsAt              *time.Time `json:"endsAt" example:"2024-04-01T00:00:00Z"`
+	UsageLimit          *int       `json:"usageLimit" example:"1000"`
+	UsageLimitPerClient *int       `json:"usageLimitPerClient" example:"1"`
+	UsedCount           int        `json:"usedCount" example:"12"`
+	IsActive            bool       `json:"isActive" example:"true"`
+	CategoryIDs         []uint     `json:"categoryIds"`
+	LineIDs             []uint     `json:"lineIds"`
+	ProductTypeIDs      []uint     `json:"productTypeIds"`
+}
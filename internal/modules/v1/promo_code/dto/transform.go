@@ -0,0 +1,98 @@
+package dto
+
+import (
+	"haircompany-shop-rest/internal/modules/v1/promo_code/model"
+	"strings"
+)
+
+// NormalizeCode brings a promo code to the stored form: codes are case-insensitive.
+func NormalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func TransformCreateDTOToModel(dto CreateDTO) *model.PromoCode {
+	return &model.PromoCode{
+		Code:                NormalizeCode(dto.Code),
+		Description:         dto.Description,
+		DiscountType:        dto.DiscountType,
+		DiscountValue:       dto.DiscountValue,
+		MinOrderAmount:      dto.MinOrderAmount,
+		StartsAt:            dto.StartsAt,
+		EndsAt:              dto.EndsAt,
+		UsageLimit:          dto.UsageLimit,
+		UsageLimitPerClient: dto.UsageLimitPerClient,
+		IsActive:            dto.IsActive,
+	}
+}
+
+func TransformUpdateDTOToModel(dto UpdateDTO, model *model.PromoCode) *model.PromoCode {
+	if dto.Code != nil && *dto.Code != "" {
+		model.Code = NormalizeCode(*dto.Code)
+	}
+	if dto.Description != nil {
+		model.Description = *dto.Description
+	}
+	if dto.DiscountType != nil {
+		model.DiscountType = *dto.DiscountType
+	}
+	if dto.DiscountValue != nil {
+		model.DiscountValue = *dto.DiscountValue
+	}
+	if dto.MinOrderAmount != nil {
+		model.MinOrderAmount = *dto.MinOrderAmount
+	}
+	if dto.StartsAt != nil {
+		model.StartsAt = dto.StartsAt
+	}
+	if dto.EndsAt != nil {
+		model.EndsAt = dto.EndsAt
+	}
+	if dto.UsageLimit != nil {
+		model.UsageLimit = dto.UsageLimit
+	}
+	if dto.UsageLimitPerClient != nil {
+		model.UsageLimitPerClient = dto.UsageLimitPerClient
+	}
+	if dto.IsActive != nil {
+		model.IsActive = *dto.IsActive
+	}
+
+	return model
+}
+
+func TransformModelToResponseDTO(model *model.PromoCode) *ResponseDTO {
+	categoryIds := make([]uint, 0, len(model.Categories))
+	for _, category := range model.Categories {
+		categoryIds = append(categoryIds, category.ID)
+	}
+
+	lineIds := make([]uint, 0, len(model.Lines))
+	for _, line := range model.Lines {
+		lineIds = append(lineIds, line.ID)
+	}
+
+	productTypeIds := make([]uint, 0, len(model.ProductTypes))
+	for _, productType := range model.ProductTypes {
+		productTypeIds = append(productTypeIds, productType.ID)
+	}
+
+	return &ResponseDTO{
+		Id:                  model.ID,
+		CreatedAt:           model.CreatedAt,
+		UpdatedAt:           model.UpdatedAt,
+		Code:                model.Code,
+		Description:         model.Description,
+		DiscountType:        model.DiscountType,
+		DiscountValue:       model.DiscountValue,
+		MinOrderAmount:      model.MinOrderAmount,
+		StartsAt:            model.StartsAt,
+		EndsAt:              model.EndsAt,
+		UsageLimit:          model.UsageLimit,
+		UsageLimitPerClient: model.UsageLimitPerClient,
+		UsedCount:           model.UsedCount,
+		IsActive:            model.IsActive,
+		CategoryIDs:         categoryIds,
+		LineIDs:             lineIds,
+		ProductTypeIDs:      productTypeIds,
+	}
+}
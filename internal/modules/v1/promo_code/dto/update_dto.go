@@ -0,0 +1,19 @@
+package dto
+
+import "time"
+
+type UpdateDTO struct {
+	Code                *string    `json:"code" validate:"omitempty,min=3,max=64"`
+	Description         *string    `json:"description" validate:"omitempty,max=1000"`
+	DiscountType        *string    `json:"discountType" validate:"omitempty,oneof=percent fixed"`
+	DiscountValue       *int64     `json:"discountValue" validate:"omitempty,gt=0"`
+	MinOrderAmount      *int64     `json:"minOrderAmount" validate:"omitempty,gte=0"`
+	StartsAt            *time.Time `json:"startsAt"`
+	EndsAt              *time.Time `json:"endsAt"`
+	UsageLimit          *int       `json:"usageLimit" validate:"omitempty,gt=0"`
+	UsageLimitPerClient *int       `json:"usageLimitPerClient" validate:"omitempty,gt=0"`
+	IsActive            *bool      `json:"isActive" validate:"omitempty"`
+	CategoryIDs         *[]uint    `json:"categoryIds" validate:"omitempty"`
+	LineIDs             *[]uint    `json:"lineIds" validate:"omitempty"`
+	ProductTypeIDs      *[]uint    `json:"productTypeIds" validate:"omitempty"`
+}
@@ -0,0 +1,250 @@
+package promo_code
+
+import (
+	"fmt"
+	"haircompany-shop-rest/internal/constraint"
+	"haircompany-shop-rest/internal/modules/v1/promo_code/dto"
+	"haircompany-shop-rest/pkg/request"
+	"haircompany-shop-rest/pkg/response"
+	"net/http"
+	"strconv"
+)
+
+type Handler struct {
+	svc Service
+}
+
+func NewHandler(s Service) *Handler {
+	return &Handler{
+		svc: s,
+	}
+}
+
+// Create creates a new promo code
+//
+//	@Summary		Create a new promo code
+//	@Description	Create a new promo code with its discount rules. Empty categoryIds, lineIds and productTypeIds mean the code applies to any product.
+//	@Tags			PromoCode
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			promoCode	body		dto.CreateDTO					true	"Promo code to create"
+//	@Success		201			{object}	docsResponse.PromoCodeCreate201	"Promo code created successfully"
+//	@Failure		400			{object}	docsResponse.PromoCodeCreate400	"Bad Request or Validation Error"
+//	@Failure		401			{object}	docsResponse.Response401		"Unauthorized"
+//	@Failure		403			{object}	docsResponse.Response403		"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		500			{object}	docsResponse.Response500		"Server Error"
+//	@Router			/api/v1/promo-code/create [post]
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	createDto, err := request.DecodeBody[dto.CreateDTO](r.Body)
+	if err != nil {
+		msg := fmt.Sprintf("invalid request body: %v", err)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	errFields := constraint.ValidateDTO(createDto)
+	if errFields != nil {
+		msg := "validation errors occurred"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	createdPromoCode, errFields, err := h.svc.Create(createDto)
+	if err != nil {
+		msg := fmt.Sprintf("failed to create promo code: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+	if errFields != nil {
+		msg := "validation errors occurred"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusCreated, createdPromoCode)
+}
+
+// GetAll retrieves all promo codes
+//
+//	@Summary		Get all promo codes
+//	@Description	Retrieve all promo codes
+//	@Tags			PromoCode
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Produce		json
+//	@Success		200	{object}	docsResponse.PromoCodeList200	"List of promo codes"
+//	@Failure		401	{object}	docsResponse.Response401		"Unauthorized"
+//	@Failure		403	{object}	docsResponse.Response403		"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		500	{object}	docsResponse.Response500		"Server error"
+//	@Router			/api/v1/promo-code [get]
+func (h *Handler) GetAll(w http.ResponseWriter) {
+	promoCodes, err := h.svc.GetAll()
+	if err != nil {
+		msg := fmt.Sprintf("failed to retrieve promo codes: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, promoCodes)
+}
+
+// GetById retrieves a promo code by its ID
+//
+//	@Summary		Get promo code by ID
+//	@Description	Retrieve promo code by its ID
+//	@Tags			PromoCode
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			id	path		int									true	"Promo code ID"
+//	@Success		200	{object}	docsResponse.PromoCodeGetById200	"Promo code found"
+//	@Failure		400	{object}	docsResponse.Response400			"Invalid ID"
+//	@Failure		401	{object}	docsResponse.Response401			"Unauthorized"
+//	@Failure		403	{object}	docsResponse.Response403			"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		404	{object}	docsResponse.Response404			"Promo code not found"
+//	@Failure		500	{object}	docsResponse.Response500			"Server error"
+//	@Router			/api/v1/promo-code/{id} [get]
+func (h *Handler) GetById(w http.ResponseWriter, r *http.Request) {
+	idStr := r.PathValue("id")
+	if idStr == "" {
+		msg := "missing promo code id"
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	id, err := strconv.Atoi(idStr)
+	if err != nil || id < 0 {
+		msg := fmt.Sprintf("invalid promo code id: %s", idStr)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	promoCode, err := h.svc.GetById(uint(id))
+	if promoCode == nil {
+		msg := fmt.Sprintf("promo code with id %d not found", id)
+		response.SendError(w, http.StatusNotFound, msg, response.NotFound)
+		return
+	}
+	if err != nil {
+		msg := fmt.Sprintf("failed to retrieve promo code: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, promoCode)
+}
+
+// Update updates a promo code by its ID
+//
+//	@Summary		Update promo code
+//	@Description	Update promo code by ID. Omitted restriction lists are kept; an empty list removes the restriction.
+//	@Tags			PromoCode
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		int								true	"Promo code ID"
+//	@Param			promoCode	body		dto.UpdateDTO					true	"Promo code update payload"
+//	@Success		200			{object}	docsResponse.PromoCodeUpdate200	"Promo code updated"
+//	@Failure		400			{object}	docsResponse.PromoCodeCreate400	"Bad request or validation error"
+//	@Failure		401			{object}	docsResponse.Response401		"Unauthorized"
+//	@Failure		403			{object}	docsResponse.Response403		"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		500			{object}	docsResponse.Response500		"Server error"
+//	@Router			/api/v1/promo-code/{id}/update [patch]
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	idStr := r.PathValue("id")
+	if idStr == "" {
+		msg := "missing promo code id"
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	id, err := strconv.Atoi(idStr)
+	if err != nil || id < 0 {
+		msg := fmt.Sprintf("invalid promo code id: %s", idStr)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	updateDto, err := request.DecodeBody[dto.UpdateDTO](r.Body)
+	if err != nil {
+		msg := fmt.Sprintf("invalid request body: %v", err)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	errFields := constraint.ValidateDTO(updateDto)
+	if errFields != nil {
+		msg := "validation errors occurred"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	updatedPromoCode, errFields, err := h.svc.Update(uint(id), updateDto)
+	if err != nil {
+		msg := fmt.Sprintf("failed to update promo code: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+	if errFields != nil {
+		msg := "validation errors occurred"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, updatedPromoCode)
+}
+
+// Delete deletes a promo code by its ID
+//
+//	@Summary		Delete promo code
+//	@Description	Delete a promo code that has never been used. Used codes can only be deactivated.
+//	@Tags			PromoCode
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			id	path		int								true	"Promo code ID"
+//	@Success		200	{object}	docsResponse.PromoCodeDelete200	"Promo code deleted"
+//	@Failure		400	{object}	docsResponse.Response400		"Invalid ID"
+//	@Failure		401	{object}	docsResponse.Response401		"Unauthorized"
+//	@Failure		403	{object}	docsResponse.Response403		"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		404	{object}	docsResponse.Response404		"Promo code not found"
+//	@Failure		409	{object}	docsResponse.Response409		"Promo code has been used"
+//	@Failure		500	{object}	docsResponse.Response500		"Server error"
+//	@Router			/api/v1/promo-code/{id}/delete [delete]
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	idStr := r.PathValue("id")
+	if idStr == "" {
+		msg := "missing promo code id"
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	id, err := strconv.Atoi(idStr)
+	if err != nil || id < 0 {
+		msg := fmt.Sprintf("invalid promo code id: %s", idStr)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	promoCode, usedCount, err := h.svc.Delete(uint(id))
+	if promoCode == nil {
+		msg := fmt.Sprintf("promo code with id %d not found", id)
+		response.SendError(w, http.StatusNotFound, msg, response.NotFound)
+		return
+	}
+	if usedCount > 0 {
+		msg := fmt.Sprintf("promo code with id %d cannot be deleted because it has been used %d times", id, usedCount)
+		response.SendError(w, http.StatusConflict, msg, response.HasLinkedEntities)
+		return
+	}
+	if err != nil {
+		msg := fmt.Sprintf("failed to delete promo code: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, promoCode)
+}
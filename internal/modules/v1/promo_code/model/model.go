@@ -0,0 +1,44 @@
+package model
+
+import (
+	categoryModel "haircompany-shop-rest/internal/modules/v1/category/model"
+	lineModel "haircompany-shop-rest/internal/modules/v1/line/model"
+	productTypeModel "haircompany-shop-rest/internal/modules/v1/product_type/model"
+	"time"
+)
+
+const (
+	DiscountPercent = "percent"
+	DiscountFixed   = "fixed"
+)
+
+// PromoCode describes a discount and the rules it is applied by. Empty
+// Categories, Lines and ProductTypes mean no restriction of that kind.
+type PromoCode struct {
+	ID                  uint `gorm:"primarykey" json:"id"`
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+	Code                string                          `gorm:"type:varchar(64);not null;unique" json:"code"`
+	Description         string                          `gorm:"type:text" json:"description"`
+	DiscountType        string                          `gorm:"type:varchar(16);not null" json:"discountType"`
+	DiscountValue       int64                           `gorm:"not null" json:"discountValue"` // Percent for percent codes, kopecks for fixed ones
+	MinOrderAmount      int64                           `gorm:"not null;default:0" json:"minOrderAmount"`
+	StartsAt            *time.Time                      `json:"startsAt"`
+	EndsAt              *time.Time                      `json:"endsAt"`
+	UsageLimit          *int                            `json:"usageLimit"`
+	UsageLimitPerClient *int                            `json:"usageLimitPerClient"`
+	UsedCount           int                             `gorm:"not null;default:0" json:"usedCount"`
+	IsActive            bool                            `gorm:"not null" json:"isActive"`
+	Categories          []*categoryModel.Category       `gorm:"many2many:promo_code_categories;" json:"categories"`
+	Lines               []*lineModel.Line               `gorm:"many2many:promo_code_lines;" json:"lines"`
+	ProductTypes        []*productTypeModel.ProductType `gorm:"many2many:promo_code_product_types;" json:"productTypes"`
+}
+
+// PromoCodeUsage records that a code was spent on an order.
+type PromoCodeUsage struct {
+	ID          uint `gorm:"primarykey" json:"id"`
+	CreatedAt   time.Time
+	PromoCodeID uint   `gorm:"not null;index" json:"promoCodeId"`
+	OrderID     uint   `gorm:"not null;unique" json:"orderId"`
+	ClientPhone string `gorm:"type:varchar(32);not null" json:"clientPhone"`
+}
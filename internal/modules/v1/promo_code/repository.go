@@ -0,0 +1,201 @@
+package promo_code
+
+import (
+	"errors"
+	"gorm.io/gorm"
+	categoryModel "haircompany-shop-rest/internal/modules/v1/category/model"
+	lineModel "haircompany-shop-rest/internal/modules/v1/line/model"
+	productModel "haircompany-shop-rest/internal/modules/v1/product/model"
+	productTypeModel "haircompany-shop-rest/internal/modules/v1/product_type/model"
+	"haircompany-shop-rest/internal/modules/v1/promo_code/model"
+	"haircompany-shop-rest/pkg/database"
+)
+
+type Repository interface {
+	Transaction(fn func(repo Repository) error) error
+	Create(model *model.PromoCode) (*model.PromoCode, error)
+	GetAll() ([]*model.PromoCode, error)
+	GetById(id uint) (*model.PromoCode, error)
+	GetByCode(code string) (*model.PromoCode, error)
+	Update(model *model.PromoCode) (*model.PromoCode, error)
+	Delete(id uint) error
+	GetCategoriesByIds(ids []uint) ([]*categoryModel.Category, error)
+	GetLinesByIds(ids []uint) ([]*lineModel.Line, error)
+	GetProductTypesByIds(ids []uint) ([]*productTypeModel.ProductType, error)
+	GetProductsByIds(ids []uint) ([]*productModel.Product, error)
+	IncrementUsage(id uint) (bool, error)
+	CountClientUsages(id uint, clientPhone string) (int64, error)
+	CreateUsage(usage *model.PromoCodeUsage) error
+}
+
+type repository struct {
+	DB *database.DB
+}
+
+func NewRepository(db *database.DB) Repository {
+	return &repository{
+		DB: db,
+	}
+}
+
+// Transaction runs fn against a repository bound to a single database transaction.
+func (r *repository) Transaction(fn func(repo Repository) error) error {
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		return fn(NewRepository(&database.DB{DB: tx}))
+	})
+}
+
+func (r *repository) Create(model *model.PromoCode) (*model.PromoCode, error) {
+	result := r.DB.Omit("Categories.*", "Lines.*", "ProductTypes.*").Create(&model)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return model, nil
+}
+
+func (r *repository) GetAll() ([]*model.PromoCode, error) {
+	var promoCodes []*model.PromoCode
+	var err error
+
+	result := r.withRelations().Order("id DESC").Find(&promoCodes)
+	if result.Error != nil {
+		err = result.Error
+	}
+
+	return promoCodes, err
+}
+
+func (r *repository) GetById(id uint) (*model.PromoCode, error) {
+	return r.first("id = ?", id)
+}
+
+func (r *repository) GetByCode(code string) (*model.PromoCode, error) {
+	return r.first("code = ?", code)
+}
+
+func (r *repository) first(query string, args ...interface{}) (*model.PromoCode, error) {
+	var promoCode *model.PromoCode
+	var err error
+
+	result := r.withRelations().Where(query, args...).First(&promoCode)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		err = result.Error
+	}
+
+	return promoCode, err
+}
+
+// Update saves the editable fields. used_count is left out: it was read before
+// the edit, and writing it back would lose a concurrent IncrementUsage.
+func (r *repository) Update(model *model.PromoCode) (*model.PromoCode, error) {
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Categories", "Lines", "ProductTypes", "UsedCount").Save(&model).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&model).Association("Categories").Replace(model.Categories); err != nil {
+			return err
+		}
+		if err := tx.Model(&model).Association("Lines").Replace(model.Lines); err != nil {
+			return err
+		}
+		if err := tx.Model(&model).Association("ProductTypes").Replace(model.ProductTypes); err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return model, nil
+}
+
+func (r *repository) Delete(id uint) error {
+	result := r.DB.Select("Categories", "Lines", "ProductTypes").Delete(&model.PromoCode{ID: id})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+func (r *repository) GetCategoriesByIds(ids []uint) ([]*categoryModel.Category, error) {
+	categories := make([]*categoryModel.Category, 0)
+	if len(ids) == 0 {
+		return categories, nil
+	}
+
+	result := r.DB.Where("id IN ?", ids).Find(&categories)
+	return categories, result.Error
+}
+
+func (r *repository) GetLinesByIds(ids []uint) ([]*lineModel.Line, error) {
+	lines := make([]*lineModel.Line, 0)
+	if len(ids) == 0 {
+		return lines, nil
+	}
+
+	result := r.DB.Where("id IN ?", ids).Find(&lines)
+	return lines, result.Error
+}
+
+func (r *repository) GetProductTypesByIds(ids []uint) ([]*productTypeModel.ProductType, error) {
+	productTypes := make([]*productTypeModel.ProductType, 0)
+	if len(ids) == 0 {
+		return productTypes, nil
+	}
+
+	result := r.DB.Where("id IN ?", ids).Find(&productTypes)
+	return productTypes, result.Error
+}
+
+// GetProductsByIds loads products without relations: the rules only need
+// their category, line and product type.
+func (r *repository) GetProductsByIds(ids []uint) ([]*productModel.Product, error) {
+	products := make([]*productModel.Product, 0)
+	if len(ids) == 0 {
+		return products, nil
+	}
+
+	result := r.DB.Where("id IN ?", ids).Find(&products)
+	return products, result.Error
+}
+
+// IncrementUsage counts one more use of the code unless its usage limit is
+// already reached. The conditional update makes the check race-free and
+// locks the row until the surrounding transaction ends.
+func (r *repository) IncrementUsage(id uint) (bool, error) {
+	result := r.DB.Model(&model.PromoCode{}).
+		Where("id = ? AND (usage_limit IS NULL OR used_count < usage_limit)", id).
+		Update("used_count", gorm.Expr("used_count + 1"))
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (r *repository) CountClientUsages(id uint, clientPhone string) (int64, error) {
+	var count int64
+	result := r.DB.Model(&model.PromoCodeUsage{}).
+		Where("promo_code_id = ? AND client_phone = ?", id, clientPhone).
+		Count(&count)
+
+	return count, result.Error
+}
+
+func (r *repository) CreateUsage(usage *model.PromoCodeUsage) error {
+	return r.DB.Create(usage).Error
+}
+
+func (r *repository) withRelations() *gorm.DB {
+	return r.DB.
+		Preload("Categories").
+		Preload("Lines").
+		Preload("ProductTypes")
+}
@@ -0,0 +1,176 @@
+package promo_code
+
+import (
+	productModel "haircompany-shop-rest/internal/modules/v1/product/model"
+	"haircompany-shop-rest/internal/modules/v1/promo_code/dto"
+	"haircompany-shop-rest/internal/modules/v1/promo_code/model"
+	"haircompany-shop-rest/pkg/response"
+	"time"
+)
+
+var rejectionMessages = map[response.ErrorCode]string{
+	response.PromoCodeNotFound:      "promo code not found",
+	response.PromoCodeInactive:      "promo code is disabled",
+	response.PromoCodeNotStarted:    "promo code is not valid yet",
+	response.PromoCodeExpired:       "promo code has expired",
+	response.PromoCodeUsageLimit:    "promo code usage limit is reached",
+	response.PromoCodeClientLimit:   "promo code has already been used by this client",
+	response.PromoCodeMinAmount:     "order amount is below the promo code minimum",
+	response.PromoCodeNotApplicable: "promo code does not apply to any item in the cart",
+	response.EmptyCart:              "cart is empty",
+}
+
+// RejectionError explains why a promo code cannot be applied.
+type RejectionError struct {
+	Reason response.ErrorCode
+}
+
+func (e *RejectionError) Error() string {
+	if msg, ok := rejectionMessages[e.Reason]; ok {
+		return msg
+	}
+	return string(e.Reason)
+}
+
+// NewRejectionError returns the rejection behind an evaluation, or nil if the code was applied.
+func NewRejectionError(evaluation *dto.EvaluationDTO) *RejectionError {
+	if evaluation == nil || evaluation.Applied || evaluation.Reason == nil {
+		return nil
+	}
+	return &RejectionError{Reason: response.ErrorCode(*evaluation.Reason)}
+}
+
+// evaluate applies the promo code rules to the lines. Line eligibility is
+// reported even when the code as a whole is rejected, so the client can see
+// which items the code would cover.
+func evaluate(promo *model.PromoCode, items []dto.ItemDTO, products map[uint]*productModel.Product, clientUsages int64, now time.Time) *dto.EvaluationDTO {
+	evaluation := &dto.EvaluationDTO{
+		Code:  promo.Code,
+		Lines: make([]*dto.LineEvaluationDTO, 0, len(items)),
+	}
+
+	var eligibleSubtotal int64
+	for _, item := range items {
+		line := &dto.LineEvaluationDTO{
+			ProductVariantID: item.ProductVariantID,
+			Subtotal:         item.Price * int64(item.Quantity),
+		}
+		if reason := lineRejection(promo, products[item.ProductID]); reason != "" {
+			reasonStr := string(reason)
+			line.Reason = &reasonStr
+		} else {
+			line.Eligible = true
+			eligibleSubtotal += line.Subtotal
+		}
+
+		evaluation.Subtotal += line.Subtotal
+		evaluation.Lines = append(evaluation.Lines, line)
+	}
+
+	reason := codeRejection(promo, clientUsages, now)
+	switch {
+	case reason != "":
+	case evaluation.Subtotal < promo.MinOrderAmount:
+		reason = response.PromoCodeMinAmount
+	case eligibleSubtotal == 0:
+		reason = response.PromoCodeNotApplicable
+	}
+
+	if reason != "" {
+		reasonStr := string(reason)
+		evaluation.Reason = &reasonStr
+	} else {
+		evaluation.Applied = true
+		distributeDiscount(promo, evaluation.Lines, eligibleSubtotal)
+	}
+
+	for _, line := range evaluation.Lines {
+		line.Total = line.Subtotal - line.Discount
+		evaluation.Discount += line.Discount
+	}
+	evaluation.Total = evaluation.Subtotal - evaluation.Discount
+
+	return evaluation
+}
+
+// codeRejection checks the rules that do not depend on the cart contents.
+func codeRejection(promo *model.PromoCode, clientUsages int64, now time.Time) response.ErrorCode {
+	switch {
+	case !promo.IsActive:
+		return response.PromoCodeInactive
+	case promo.StartsAt != nil && now.Before(*promo.StartsAt):
+		return response.PromoCodeNotStarted
+	case promo.EndsAt != nil && !now.Before(*promo.EndsAt):
+		return response.PromoCodeExpired
+	case promo.UsageLimit != nil && promo.UsedCount >= *promo.UsageLimit:
+		return response.PromoCodeUsageLimit
+	case promo.UsageLimitPerClient != nil && clientUsages >= int64(*promo.UsageLimitPerClient):
+		return response.PromoCodeClientLimit
+	}
+
+	return ""
+}
+
+// lineRejection checks the product against the code restrictions. All
+// restriction kinds that are set must match.
+func lineRejection(promo *model.PromoCode, product *productModel.Product) response.ErrorCode {
+	if product == nil {
+		return response.PromoCodeNotApplicable
+	}
+
+	if len(promo.Categories) > 0 && !containsId(getCategoryIds(promo), product.CategoryID) {
+		return response.CategoryNotEligible
+	}
+	if len(promo.Lines) > 0 && !containsId(getLineIds(promo), product.LineID) {
+		return response.LineNotEligible
+	}
+	if len(promo.ProductTypes) > 0 && !containsId(getProductTypeIds(promo), product.ProductTypeID) {
+		return response.ProductTypeNotEligible
+	}
+
+	return ""
+}
+
+// distributeDiscount spreads the discount over eligible lines. A percent is
+// taken from every line and rounded down. A fixed amount, capped by the
+// eligible subtotal, is split in proportion to line subtotals; kopecks lost
+// to rounding go to the first lines that still have room for them.
+func distributeDiscount(promo *model.PromoCode, lines []*dto.LineEvaluationDTO, eligibleSubtotal int64) {
+	if promo.DiscountType == model.DiscountPercent {
+		for _, line := range lines {
+			if line.Eligible {
+				line.Discount = line.Subtotal * promo.DiscountValue / 100
+			}
+		}
+		return
+	}
+
+	amount := min(promo.DiscountValue, eligibleSubtotal)
+	remainder := amount
+	for _, line := range lines {
+		if line.Eligible {
+			line.Discount = amount * line.Subtotal / eligibleSubtotal
+			remainder -= line.Discount
+		}
+	}
+
+	for _, line := range lines {
+		if remainder == 0 {
+			break
+		}
+		if line.Eligible {
+			extra := min(remainder, line.Subtotal-line.Discount)
+			line.Discount += extra
+			remainder -= extra
+		}
+	}
+}
+
+func containsId(ids []uint, id uint) bool {
+	for _, existing := range ids {
+		if existing == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,305 @@
+package promo_code
+
+import (
+	"errors"
+	categoryModel "haircompany-shop-rest/internal/modules/v1/category/model"
+	lineModel "haircompany-shop-rest/internal/modules/v1/line/model"
+	productModel "haircompany-shop-rest/internal/modules/v1/product/model"
+	productTypeModel "haircompany-shop-rest/internal/modules/v1/product_type/model"
+	"haircompany-shop-rest/internal/modules/v1/promo_code/dto"
+	"haircompany-shop-rest/internal/modules/v1/promo_code/model"
+	"haircompany-shop-rest/pkg/response"
+	"haircompany-shop-rest/pkg/utils"
+	"log"
+	"regexp"
+	"time"
+)
+
+var codePattern = regexp.MustCompile(`^[A-Z0-9_-]+$`)
+
+type Service interface {
+	Create(createDto dto.CreateDTO) (*dto.ResponseDTO, []response.ErrorField, error)
+	GetAll() ([]*dto.ResponseDTO, error)
+	GetById(id uint) (*dto.ResponseDTO, error)
+	Update(id uint, updateDto dto.UpdateDTO) (*dto.ResponseDTO, []response.ErrorField, error)
+	Delete(id uint) (*dto.ResponseDTO, int64, error)
+	Evaluate(code, clientPhone string, items []dto.ItemDTO) (*dto.EvaluationDTO, error)
+	RecordUsage(code string, orderId uint, clientPhone string) error
+}
+
+type service struct {
+	repo Repository
+	now  func() time.Time
+}
+
+func NewService(r Repository) Service {
+	return &service{
+		repo: r,
+		now:  time.Now,
+	}
+}
+
+func (c *service) Create(createDto dto.CreateDTO) (*dto.ResponseDTO, []response.ErrorField, error) {
+	promoCode := dto.TransformCreateDTOToModel(createDto)
+
+	validationErrors, err := c.validate(promoCode, 0)
+	if err != nil || validationErrors != nil {
+		return nil, validationErrors, err
+	}
+
+	validationErrors, err = c.loadRelations(promoCode, createDto.CategoryIDs, createDto.LineIDs, createDto.ProductTypeIDs)
+	if err != nil || validationErrors != nil {
+		return nil, validationErrors, err
+	}
+
+	createdPromoCode, err := c.repo.Create(promoCode)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return dto.TransformModelToResponseDTO(createdPromoCode), nil, nil
+}
+
+func (c *service) GetAll() ([]*dto.ResponseDTO, error) {
+	promoCodeDTOs := make([]*dto.ResponseDTO, 0)
+	models, err := c.repo.GetAll()
+	if err != nil {
+		log.Printf("error retrieving promo codes: %v", err)
+	}
+
+	for _, model := range models {
+		promoCodeDTOs = append(promoCodeDTOs, dto.TransformModelToResponseDTO(model))
+	}
+
+	return promoCodeDTOs, err
+}
+
+func (c *service) GetById(id uint) (*dto.ResponseDTO, error) {
+	model, err := c.repo.GetById(id)
+	if model == nil {
+		return nil, err
+	}
+
+	return dto.TransformModelToResponseDTO(model), err
+}
+
+func (c *service) Update(id uint, updateDto dto.UpdateDTO) (*dto.ResponseDTO, []response.ErrorField, error) {
+	promoCode, err := c.repo.GetById(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if promoCode == nil {
+		return nil, nil, errors.New("promo code not found")
+	}
+
+	categoryIds := getCategoryIds(promoCode)
+	if updateDto.CategoryIDs != nil {
+		categoryIds = *updateDto.CategoryIDs
+	}
+	lineIds := getLineIds(promoCode)
+	if updateDto.LineIDs != nil {
+		lineIds = *updateDto.LineIDs
+	}
+	productTypeIds := getProductTypeIds(promoCode)
+	if updateDto.ProductTypeIDs != nil {
+		productTypeIds = *updateDto.ProductTypeIDs
+	}
+
+	dto.TransformUpdateDTOToModel(updateDto, promoCode)
+
+	validationErrors, err := c.validate(promoCode, id)
+	if err != nil || validationErrors != nil {
+		return nil, validationErrors, err
+	}
+
+	validationErrors, err = c.loadRelations(promoCode, categoryIds, lineIds, productTypeIds)
+	if err != nil || validationErrors != nil {
+		return nil, validationErrors, err
+	}
+
+	updatedPromoCode, err := c.repo.Update(promoCode)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return dto.TransformModelToResponseDTO(updatedPromoCode), nil, nil
+}
+
+// Delete removes a code that has never been used. Spent codes are kept for
+// order history and can only be deactivated.
+func (c *service) Delete(id uint) (*dto.ResponseDTO, int64, error) {
+	existedPromoCode, err := c.repo.GetById(id)
+	if existedPromoCode == nil {
+		return nil, 0, err
+	}
+
+	promoCodeDTO := dto.TransformModelToResponseDTO(existedPromoCode)
+	usedCount := int64(existedPromoCode.UsedCount)
+	if usedCount > 0 {
+		return promoCodeDTO, usedCount, errors.New("promo code has been used and cannot be deleted")
+	}
+
+	err = c.repo.Delete(id)
+	if err != nil {
+		return promoCodeDTO, 0, err
+	}
+
+	return promoCodeDTO, 0, nil
+}
+
+// Evaluate applies the code to the lines and returns the per-line breakdown.
+// A rejected code is not an error: the evaluation carries the reason.
+// Per-client limits are checked only when the client phone is known.
+func (c *service) Evaluate(code, clientPhone string, items []dto.ItemDTO) (*dto.EvaluationDTO, error) {
+	code = dto.NormalizeCode(code)
+
+	promoCode, err := c.repo.GetByCode(code)
+	if err != nil {
+		return nil, err
+	}
+	if promoCode == nil {
+		reason := string(response.PromoCodeNotFound)
+		return &dto.EvaluationDTO{Code: code, Reason: &reason, Lines: make([]*dto.LineEvaluationDTO, 0)}, nil
+	}
+
+	var clientUsages int64
+	if clientPhone != "" && promoCode.UsageLimitPerClient != nil {
+		clientUsages, err = c.repo.CountClientUsages(promoCode.ID, clientPhone)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	productIds := make([]uint, 0, len(items))
+	for _, item := range items {
+		productIds = append(productIds, item.ProductID)
+	}
+	products, err := c.repo.GetProductsByIds(productIds)
+	if err != nil {
+		return nil, err
+	}
+	productsById := make(map[uint]*productModel.Product, len(products))
+	for _, product := range products {
+		productsById[product.ID] = product
+	}
+
+	return evaluate(promoCode, items, productsById, clientUsages, c.now()), nil
+}
+
+// RecordUsage spends one use of the code on the order. The usage counter is
+// incremented first, which locks the code row until the checkout transaction
+// ends, so concurrent checkouts cannot exceed either limit.
+func (c *service) RecordUsage(code string, orderId uint, clientPhone string) error {
+	return c.repo.Transaction(func(repo Repository) error {
+		promoCode, err := repo.GetByCode(dto.NormalizeCode(code))
+		if err != nil {
+			return err
+		}
+		if promoCode == nil {
+			return &RejectionError{Reason: response.PromoCodeNotFound}
+		}
+
+		incremented, err := repo.IncrementUsage(promoCode.ID)
+		if err != nil {
+			return err
+		}
+		if !incremented {
+			return &RejectionError{Reason: response.PromoCodeUsageLimit}
+		}
+
+		if promoCode.UsageLimitPerClient != nil {
+			clientUsages, err := repo.CountClientUsages(promoCode.ID, clientPhone)
+			if err != nil {
+				return err
+			}
+			if clientUsages >= int64(*promoCode.UsageLimitPerClient) {
+				return &RejectionError{Reason: response.PromoCodeClientLimit}
+			}
+		}
+
+		return repo.CreateUsage(&model.PromoCodeUsage{
+			PromoCodeID: promoCode.ID,
+			OrderID:     orderId,
+			ClientPhone: clientPhone,
+		})
+	})
+}
+
+// validate checks the rules the validator tags cannot express. excludeId
+// is the code being updated, 0 on create.
+func (c *service) validate(promoCode *model.PromoCode, excludeId uint) ([]response.ErrorField, error) {
+	var validationErrors []response.ErrorField
+
+	if !codePattern.MatchString(promoCode.Code) {
+		validationErrors = append(validationErrors, response.NewErrorField("code", string(response.BadRequest)))
+	}
+	if promoCode.DiscountType == model.DiscountPercent && promoCode.DiscountValue > 100 {
+		validationErrors = append(validationErrors, response.NewErrorField("discountValue", string(response.BadRequest)))
+	}
+	if promoCode.StartsAt != nil && promoCode.EndsAt != nil && !promoCode.EndsAt.After(*promoCode.StartsAt) {
+		validationErrors = append(validationErrors, response.NewErrorField("endsAt", string(response.BadRequest)))
+	}
+
+	existingPromoCode, err := c.repo.GetByCode(promoCode.Code)
+	if err != nil {
+		return nil, err
+	}
+	if existingPromoCode != nil && existingPromoCode.ID != excludeId {
+		validationErrors = append(validationErrors, response.NewErrorField("code", string(response.NotUnique)))
+	}
+
+	return validationErrors, nil
+}
+
+func (c *service) loadRelations(promoCode *model.PromoCode, categoryIds, lineIds, productTypeIds []uint) ([]response.ErrorField, error) {
+	var validationErrors []response.ErrorField
+
+	categoryIds = utils.UniqueIDs(categoryIds)
+	categories, err := c.repo.GetCategoriesByIds(categoryIds)
+	if err != nil {
+		return nil, err
+	}
+	if len(categories) != len(categoryIds) {
+		validationErrors = append(validationErrors, response.NewErrorField("categoryIds", string(response.NotFound)))
+	}
+
+	lineIds = utils.UniqueIDs(lineIds)
+	lines, err := c.repo.GetLinesByIds(lineIds)
+	if err != nil {
+		return nil, err
+	}
+	if len(lines) != len(lineIds) {
+		validationErrors = append(validationErrors, response.NewErrorField("lineIds", string(response.NotFound)))
+	}
+
+	productTypeIds = utils.UniqueIDs(productTypeIds)
+	productTypes, err := c.repo.GetProductTypesByIds(productTypeIds)
+	if err != nil {
+		return nil, err
+	}
+	if len(productTypes) != len(productTypeIds) {
+		validationErrors = append(validationErrors, response.NewErrorField("productTypeIds", string(response.NotFound)))
+	}
+
+	if validationErrors != nil {
+		return validationErrors, nil
+	}
+
+	promoCode.Categories = categories
+	promoCode.Lines = lines
+	promoCode.ProductTypes = productTypes
+
+	return nil, nil
+}
+
+func getCategoryIds(promoCode *model.PromoCode) []uint {
+	return utils.IDs(promoCode.Categories, func(category *categoryModel.Category) uint { return category.ID })
+}
+
+func getLineIds(promoCode *model.PromoCode) []uint {
+	return utils.IDs(promoCode.Lines, func(line *lineModel.Line) uint { return line.ID })
+}
+
+func getProductTypeIds(promoCode *model.PromoCode) []uint {
+	return utils.IDs(promoCode.ProductTypes, func(productType *productTypeModel.ProductType) uint { return productType.ID })
+}
@@ -0,0 +1,209 @@
+package promo_code
+
+import (
+	"errors"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	categoryModel "haircompany-shop-rest/internal/modules/v1/category/model"
+	lineModel "haircompany-shop-rest/internal/modules/v1/line/model"
+	productModel "haircompany-shop-rest/internal/modules/v1/product/model"
+	productTypeModel "haircompany-shop-rest/internal/modules/v1/product_type/model"
+	"haircompany-shop-rest/internal/modules/v1/promo_code/dto"
+	"haircompany-shop-rest/internal/modules/v1/promo_code/model"
+	"haircompany-shop-rest/pkg/database"
+	"haircompany-shop-rest/pkg/response"
+	"testing"
+	"time"
+)
+
+func setupTestService(t *testing.T) (Service, *gorm.DB) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatal("Failed to connect to test database:", err)
+	}
+
+	err = db.AutoMigrate(
+		&categoryModel.Category{},
+		&lineModel.Line{},
+		&productTypeModel.ProductType{},
+		&productModel.Product{},
+		&model.PromoCode{},
+		&model.PromoCodeUsage{},
+	)
+	if err != nil {
+		t.Fatal("Failed to migrate test database:", err)
+	}
+
+	return NewService(NewRepository(&database.DB{DB: db})), db
+}
+
+func TestEvaluate_Restrictions(t *testing.T) {
+	promo := &model.PromoCode{
+		Code:          "CARE15",
+		DiscountType:  model.DiscountPercent,
+		DiscountValue: 15,
+		IsActive:      true,
+		Categories:    []*categoryModel.Category{{ID: 1}},
+		Lines:         []*lineModel.Line{{ID: 2}},
+	}
+	products := map[uint]*productModel.Product{
+		1: {ID: 1, CategoryID: 1, LineID: 2, ProductTypeID: 1},
+		2: {ID: 2, CategoryID: 3, LineID: 2, ProductTypeID: 1},
+		3: {ID: 3, CategoryID: 1, LineID: 4, ProductTypeID: 1},
+	}
+	items := []dto.ItemDTO{
+		{ProductVariantID: 10, ProductID: 1, Price: 1999, Quantity: 1},
+		{ProductVariantID: 20, ProductID: 2, Price: 1000, Quantity: 1},
+		{ProductVariantID: 30, ProductID: 3, Price: 1000, Quantity: 1},
+	}
+
+	evaluation := evaluate(promo, items, products, 0, time.Now())
+
+	if !evaluation.Applied || evaluation.Discount != 299 || evaluation.Total != 3700 {
+		t.Fatalf("Expected 15%% of 1999 rounded down, got applied %v discount %d total %d", evaluation.Applied, evaluation.Discount, evaluation.Total)
+	}
+	if reason := evaluation.Lines[1].Reason; reason == nil || *reason != string(response.CategoryNotEligible) {
+		t.Errorf("Expected CATEGORY_NOT_ELIGIBLE for second line, got %v", reason)
+	}
+	if reason := evaluation.Lines[2].Reason; reason == nil || *reason != string(response.LineNotEligible) {
+		t.Errorf("Expected LINE_NOT_ELIGIBLE for third line, got %v", reason)
+	}
+}
+
+func TestEvaluate_FixedDiscountSplit(t *testing.T) {
+	promo := &model.PromoCode{Code: "MINUS100", DiscountType: model.DiscountFixed, DiscountValue: 100, IsActive: true}
+	products := map[uint]*productModel.Product{1: {ID: 1}}
+	items := []dto.ItemDTO{
+		{ProductVariantID: 1, ProductID: 1, Price: 100, Quantity: 1},
+		{ProductVariantID: 2, ProductID: 1, Price: 100, Quantity: 1},
+		{ProductVariantID: 3, ProductID: 1, Price: 100, Quantity: 1},
+	}
+
+	evaluation := evaluate(promo, items, products, 0, time.Now())
+
+	if evaluation.Discount != 100 {
+		t.Fatalf("Expected the whole fixed amount to be spread, got %d", evaluation.Discount)
+	}
+	if evaluation.Lines[0].Discount != 34 || evaluation.Lines[1].Discount != 33 || evaluation.Lines[2].Discount != 33 {
+		t.Errorf("Expected 34/33/33 split, got %d/%d/%d", evaluation.Lines[0].Discount, evaluation.Lines[1].Discount, evaluation.Lines[2].Discount)
+	}
+
+	promo.DiscountValue = 1000
+	evaluation = evaluate(promo, items, products, 0, time.Now())
+	if evaluation.Discount != 300 || evaluation.Total != 0 {
+		t.Errorf("Expected discount to be capped by the subtotal, got %d", evaluation.Discount)
+	}
+}
+
+func TestEvaluate_Rejections(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+	limit := 1
+	products := map[uint]*productModel.Product{1: {ID: 1, ProductTypeID: 1}}
+	items := []dto.ItemDTO{{ProductVariantID: 1, ProductID: 1, Price: 1000, Quantity: 1}}
+
+	tests := []struct {
+		name         string
+		promo        model.PromoCode
+		clientUsages int64
+		reason       response.ErrorCode
+	}{
+		{"inactive", model.PromoCode{IsActive: false}, 0, response.PromoCodeInactive},
+		{"not started", model.PromoCode{IsActive: true, StartsAt: &future}, 0, response.PromoCodeNotStarted},
+		{"expired", model.PromoCode{IsActive: true, EndsAt: &past}, 0, response.PromoCodeExpired},
+		{"usage limit", model.PromoCode{IsActive: true, UsageLimit: &limit, UsedCount: 1}, 0, response.PromoCodeUsageLimit},
+		{"client limit", model.PromoCode{IsActive: true, UsageLimitPerClient: &limit}, 1, response.PromoCodeClientLimit},
+		{"min amount", model.PromoCode{IsActive: true, MinOrderAmount: 5000}, 0, response.PromoCodeMinAmount},
+		{"not applicable", model.PromoCode{IsActive: true, ProductTypes: []*productTypeModel.ProductType{{ID: 2}}}, 0, response.PromoCodeNotApplicable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.promo.DiscountType = model.DiscountPercent
+			tt.promo.DiscountValue = 10
+
+			evaluation := evaluate(&tt.promo, items, products, tt.clientUsages, now)
+			if evaluation.Applied || evaluation.Reason == nil || *evaluation.Reason != string(tt.reason) {
+				t.Fatalf("Expected %s, got applied %v reason %v", tt.reason, evaluation.Applied, evaluation.Reason)
+			}
+			if evaluation.Discount != 0 {
+				t.Errorf("Expected no discount for a rejected code, got %d", evaluation.Discount)
+			}
+		})
+	}
+}
+
+func TestService_Create_Validation(t *testing.T) {
+	svc, _ := setupTestService(t)
+
+	created, errFields, err := svc.Create(dto.CreateDTO{Code: "spring10", DiscountType: model.DiscountPercent, DiscountValue: 10, IsActive: true})
+	if err != nil || errFields != nil {
+		t.Fatalf("Expected no error, got %v %v", err, errFields)
+	}
+	if created.Code != "SPRING10" {
+		t.Errorf("Expected code to be uppercased, got %s", created.Code)
+	}
+
+	_, errFields, err = svc.Create(dto.CreateDTO{Code: "SPRING10", DiscountType: model.DiscountPercent, DiscountValue: 150, CategoryIDs: []uint{42}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	fields := map[string]string{}
+	for _, field := range errFields {
+		fields[field.Field] = field.ErrorCode
+	}
+	if fields["code"] != string(response.NotUnique) || fields["discountValue"] != string(response.BadRequest) {
+		t.Errorf("Expected duplicate code and percent over 100 to be rejected, got %+v", errFields)
+	}
+}
+
+func TestService_RecordUsage_Limits(t *testing.T) {
+	svc, db := setupTestService(t)
+	limit := 2
+	perClient := 1
+	db.Create(&model.PromoCode{Code: "ONCE", DiscountType: model.DiscountFixed, DiscountValue: 100, UsageLimit: &limit, UsageLimitPerClient: &perClient, IsActive: true})
+
+	if err := svc.RecordUsage("once", 1, "+79990000001"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var rejection *RejectionError
+	err := svc.RecordUsage("ONCE", 2, "+79990000001")
+	if !errors.As(err, &rejection) || rejection.Reason != response.PromoCodeClientLimit {
+		t.Fatalf("Expected client limit rejection, got %v", err)
+	}
+
+	if err := svc.RecordUsage("ONCE", 3, "+79990000002"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	err = svc.RecordUsage("ONCE", 4, "+79990000003")
+	if !errors.As(err, &rejection) || rejection.Reason != response.PromoCodeUsageLimit {
+		t.Fatalf("Expected usage limit rejection, got %v", err)
+	}
+}
+
+func TestRepository_UpdateKeepsUsedCount(t *testing.T) {
+	svc, db := setupTestService(t)
+	repo := svc.(*service).repo
+	db.Create(&model.PromoCode{Code: "KEEP", DiscountType: model.DiscountFixed, DiscountValue: 100, IsActive: true})
+
+	stale, err := repo.GetById(1)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if ok, err := repo.IncrementUsage(1); !ok || err != nil {
+		t.Fatalf("Expected usage to be counted, got %v %v", ok, err)
+	}
+
+	stale.DiscountValue = 200
+	if _, err := repo.Update(stale); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var saved model.PromoCode
+	db.First(&saved, 1)
+	if saved.UsedCount != 1 || saved.DiscountValue != 200 {
+		t.Errorf("Expected used count 1 and discount 200, got %d and %d", saved.UsedCount, saved.DiscountValue)
+	}
+}
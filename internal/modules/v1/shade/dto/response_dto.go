@@ -1,12 +1,17 @@
 package dto
 
-import "time"
+import (
+	imageDto "haircompany-shop-rest/internal/modules/v1/image/dto"
+	"time"
+)
 
 type ResponseDTO struct {
-	Id        uint      `json:"id" example:"1"`
-	CreatedAt time.Time `json:"createdAt" example:"2023-10-01T12:00:00Z"`
-	UpdatedAt time.Time `json:"updatedAt" example:"2023-10-01T12:00:00Z"`
-	Name      string    `json:"name" example:"Shade Name"`
-	Image     string    `json:"image" example:"shade.png"`
-	SortIndex int       `json:"sortIndex" example:"1"`
+	Id        uint                  `json:"id" example:"1"`
+	CreatedAt time.Time             `json:"createdAt" example:"2023-10-01T12:00:00Z"`
+	UpdatedAt time.Time             `json:"updatedAt" example:"2023-10-01T12:00:00Z"`
+	DeletedAt *time.Time            `json:"deletedAt,omitempty" example:"2023-10-02T12:00:00Z"`
+	Name      string                `json:"name" example:"Shade Name"`
+	Image     string                `json:"image" example:"shade.png"`
+	ImageUrls *imageDto.ResponseDTO `json:"imageUrls,omitempty"`
+	SortIndex int                   `json:"sortIndex" example:"1"`
 }
@@ -1,6 +1,9 @@
 package dto
 
-import "haircompany-shop-rest/internal/modules/v1/shade/model"
+import (
+	imageDto "haircompany-shop-rest/internal/modules/v1/image/dto"
+	"haircompany-shop-rest/internal/modules/v1/shade/model"
+)
 
 func TransformCreateDTOToModel(dto CreateDTO) *model.Shade {
 	return &model.Shade{
@@ -25,12 +28,19 @@ func TransformUpdateDTOToModel(dto UpdateDTO, model *model.Shade) *model.Shade {
 }
 
 func TransformModelToResponseDTO(model *model.Shade) *ResponseDTO {
-	return &ResponseDTO{
+	responseDTO := &ResponseDTO{
 		Id:        model.ID,
 		CreatedAt: model.CreatedAt,
 		UpdatedAt: model.UpdatedAt,
 		Name:      model.Name,
 		Image:     model.Image,
+		ImageUrls: imageDto.TransformFilenameToResponseDTO(model.Image, "shade"),
 		SortIndex: model.SortIndex,
 	}
+	if model.DeletedAt.Valid {
+		deletedAt := model.DeletedAt.Time
+		responseDTO.DeletedAt = &deletedAt
+	}
+
+	return responseDTO
 }
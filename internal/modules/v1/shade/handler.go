@@ -61,6 +61,20 @@ func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 	response.SendSuccess(w, http.StatusCreated, createdShade)
 }
 
+var listOptions = request.ListOptions{
+	SortFields: map[string]string{
+		"id":        "id",
+		"name":      "name",
+		"sortIndex": "sort_index",
+		"createdAt": "created_at",
+		"deletedAt": "deleted_at",
+	},
+	FilterFields: map[string]request.Filter{
+		"name": {Column: "name", Type: request.FilterContains},
+	},
+	DefaultSort: []request.Sort{{Column: "sort_index"}},
+}
+
 // GetAll retrieves all shades
 //
 //	@Summary		Get all shades
@@ -68,19 +82,31 @@ func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
 //	@Tags			Shade
 //	@Security		AppAuth
 //	@Produce		json
-//	@Success		200	{object}	docsResponse.ShadeList200	"List of shades"
-//	@Failure		403	{object}	docsResponse.Response403	"Forbidden - Invalid X-AUTH-APP"
-//	@Failure		500	{object}	docsResponse.Response500	"Server error"
+//	@Param			page	query		int							false	"Page number, starting from 1"
+//	@Param			perPage	query		int							false	"Items per page, up to 100 (default 20)"
+//	@Param			sort	query		string						false	"Comma-separated sort fields, prefix with - for descending: id, name, sortIndex, createdAt"
+//	@Param			name	query		string						false	"Filter by name substring"
+//	@Success		200		{object}	docsResponse.ShadeList200	"List of shades"
+//	@Failure		400		{object}	docsResponse.Response400	"Invalid page, perPage, sort or filter value"
+//	@Failure		403		{object}	docsResponse.Response403	"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		500		{object}	docsResponse.Response500	"Server error"
 //	@Router			/api/v1/shade [get]
-func (h *Handler) GetAll(w http.ResponseWriter) {
-	shades, err := h.svc.GetAll()
+func (h *Handler) GetAll(w http.ResponseWriter, r *http.Request) {
+	query, errFields := request.ParseListQuery(r.URL.Query(), listOptions)
+	if errFields != nil {
+		msg := "invalid list query"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	shades, total, err := h.svc.GetAll(query)
 	if err != nil {
 		msg := fmt.Sprintf("failed to retrieve shades: %v", err)
 		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
 		return
 	}
 
-	response.SendSuccess(w, http.StatusOK, shades)
+	response.SendPaginated(w, http.StatusOK, shades, response.Pagination{Total: total, Page: query.Page, PerPage: query.PerPage})
 }
 
 // GetById retrieves a shade by its ID
@@ -215,12 +241,17 @@ func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	shade, err := h.svc.Delete(uint(id))
+	shade, linkedEntitiesCount, err := h.svc.Delete(uint(id))
 	if shade == nil {
 		msg := fmt.Sprintf("shade with id %d not found", id)
 		response.SendError(w, http.StatusNotFound, msg, response.NotFound)
 		return
 	}
+	if linkedEntitiesCount > 0 {
+		msg := fmt.Sprintf("shade with id %d cannot be deleted because it is used by %d products", id, linkedEntitiesCount)
+		response.SendError(w, http.StatusConflict, msg, response.HasLinkedEntities)
+		return
+	}
 	if err != nil {
 		msg := fmt.Sprintf("failed to delete shade: %v", err)
 		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
@@ -229,3 +260,84 @@ func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
 
 	response.SendSuccess(w, http.StatusOK, shade)
 }
+
+// GetTrash retrieves deleted shades
+//
+//	@Summary		Get deleted shades
+//	@Description	Retrieve shades in the trash. They are purged permanently after the retention period
+//	@Tags			Shade
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			page	query		int							false	"Page number, starting from 1"
+//	@Param			perPage	query		int							false	"Items per page, up to 100 (default 20)"
+//	@Param			sort	query		string						false	"Comma-separated sort fields, prefix with - for descending, e.g. -deletedAt"
+//	@Success		200		{object}	docsResponse.ShadeList200	"Deleted shades"
+//	@Failure		400		{object}	docsResponse.Response400	"Invalid page, perPage, sort or filter value"
+//	@Failure		401		{object}	docsResponse.Response401	"Unauthorized"
+//	@Failure		403		{object}	docsResponse.Response403	"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		500		{object}	docsResponse.Response500	"Server error"
+//	@Router			/api/v1/shade/trash [get]
+func (h *Handler) GetTrash(w http.ResponseWriter, r *http.Request) {
+	query, errFields := request.ParseListQuery(r.URL.Query(), listOptions)
+	if errFields != nil {
+		msg := "invalid list query"
+		response.SendValidationError(w, http.StatusBadRequest, msg, response.BadRequest, errFields)
+		return
+	}
+
+	shadeList, total, err := h.svc.GetTrash(query)
+	if err != nil {
+		msg := fmt.Sprintf("failed to retrieve deleted shades: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+
+	response.SendPaginated(w, http.StatusOK, shadeList, response.Pagination{Total: total, Page: query.Page, PerPage: query.PerPage})
+}
+
+// Restore restores a deleted shade
+//
+//	@Summary		Restore shade
+//	@Description	Move a shade out of the trash
+//	@Tags			Shade
+//	@Security		BearerAuth
+//	@Security		AppAuth
+//	@Produce		json
+//	@Param			id	path		int								true	"Shade ID"
+//	@Success		200	{object}	docsResponse.ShadeGetById200	"Shade restored"
+//	@Failure		400	{object}	docsResponse.Response400		"Invalid ID"
+//	@Failure		401	{object}	docsResponse.Response401		"Unauthorized"
+//	@Failure		403	{object}	docsResponse.Response403		"Forbidden - Invalid X-AUTH-APP"
+//	@Failure		404	{object}	docsResponse.Response404		"Shade not found in the trash"
+//	@Failure		500	{object}	docsResponse.Response500		"Server error"
+//	@Router			/api/v1/shade/{id}/restore [patch]
+func (h *Handler) Restore(w http.ResponseWriter, r *http.Request) {
+	idStr := r.PathValue("id")
+	if idStr == "" {
+		msg := "missing shade id"
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	id, err := strconv.Atoi(idStr)
+	if err != nil || id < 0 {
+		msg := fmt.Sprintf("invalid shade id: %s", idStr)
+		response.SendError(w, http.StatusBadRequest, msg, response.BadRequest)
+		return
+	}
+
+	shade, err := h.svc.Restore(uint(id))
+	if err != nil {
+		msg := fmt.Sprintf("failed to restore shade: %v", err)
+		response.SendError(w, http.StatusInternalServerError, msg, response.ServerError)
+		return
+	}
+	if shade == nil {
+		msg := fmt.Sprintf("deleted shade with id %d not found", id)
+		response.SendError(w, http.StatusNotFound, msg, response.NotFound)
+		return
+	}
+
+	response.SendSuccess(w, http.StatusOK, shade)
+}
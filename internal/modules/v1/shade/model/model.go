@@ -1,12 +1,16 @@
 package model
 
-import "time"
+import (
+	"gorm.io/gorm"
+	"time"
+)
 
 type Shade struct {
 	ID        uint `gorm:"primarykey" json:"id"`
 	CreatedAt time.Time
 	UpdatedAt time.Time
-	Name      string `gorm:"type:varchar(255);not null" json:"name"`
-	Image     string `gorm:"type:varchar(255);not null" json:"image"`
-	SortIndex int    `gorm:"not null;default:0" json:"sort_index"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"deletedAt"`
+	Name      string         `gorm:"type:varchar(255);not null" json:"name"`
+	Image     string         `gorm:"type:varchar(255);not null" json:"image"`
+	SortIndex int            `gorm:"not null;default:0" json:"sort_index"`
 }
@@ -4,15 +4,26 @@ import (
 	"errors"
 	"gorm.io/gorm"
 	"haircompany-shop-rest/internal/modules/v1/shade/model"
+	"haircompany-shop-rest/internal/services"
 	"haircompany-shop-rest/pkg/database"
+	"haircompany-shop-rest/pkg/request"
+	"time"
 )
 
 type Repository interface {
+	Transaction(fn func(repo Repository) error) error
+	Enqueue(queue services.Queue, jobType string, payload any) error
 	Create(model *model.Shade) (*model.Shade, error)
-	GetAll() ([]*model.Shade, error)
+	GetAll(query request.ListQuery) ([]*model.Shade, int64, error)
 	GetById(id uint) (*model.Shade, error)
+	GetByIds(ids []uint) ([]*model.Shade, error)
 	Update(model *model.Shade) (*model.Shade, error)
 	Delete(id uint) error
+	GetDeleted(query request.ListQuery) ([]*model.Shade, int64, error)
+	GetDeletedById(id uint) (*model.Shade, error)
+	Restore(id uint) error
+	PurgeDeleted(before time.Time) ([]*model.Shade, error)
+	CountProductsByShadeId(shadeId uint) (int64, error)
 }
 
 type repository struct {
@@ -25,6 +36,19 @@ func NewRepository(db *database.DB) Repository {
 	}
 }
 
+// Transaction runs fn against a repository bound to a single database transaction.
+func (r *repository) Transaction(fn func(repo Repository) error) error {
+	return r.DB.Transaction(func(tx *gorm.DB) error {
+		return fn(NewRepository(&database.DB{DB: tx}))
+	})
+}
+
+// Enqueue записывает задачу через текущее соединение: внутри Transaction она
+// появится в очереди, только если оттенок сохранится.
+func (r *repository) Enqueue(queue services.Queue, jobType string, payload any) error {
+	return queue.EnqueueTx(r.DB, jobType, payload)
+}
+
 func (r *repository) Create(model *model.Shade) (*model.Shade, error) {
 	result := r.DB.Create(&model)
 	if result.Error != nil {
@@ -34,16 +58,8 @@ func (r *repository) Create(model *model.Shade) (*model.Shade, error) {
 	return model, nil
 }
 
-func (r *repository) GetAll() ([]*model.Shade, error) {
-	var shades []*model.Shade
-	var err error
-
-	result := r.DB.Find(&shades)
-	if result.Error != nil {
-		err = result.Error
-	}
-
-	return shades, err
+func (r *repository) GetAll(query request.ListQuery) ([]*model.Shade, int64, error) {
+	return database.FindPage[model.Shade](r.DB.DB, query)
 }
 
 func (r *repository) GetById(id uint) (*model.Shade, error) {
@@ -61,6 +77,20 @@ func (r *repository) GetById(id uint) (*model.Shade, error) {
 	return shade, err
 }
 
+func (r *repository) GetByIds(ids []uint) ([]*model.Shade, error) {
+	var shades []*model.Shade
+	if len(ids) == 0 {
+		return shades, nil
+	}
+
+	result := r.DB.Find(&shades, ids)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return shades, nil
+}
+
 func (r *repository) Update(model *model.Shade) (*model.Shade, error) {
 	result := r.DB.Save(&model)
 	if result.Error != nil {
@@ -78,3 +108,35 @@ func (r *repository) Delete(id uint) error {
 
 	return nil
 }
+
+func (r *repository) GetDeleted(query request.ListQuery) ([]*model.Shade, int64, error) {
+	return database.FindDeletedPage[model.Shade](r.DB.DB, query)
+}
+
+func (r *repository) GetDeletedById(id uint) (*model.Shade, error) {
+	return database.FindDeleted[model.Shade](r.DB.DB, id)
+}
+
+func (r *repository) Restore(id uint) error {
+	return database.Restore[model.Shade](r.DB.DB, id)
+}
+
+func (r *repository) PurgeDeleted(before time.Time) ([]*model.Shade, error) {
+	return database.PurgeDeleted[model.Shade](r.DB.DB, before)
+}
+
+// CountProductsByShadeId считает товары, у которых оттенок привязан к самому товару или к одному из его вариантов.
+func (r *repository) CountProductsByShadeId(shadeId uint) (int64, error) {
+	var count int64
+	result := r.DB.Raw(`
+		SELECT COUNT(DISTINCT product_id) FROM (
+			SELECT product_id FROM product_shades WHERE shade_id = ?
+			UNION ALL
+			SELECT product_id FROM product_variants WHERE shade_id = ?
+		) AS linked`, shadeId, shadeId).Scan(&count)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return count, nil
+}
@@ -1,66 +1,63 @@
 package shade
 
 import (
-	"context"
 	"errors"
 	"haircompany-shop-rest/internal/modules/v1/shade/dto"
 	"haircompany-shop-rest/internal/services"
-	"haircompany-shop-rest/pkg/utils"
+	"haircompany-shop-rest/pkg/request"
 	"log"
-	"sync"
+	"time"
 )
 
 type Service interface {
 	Create(createDto dto.CreateDTO) (*dto.ResponseDTO, error)
-	GetAll() ([]*dto.ResponseDTO, error)
+	GetAll(query request.ListQuery) ([]*dto.ResponseDTO, int64, error)
 	GetById(id uint) (*dto.ResponseDTO, error)
 	Update(id uint, updateDto dto.UpdateDTO) (*dto.ResponseDTO, error)
-	Delete(id uint) (*dto.ResponseDTO, error)
+	Delete(id uint) (*dto.ResponseDTO, int64, error)
+	GetTrash(query request.ListQuery) ([]*dto.ResponseDTO, int64, error)
+	Restore(id uint) (*dto.ResponseDTO, error)
+	Purge(before time.Time) (int, error)
 }
 
 type service struct {
 	repo        Repository
 	fileService services.FileSystemService
-	ctx         context.Context
-	wg          *sync.WaitGroup
+	queue       services.Queue
 }
 
-func NewService(r Repository, fs services.FileSystemService, ctx context.Context, wg *sync.WaitGroup) Service {
+func NewService(r Repository, fs services.FileSystemService, queue services.Queue) Service {
 	return &service{
 		repo:        r,
 		fileService: fs,
-		ctx:         ctx,
-		wg:          wg,
+		queue:       queue,
 	}
 }
 
 func (c *service) Create(createDto dto.CreateDTO) (*dto.ResponseDTO, error) {
 	shadeModel := dto.TransformCreateDTOToModel(createDto)
-	createdShade, err := c.repo.Create(shadeModel)
-	if err != nil {
-		return nil, err
-	}
-
-	filenames := []string{shadeModel.Image}
-	utils.SafeGo(c.ctx, c.wg, "MoveImageToPermanent", func(ctx context.Context) {
-		if ctx.Err() != nil {
-			log.Println("context cancelled, skipping image move")
-			return
+	err := c.repo.Transaction(func(repo Repository) error {
+		if _, err := repo.Create(shadeModel); err != nil {
+			return err
 		}
 
-		if err := c.fileService.MoveToPermanent(filenames, "images/shade"); err != nil {
-			log.Printf("error moving images to permanent storage: %v", err)
-		}
+		filenames := []string{shadeModel.Image}
+		owner := &services.FileOwner{Type: "shade", ID: shadeModel.ID, Fields: map[string]string{shadeModel.Image: "image"}}
+		payload := services.FilesPayload{Filenames: filenames, Folder: "images/shade", Owner: owner}
+		return repo.Enqueue(c.queue, services.QueueMoveFiles, payload)
 	})
+	if err != nil {
+		return nil, err
+	}
 
-	createdShadeResponse := dto.TransformModelToResponseDTO(createdShade)
+	createdShadeResponse := dto.TransformModelToResponseDTO(shadeModel)
 
 	return createdShadeResponse, nil
 }
 
-func (c *service) GetAll() ([]*dto.ResponseDTO, error) {
+func (c *service) GetAll(query request.ListQuery) ([]*dto.ResponseDTO, int64, error) {
 	shadeDTOs := make([]*dto.ResponseDTO, 0)
-	models, err := c.repo.GetAll()
+	models, total, err := c.repo.GetAll(query)
 	if err != nil {
 		log.Printf("error retrieving shades: %v", err)
 	}
@@ -70,7 +67,7 @@ func (c *service) GetAll() ([]*dto.ResponseDTO, error) {
 		shadeDTOs = append(shadeDTOs, shadeResponse)
 	}
 
-	return shadeDTOs, err
+	return shadeDTOs, total, err
 }
 
 func (c *service) GetById(id uint) (*dto.ResponseDTO, error) {
@@ -96,47 +93,106 @@ func (c *service) Update(id uint, updateDto dto.UpdateDTO) (*dto.ResponseDTO, er
 
 	dto.TransformUpdateDTOToModel(updateDto, model)
 
-	updatedShade, err := c.repo.Update(model)
+	err = c.repo.Transaction(func(repo Repository) error {
+		if _, err := repo.Update(model); err != nil {
+			return err
+		}
+
+		var filenames []string
+		owner := &services.FileOwner{Type: "shade", ID: id, Fields: map[string]string{}}
+
+		if updateDto.Image != nil {
+			filenames = append(filenames, *updateDto.Image)
+			owner.Fields[*updateDto.Image] = "image"
+		}
+
+		if len(filenames) == 0 {
+			return nil
+		}
+		payload := services.FilesPayload{Filenames: filenames, Folder: "images/shade", Owner: owner}
+		return repo.Enqueue(c.queue, services.QueueMoveFiles, payload)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	var filenames []string
+	updatedShadeResponse := dto.TransformModelToResponseDTO(model)
 
-	if updateDto.Image != nil {
-		filenames = append(filenames, *updateDto.Image)
+	return updatedShadeResponse, nil
+}
+
+func (c *service) Delete(id uint) (*dto.ResponseDTO, int64, error) {
+	var linkedEntitiesCount int64
+	existedShade, err := c.repo.GetById(id)
+	if existedShade == nil {
+		return nil, linkedEntitiesCount, err
 	}
 
-	if len(filenames) != 0 {
-		utils.SafeGo(c.ctx, c.wg, "MoveImageToPermanent", func(ctx context.Context) {
-			if ctx.Err() != nil {
-				log.Println("context cancelled, skipping image move")
-				return
-			}
+	shadeDTO := dto.TransformModelToResponseDTO(existedShade)
+	linkedEntitiesCount, err = c.repo.CountProductsByShadeId(id)
+	if err != nil {
+		return shadeDTO, linkedEntitiesCount, err
+	}
+	if linkedEntitiesCount > 0 {
+		return shadeDTO, linkedEntitiesCount, errors.New("shade has products and cannot be deleted")
+	}
+
+	err = c.repo.Delete(id)
+	if err != nil {
+		return shadeDTO, linkedEntitiesCount, err
+	}
 
-			if err := c.fileService.MoveToPermanent(filenames, "images/shade"); err != nil {
-				log.Printf("error moving images to permanent storage: %v", err)
-			}
-		})
+	return shadeDTO, linkedEntitiesCount, nil
+}
+
+func (c *service) GetTrash(query request.ListQuery) ([]*dto.ResponseDTO, int64, error) {
+	shadeDTOs := make([]*dto.ResponseDTO, 0)
+	models, total, err := c.repo.GetDeleted(query)
+	if err != nil {
+		return nil, 0, err
 	}
 
-	updatedShadeResponse := dto.TransformModelToResponseDTO(updatedShade)
+	for _, model := range models {
+		shadeDTOs = append(shadeDTOs, dto.TransformModelToResponseDTO(model))
+	}
 
-	return updatedShadeResponse, nil
+	return shadeDTOs, total, nil
 }
 
-func (c *service) Delete(id uint) (*dto.ResponseDTO, error) {
-	existedShade, err := c.repo.GetById(id)
-	if existedShade == nil {
+func (c *service) Restore(id uint) (*dto.ResponseDTO, error) {
+	deletedShade, err := c.repo.GetDeletedById(id)
+	if deletedShade == nil {
 		return nil, err
 	}
 
-	shadeDTO := dto.TransformModelToResponseDTO(existedShade)
+	if err := c.repo.Restore(id); err != nil {
+		return nil, err
+	}
 
-	err = c.repo.Delete(id)
+	restoredShade, err := c.repo.GetById(id)
+	if restoredShade == nil {
+		return nil, err
+	}
+
+	return dto.TransformModelToResponseDTO(restoredShade), nil
+}
+
+// Purge окончательно удаляет оттенки из корзины вместе с их изображениями.
+func (c *service) Purge(before time.Time) (int, error) {
+	purged, err := c.repo.PurgeDeleted(before)
 	if err != nil {
-		return shadeDTO, err
+		return 0, err
+	}
+
+	var filenames []string
+	for _, shade := range purged {
+		filenames = append(filenames, shade.Image)
+	}
+	if len(filenames) != 0 {
+		if err := c.fileService.Delete(filenames, "images/shade"); err != nil {
+			log.Printf("error deleting images: %v", err)
+		}
 	}
 
-	return shadeDTO, nil
+	return len(purged), nil
 }
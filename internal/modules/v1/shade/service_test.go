@@ -0,0 +1,119 @@
+package shade
+
+import (
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"haircompany-shop-rest/internal/modules/v1/shade/model"
+	"haircompany-shop-rest/pkg/database"
+	"io"
+	"testing"
+	"time"
+)
+
+// Мок файлового сервиса запоминает удалённые файлы
+type mockFileService struct {
+	deletedFiles  []string
+	deletedFolder string
+}
+
+func (m *mockFileService) SaveToTemp(file io.Reader, filename string) (string, error) {
+	return "temp_" + filename, nil
+}
+
+func (m *mockFileService) WriteToTemp(file io.Reader, filename string) error {
+	return nil
+}
+
+func (m *mockFileService) CleanTemp(before time.Time) (int, error) {
+	return 0, nil
+}
+
+func (m *mockFileService) MoveToPermanent(filenames []string, folder string) error {
+	return nil
+}
+
+func (m *mockFileService) Delete(filenames []string, folder string) error {
+	m.deletedFiles = append(m.deletedFiles, filenames...)
+	m.deletedFolder = folder
+	return nil
+}
+
+func setupTestDB(t *testing.T) *database.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatal("Failed to connect to test database:", err)
+	}
+
+	err = db.AutoMigrate(&model.Shade{})
+	if err != nil {
+		t.Fatal("Failed to migrate test database:", err)
+	}
+
+	return &database.DB{DB: db}
+}
+
+func setupTestService(t *testing.T) (Service, Repository, *database.DB, *mockFileService) {
+	db := setupTestDB(t)
+	repo := NewRepository(db)
+	mockFS := &mockFileService{}
+
+	// Корзине очередь не нужна
+	return NewService(repo, mockFS, nil), repo, db, mockFS
+}
+
+func deleteShade(t *testing.T, db *database.DB, repo Repository, name string, deletedAt time.Time) *model.Shade {
+	shade, err := repo.Create(&model.Shade{Name: name, Image: name + ".png"})
+	if err != nil {
+		t.Fatalf("Failed to create test shade: %v", err)
+	}
+	if err := repo.Delete(shade.ID); err != nil {
+		t.Fatalf("Failed to delete test shade: %v", err)
+	}
+	if err := db.Unscoped().Model(&model.Shade{}).Where("id = ?", shade.ID).Update("deleted_at", deletedAt).Error; err != nil {
+		t.Fatalf("Failed to age test shade: %v", err)
+	}
+	return shade
+}
+
+func TestService_Restore(t *testing.T) {
+	service, repo, db, _ := setupTestService(t)
+
+	deleted := deleteShade(t, db, repo, "blond", time.Now())
+
+	restored, err := service.Restore(deleted.ID)
+	if err != nil || restored == nil {
+		t.Fatalf("Expected shade to be restored, got %+v, %v", restored, err)
+	}
+	if restored.DeletedAt != nil {
+		t.Errorf("Expected restored shade without deletedAt, got %v", restored.DeletedAt)
+	}
+
+	restored, err = service.Restore(999)
+	if err != nil || restored != nil {
+		t.Errorf("Expected nothing for a shade outside the trash, got %+v, %v", restored, err)
+	}
+}
+
+func TestService_Purge(t *testing.T) {
+	service, repo, db, mockFS := setupTestService(t)
+
+	old := deleteShade(t, db, repo, "old", time.Now().Add(-48*time.Hour))
+	recent := deleteShade(t, db, repo, "recent", time.Now())
+
+	count, err := service.Purge(time.Now().Add(-24 * time.Hour))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if count != 1 {
+		t.Errorf("Expected only the old shade to be purged, got %d", count)
+	}
+	if shade, _ := repo.GetDeletedById(old.ID); shade != nil {
+		t.Error("Expected old shade to be gone")
+	}
+	if shade, _ := repo.GetDeletedById(recent.ID); shade == nil {
+		t.Error("Expected recently deleted shade to stay in the trash")
+	}
+	if len(mockFS.deletedFiles) != 1 || mockFS.deletedFiles[0] != "old.png" || mockFS.deletedFolder != "images/shade" {
+		t.Errorf("Expected only the image of the purged shade to be deleted, got %v in %q", mockFS.deletedFiles, mockFS.deletedFolder)
+	}
+}
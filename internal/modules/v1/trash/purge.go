@@ -0,0 +1,52 @@
+package trash
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"haircompany-shop-rest/internal/container"
+	"haircompany-shop-rest/internal/modules/v1/category"
+	"haircompany-shop-rest/internal/modules/v1/desired_result"
+	"haircompany-shop-rest/internal/modules/v1/line"
+	"haircompany-shop-rest/internal/modules/v1/product_type"
+	"haircompany-shop-rest/internal/modules/v1/shade"
+	"log"
+	"time"
+)
+
+type purger interface {
+	Purge(before time.Time) (int, error)
+}
+
+// NewPurgeTask returns a scheduler callback that permanently deletes catalog
+// records which have been in the trash for longer than retention.
+func NewPurgeTask(container *container.Container, retention time.Duration) func(ctx context.Context) error {
+	purgers := []struct {
+		name string
+		svc  purger
+	}{
+		{"categories", category.NewService(category.NewRepository(container.DB), container.FileService, container.Queue)},
+		{"lines", line.NewService(line.NewRepository(container.DB))},
+		{"product types", product_type.NewService(product_type.NewRepository(container.DB))},
+		{"shades", shade.NewService(shade.NewRepository(container.DB), container.FileService, container.Queue)},
+		{"desired results", desired_result.NewService(desired_result.NewRepository(container.DB))},
+	}
+
+	return func(ctx context.Context) error {
+		var errs []error
+		before := time.Now().Add(-retention)
+		for _, p := range purgers {
+			count, err := p.svc.Purge(before)
+			if err != nil {
+				log.Printf("[Trash] failed to purge %s: %v", p.name, err)
+				errs = append(errs, fmt.Errorf("failed to purge %s: %w", p.name, err))
+				continue
+			}
+			if count > 0 {
+				log.Printf("[Trash] purged %d %s deleted before %s", count, p.name, before.Format(time.DateOnly))
+			}
+		}
+
+		return errors.Join(errs...)
+	}
+}
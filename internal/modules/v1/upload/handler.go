@@ -0,0 +1,87 @@
+package upload
+
+import (
+	"errors"
+	"haircompany-shop-rest/internal/services"
+	"haircompany-shop-rest/pkg/response"
+	"log"
+	"net/http"
+	"path"
+	"regexp"
+	"strings"
+)
+
+const (
+	// immutableCacheControl — для файлов с уникальным суффиксом в имени: по
+	// этому имени содержимое больше никогда не изменится.
+	immutableCacheControl = "public, max-age=31536000, immutable"
+	defaultCacheControl   = "public, max-age=300"
+)
+
+// hashedName соответствует именам, которые даёт FileSystemService:
+// photo_1a2b3c4d5e6f7a8b.png, photo_1a2b3c4d5e6f7a8b@thumb.webp.
+var hashedName = regexp.MustCompile(`_[0-9a-f]{16}(@[a-z0-9-]+)?\.[a-zA-Z0-9]+$`)
+
+type Handler struct {
+	storage services.StorageDriver
+}
+
+func NewHandler(storage services.StorageDriver) *Handler {
+	return &Handler{
+		storage: storage,
+	}
+}
+
+// Serve отдаёт постоянные загрузки из хранилища. Условные запросы и Range
+// обрабатывает http.ServeContent; временные загрузки недоступны.
+func (h *Handler) Serve(w http.ResponseWriter, r *http.Request) {
+	key := strings.TrimPrefix(r.URL.Path, "/")
+
+	if key == "temp" || strings.HasPrefix(key, "temp/") {
+		response.SendError(w, http.StatusForbidden, "Access to temporary uploads is forbidden", response.Forbidden)
+		return
+	}
+	if !strings.HasPrefix(key, "images/") || hasHiddenSegment(key) {
+		response.SendError(w, http.StatusNotFound, "File not found", response.NotFound)
+		return
+	}
+
+	info, err := h.storage.Stat(key)
+	if errors.Is(err, services.ErrObjectNotFound) || errors.Is(err, services.ErrInvalidKey) {
+		response.SendError(w, http.StatusNotFound, "File not found", response.NotFound)
+		return
+	}
+	if err != nil {
+		log.Printf("failed to stat upload %s: %v", key, err)
+		response.SendError(w, http.StatusInternalServerError, "Failed to read file", response.ServerError)
+		return
+	}
+
+	header := w.Header()
+	header.Set("Content-Type", info.ContentType)
+	header.Set("X-Content-Type-Options", "nosniff")
+	if info.ETag != "" {
+		header.Set("ETag", info.ETag)
+	}
+	if hashedName.MatchString(key) {
+		header.Set("Cache-Control", immutableCacheControl)
+	} else {
+		header.Set("Cache-Control", defaultCacheControl)
+	}
+
+	content := newObjectReader(h.storage, key, info.Size)
+	defer content.Close()
+
+	http.ServeContent(w, r, path.Base(key), info.LastModified, content)
+}
+
+// hasHiddenSegment отсекает служебные файлы вроде незавершённых загрузок
+// локального хранилища (.upload-*).
+func hasHiddenSegment(key string) bool {
+	for _, segment := range strings.Split(key, "/") {
+		if strings.HasPrefix(segment, ".") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,134 @@
+package upload
+
+import (
+	"haircompany-shop-rest/internal/container"
+	"haircompany-shop-rest/internal/services"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const testContent = "0123456789abcdef"
+
+func newTestServer(t *testing.T, storage services.StorageDriver) http.Handler {
+	t.Helper()
+
+	for _, key := range []string{"images/category/photo_1a2b3c4d5e6f7a8b@thumb.png", "images/category/legacy.png", "temp/photo_0000000000000000.png"} {
+		if err := storage.Put(key, strings.NewReader(testContent), ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	mux := http.NewServeMux()
+	RegisterUploadRoutes(mux, &container.Container{Storage: storage})
+	return mux
+}
+
+func serve(handler http.Handler, method, path string, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	return w
+}
+
+func TestServe(t *testing.T) {
+	drivers := map[string]services.StorageDriver{
+		"memory": services.NewMemoryStorage(),
+		"local":  services.NewLocalStorage(t.TempDir()),
+	}
+
+	for name, storage := range drivers {
+		t.Run(name, func(t *testing.T) {
+			handler := newTestServer(t, storage)
+			const path = "/images/category/photo_1a2b3c4d5e6f7a8b@thumb.png"
+
+			w := serve(handler, http.MethodGet, path, nil)
+			if w.Code != http.StatusOK || w.Body.String() != testContent {
+				t.Fatalf("Expected 200 with content, got %d %q", w.Code, w.Body.String())
+			}
+			if w.Header().Get("Cache-Control") != immutableCacheControl {
+				t.Errorf("Expected immutable Cache-Control, got %q", w.Header().Get("Cache-Control"))
+			}
+			if w.Header().Get("Content-Type") != "image/png" || w.Header().Get("Last-Modified") == "" || w.Header().Get("Accept-Ranges") != "bytes" {
+				t.Errorf("Unexpected headers: %v", w.Header())
+			}
+			etag := w.Header().Get("ETag")
+			if etag == "" {
+				t.Fatal("Expected ETag")
+			}
+
+			if w := serve(handler, http.MethodGet, path, map[string]string{"If-None-Match": etag}); w.Code != http.StatusNotModified {
+				t.Errorf("Expected 304 for matching ETag, got %d", w.Code)
+			}
+
+			w = serve(handler, http.MethodGet, path, map[string]string{"Range": "bytes=4-7"})
+			if w.Code != http.StatusPartialContent || w.Body.String() != "4567" || w.Header().Get("Content-Range") != "bytes 4-7/16" {
+				t.Errorf("Expected 206 with bytes 4-7, got %d %q %q", w.Code, w.Body.String(), w.Header().Get("Content-Range"))
+			}
+
+			// Несколько диапазонов в обратном порядке требуют перемотки назад
+			w = serve(handler, http.MethodGet, path, map[string]string{"Range": "bytes=10-11,0-1"})
+			if w.Code != http.StatusPartialContent {
+				t.Fatalf("Expected 206 for multiple ranges, got %d", w.Code)
+			}
+			_, params, _ := mime.ParseMediaType(w.Header().Get("Content-Type"))
+			reader := multipart.NewReader(w.Body, params["boundary"])
+			var parts []string
+			for {
+				part, err := reader.NextPart()
+				if err != nil {
+					break
+				}
+				data, _ := io.ReadAll(part)
+				parts = append(parts, string(data))
+			}
+			if strings.Join(parts, ",") != "ab,01" {
+				t.Errorf("Expected parts ab,01, got %v", parts)
+			}
+
+			w = serve(handler, http.MethodHead, path, nil)
+			if w.Code != http.StatusOK || w.Body.Len() != 0 || w.Header().Get("Content-Length") != "16" {
+				t.Errorf("Unexpected HEAD response: %d %q %v", w.Code, w.Body.String(), w.Header())
+			}
+
+			if w := serve(handler, http.MethodGet, "/images/category/legacy.png", nil); w.Header().Get("Cache-Control") != defaultCacheControl {
+				t.Errorf("Expected short Cache-Control for legacy names, got %q", w.Header().Get("Cache-Control"))
+			}
+		})
+	}
+}
+
+func TestServe_Denied(t *testing.T) {
+	handler := newTestServer(t, services.NewMemoryStorage())
+
+	cases := []struct {
+		method string
+		path   string
+		code   int
+	}{
+		{http.MethodGet, "/temp/photo_0000000000000000.png", http.StatusForbidden},
+		{http.MethodGet, "/temp", http.StatusForbidden},
+		{http.MethodGet, "/images/category/missing.png", http.StatusNotFound},
+		{http.MethodGet, "/images/category/.upload-123", http.StatusNotFound},
+		{http.MethodGet, "/other/file.png", http.StatusNotFound},
+		{http.MethodPost, "/images/category/legacy.png", http.StatusMethodNotAllowed},
+	}
+	for _, c := range cases {
+		if w := serve(handler, c.method, c.path, nil); w.Code != c.code {
+			t.Errorf("%s %s: expected %d, got %d", c.method, c.path, c.code, w.Code)
+		}
+	}
+
+	// Путь с ".." мультиплексор перенаправляет на очищенный, а тот запрещён
+	w := serve(handler, http.MethodGet, "/images/../temp/photo_0000000000000000.png", nil)
+	if w.Code/100 != 3 || w.Header().Get("Location") != "/temp/photo_0000000000000000.png" {
+		t.Errorf("Expected redirect to the cleaned path, got %d %q", w.Code, w.Header().Get("Location"))
+	}
+}
@@ -0,0 +1,23 @@
+package model
+
+import "time"
+
+const (
+	FileStatusTemp      = "temp"
+	FileStatusPermanent = "permanent"
+)
+
+// UploadedFile — загруженное изображение и запись, которая на него ссылается.
+// Owner* заполняются, когда файл переносится в постоянное хранилище.
+type UploadedFile struct {
+	ID         uint       `gorm:"primarykey" json:"id"`
+	Filename   string     `gorm:"type:varchar(255);not null;uniqueIndex" json:"filename"`
+	ImageType  string     `gorm:"type:varchar(32);not null" json:"imageType"`
+	Status     string     `gorm:"type:varchar(16);not null;default:temp;index:idx_uploaded_files_status_created_at" json:"status"`
+	OwnerType  *string    `gorm:"type:varchar(32);index:idx_uploaded_files_owner" json:"ownerType"`
+	OwnerID    *uint      `gorm:"index:idx_uploaded_files_owner" json:"ownerId"`
+	OwnerField *string    `gorm:"type:varchar(32)" json:"ownerField"`
+	PromotedAt *time.Time `json:"promotedAt"`
+	CreatedAt  time.Time  `gorm:"index:idx_uploaded_files_status_created_at" json:"createdAt"`
+	UpdatedAt  time.Time  `json:"updatedAt"`
+}
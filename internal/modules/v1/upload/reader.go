@@ -0,0 +1,85 @@
+package upload
+
+import (
+	"errors"
+	"haircompany-shop-rest/internal/services"
+	"io"
+)
+
+// objectReader даёт http.ServeContent интерфейс io.ReadSeeker поверх объекта
+// хранилища. Объект открывается только при первом чтении, поэтому на ответы
+// 304 и HEAD содержимое не загружается. После перемотки объект открывается заново
+// с нужного байта, так что запрос Range к концу файла не скачивает его начало.
+type objectReader struct {
+	storage services.StorageDriver
+	key     string
+	size    int64
+
+	offset int64 // позиция, запрошенная через Seek
+	pos    int64 // позиция открытого потока
+	body   io.ReadCloser
+}
+
+func newObjectReader(storage services.StorageDriver, key string, size int64) *objectReader {
+	return &objectReader{
+		storage: storage,
+		key:     key,
+		size:    size,
+	}
+}
+
+func (o *objectReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += o.offset
+	case io.SeekEnd:
+		offset += o.size
+	default:
+		return 0, errors.New("objectReader.Seek: invalid whence")
+	}
+	if offset < 0 {
+		return 0, errors.New("objectReader.Seek: negative position")
+	}
+
+	o.offset = offset
+	return offset, nil
+}
+
+func (o *objectReader) Read(p []byte) (int, error) {
+	if o.offset >= o.size {
+		return 0, io.EOF
+	}
+
+	if o.body == nil || o.offset != o.pos {
+		if err := o.reopen(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := o.body.Read(p)
+	o.pos += int64(n)
+	o.offset = o.pos
+	return n, err
+}
+
+func (o *objectReader) reopen() error {
+	o.Close()
+
+	body, err := o.storage.GetRange(o.key, o.offset)
+	if err != nil {
+		return err
+	}
+	o.body, o.pos = body, o.offset
+
+	return nil
+}
+
+func (o *objectReader) Close() error {
+	if o.body == nil {
+		return nil
+	}
+	err := o.body.Close()
+	o.body = nil
+	return err
+}
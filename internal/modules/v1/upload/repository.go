@@ -0,0 +1,182 @@
+package upload
+
+import (
+	"fmt"
+	"gorm.io/gorm/clause"
+	"haircompany-shop-rest/internal/modules/v1/upload/model"
+	"haircompany-shop-rest/internal/services"
+	"haircompany-shop-rest/pkg/database"
+	"time"
+)
+
+// reference — колонка таблицы, в которой хранится имя загруженного файла.
+type reference struct {
+	ownerType string
+	field     string
+	table     string
+	column    string
+	imageType string
+}
+
+// references перечисляет все места, где записи ссылаются на изображения.
+// Файлы в images/<imageType>, на которые не ссылается ни одна из колонок,
+// считаются осиротевшими.
+var references = []reference{
+	{ownerType: "category", field: "image", table: "categories", column: "image", imageType: "category"},
+	{ownerType: "category", field: "headerImage", table: "categories", column: "header_image", imageType: "category"},
+	{ownerType: "shade", field: "image", table: "shades", column: "image", imageType: "shade"},
+	{ownerType: "product", field: "image", table: "products", column: "image", imageType: "product"},
+}
+
+func findReference(ownerType, field string) (reference, error) {
+	for _, ref := range references {
+		if ref.ownerType == ownerType && ref.field == field {
+			return ref, nil
+		}
+	}
+	return reference{}, fmt.Errorf("unknown file reference %s.%s", ownerType, field)
+}
+
+// imageTypes возвращает типы изображений из references без повторов.
+func imageTypes() []string {
+	var types []string
+	seen := make(map[string]bool)
+	for _, ref := range references {
+		if !seen[ref.imageType] {
+			seen[ref.imageType] = true
+			types = append(types, ref.imageType)
+		}
+	}
+	return types
+}
+
+type Repository interface {
+	Track(file *model.UploadedFile) error
+	IsReferenced(owner services.FileOwner, field, filename string) (bool, error)
+	MarkPermanent(filenames []string, owner services.FileOwner, promotedAt time.Time) error
+	DeleteTempBefore(before time.Time, keep []string) (int64, error)
+	GetReferencedFilenames(imageType string) ([]string, error)
+	DeleteUnreferenced(imageType string, referenced []string, before time.Time) (int64, error)
+	DeleteByFilenames(filenames []string) error
+}
+
+type repository struct {
+	DB *database.DB
+}
+
+func NewRepository(db *database.DB) Repository {
+	return &repository{
+		DB: db,
+	}
+}
+
+func (r *repository) Track(file *model.UploadedFile) error {
+	if file.Status == "" {
+		file.Status = model.FileStatusTemp
+	}
+
+	return r.DB.Create(file).Error
+}
+
+// IsReferenced проверяет, что запись владельца всё ещё хранит имя файла в
+// поле. Записи в корзине тоже учитываются: их можно восстановить.
+func (r *repository) IsReferenced(owner services.FileOwner, field, filename string) (bool, error) {
+	ref, err := findReference(owner.Type, field)
+	if err != nil {
+		return false, err
+	}
+
+	var count int64
+	result := r.DB.Table(ref.table).
+		Where("id = ? AND "+ref.column+" = ?", owner.ID, filename).
+		Count(&count)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return count > 0, nil
+}
+
+// MarkPermanent отмечает файлы перенесёнными и запоминает владельца. Файлы,
+// загруженные до появления учёта, добавляются в таблицу.
+func (r *repository) MarkPermanent(filenames []string, owner services.FileOwner, promotedAt time.Time) error {
+	for _, filename := range filenames {
+		field := owner.Fields[filename]
+		ref, err := findReference(owner.Type, field)
+		if err != nil {
+			return err
+		}
+
+		ownerType, ownerId := owner.Type, owner.ID
+		file := &model.UploadedFile{
+			Filename:   filename,
+			ImageType:  ref.imageType,
+			Status:     model.FileStatusPermanent,
+			OwnerType:  &ownerType,
+			OwnerID:    &ownerId,
+			OwnerField: &field,
+			PromotedAt: &promotedAt,
+		}
+		result := r.DB.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "filename"}},
+			DoUpdates: clause.AssignmentColumns([]string{"status", "owner_type", "owner_id", "owner_field", "promoted_at", "updated_at"}),
+		}).Create(file)
+		if result.Error != nil {
+			return result.Error
+		}
+	}
+
+	return nil
+}
+
+// DeleteTempBefore удаляет учёт временных файлов, загруженных до before,
+// кроме файлов из keep.
+func (r *repository) DeleteTempBefore(before time.Time, keep []string) (int64, error) {
+	query := r.DB.Where("status = ? AND created_at < ?", model.FileStatusTemp, before)
+	if len(keep) > 0 {
+		query = query.Where("filename NOT IN ?", keep)
+	}
+	result := query.Delete(&model.UploadedFile{})
+
+	return result.RowsAffected, result.Error
+}
+
+// GetReferencedFilenames собирает имена файлов, на которые ссылаются записи
+// (включая записи в корзине) для типа изображений.
+func (r *repository) GetReferencedFilenames(imageType string) ([]string, error) {
+	var filenames []string
+	for _, ref := range references {
+		if ref.imageType != imageType {
+			continue
+		}
+
+		var names []string
+		result := r.DB.Table(ref.table).Where(ref.column+" <> ''").Pluck(ref.column, &names)
+		if result.Error != nil {
+			return nil, result.Error
+		}
+		filenames = append(filenames, names...)
+	}
+
+	return filenames, nil
+}
+
+// DeleteUnreferenced удаляет учёт перенесённых файлов типа, которых нет среди
+// referenced и которые не обновлялись с before.
+func (r *repository) DeleteUnreferenced(imageType string, referenced []string, before time.Time) (int64, error) {
+	query := r.DB.Where("status = ? AND image_type = ? AND updated_at < ?", model.FileStatusPermanent, imageType, before)
+	if len(referenced) > 0 {
+		query = query.Where("filename NOT IN ?", referenced)
+	}
+	result := query.Delete(&model.UploadedFile{})
+
+	return result.RowsAffected, result.Error
+}
+
+func (r *repository) DeleteByFilenames(filenames []string) error {
+	if len(filenames) == 0 {
+		return nil
+	}
+
+	return r.DB.Where("filename IN ?", filenames).Delete(&model.UploadedFile{}).Error
+}
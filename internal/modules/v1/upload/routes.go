@@ -0,0 +1,21 @@
+package upload
+
+import (
+	"haircompany-shop-rest/internal/container"
+	"haircompany-shop-rest/pkg/response"
+	"net/http"
+)
+
+func RegisterUploadRoutes(mux *http.ServeMux, container *container.Container) {
+	h := NewHandler(container.Storage)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet, http.MethodHead:
+			h.Serve(w, r)
+		default:
+			msg := "Method not allowed. Allowed methods: GET, HEAD"
+			response.SendError(w, http.StatusMethodNotAllowed, msg, response.MethodNotAllowed)
+		}
+	})
+}
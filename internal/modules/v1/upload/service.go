@@ -0,0 +1,183 @@
+package upload
+
+import (
+	"errors"
+	"haircompany-shop-rest/internal/modules/v1/upload/model"
+	"haircompany-shop-rest/internal/services"
+	"log"
+	"path"
+	"strings"
+	"time"
+)
+
+type Service interface {
+	Track(filename, imageType string) error
+	MoveFiles(payload services.FilesPayload) error
+	DeleteFiles(payload services.FilesPayload) error
+	CleanTemp(before time.Time) (int, error)
+	CollectOrphans(before time.Time, remove bool) ([]string, error)
+}
+
+type service struct {
+	repo        Repository
+	fileService services.FileSystemService
+	storage     services.StorageDriver
+}
+
+func NewService(r Repository, fs services.FileSystemService, storage services.StorageDriver) Service {
+	return &service{
+		repo:        r,
+		fileService: fs,
+		storage:     storage,
+	}
+}
+
+// Track учитывает файл, только что сохранённый во временный каталог.
+func (s *service) Track(filename, imageType string) error {
+	return s.repo.Track(&model.UploadedFile{Filename: filename, ImageType: imageType})
+}
+
+// MoveFiles переносит в постоянное хранилище только те файлы, на которые
+// владелец ссылается в момент выполнения задачи: если изображение успели
+// заменить, старый файл останется во временном каталоге и будет удалён по
+// истечении срока. Задачи без владельца переносят файлы как есть.
+func (s *service) MoveFiles(payload services.FilesPayload) error {
+	if payload.Owner == nil {
+		return s.fileService.MoveToPermanent(payload.Filenames, payload.Folder)
+	}
+
+	var referenced []string
+	for _, filename := range payload.Filenames {
+		if filename == "" {
+			continue
+		}
+
+		ok, err := s.repo.IsReferenced(*payload.Owner, payload.Owner.Fields[filename], filename)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			log.Printf("[Uploads] skipping %s: %s %d no longer references it", filename, payload.Owner.Type, payload.Owner.ID)
+			continue
+		}
+		referenced = append(referenced, filename)
+	}
+
+	if len(referenced) == 0 {
+		return nil
+	}
+	if err := s.fileService.MoveToPermanent(referenced, payload.Folder); err != nil {
+		return err
+	}
+
+	return s.repo.MarkPermanent(referenced, *payload.Owner, time.Now())
+}
+
+func (s *service) DeleteFiles(payload services.FilesPayload) error {
+	if err := s.fileService.Delete(payload.Filenames, payload.Folder); err != nil {
+		return err
+	}
+
+	return s.repo.DeleteByFilenames(payload.Filenames)
+}
+
+// CleanTemp удаляет временные загрузки старше before вместе с их учётом.
+// Файлы, на которые уже ссылается запись, остаются: задача их переноса ещё
+// может повторяться с задержкой.
+func (s *service) CleanTemp(before time.Time) (int, error) {
+	var referenced []string
+	for _, imageType := range imageTypes() {
+		filenames, err := s.repo.GetReferencedFilenames(imageType)
+		if err != nil {
+			return 0, err
+		}
+		referenced = append(referenced, filenames...)
+	}
+	stems := fileStems(referenced)
+
+	objects, err := s.storage.List("temp/")
+	if err != nil {
+		return 0, err
+	}
+
+	var errs []error
+	deleted := 0
+	for _, object := range objects {
+		if !object.LastModified.Before(before) || isReferenced(stems, path.Base(object.Key)) {
+			continue
+		}
+		if err := s.storage.Delete(object.Key); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		deleted++
+	}
+
+	if _, err := s.repo.DeleteTempBefore(before, referenced); err != nil {
+		errs = append(errs, err)
+	}
+
+	return deleted, errors.Join(errs...)
+}
+
+// CollectOrphans находит в images/<type> файлы, на которые не ссылается ни
+// одна запись, и возвращает их ключи. Файлы, изменённые после before, не
+// учитываются: их перенос мог ещё не завершиться. С remove найденные файлы
+// удаляются вместе с учётом.
+func (s *service) CollectOrphans(before time.Time, remove bool) ([]string, error) {
+	var orphans []string
+	var errs []error
+	for _, imageType := range imageTypes() {
+		filenames, err := s.repo.GetReferencedFilenames(imageType)
+		if err != nil {
+			return orphans, err
+		}
+		stems := fileStems(filenames)
+
+		objects, err := s.storage.List("images/" + imageType + "/")
+		if err != nil {
+			return orphans, err
+		}
+		for _, object := range objects {
+			if !object.LastModified.Before(before) || isReferenced(stems, path.Base(object.Key)) {
+				continue
+			}
+			orphans = append(orphans, object.Key)
+
+			if remove {
+				if err := s.storage.Delete(object.Key); err != nil {
+					errs = append(errs, err)
+				}
+			}
+		}
+
+		if remove {
+			if _, err := s.repo.DeleteUnreferenced(imageType, filenames, before); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	return orphans, errors.Join(errs...)
+}
+
+func fileStems(filenames []string) map[string]bool {
+	stems := make(map[string]bool, len(filenames))
+	for _, filename := range filenames {
+		stems[strings.TrimSuffix(filename, path.Ext(filename))] = true
+	}
+	return stems
+}
+
+// isReferenced сопоставляет файл с именами из записей по основе имени:
+// photo_1a2b.webp и photo_1a2b@thumb.png относятся к photo_1a2b.png.
+func isReferenced(stems map[string]bool, name string) bool {
+	stem := strings.TrimSuffix(name, path.Ext(name))
+	if stems[stem] {
+		return true
+	}
+	if i := strings.LastIndex(stem, "@"); i >= 0 {
+		return stems[stem[:i]]
+	}
+	return false
+}
@@ -0,0 +1,219 @@
+package upload
+
+import (
+	"errors"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"haircompany-shop-rest/internal/modules/v1/upload/model"
+	"haircompany-shop-rest/internal/services"
+	"haircompany-shop-rest/pkg/database"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+func setupTestDB(t *testing.T) *database.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatal("Failed to connect to test database:", err)
+	}
+
+	if err := db.AutoMigrate(&model.UploadedFile{}); err != nil {
+		t.Fatal("Failed to migrate test database:", err)
+	}
+	// Только колонки, на которые ссылаются references
+	for _, stmt := range []string{
+		"CREATE TABLE categories (id INTEGER PRIMARY KEY, image TEXT, header_image TEXT, deleted_at DATETIME)",
+		"CREATE TABLE shades (id INTEGER PRIMARY KEY, image TEXT, deleted_at DATETIME)",
+		"CREATE TABLE products (id INTEGER PRIMARY KEY, image TEXT, deleted_at DATETIME)",
+	} {
+		if err := db.Exec(stmt).Error; err != nil {
+			t.Fatal("Failed to create table:", err)
+		}
+	}
+
+	return &database.DB{DB: db}
+}
+
+func setupService(t *testing.T) (*database.DB, services.StorageDriver, Service) {
+	db := setupTestDB(t)
+	storage := services.NewMemoryStorage()
+	svc := NewService(NewRepository(db), services.NewFileSystemService(storage), storage)
+
+	return db, storage, svc
+}
+
+func putObjects(t *testing.T, storage services.StorageDriver, keys ...string) {
+	t.Helper()
+	for _, key := range keys {
+		if err := storage.Put(key, strings.NewReader(key), ""); err != nil {
+			t.Fatalf("Put failed: %v", err)
+		}
+	}
+}
+
+func TestService_MoveFilesOnlyReferenced(t *testing.T) {
+	db, storage, svc := setupService(t)
+
+	db.Exec("INSERT INTO categories (id, image, header_image) VALUES (1, 'a_1.png', 'c_1.png')")
+	putObjects(t, storage, "temp/a_1.png", "temp/a_1@thumb.webp", "temp/b_1.png")
+	if err := svc.Track("a_1.png", "category"); err != nil {
+		t.Fatalf("Track failed: %v", err)
+	}
+
+	// Заголовок успели заменить на c_1.png, b_1.png переносить не нужно
+	payload := services.FilesPayload{
+		Filenames: []string{"a_1.png", "b_1.png"},
+		Folder:    "images/category",
+		Owner: &services.FileOwner{
+			Type:   "category",
+			ID:     1,
+			Fields: map[string]string{"a_1.png": "image", "b_1.png": "headerImage"},
+		},
+	}
+	if err := svc.MoveFiles(payload); err != nil {
+		t.Fatalf("MoveFiles failed: %v", err)
+	}
+
+	for _, key := range []string{"images/category/a_1.png", "images/category/a_1@thumb.webp", "temp/b_1.png"} {
+		if _, err := storage.Stat(key); err != nil {
+			t.Errorf("Expected %s to exist, got %v", key, err)
+		}
+	}
+	if _, err := storage.Stat("images/category/b_1.png"); !errors.Is(err, services.ErrObjectNotFound) {
+		t.Errorf("Expected unreferenced file to stay in temp, got %v", err)
+	}
+
+	var file model.UploadedFile
+	if err := db.Where("filename = ?", "a_1.png").First(&file).Error; err != nil {
+		t.Fatalf("Expected tracked file, got %v", err)
+	}
+	if file.Status != model.FileStatusPermanent || file.OwnerType == nil || *file.OwnerType != "category" ||
+		file.OwnerID == nil || *file.OwnerID != 1 || file.OwnerField == nil || *file.OwnerField != "image" || file.PromotedAt == nil {
+		t.Errorf("Unexpected tracked file: %+v", file)
+	}
+
+	// Повтор задачи не должен падать
+	if err := svc.MoveFiles(payload); err != nil {
+		t.Errorf("Expected repeated move to succeed, got %v", err)
+	}
+
+	payload.Owner.Fields["a_1.png"] = "unknown"
+	if err := svc.MoveFiles(payload); err == nil {
+		t.Error("Expected error for unknown reference")
+	}
+}
+
+func TestService_MoveFilesWithoutOwner(t *testing.T) {
+	_, storage, svc := setupService(t)
+	putObjects(t, storage, "temp/a_1.png")
+
+	if err := svc.MoveFiles(services.FilesPayload{Filenames: []string{"a_1.png"}, Folder: "images/shade"}); err != nil {
+		t.Fatalf("MoveFiles failed: %v", err)
+	}
+	if _, err := storage.Stat("images/shade/a_1.png"); err != nil {
+		t.Errorf("Expected file to be moved, got %v", err)
+	}
+}
+
+func TestService_CleanTemp(t *testing.T) {
+	db, storage, svc := setupService(t)
+	putObjects(t, storage, "temp/a_1.png", "temp/a_1.webp")
+	svc.Track("a_1.png", "category")
+
+	if deleted, err := svc.CleanTemp(time.Now().Add(-time.Hour)); err != nil || deleted != 0 {
+		t.Fatalf("Expected fresh uploads to stay, got %d, %v", deleted, err)
+	}
+
+	if deleted, err := svc.CleanTemp(time.Now().Add(time.Second)); err != nil || deleted != 2 {
+		t.Fatalf("Expected 2 deleted files, got %d, %v", deleted, err)
+	}
+	var count int64
+	db.Model(&model.UploadedFile{}).Count(&count)
+	if count != 0 {
+		t.Errorf("Expected temp tracking rows to be deleted, got %d", count)
+	}
+}
+
+func TestService_CleanTempKeepsPendingMoves(t *testing.T) {
+	db, storage, svc := setupService(t)
+
+	// Категория уже ссылается на файл, но задача переноса ещё не выполнена
+	db.Exec("INSERT INTO categories (id, image, header_image) VALUES (1, 'a_1.png', '')")
+	putObjects(t, storage, "temp/a_1.png", "temp/a_1@thumb.png", "temp/b_1.png")
+	svc.Track("a_1.png", "category")
+	svc.Track("b_1.png", "category")
+
+	if deleted, err := svc.CleanTemp(time.Now().Add(time.Second)); err != nil || deleted != 1 {
+		t.Fatalf("Expected only the unreferenced file to be deleted, got %d, %v", deleted, err)
+	}
+	for _, key := range []string{"temp/a_1.png", "temp/a_1@thumb.png"} {
+		if _, err := storage.Stat(key); err != nil {
+			t.Errorf("Expected %s to stay, got %v", key, err)
+		}
+	}
+
+	var tracked []string
+	db.Model(&model.UploadedFile{}).Pluck("filename", &tracked)
+	if len(tracked) != 1 || tracked[0] != "a_1.png" {
+		t.Errorf("Expected tracking of a_1.png to stay, got %v", tracked)
+	}
+}
+
+func TestService_CollectOrphans(t *testing.T) {
+	db, storage, svc := setupService(t)
+
+	db.Exec("INSERT INTO categories (id, image, header_image) VALUES (1, 'a_1.png', '')")
+	// Оттенок в корзине всё ещё ссылается на файл
+	db.Exec("INSERT INTO shades (id, image, deleted_at) VALUES (1, 's_1.png', CURRENT_TIMESTAMP)")
+	putObjects(t, storage,
+		"images/category/a_1.png", "images/category/a_1@thumb.webp", "images/category/a_1.webp",
+		"images/category/c_1.png", "images/category/c_1@thumb.png",
+		"images/shade/s_1.png", "images/shade/s_2@swatch.png",
+		"temp/t_1.png",
+	)
+	owner := services.FileOwner{Type: "category", ID: 2, Fields: map[string]string{"c_1.png": "image"}}
+	NewRepository(db).MarkPermanent([]string{"c_1.png"}, owner, time.Now().Add(-time.Hour))
+
+	if orphans, err := svc.CollectOrphans(time.Now().Add(-time.Hour), true); err != nil || len(orphans) != 0 {
+		t.Fatalf("Expected fresh files to be skipped, got %v, %v", orphans, err)
+	}
+
+	want := "images/category/c_1.png,images/category/c_1@thumb.png,images/shade/s_2@swatch.png"
+	orphans, err := svc.CollectOrphans(time.Now().Add(time.Second), false)
+	if err != nil {
+		t.Fatalf("CollectOrphans failed: %v", err)
+	}
+	sort.Strings(orphans)
+	if strings.Join(orphans, ",") != want {
+		t.Errorf("Unexpected orphans: %v", orphans)
+	}
+	if _, err := storage.Stat("images/category/c_1.png"); err != nil {
+		t.Errorf("Expected report mode to keep files, got %v", err)
+	}
+
+	orphans, err = svc.CollectOrphans(time.Now().Add(time.Second), true)
+	if err != nil {
+		t.Fatalf("CollectOrphans failed: %v", err)
+	}
+	if len(orphans) != 3 {
+		t.Errorf("Expected 3 deleted orphans, got %v", orphans)
+	}
+	for _, key := range strings.Split(want, ",") {
+		if _, err := storage.Stat(key); !errors.Is(err, services.ErrObjectNotFound) {
+			t.Errorf("Expected %s to be deleted, got %v", key, err)
+		}
+	}
+	for _, key := range []string{"images/category/a_1.png", "images/category/a_1@thumb.webp", "images/shade/s_1.png", "temp/t_1.png"} {
+		if _, err := storage.Stat(key); err != nil {
+			t.Errorf("Expected %s to stay, got %v", key, err)
+		}
+	}
+
+	var count int64
+	db.Model(&model.UploadedFile{}).Where("filename = ?", "c_1.png").Count(&count)
+	if count != 0 {
+		t.Errorf("Expected tracking row of orphan to be deleted, got %d", count)
+	}
+}
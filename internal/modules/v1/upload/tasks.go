@@ -0,0 +1,55 @@
+package upload
+
+import (
+	"context"
+	"haircompany-shop-rest/internal/container"
+	"log"
+	"time"
+)
+
+// NewCleanTempTask returns a scheduler callback that deletes temporary uploads
+// older than ttl. Newer files may still be referenced by a form in progress.
+func NewCleanTempTask(container *container.Container, ttl time.Duration) func(ctx context.Context) error {
+	svc := NewService(NewRepository(container.DB), container.FileService, container.Storage)
+
+	return func(ctx context.Context) error {
+		before := time.Now().Add(-ttl)
+		deleted, err := svc.CleanTemp(before)
+		if err != nil {
+			log.Printf("[Uploads] failed to clean temporary uploads: %v", err)
+		}
+		if deleted > 0 {
+			log.Printf("[Uploads] deleted %d temporary files uploaded before %s", deleted, before.Format(time.DateTime))
+		}
+
+		return err
+	}
+}
+
+// NewOrphanTask returns a scheduler callback that looks for permanent images
+// no category, shade or product refers to. Orphans are only reported unless
+// remove is set.
+func NewOrphanTask(container *container.Container, minAge time.Duration, remove bool) func(ctx context.Context) error {
+	svc := NewService(NewRepository(container.DB), container.FileService, container.Storage)
+
+	return func(ctx context.Context) error {
+		orphans, err := svc.CollectOrphans(time.Now().Add(-minAge), remove)
+		if err != nil {
+			log.Printf("[Uploads] failed to collect orphaned files: %v", err)
+		}
+		if len(orphans) == 0 {
+			return err
+		}
+
+		if remove {
+			log.Printf("[Uploads] deleted %d orphaned files", len(orphans))
+			return err
+		}
+		log.Printf("[Uploads] found %d orphaned files, set ORPHAN_GC_DELETE=true to delete them", len(orphans))
+		for _, key := range orphans {
+			log.Printf("[Uploads] orphaned file: %s", key)
+		}
+
+		return err
+	}
+}
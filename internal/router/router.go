@@ -7,13 +7,22 @@ import (
 	"haircompany-shop-rest/internal/container"
 	"haircompany-shop-rest/internal/middleware"
 	"haircompany-shop-rest/internal/modules/v1/auth"
+	"haircompany-shop-rest/internal/modules/v1/cart"
 	"haircompany-shop-rest/internal/modules/v1/category"
 	"haircompany-shop-rest/internal/modules/v1/dashboard_user"
 	"haircompany-shop-rest/internal/modules/v1/desired_result"
 	"haircompany-shop-rest/internal/modules/v1/image"
+	"haircompany-shop-rest/internal/modules/v1/inventory"
+	"haircompany-shop-rest/internal/modules/v1/job"
 	"haircompany-shop-rest/internal/modules/v1/line"
+	"haircompany-shop-rest/internal/modules/v1/order"
+	"haircompany-shop-rest/internal/modules/v1/payment"
+	"haircompany-shop-rest/internal/modules/v1/product"
+	"haircompany-shop-rest/internal/modules/v1/product_variant"
 	"haircompany-shop-rest/internal/modules/v1/product_type"
+	"haircompany-shop-rest/internal/modules/v1/promo_code"
 	"haircompany-shop-rest/internal/modules/v1/shade"
+	"haircompany-shop-rest/internal/modules/v1/upload"
 	"net/http"
 )
 
@@ -29,6 +38,14 @@ func NewRouter(cfg *config.Config, container *container.Container) http.Handler
 	product_type.RegisterV1ProductTypeRoutes(v1, container)
 	desired_result.RegisterV1DesiredResultRoutes(v1, container)
 	shade.RegisterV1ShadeRoutes(v1, container)
+	product.RegisterV1ProductRoutes(v1, container)
+	product_variant.RegisterV1ProductVariantRoutes(v1, container)
+	inventory.RegisterV1InventoryRoutes(v1, container)
+	cart.RegisterV1CartRoutes(v1, container)
+	order.RegisterV1OrderRoutes(v1, container)
+	payment.RegisterV1PaymentRoutes(v1, container)
+	promo_code.RegisterV1PromoCodeRoutes(v1, container)
+	job.RegisterV1JobRoutes(v1, container)
 
 	apiHandler := middleware.ChainMiddleware(
 		v1,
@@ -37,6 +54,25 @@ func NewRouter(cfg *config.Config, container *container.Container) http.Handler
 	)
 	mux.Handle("/api/v1/", http.StripPrefix("/api/v1", apiHandler))
 
+	slugs := http.NewServeMux()
+	category.RegisterV1CategorySlugRoutes(slugs, container)
+	product.RegisterV1ProductSlugRoutes(slugs, container)
+	slugHandler := middleware.ChainMiddleware(
+		slugs,
+		middleware.APIMiddleware(cfg.AuthAppKey),
+		middleware.CORSMiddleware(cfg.CORS),
+	)
+	mux.Handle("/api/v1/category/by-slug/", http.StripPrefix("/api/v1", slugHandler))
+	mux.Handle("/api/v1/product/by-slug/", http.StripPrefix("/api/v1", slugHandler))
+
+	webhooks := http.NewServeMux()
+	payment.RegisterV1PaymentWebhookRoutes(webhooks, container)
+	mux.Handle("/webhooks/v1/", http.StripPrefix("/webhooks/v1", webhooks))
+
+	uploads := http.NewServeMux()
+	upload.RegisterUploadRoutes(uploads, container)
+	mux.Handle("/uploads/", http.StripPrefix("/uploads", uploads))
+
 	if cfg.AppEnv != "production" {
 		mux.HandleFunc("/swagger/", httpSwagger.WrapHandler)
 	}
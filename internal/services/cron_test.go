@@ -0,0 +1,84 @@
+package services
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseSchedule_Next(t *testing.T) {
+	from := time.Date(2024, time.January, 31, 10, 17, 30, 0, time.UTC) // среда
+
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2024, time.January, 31, 10, 18, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2024, time.January, 31, 10, 30, 0, 0, time.UTC)},
+		{"0 4 * * *", time.Date(2024, time.February, 1, 4, 0, 0, 0, time.UTC)},
+		{"30 9 * * mon-fri", time.Date(2024, time.February, 1, 9, 30, 0, 0, time.UTC)},
+		{"0 0 29 feb *", time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{"0 12 1 * 7", time.Date(2024, time.February, 1, 12, 0, 0, 0, time.UTC)},
+		{"0 12 15 * 0", time.Date(2024, time.February, 4, 12, 0, 0, 0, time.UTC)},
+		{"5,10 11 * * *", time.Date(2024, time.January, 31, 11, 5, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)},
+		{"@every 1h", time.Date(2024, time.January, 31, 11, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		schedule, err := ParseSchedule(tt.expr)
+		if err != nil {
+			t.Errorf("ParseSchedule(%q) returned error: %v", tt.expr, err)
+			continue
+		}
+		if got := schedule.Next(from); !got.Equal(tt.want) {
+			t.Errorf("ParseSchedule(%q).Next() = %s, want %s", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestParseSchedule_String(t *testing.T) {
+	for expr, want := range map[string]string{
+		"0 4 * * *": "0 4 * * *",
+		"@daily":    "@daily",
+		"@every 1h": "@every 1h0m0s",
+	} {
+		schedule, err := ParseSchedule(expr)
+		if err != nil {
+			t.Fatalf("ParseSchedule(%q) returned error: %v", expr, err)
+		}
+		if schedule.String() != want {
+			t.Errorf("Expected String() %q, got %q", want, schedule.String())
+		}
+	}
+}
+
+func TestParseSchedule_NeverFires(t *testing.T) {
+	schedule, err := ParseSchedule("0 0 30 feb *")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if next := schedule.Next(time.Now()); !next.IsZero() {
+		t.Errorf("Expected zero time, got %s", next)
+	}
+}
+
+func TestParseSchedule_Invalid(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"10-5 * * * *",
+		"a * * * *",
+		"@every 10ms",
+		"@every soon",
+	} {
+		if _, err := ParseSchedule(expr); err == nil {
+			t.Errorf("Expected error for %q", expr)
+		}
+	}
+}
@@ -0,0 +1,218 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+var ErrSessionNotFound = errors.New("session not found")
+
+// DashboardSession is one signed-in device of a dashboard user. Each session
+// has its own refresh token; only the token hash is stored.
+type DashboardSession struct {
+	ID               string    `json:"id"`
+	Email            string    `json:"email"`
+	Device           string    `json:"device"`
+	IP               string    `json:"ip"`
+	UserAgent        string    `json:"userAgent"`
+	RefreshTokenHash string    `json:"refreshTokenHash"`
+	CreatedAt        time.Time `json:"createdAt"`
+	LastUsedAt       time.Time `json:"lastUsedAt"`
+	ExpiresAt        time.Time `json:"expiresAt"`
+}
+
+// DashboardSessionStore keeps dashboard sessions in Redis:
+//
+//	dashboard_session:<id>            session JSON
+//	dashboard_refresh:<token hash>    session id
+//	dashboard_sessions:<email>        set of session ids of the user
+type DashboardSessionStore interface {
+	Save(session *DashboardSession, refreshToken string, ttl time.Duration) error
+	Rotate(session *DashboardSession, oldRefreshToken, newRefreshToken string, ttl time.Duration) error
+	GetByRefreshToken(refreshToken string) (*DashboardSession, error)
+	List(email string) ([]*DashboardSession, error)
+	Revoke(email, id string) error
+	RevokeAll(email string) ([]string, error)
+}
+
+type dashboardSessionStore struct {
+	redis RedisService
+}
+
+func NewDashboardSessionStore(redis RedisService) DashboardSessionStore {
+	return &dashboardSessionStore{
+		redis: redis,
+	}
+}
+
+// Save stores the session with its current refresh token and prolongs it by ttl.
+func (s *dashboardSessionStore) Save(session *DashboardSession, refreshToken string, ttl time.Duration) error {
+	session.RefreshTokenHash = hashSessionToken(refreshToken)
+	session.ExpiresAt = time.Now().Add(ttl)
+
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+
+	if err := s.redis.Set(sessionKey(session.ID), string(data), ttl); err != nil {
+		return err
+	}
+	if err := s.redis.Set(sessionRefreshKey(session.RefreshTokenHash), session.ID, ttl); err != nil {
+		return err
+	}
+
+	return s.redis.SAdd(userSessionsKey(session.Email), session.ID, ttl)
+}
+
+// Rotate replaces the refresh token of the session. The old token is removed
+// atomically, so of two concurrent refreshes with the same token only one wins.
+func (s *dashboardSessionStore) Rotate(session *DashboardSession, oldRefreshToken, newRefreshToken string, ttl time.Duration) error {
+	deleted, err := s.redis.CompareAndDelete(sessionRefreshKey(hashSessionToken(oldRefreshToken)), session.ID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrSessionNotFound
+	}
+
+	return s.Save(session, newRefreshToken, ttl)
+}
+
+// GetByRefreshToken returns the session the refresh token currently belongs to.
+func (s *dashboardSessionStore) GetByRefreshToken(refreshToken string) (*DashboardSession, error) {
+	tokenHash := hashSessionToken(refreshToken)
+	id, err := s.redis.Get(sessionRefreshKey(tokenHash))
+	if errors.Is(err, ErrKeyNotFound) {
+		return nil, ErrSessionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := s.get(id)
+	if err != nil {
+		return nil, err
+	}
+	if session == nil || session.RefreshTokenHash != tokenHash {
+		return nil, ErrSessionNotFound
+	}
+
+	return session, nil
+}
+
+// List returns active sessions of the user, most recently used first.
+// Expired sessions are removed from the user index on the way.
+func (s *dashboardSessionStore) List(email string) ([]*DashboardSession, error) {
+	ids, err := s.redis.SMembers(userSessionsKey(email))
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]*DashboardSession, 0, len(ids))
+	for _, id := range ids {
+		session, err := s.get(id)
+		if err != nil {
+			return nil, err
+		}
+		if session == nil {
+			if err := s.redis.SRem(userSessionsKey(email), id); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		sessions = append(sessions, session)
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastUsedAt.After(sessions[j].LastUsedAt)
+	})
+
+	return sessions, nil
+}
+
+// Revoke ends one session of the user.
+func (s *dashboardSessionStore) Revoke(email, id string) error {
+	session, err := s.get(id)
+	if err != nil {
+		return err
+	}
+	if session == nil || session.Email != email {
+		return ErrSessionNotFound
+	}
+
+	return s.delete(session)
+}
+
+// RevokeAll ends every session of the user and returns their ids.
+func (s *dashboardSessionStore) RevokeAll(email string) ([]string, error) {
+	sessions, err := s.List(email)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(sessions))
+	var errs []error
+	for _, session := range sessions {
+		if err := s.delete(session); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		ids = append(ids, session.ID)
+	}
+	if len(errs) > 0 {
+		return ids, errors.Join(errs...)
+	}
+
+	return ids, s.redis.Delete(userSessionsKey(email))
+}
+
+func (s *dashboardSessionStore) get(id string) (*DashboardSession, error) {
+	data, err := s.redis.Get(sessionKey(id))
+	if errors.Is(err, ErrKeyNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var session DashboardSession
+	if err := json.Unmarshal([]byte(data), &session); err != nil {
+		return nil, fmt.Errorf("invalid session %s: %w", id, err)
+	}
+
+	return &session, nil
+}
+
+func (s *dashboardSessionStore) delete(session *DashboardSession) error {
+	if err := s.redis.Delete(sessionRefreshKey(session.RefreshTokenHash)); err != nil {
+		return err
+	}
+	if err := s.redis.Delete(sessionKey(session.ID)); err != nil {
+		return err
+	}
+
+	return s.redis.SRem(userSessionsKey(session.Email), session.ID)
+}
+
+func sessionKey(id string) string {
+	return fmt.Sprintf("dashboard_session:%s", id)
+}
+
+func sessionRefreshKey(tokenHash string) string {
+	return fmt.Sprintf("dashboard_refresh:%s", tokenHash)
+}
+
+func userSessionsKey(email string) string {
+	return fmt.Sprintf("dashboard_sessions:%s", email)
+}
+
+func hashSessionToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
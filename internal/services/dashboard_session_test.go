@@ -0,0 +1,128 @@
+package services
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDashboardSessionStore_Rotate(t *testing.T) {
+	redis := newMemoryRedis()
+	store := NewDashboardSessionStore(redis)
+
+	session := &DashboardSession{ID: "s1", Email: "manager@example.com", CreatedAt: time.Now(), LastUsedAt: time.Now()}
+	if err := store.Save(session, "token-1", time.Hour); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	for key := range redis.values {
+		if key == sessionRefreshKey("token-1") {
+			t.Fatal("Expected only refresh token hash to be stored")
+		}
+	}
+
+	found, err := store.GetByRefreshToken("token-1")
+	if err != nil || found.ID != "s1" {
+		t.Fatalf("Expected session s1, got %v, %v", found, err)
+	}
+
+	if err := store.Rotate(found, "token-1", "token-2", time.Hour); err != nil {
+		t.Fatalf("Rotate failed: %v", err)
+	}
+	if _, err := store.GetByRefreshToken("token-1"); !errors.Is(err, ErrSessionNotFound) {
+		t.Errorf("Expected old refresh token to be rejected, got %v", err)
+	}
+	// Повторная ротация тем же токеном не проходит
+	if err := store.Rotate(found, "token-1", "token-3", time.Hour); !errors.Is(err, ErrSessionNotFound) {
+		t.Errorf("Expected reused token rotation to fail, got %v", err)
+	}
+	if _, err := store.GetByRefreshToken("token-2"); err != nil {
+		t.Errorf("Expected new refresh token to be valid, got %v", err)
+	}
+}
+
+func TestDashboardSessionStore_Revoke(t *testing.T) {
+	redis := newMemoryRedis()
+	store := NewDashboardSessionStore(redis)
+	email := "manager@example.com"
+
+	now := time.Now()
+	for i, id := range []string{"s1", "s2", "s3"} {
+		session := &DashboardSession{ID: id, Email: email, CreatedAt: now, LastUsedAt: now.Add(time.Duration(i) * time.Minute)}
+		if err := store.Save(session, "token-"+id, time.Hour); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+	}
+
+	sessions, err := store.List(email)
+	if err != nil || len(sessions) != 3 || sessions[0].ID != "s3" {
+		t.Fatalf("Expected 3 sessions, most recent first, got %v, %v", sessions, err)
+	}
+
+	if err := store.Revoke("other@example.com", "s1"); !errors.Is(err, ErrSessionNotFound) {
+		t.Errorf("Expected foreign session revoke to fail, got %v", err)
+	}
+	if err := store.Revoke(email, "s1"); err != nil {
+		t.Fatalf("Revoke failed: %v", err)
+	}
+	if _, err := store.GetByRefreshToken("token-s1"); !errors.Is(err, ErrSessionNotFound) {
+		t.Errorf("Expected revoked session token to be rejected, got %v", err)
+	}
+
+	// Истёкшая сессия пропадает из списка
+	redis.Delete(sessionKey("s2"))
+	redis.Delete(sessionRefreshKey(hashSessionToken("token-s2")))
+	if sessions, _ := store.List(email); len(sessions) != 1 || sessions[0].ID != "s3" {
+		t.Errorf("Expected only s3 to remain, got %v", sessions)
+	}
+
+	if ids, err := store.RevokeAll(email); err != nil || len(ids) != 1 || ids[0] != "s3" {
+		t.Fatalf("Expected s3 to be revoked, got %v, %v", ids, err)
+	}
+	if _, err := store.GetByRefreshToken("token-s3"); !errors.Is(err, ErrSessionNotFound) {
+		t.Errorf("Expected all sessions to be revoked, got %v", err)
+	}
+	if len(redis.values) != 0 || len(redis.sets) != 0 {
+		t.Errorf("Expected no keys left, got %v, %v", redis.values, redis.sets)
+	}
+}
+
+// brokenRedis отвечает ошибкой на чтение одного ключа, как при сбое Redis
+type brokenRedis struct {
+	*memoryRedis
+	key string
+}
+
+func (b *brokenRedis) Get(key string) (string, error) {
+	if key == b.key {
+		return "", errors.New("connection reset")
+	}
+	return b.memoryRedis.Get(key)
+}
+
+func TestDashboardSessionStore_ListKeepsSessionOnRedisError(t *testing.T) {
+	redis := &brokenRedis{memoryRedis: newMemoryRedis()}
+	store := NewDashboardSessionStore(redis)
+	email := "manager@example.com"
+
+	session := &DashboardSession{ID: "s1", Email: email, CreatedAt: time.Now(), LastUsedAt: time.Now()}
+	if err := store.Save(session, "token-s1", time.Hour); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	redis.key = sessionKey("s1")
+	if _, err := store.List(email); err == nil {
+		t.Fatal("Expected List to fail while the session can't be read")
+	}
+	if _, err := store.RevokeAll(email); err == nil {
+		t.Fatal("Expected RevokeAll to fail while the session can't be read")
+	}
+
+	// После восстановления Redis сессия всё ещё в индексе и отзывается
+	redis.key = ""
+	if ids, err := store.RevokeAll(email); err != nil || len(ids) != 1 || ids[0] != "s1" {
+		t.Fatalf("Expected s1 to be revoked, got %v, %v", ids, err)
+	}
+	if _, err := store.GetByRefreshToken("token-s1"); !errors.Is(err, ErrSessionNotFound) {
+		t.Errorf("Expected revoked session token to be rejected, got %v", err)
+	}
+}
@@ -3,116 +3,180 @@ package services
 import (
 	"crypto/rand"
 	"encoding/hex"
+	"errors"
+	"fmt"
 	"io"
 	"log"
-	"mime/multipart"
-	"os"
+	"path"
 	"path/filepath"
 	"strings"
+	"time"
 )
 
-const uploadDir = "uploads"
 const tempDir = "temp"
 
 type FileSystemService interface {
-	SaveToTemp(file multipart.File, filename string) (string, error)
-	CleanTemp()
+	SaveToTemp(file io.Reader, filename string) (string, error)
+	// WriteToTemp stores a file derived from an upload, e.g. an image variant,
+	// under the given name.
+	WriteToTemp(file io.Reader, filename string) error
+	// CleanTemp deletes temporary uploads last modified before the cutoff and
+	// returns how many files were removed.
+	CleanTemp(before time.Time) (int, error)
 	MoveToPermanent(filenames []string, folder string) error
 	Delete(filenames []string, folder string) error
 }
 
-type fileSystemService struct{}
-
-func NewFileSystemService() FileSystemService {
-	return &fileSystemService{}
+type fileSystemService struct {
+	storage StorageDriver
 }
 
-func (s *fileSystemService) SaveToTemp(file multipart.File, filename string) (string, error) {
-	dir := filepath.Join(uploadDir, tempDir)
-	err := os.MkdirAll(dir, os.ModePerm)
-	if err != nil {
-		log.Printf("failed to create temp directory: %v", err)
-		return "", err
+func NewFileSystemService(storage StorageDriver) FileSystemService {
+	return &fileSystemService{
+		storage: storage,
 	}
+}
 
+func (s *fileSystemService) SaveToTemp(file io.Reader, filename string) (string, error) {
 	newFilename, err := s.getNewFileName(filename)
 	if err != nil {
 		log.Printf("failed to generate new filename: %v", err)
 		return "", err
 	}
 
-	tempFilePath := filepath.Join(dir, newFilename)
-	outFile, err := os.Create(tempFilePath)
-	if err != nil {
-		log.Printf("failed to create temporary file: %v", err)
+	key := path.Join(tempDir, newFilename)
+	if err := s.storage.Put(key, file, contentTypeByKey(key)); err != nil {
+		log.Printf("failed to write file contents: %v", err)
 		return "", err
 	}
 
-	defer func(outFile *os.File) {
-		err := outFile.Close()
-		if err != nil {
-			log.Printf("failed to close temporary file: %v", err)
-		}
-	}(outFile)
+	return newFilename, nil
+}
 
-	if _, err := io.Copy(outFile, file); err != nil {
+func (s *fileSystemService) WriteToTemp(file io.Reader, filename string) error {
+	key := path.Join(tempDir, filename)
+	if err := s.storage.Put(key, file, contentTypeByKey(key)); err != nil {
 		log.Printf("failed to write file contents: %v", err)
-		return "", err
+		return err
 	}
 
-	return newFilename, nil
+	return nil
 }
 
-func (s *fileSystemService) CleanTemp() {
-	tempDirPath := filepath.Join(uploadDir, tempDir)
-	err := os.RemoveAll(tempDirPath)
+func (s *fileSystemService) CleanTemp(before time.Time) (int, error) {
+	objects, err := s.storage.List(tempDir + "/")
 	if err != nil {
-		log.Printf("failed to clean temporary upload directory: %v", err)
+		log.Printf("failed to list temporary uploads: %v", err)
+		return 0, err
 	}
-}
 
-func (s *fileSystemService) MoveToPermanent(filenames []string, folder string) error {
-	permanentDir := s.getPermanentPath(folder)
-	err := os.MkdirAll(permanentDir, os.ModePerm)
-	if err != nil {
-		log.Printf("failed to create permanent upload directory: %v", err)
-		return err
+	var errs []error
+	deleted := 0
+	for _, object := range objects {
+		if !object.LastModified.Before(before) {
+			continue
+		}
+		if err := s.storage.Delete(object.Key); err != nil {
+			log.Printf("failed to delete temporary upload %s: %v", object.Key, err)
+			errs = append(errs, err)
+			continue
+		}
+		deleted++
 	}
 
+	return deleted, errors.Join(errs...)
+}
+
+// MoveToPermanent moves uploaded files and their variants from the temporary
+// directory. Files that are already in the folder are skipped, so a retried
+// move succeeds. Variants are moved first: once the file itself is in the
+// folder, the whole set is.
+func (s *fileSystemService) MoveToPermanent(filenames []string, folder string) error {
+	var errs []error
 	for _, filename := range filenames {
-		tempFilePath := filepath.Join(uploadDir, tempDir, filename)
-		permanentFilePath := filepath.Join(permanentDir, filename)
+		if filename == "" {
+			continue
+		}
+
+		tempKey := path.Join(tempDir, filename)
+		permanentKey := s.getPermanentKey(folder, filename)
 
-		if _, err := os.Stat(tempFilePath); os.IsNotExist(err) {
-			log.Printf("temporary file does not exist: %s", tempFilePath)
+		if _, err := s.storage.Stat(tempKey); errors.Is(err, ErrObjectNotFound) {
+			if _, err := s.storage.Stat(permanentKey); err == nil {
+				continue
+			}
+			errs = append(errs, fmt.Errorf("file %s not found in temporary storage", filename))
 			continue
 		}
 
-		if err := os.Rename(tempFilePath, permanentFilePath); err != nil {
-			log.Printf("failed to move file from %s to %s: %v", tempFilePath, permanentFilePath, err)
+		variants, err := s.variantKeys(tempDir, filename)
+		if err != nil {
+			errs = append(errs, err)
 			continue
 		}
+		for _, key := range variants {
+			if err := s.storage.Move(key, s.getPermanentKey(folder, path.Base(key))); err != nil {
+				errs = append(errs, fmt.Errorf("failed to move file %s: %w", key, err))
+			}
+		}
+
+		if err := s.storage.Move(tempKey, permanentKey); err != nil {
+			errs = append(errs, fmt.Errorf("failed to move file from %s to %s: %w", tempKey, permanentKey, err))
+		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
+// Delete removes files and their variants from the folder; missing files are
+// not an error.
 func (s *fileSystemService) Delete(filename []string, folder string) error {
-	permanentDir := s.getPermanentPath(folder)
-
 	for _, file := range filename {
-		filePath := filepath.Join(permanentDir, file)
-		if err := os.Remove(filePath); err != nil {
-			log.Printf("failed to delete file %s: %v", filePath, err)
+		if file == "" {
+			continue
+		}
+
+		variants, err := s.variantKeys(folder, file)
+		if err != nil {
+			log.Printf("failed to list variants of %s: %v", file, err)
 			return err
 		}
+
+		for _, key := range append(variants, s.getPermanentKey(folder, file)) {
+			if err := s.storage.Delete(key); err != nil {
+				log.Printf("failed to delete file %s: %v", key, err)
+				return err
+			}
+		}
 	}
 
 	return nil
 }
 
-func (s *fileSystemService) getPermanentPath(folder string) string {
-	return filepath.Join(uploadDir, folder)
+// variantKeys lists files generated from filename in dir: photo_1a2b@thumb.png,
+// photo_1a2b.webp and so on. The file itself is not included.
+func (s *fileSystemService) variantKeys(dir, filename string) ([]string, error) {
+	stemKey := path.Join(dir, strings.TrimSuffix(filename, path.Ext(filename)))
+	objects, err := s.storage.List(stemKey)
+	if err != nil {
+		return nil, err
+	}
+
+	fileKey := path.Join(dir, filename)
+	keys := make([]string, 0, len(objects))
+	for _, object := range objects {
+		rest := strings.TrimPrefix(object.Key, stemKey)
+		if object.Key == fileKey || (!strings.HasPrefix(rest, "@") && !strings.HasPrefix(rest, ".")) {
+			continue
+		}
+		keys = append(keys, object.Key)
+	}
+
+	return keys, nil
+}
+
+func (s *fileSystemService) getPermanentKey(folder, filename string) string {
+	return path.Join(folder, filename)
 }
 
 func (s *fileSystemService) getNewFileName(filename string) (string, error) {
@@ -122,7 +186,7 @@ func (s *fileSystemService) getNewFileName(filename string) (string, error) {
 	}
 	randomPrefix := hex.EncodeToString(randomBytes)
 	ext := filepath.Ext(filename)
-	name := strings.TrimSuffix(filename, ext)
+	name := strings.TrimSuffix(filepath.Base(filename), ext)
 	newName := name + "_" + randomPrefix + ext
 
 	return newName, nil
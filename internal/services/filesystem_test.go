@@ -0,0 +1,127 @@
+package services
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// tempFile satisfies multipart.File for SaveToTemp.
+func tempFile(t *testing.T, content string) *os.File {
+	t.Helper()
+
+	file, err := os.CreateTemp(t.TempDir(), "upload")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { file.Close() })
+
+	if _, err := file.WriteString(content); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := file.Seek(0, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	return file
+}
+
+func TestFileSystemService_SaveAndMove(t *testing.T) {
+	storage := NewMemoryStorage()
+	svc := NewFileSystemService(storage)
+
+	filename, err := svc.SaveToTemp(tempFile(t, "data"), "photo.png")
+	if err != nil {
+		t.Fatalf("SaveToTemp failed: %v", err)
+	}
+	if !strings.HasPrefix(filename, "photo_") || !strings.HasSuffix(filename, ".png") {
+		t.Errorf("Unexpected filename: %s", filename)
+	}
+
+	info, err := storage.Stat("temp/" + filename)
+	if err != nil {
+		t.Fatalf("Expected temp object, got %v", err)
+	}
+	if info.ContentType != "image/png" {
+		t.Errorf("Expected image/png, got %s", info.ContentType)
+	}
+
+	if err := svc.MoveToPermanent([]string{filename, ""}, "images/category"); err != nil {
+		t.Fatalf("MoveToPermanent failed: %v", err)
+	}
+	if _, err := storage.Stat("images/category/" + filename); err != nil {
+		t.Errorf("Expected permanent object, got %v", err)
+	}
+
+	// Повторный перенос (ретрай задачи очереди) не должен падать
+	if err := svc.MoveToPermanent([]string{filename}, "images/category"); err != nil {
+		t.Errorf("Expected repeated move to succeed, got %v", err)
+	}
+	if err := svc.MoveToPermanent([]string{"missing.png"}, "images/category"); err == nil {
+		t.Error("Expected error for missing file")
+	}
+
+	if err := svc.Delete([]string{filename, "missing.png"}, "images/category"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if _, err := storage.Stat("images/category/" + filename); !errors.Is(err, ErrObjectNotFound) {
+		t.Errorf("Expected object to be deleted, got %v", err)
+	}
+}
+
+func TestFileSystemService_CleanTemp(t *testing.T) {
+	storage := NewMemoryStorage()
+	svc := NewFileSystemService(storage)
+
+	storage.Put("temp/a.png", strings.NewReader("a"), "")
+	storage.Put("temp/b.png", strings.NewReader("b"), "")
+	storage.Put("images/category/c.png", strings.NewReader("c"), "")
+
+	// Свежие загрузки не трогаем
+	if deleted, err := svc.CleanTemp(time.Now().Add(-time.Hour)); err != nil || deleted != 0 {
+		t.Fatalf("Expected nothing to be deleted, got %d, %v", deleted, err)
+	}
+	if objects, _ := storage.List("temp/"); len(objects) != 2 {
+		t.Errorf("Expected fresh temp files to stay, got %v", objects)
+	}
+
+	if deleted, err := svc.CleanTemp(time.Now().Add(time.Second)); err != nil || deleted != 2 {
+		t.Fatalf("Expected 2 deleted files, got %d, %v", deleted, err)
+	}
+	if objects, _ := storage.List("temp/"); len(objects) != 0 {
+		t.Errorf("Expected temp to be empty, got %v", objects)
+	}
+	if _, err := storage.Stat("images/category/c.png"); err != nil {
+		t.Errorf("Expected permanent file to stay, got %v", err)
+	}
+}
+
+func TestFileSystemService_MovesVariants(t *testing.T) {
+	storage := NewMemoryStorage()
+	svc := NewFileSystemService(storage)
+
+	for _, name := range []string{"a_1.png", "a_1.webp", "a_1@thumb.png", "a_1@thumb.webp", "a_10.png"} {
+		storage.Put("temp/"+name, strings.NewReader(name), "")
+	}
+
+	if err := svc.MoveToPermanent([]string{"a_1.png"}, "images/shade"); err != nil {
+		t.Fatalf("MoveToPermanent failed: %v", err)
+	}
+
+	moved, _ := storage.List("images/shade/")
+	if len(moved) != 4 {
+		t.Errorf("Expected the file and 3 variants to be moved, got %v", moved)
+	}
+	if _, err := storage.Stat("temp/a_10.png"); err != nil {
+		t.Errorf("Expected an unrelated file to stay in temp, got %v", err)
+	}
+
+	if err := svc.Delete([]string{"a_1.png"}, "images/shade"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if left, _ := storage.List("images/shade/"); len(left) != 0 {
+		t.Errorf("Expected variants to be deleted, got %v", left)
+	}
+}
@@ -0,0 +1,285 @@
+package services
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"path"
+	"strings"
+
+	"golang.org/x/image/draw"
+)
+
+const (
+	// ImageFitCover обрезает изображение по центру до пропорций варианта.
+	ImageFitCover = "cover"
+	// ImageFitContain вписывает изображение в размеры варианта целиком.
+	ImageFitContain = "contain"
+
+	jpegQuality = 85
+	// maxImagePixels — наибольший размер из правил constraint для типов изображений
+	// (6000×6000). Больше декодировать не нужно: такие файлы не проходят валидацию.
+	maxImagePixels = 6000 * 6000
+)
+
+var ErrInvalidImage = errors.New("invalid image")
+
+type ImageVariant struct {
+	Name   string
+	Width  int
+	Height int
+	Fit    string
+}
+
+// ImageVariants — варианты, которые генерируются при загрузке для каждого типа
+// изображений. Оригинал сохраняется всегда.
+var ImageVariants = map[string][]ImageVariant{
+	"category": {
+		{Name: "thumb", Width: 400, Height: 400, Fit: ImageFitCover},
+		{Name: "header", Width: 1920, Height: 600, Fit: ImageFitCover},
+	},
+	"shade": {
+		{Name: "swatch", Width: 96, Height: 96, Fit: ImageFitCover},
+	},
+	"product": {},
+}
+
+// ImageRendition — одна закодированная версия загруженного изображения.
+// Variant пустой для оригинала.
+type ImageRendition struct {
+	Variant string
+	Ext     string
+	Width   int
+	Height  int
+	Data    []byte
+}
+
+// ProcessImage декодирует изображение, поворачивает его по EXIF Orientation и
+// кодирует заново (метаданные при этом не переносятся), затем строит варианты.
+// Каждая версия сохраняется в формате исходного файла и в WebP. ext задаёт
+// расширение исходного файла, по нему выбирается основной формат.
+func ProcessImage(data []byte, ext string, variants []ImageVariant) ([]ImageRendition, error) {
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImage, err)
+	}
+	if config.Width*config.Height > maxImagePixels {
+		return nil, fmt.Errorf("%w: image is too large", ErrInvalidImage)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImage, err)
+	}
+	if format == "jpeg" {
+		img = applyOrientation(img, jpegOrientation(data))
+	}
+
+	primary := strings.ToLower(ext)
+	switch primary {
+	case ".jpg", ".jpeg", ".png":
+	default:
+		primary = "." + format
+	}
+
+	renditions := make([]ImageRendition, 0, 2*(len(variants)+1))
+	add := func(variant string, img image.Image) error {
+		encoded, err := encodeImage(img, primary)
+		if err != nil {
+			return err
+		}
+		var webp bytes.Buffer
+		if err := EncodeWebP(&webp, img); err != nil {
+			return err
+		}
+
+		size := img.Bounds().Size()
+		renditions = append(renditions,
+			ImageRendition{Variant: variant, Ext: ext, Width: size.X, Height: size.Y, Data: encoded},
+			ImageRendition{Variant: variant, Ext: ".webp", Width: size.X, Height: size.Y, Data: webp.Bytes()},
+		)
+		return nil
+	}
+
+	if err := add("", img); err != nil {
+		return nil, err
+	}
+	for _, variant := range variants {
+		if err := add(variant.Name, resizeImage(img, variant)); err != nil {
+			return nil, err
+		}
+	}
+
+	return renditions, nil
+}
+
+// VariantFilename возвращает имя файла варианта: photo_1a2b.png ->
+// photo_1a2b@thumb.webp. Пустой variant означает сам оригинал.
+func VariantFilename(filename, variant, ext string) string {
+	stem := strings.TrimSuffix(filename, path.Ext(filename))
+	if variant != "" {
+		stem += "@" + variant
+	}
+	if ext == "" {
+		ext = path.Ext(filename)
+	}
+	return stem + ext
+}
+
+func encodeImage(img image.Image, ext string) ([]byte, error) {
+	var buf bytes.Buffer
+	var err error
+	switch ext {
+	case ".png":
+		encoder := png.Encoder{CompressionLevel: png.BestCompression}
+		err = encoder.Encode(&buf, img)
+	default:
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// resizeImage уменьшает изображение до размеров варианта. Изображения меньше
+// варианта не увеличиваются.
+func resizeImage(src image.Image, variant ImageVariant) image.Image {
+	bounds := src.Bounds()
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+	crop := bounds
+	dstW, dstH := variant.Width, variant.Height
+
+	if variant.Fit == ImageFitCover {
+		// Обрезаем по центру до пропорций варианта
+		if srcW*variant.Height > srcH*variant.Width {
+			w := srcH * variant.Width / variant.Height
+			crop.Min.X += (srcW - w) / 2
+			crop.Max.X = crop.Min.X + w
+		} else {
+			h := srcW * variant.Height / variant.Width
+			crop.Min.Y += (srcH - h) / 2
+			crop.Max.Y = crop.Min.Y + h
+		}
+		if crop.Dx() < dstW {
+			dstW, dstH = crop.Dx(), max(crop.Dy(), 1)
+		}
+	} else {
+		scale := min(float64(variant.Width)/float64(srcW), float64(variant.Height)/float64(srcH), 1)
+		dstW = max(int(float64(srcW)*scale+0.5), 1)
+		dstH = max(int(float64(srcH)*scale+0.5), 1)
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, max(dstW, 1), max(dstH, 1)))
+	draw.CatmullRom.Scale(dst, dst.Rect, src, crop, draw.Src, nil)
+
+	return dst
+}
+
+// applyOrientation поворачивает и отражает изображение так, как указано в теге
+// EXIF Orientation (значения 2–8).
+func applyOrientation(src image.Image, orientation int) image.Image {
+	if orientation < 2 || orientation > 8 {
+		return src
+	}
+
+	bounds := src.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+	dstW, dstH := w, h
+	if orientation >= 5 {
+		dstW, dstH = h, w
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstW, dstH))
+	for y := 0; y < dstH; y++ {
+		for x := 0; x < dstW; x++ {
+			var sx, sy int
+			switch orientation {
+			case 2:
+				sx, sy = w-1-x, y
+			case 3:
+				sx, sy = w-1-x, h-1-y
+			case 4:
+				sx, sy = x, h-1-y
+			case 5:
+				sx, sy = y, x
+			case 6:
+				sx, sy = y, h-1-x
+			case 7:
+				sx, sy = w-1-y, h-1-x
+			case 8:
+				sx, sy = w-1-y, x
+			}
+			dst.Set(x, y, src.At(bounds.Min.X+sx, bounds.Min.Y+sy))
+		}
+	}
+
+	return dst
+}
+
+// jpegOrientation читает тег Orientation из сегмента APP1 (EXIF). Если тега
+// нет или он повреждён, возвращает 1.
+func jpegOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xff || data[1] != 0xd8 {
+		return 1
+	}
+
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xff {
+			return 1
+		}
+		marker := data[i+1]
+		if marker == 0xda || marker == 0xd9 {
+			return 1
+		}
+		length := int(binary.BigEndian.Uint16(data[i+2:]))
+		if length < 2 || i+2+length > len(data) {
+			return 1
+		}
+		segment := data[i+4 : i+2+length]
+		if marker == 0xe1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return tiffOrientation(segment[6:])
+		}
+		i += 2 + length
+	}
+
+	return 1
+}
+
+func tiffOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+
+	offset := int(order.Uint32(tiff[4:]))
+	if offset < 8 || offset+2 > len(tiff) {
+		return 1
+	}
+	count := int(order.Uint16(tiff[offset:]))
+	for i := 0; i < count; i++ {
+		entry := offset + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+		// 0x0112 — Orientation, тип SHORT
+		if order.Uint16(tiff[entry:]) == 0x0112 && order.Uint16(tiff[entry+2:]) == 3 {
+			return int(order.Uint16(tiff[entry+8:]))
+		}
+	}
+
+	return 1
+}
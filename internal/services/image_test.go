@@ -0,0 +1,198 @@
+package services
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"strings"
+	"testing"
+
+	"golang.org/x/image/webp"
+)
+
+// jpegWithOrientation кодирует изображение в JPEG и добавляет сегмент EXIF с
+// тегом Orientation.
+func jpegWithOrientation(t *testing.T, img image.Image, orientation uint16) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	tiff := []byte{'M', 'M', 0, 42, 0, 0, 0, 8, 0, 1, 0x01, 0x12, 0, 3, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0}
+	binary.BigEndian.PutUint16(tiff[18:], orientation)
+	segment := append([]byte("Exif\x00\x00"), tiff...)
+
+	app1 := []byte{0xff, 0xe1, 0, 0}
+	binary.BigEndian.PutUint16(app1[2:], uint16(len(segment)+2))
+	app1 = append(app1, segment...)
+
+	data := buf.Bytes()
+	return append(append(append([]byte{}, data[:2]...), app1...), data[2:]...)
+}
+
+func TestProcessImage_JPEG(t *testing.T) {
+	// Левая половина красная, правая синяя; после поворота на 90° по часовой
+	// красная окажется сверху
+	src := image.NewRGBA(image.Rect(0, 0, 800, 600))
+	for y := 0; y < 600; y++ {
+		for x := 0; x < 800; x++ {
+			c := color.RGBA{R: 255, A: 255}
+			if x >= 400 {
+				c = color.RGBA{B: 255, A: 255}
+			}
+			src.Set(x, y, c)
+		}
+	}
+	data := jpegWithOrientation(t, src, 6)
+	if jpegOrientation(data) != 6 {
+		t.Fatalf("Expected orientation 6, got %d", jpegOrientation(data))
+	}
+
+	renditions, err := ProcessImage(data, ".jpg", ImageVariants["category"])
+	if err != nil {
+		t.Fatalf("ProcessImage failed: %v", err)
+	}
+	if len(renditions) != 6 {
+		t.Fatalf("Expected 6 renditions, got %d", len(renditions))
+	}
+
+	expected := []struct {
+		variant       string
+		ext           string
+		width, height int
+	}{
+		{"", ".jpg", 600, 800},
+		{"", ".webp", 600, 800},
+		{"thumb", ".jpg", 400, 400},
+		{"thumb", ".webp", 400, 400},
+		{"header", ".jpg", 600, 187},
+		{"header", ".webp", 600, 187},
+	}
+	for i, want := range expected {
+		r := renditions[i]
+		if r.Variant != want.variant || r.Ext != want.ext || r.Width != want.width || r.Height != want.height {
+			t.Errorf("Rendition %d: expected %+v, got %s%s %dx%d", i, want, r.Variant, r.Ext, r.Width, r.Height)
+		}
+		if bytes.Contains(r.Data, []byte("Exif")) {
+			t.Errorf("Rendition %d still contains EXIF", i)
+		}
+	}
+
+	original, err := jpeg.Decode(bytes.NewReader(renditions[0].Data))
+	if err != nil {
+		t.Fatalf("Failed to decode original: %v", err)
+	}
+	if r, _, b, _ := original.At(300, 100).RGBA(); r>>8 < 200 || b>>8 > 50 {
+		t.Errorf("Expected red at the top after rotation, got r=%d b=%d", r>>8, b>>8)
+	}
+
+	if _, err := webp.Decode(bytes.NewReader(renditions[3].Data)); err != nil {
+		t.Errorf("Failed to decode WebP variant: %v", err)
+	}
+}
+
+func TestProcessImage_PNGKeepsFormatAndAlpha(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 50, 50))
+	src.SetNRGBA(10, 10, color.NRGBA{R: 10, G: 20, B: 30, A: 128})
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, src); err != nil {
+		t.Fatal(err)
+	}
+
+	renditions, err := ProcessImage(buf.Bytes(), ".png", ImageVariants["shade"])
+	if err != nil {
+		t.Fatalf("ProcessImage failed: %v", err)
+	}
+
+	// Изображение меньше варианта не увеличивается
+	if r := renditions[2]; r.Variant != "swatch" || r.Width != 50 || r.Height != 50 {
+		t.Errorf("Unexpected swatch: %s %dx%d", r.Variant, r.Width, r.Height)
+	}
+
+	decoded, err := png.Decode(bytes.NewReader(renditions[0].Data))
+	if err != nil {
+		t.Fatalf("Expected PNG output: %v", err)
+	}
+	if _, _, _, a := decoded.At(10, 10).RGBA(); a>>8 != 128 {
+		t.Errorf("Expected alpha to be kept, got %d", a>>8)
+	}
+}
+
+func TestProcessImage_Invalid(t *testing.T) {
+	if _, err := ProcessImage([]byte("not an image"), ".png", nil); !errors.Is(err, ErrInvalidImage) {
+		t.Errorf("Expected ErrInvalidImage, got %v", err)
+	}
+}
+
+// pngHeader возвращает начало PNG с заголовком IHDR: размеры читаются из него,
+// до декодирования пикселей дело не доходит.
+func pngHeader(width, height uint32) []byte {
+	ihdr := make([]byte, 17)
+	copy(ihdr, "IHDR")
+	binary.BigEndian.PutUint32(ihdr[4:], width)
+	binary.BigEndian.PutUint32(ihdr[8:], height)
+	ihdr[12], ihdr[13] = 8, 2 // 8 бит, RGB
+
+	data := []byte("\x89PNG\r\n\x1a\n")
+	data = binary.BigEndian.AppendUint32(data, 13)
+	data = append(data, ihdr...)
+	return binary.BigEndian.AppendUint32(data, crc32.ChecksumIEEE(ihdr))
+}
+
+func TestProcessImage_TooLarge(t *testing.T) {
+	_, err := ProcessImage(pngHeader(6001, 6000), ".png", nil)
+	if !errors.Is(err, ErrInvalidImage) || !strings.Contains(err.Error(), "too large") {
+		t.Errorf("Expected image over the pixel limit to be rejected, got %v", err)
+	}
+}
+
+func TestApplyOrientation(t *testing.T) {
+	// 2x1: левый пиксель чёрный, правый белый
+	src := image.NewGray(image.Rect(0, 0, 2, 1))
+	src.Pix[1] = 255
+
+	cases := map[int]struct {
+		w, h  int
+		white image.Point
+	}{
+		1: {2, 1, image.Pt(1, 0)},
+		2: {2, 1, image.Pt(0, 0)},
+		3: {2, 1, image.Pt(0, 0)},
+		4: {2, 1, image.Pt(1, 0)},
+		5: {1, 2, image.Pt(0, 1)},
+		6: {1, 2, image.Pt(0, 1)},
+		7: {1, 2, image.Pt(0, 0)},
+		8: {1, 2, image.Pt(0, 0)},
+	}
+	for orientation, want := range cases {
+		dst := applyOrientation(src, orientation)
+		if dst.Bounds().Dx() != want.w || dst.Bounds().Dy() != want.h {
+			t.Errorf("Orientation %d: expected %dx%d, got %v", orientation, want.w, want.h, dst.Bounds())
+			continue
+		}
+		if r, _, _, _ := dst.At(want.white.X, want.white.Y).RGBA(); r != 0xffff {
+			t.Errorf("Orientation %d: expected white pixel at %v", orientation, want.white)
+		}
+	}
+}
+
+func TestVariantFilename(t *testing.T) {
+	cases := []struct{ variant, ext, want string }{
+		{"", "", "photo_1a2b.png"},
+		{"", ".webp", "photo_1a2b.webp"},
+		{"thumb", "", "photo_1a2b@thumb.png"},
+		{"thumb", ".webp", "photo_1a2b@thumb.webp"},
+	}
+	for _, c := range cases {
+		if got := VariantFilename("photo_1a2b.png", c.variant, c.ext); got != c.want {
+			t.Errorf("Expected %s, got %s", c.want, got)
+		}
+	}
+}
@@ -8,6 +8,10 @@ import (
 	"time"
 )
 
+// DashboardAccessTokenTTL is the lifetime of dashboard access tokens; revoked
+// tokens stay in the denylist for the same time.
+const DashboardAccessTokenTTL = 1 * time.Hour
+
 type DashboardCredentials struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
@@ -18,8 +22,9 @@ type ClientCredentials struct {
 }
 
 type DashboardClaims struct {
-	Email string `json:"email"`
-	Role  string `json:"role"`
+	Email     string `json:"email"`
+	Role      string `json:"role"`
+	SessionID string `json:"sid,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -34,7 +39,7 @@ type TokenPair struct {
 }
 
 type JWTService interface {
-	GenerateDashboardTokenPair(email, role string) (*TokenPair, error)
+	GenerateDashboardTokenPair(email, role, sessionID string) (*TokenPair, error)
 	GenerateClientTokenPair(phone string) (*TokenPair, error)
 	ValidateDashboardToken(tokenString string) (*DashboardClaims, error)
 	ValidateClientToken(tokenString string) (*ClientClaims, error)
@@ -53,13 +58,21 @@ func NewJWTService(dashboardSecret, clientSecret string) JWTService {
 	}
 }
 
-func (s *jwtService) GenerateDashboardTokenPair(email, role string) (*TokenPair, error) {
-	expirationTime := time.Now().Add(1 * time.Hour)
+func (s *jwtService) GenerateDashboardTokenPair(email, role, sessionID string) (*TokenPair, error) {
+	tokenID, err := s.generateTokenID()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
 	claims := &DashboardClaims{
-		Email: email,
-		Role:  role,
+		Email:     email,
+		Role:      role,
+		SessionID: sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(expirationTime),
+			ID:        tokenID,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(DashboardAccessTokenTTL)),
 		},
 	}
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
@@ -133,6 +146,16 @@ func (s *jwtService) ValidateClientToken(tokenString string) (*ClientClaims, err
 	return nil, errors.New("invalid client token")
 }
 
+// generateTokenID returns a jti used to revoke a single access token.
+func (s *jwtService) generateTokenID() (string, error) {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(bytes), nil
+}
+
 func (s *jwtService) generateRefreshToken() (string, error) {
 	bytes := make([]byte, 32)
 	if _, err := rand.Read(bytes); err != nil {
@@ -10,7 +10,7 @@ func TestJWTService_GenerateDashboardTokenPair(t *testing.T) {
 	email := "test@example.com"
 	role := "admin"
 
-	tokenPair, err := jwtService.GenerateDashboardTokenPair(email, role)
+	tokenPair, err := jwtService.GenerateDashboardTokenPair(email, role, "session-id")
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
 	}
@@ -53,7 +53,7 @@ func TestJWTService_ValidateDashboardToken(t *testing.T) {
 	email := "test@example.com"
 	role := "admin"
 
-	tokenPair, err := jwtService.GenerateDashboardTokenPair(email, role)
+	tokenPair, err := jwtService.GenerateDashboardTokenPair(email, role, "session-id")
 	if err != nil {
 		t.Fatalf("Failed to generate token pair: %v", err)
 	}
@@ -70,6 +70,10 @@ func TestJWTService_ValidateDashboardToken(t *testing.T) {
 	if claims.Role != role {
 		t.Errorf("Expected role %s, got %s", role, claims.Role)
 	}
+
+	if claims.SessionID != "session-id" {
+		t.Errorf("Expected session id session-id, got %s", claims.SessionID)
+	}
 }
 
 func TestJWTService_ValidateClientToken(t *testing.T) {
@@ -113,7 +117,7 @@ func TestJWTService_ValidateTokenWithWrongSecret(t *testing.T) {
 	email := "test@example.com"
 	role := "admin"
 
-	tokenPair, err := jwtService1.GenerateDashboardTokenPair(email, role)
+	tokenPair, err := jwtService1.GenerateDashboardTokenPair(email, role, "session-id")
 	if err != nil {
 		t.Fatalf("Failed to generate token pair: %v", err)
 	}
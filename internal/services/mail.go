@@ -0,0 +1,138 @@
+package services
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Mail is a plain-text email message.
+type Mail struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer delivers emails to dashboard users.
+type Mailer interface {
+	Send(mail Mail) error
+}
+
+type SMTPOptions struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+}
+
+// NewMailer returns the mailer selected by driver: "log" for local development
+// or "smtp".
+func NewMailer(driver, logFile string, opts SMTPOptions) (Mailer, error) {
+	switch driver {
+	case "log":
+		return NewLogMailer(logFile), nil
+	case "smtp":
+		return NewSMTPMailer(opts)
+	default:
+		return nil, fmt.Errorf("unknown mail driver %q", driver)
+	}
+}
+
+// logMailer is intended for local development: emails are written to the
+// application log and, if filePath is set, appended to that file.
+type logMailer struct {
+	filePath string
+	mu       sync.Mutex
+}
+
+func NewLogMailer(filePath string) Mailer {
+	return &logMailer{
+		filePath: filePath,
+	}
+}
+
+func (m *logMailer) Send(mail Mail) error {
+	log.Printf("[Mail] to %s: %s\n%s", mail.To, mail.Subject, mail.Body)
+
+	if m.filePath == "" {
+		return nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	file, err := os.OpenFile(m.filePath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = fmt.Fprintf(file, "%s\nTo: %s\nSubject: %s\n\n%s\n\n", time.Now().Format(time.RFC3339), mail.To, mail.Subject, mail.Body)
+	return err
+}
+
+type smtpMailer struct {
+	addr string
+	auth smtp.Auth
+	from mail.Address
+}
+
+func NewSMTPMailer(opts SMTPOptions) (Mailer, error) {
+	if opts.Host == "" {
+		return nil, fmt.Errorf("smtp host is not set")
+	}
+	from, err := mail.ParseAddress(opts.From)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender address %q: %w", opts.From, err)
+	}
+
+	mailer := &smtpMailer{
+		addr: net.JoinHostPort(opts.Host, strconv.Itoa(opts.Port)),
+		from: *from,
+	}
+	// net/smtp отправляет PLAIN только по TLS или на localhost
+	if opts.Username != "" {
+		mailer.auth = smtp.PlainAuth("", opts.Username, opts.Password, opts.Host)
+	}
+
+	return mailer, nil
+}
+
+func (m *smtpMailer) Send(mail Mail) error {
+	return smtp.SendMail(m.addr, m.auth, m.from.Address, []string{mail.To}, buildMailMessage(m.from, mail, time.Now()))
+}
+
+// buildMailMessage formats a UTF-8 plain-text message; the subject is
+// encoded for non-ASCII text and the body is sent in base64.
+func buildMailMessage(from mail.Address, msg Mail, date time.Time) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from.String())
+	fmt.Fprintf(&buf, "To: %s\r\n", msg.To)
+	// Длинная тема делится на несколько encoded-word, каждое на своей строке
+	subject := strings.ReplaceAll(mime.QEncoding.Encode("utf-8", msg.Subject), "?= =?", "?=\r\n =?")
+	fmt.Fprintf(&buf, "Subject: %s\r\n", subject)
+	fmt.Fprintf(&buf, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: base64\r\n\r\n")
+
+	body := base64.StdEncoding.EncodeToString([]byte(msg.Body))
+	for len(body) > 76 {
+		buf.WriteString(body[:76] + "\r\n")
+		body = body[76:]
+	}
+	buf.WriteString(body + "\r\n")
+
+	return buf.Bytes()
+}
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"encoding/base64"
+	"io"
+	"mime"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBuildMailMessage(t *testing.T) {
+	from := mail.Address{Name: "Hair Company", Address: "noreply@example.com"}
+	body := strings.Repeat("Ссылка для сброса пароля: https://example.com/reset?token=abc ", 3)
+	raw := buildMailMessage(from, Mail{To: "user@example.com", Subject: "Сброс пароля", Body: body}, time.Now())
+
+	msg, err := mail.ReadMessage(strings.NewReader(string(raw)))
+	if err != nil {
+		t.Fatalf("Failed to parse message: %v", err)
+	}
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil || subject != "Сброс пароля" {
+		t.Errorf("Unexpected subject %q, %v", subject, err)
+	}
+	if got := msg.Header.Get("From"); got != `"Hair Company" <noreply@example.com>` {
+		t.Errorf("Unexpected From header: %s", got)
+	}
+
+	_, rawBody, _ := strings.Cut(string(raw), "\r\n\r\n")
+	for _, line := range strings.Split(rawBody, "\r\n") {
+		if len(line) > 76 {
+			t.Fatalf("Body line exceeds 76 characters: %q", line)
+		}
+	}
+
+	decoded, err := io.ReadAll(base64.NewDecoder(base64.StdEncoding, msg.Body))
+	if err != nil || string(decoded) != body {
+		t.Errorf("Unexpected body %q, %v", decoded, err)
+	}
+}
+
+func TestLogMailer_WritesFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mail.log")
+	mailer := NewLogMailer(path)
+
+	if err := mailer.Send(Mail{To: "user@example.com", Subject: "Subject", Body: "token: abc"}); err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read mail log: %v", err)
+	}
+	if !strings.Contains(string(data), "To: user@example.com") || !strings.Contains(string(data), "token: abc") {
+		t.Errorf("Unexpected mail log: %s", data)
+	}
+}
+
+func TestNewMailer_InvalidOptions(t *testing.T) {
+	if _, err := NewMailer("pigeon", "", SMTPOptions{}); err == nil {
+		t.Error("Expected error for unknown driver")
+	}
+	if _, err := NewMailer("smtp", "", SMTPOptions{From: "noreply@example.com"}); err == nil {
+		t.Error("Expected error for missing host")
+	}
+	if _, err := NewMailer("smtp", "", SMTPOptions{Host: "smtp.example.com", Port: 587, From: "not an address"}); err == nil {
+		t.Error("Expected error for invalid sender")
+	}
+}
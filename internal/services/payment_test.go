@@ -0,0 +1,49 @@
+package services
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestFakePaymentProvider_HandleCallback(t *testing.T) {
+	provider := NewFakePaymentProvider("secret", "")
+	body := []byte(`{"eventId":"evt_1","paymentId":"fake_1","status":"succeeded","amount":1000}`)
+
+	header := http.Header{}
+	header.Set("X-Payment-Signature", SignFakePaymentCallback("secret", body))
+
+	event, err := provider.HandleCallback(body, header)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if event.EventID != "evt_1" || event.ExternalID != "fake_1" || event.Status != PaymentStatusSucceeded || event.Amount != 1000 {
+		t.Errorf("Unexpected event: %+v", event)
+	}
+
+	header.Set("X-Payment-Signature", SignFakePaymentCallback("other-secret", body))
+	if _, err := provider.HandleCallback(body, header); !errors.Is(err, ErrInvalidPaymentSignature) {
+		t.Errorf("Expected ErrInvalidPaymentSignature, got %v", err)
+	}
+
+	header.Del("X-Payment-Signature")
+	if _, err := provider.HandleCallback(body, header); !errors.Is(err, ErrInvalidPaymentSignature) {
+		t.Errorf("Expected ErrInvalidPaymentSignature for missing signature, got %v", err)
+	}
+}
+
+func TestFakePaymentProvider_CreatePayment(t *testing.T) {
+	provider := NewFakePaymentProvider("secret", "http://localhost:3000/success")
+
+	result, err := provider.CreatePayment(PaymentRequest{Reference: "order-1", Amount: 1000})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.ExternalID == "" || result.Status != PaymentStatusPending || result.ConfirmationURL == "" {
+		t.Errorf("Unexpected payment result: %+v", result)
+	}
+
+	if _, err := provider.CreatePayment(PaymentRequest{Reference: "order-1"}); err == nil {
+		t.Error("Expected error for zero amount")
+	}
+}
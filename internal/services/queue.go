@@ -0,0 +1,259 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"haircompany-shop-rest/pkg/database"
+	"haircompany-shop-rest/pkg/utils"
+	"log"
+	"runtime/debug"
+	"sync"
+	"time"
+)
+
+// Типы фоновых задач и их полезная нагрузка.
+const (
+	QueueMoveFiles   = "files.move"
+	QueueDeleteFiles = "files.delete"
+	QueueSendSMS     = "sms.send"
+
+	QueuePasswordReset = "auth.password_reset"
+)
+
+type FilesPayload struct {
+	Filenames []string   `json:"filenames"`
+	Folder    string     `json:"folder"`
+	Owner     *FileOwner `json:"owner,omitempty"`
+}
+
+// FileOwner — запись, которая ссылается на перемещаемые файлы. Файл
+// переносится в постоянное хранилище, только если запись всё ещё ссылается на
+// него. Fields сопоставляет имя файла с полем записи.
+type FileOwner struct {
+	Type   string            `json:"type"`
+	ID     uint              `json:"id"`
+	Fields map[string]string `json:"fields"`
+}
+
+// SMSPayload — сообщение для отправки. Сообщение с ExpiresAt в прошлом
+// (например, истёкший код входа) уже не отправляется.
+type SMSPayload struct {
+	Phone     string `json:"phone"`
+	Message   string `json:"message"`
+	ExpiresAt int64  `json:"expiresAt,omitempty"`
+}
+
+// PasswordResetPayload — адрес, на который запрошено восстановление пароля.
+// Есть ли такой пользователь, проверяет уже обработчик задачи.
+type PasswordResetPayload struct {
+	Email string `json:"email"`
+}
+
+const (
+	DefaultMaxAttempts = 8
+
+	queuePollInterval = time.Second
+	// queueStaleTimeout должен превышать время выполнения самой долгой задачи,
+	// иначе задача будет запущена повторно.
+	queueStaleTimeout = 10 * time.Minute
+	queueBaseBackoff  = 5 * time.Second
+	queueMaxBackoff   = time.Hour
+)
+
+// JobHandler processes the raw JSON payload of a queued job.
+type JobHandler func(ctx context.Context, payload []byte) error
+
+// HandleJob adapts a handler of a typed payload to a JobHandler.
+func HandleJob[T any](handler func(ctx context.Context, payload T) error) JobHandler {
+	return func(ctx context.Context, payload []byte) error {
+		var value T
+		if err := json.Unmarshal(payload, &value); err != nil {
+			return fmt.Errorf("invalid payload: %w", err)
+		}
+		return handler(ctx, value)
+	}
+}
+
+type Queue interface {
+	Register(jobType string, handler JobHandler)
+	Enqueue(jobType string, payload any) error
+	// EnqueueTx persists the job through db, normally an open transaction, so
+	// the job exists only if the changes it belongs to are committed.
+	EnqueueTx(db *database.DB, jobType string, payload any) error
+	Start(workers int)
+}
+
+type queue struct {
+	ctx      context.Context
+	wg       *sync.WaitGroup
+	store    QueueStore
+	instance string
+	now      func() time.Time
+
+	mu       sync.RWMutex
+	handlers map[string]JobHandler
+	wake     chan struct{}
+}
+
+func NewQueue(ctx context.Context, wg *sync.WaitGroup, store QueueStore) Queue {
+	return &queue{
+		ctx:      ctx,
+		wg:       wg,
+		store:    store,
+		instance: newInstanceID(),
+		now:      time.Now,
+		handlers: make(map[string]JobHandler),
+		wake:     make(chan struct{}, 1),
+	}
+}
+
+func (q *queue) Register(jobType string, handler JobHandler) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.handlers[jobType] = handler
+}
+
+// Enqueue persists the job; it is executed by a worker of any instance.
+func (q *queue) Enqueue(jobType string, payload any) error {
+	return q.push(q.store, jobType, payload)
+}
+
+func (q *queue) EnqueueTx(db *database.DB, jobType string, payload any) error {
+	return q.push(NewQueueStore(db), jobType, payload)
+}
+
+func (q *queue) push(store QueueStore, jobType string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s payload: %w", jobType, err)
+	}
+
+	job := &QueueJob{
+		Type:        jobType,
+		Payload:     string(data),
+		Status:      QueueJobPending,
+		MaxAttempts: DefaultMaxAttempts,
+		RunAt:       q.now(),
+	}
+	if err := store.Push(job); err != nil {
+		return fmt.Errorf("failed to enqueue %s: %w", jobType, err)
+	}
+
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// Start launches the workers. They stop taking new jobs once the context is
+// cancelled and finish the current one before releasing the wait group.
+func (q *queue) Start(workers int) {
+	for i := 1; i <= workers; i++ {
+		worker := fmt.Sprintf("%s#%d", q.instance, i)
+		utils.SafeGo(q.ctx, q.wg, "QueueWorker", func(ctx context.Context) {
+			q.work(ctx, worker)
+		})
+	}
+}
+
+func (q *queue) work(ctx context.Context, worker string) {
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+
+		processed, err := q.processNext(worker)
+		if err != nil {
+			log.Printf("[Queue] Failed to claim job: %v", err)
+		}
+		if processed {
+			continue
+		}
+
+		timer := time.NewTimer(queuePollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-q.wake:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+// processNext runs one due job and reports whether there was one.
+func (q *queue) processNext(worker string) (bool, error) {
+	now := q.now()
+	job, err := q.store.Claim(worker, now, now.Add(-queueStaleTimeout))
+	if err != nil || job == nil {
+		return false, err
+	}
+
+	q.mu.RLock()
+	handler, ok := q.handlers[job.Type]
+	q.mu.RUnlock()
+
+	var runErr error
+	switch {
+	case !ok:
+		runErr = fmt.Errorf("no handler registered for job type %s", job.Type)
+	case job.Attempts > job.MaxAttempts:
+		// Задача была заблокирована упавшим воркером больше раз, чем допускается попыток
+		runErr = fmt.Errorf("abandoned after %d attempts", job.MaxAttempts)
+	default:
+		runErr = q.invoke(handler, job)
+	}
+
+	if runErr == nil {
+		if err := q.store.Complete(job.ID); err != nil {
+			log.Printf("[Queue] Failed to complete job %d (%s): %v", job.ID, job.Type, err)
+		}
+		return true, nil
+	}
+
+	if !ok || job.Attempts >= job.MaxAttempts {
+		log.Printf("[Queue] Job %d (%s) moved to dead letters after %d attempts: %v", job.ID, job.Type, job.Attempts, runErr)
+		if err := q.store.Bury(job.ID, runErr.Error()); err != nil {
+			log.Printf("[Queue] Failed to bury job %d (%s): %v", job.ID, job.Type, err)
+		}
+		return true, nil
+	}
+
+	runAt := q.now().Add(Backoff(job.Attempts))
+	log.Printf("[Queue] Job %d (%s) failed, attempt %d of %d, retry at %s: %v", job.ID, job.Type, job.Attempts, job.MaxAttempts, runAt.Format(time.DateTime), runErr)
+	if err := q.store.Retry(job.ID, runAt, runErr.Error()); err != nil {
+		log.Printf("[Queue] Failed to schedule retry of job %d (%s): %v", job.ID, job.Type, err)
+	}
+
+	return true, nil
+}
+
+func (q *queue) invoke(handler JobHandler, job *QueueJob) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v\n%s", r, debug.Stack())
+		}
+	}()
+
+	// Начатая задача доводится до конца даже при остановке приложения
+	return handler(context.WithoutCancel(q.ctx), []byte(job.Payload))
+}
+
+// Backoff returns the delay before the next attempt: 5s, 10s, 20s and so on,
+// up to an hour.
+func Backoff(attempt int) time.Duration {
+	delay := queueBaseBackoff
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= queueMaxBackoff {
+			return queueMaxBackoff
+		}
+	}
+
+	return delay
+}
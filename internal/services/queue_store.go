@@ -0,0 +1,120 @@
+package services
+
+import (
+	"errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"haircompany-shop-rest/pkg/database"
+	"time"
+)
+
+const (
+	QueueJobPending = "pending"
+	QueueJobRunning = "running"
+	QueueJobDead    = "dead"
+)
+
+// QueueJob is a persisted background job. Finished jobs are deleted, failed
+// ones wait in pending until RunAt and end up dead after MaxAttempts.
+type QueueJob struct {
+	ID          uint `gorm:"primarykey" json:"id"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Type        string     `gorm:"type:varchar(64);not null" json:"type"`
+	Payload     string     `gorm:"type:text;not null" json:"payload"`
+	Status      string     `gorm:"type:varchar(16);not null;default:pending;index:idx_queue_jobs_status_run_at" json:"status"`
+	Attempts    int        `gorm:"not null;default:0" json:"attempts"`
+	MaxAttempts int        `gorm:"not null" json:"maxAttempts"`
+	RunAt       time.Time  `gorm:"not null;index:idx_queue_jobs_status_run_at" json:"runAt"`
+	LockedBy    *string    `gorm:"type:varchar(255)" json:"lockedBy"`
+	LockedAt    *time.Time `json:"lockedAt"`
+	LastError   *string    `gorm:"type:text" json:"lastError"`
+}
+
+// QueueStore persists queue jobs.
+type QueueStore interface {
+	Push(job *QueueJob) error
+	// Claim marks the next due job as running by worker. Jobs left running
+	// since before staleBefore are considered abandoned and claimed again.
+	Claim(worker string, now, staleBefore time.Time) (*QueueJob, error)
+	Complete(id uint) error
+	Retry(id uint, runAt time.Time, runErr string) error
+	Bury(id uint, runErr string) error
+}
+
+type queueStore struct {
+	DB *database.DB
+}
+
+func NewQueueStore(db *database.DB) QueueStore {
+	return &queueStore{
+		DB: db,
+	}
+}
+
+func (s *queueStore) Push(job *QueueJob) error {
+	return s.DB.Create(job).Error
+}
+
+func (s *queueStore) Claim(worker string, now, staleBefore time.Time) (*QueueJob, error) {
+	var job *QueueJob
+
+	err := s.DB.Transaction(func(tx *gorm.DB) error {
+		query := tx.
+			Where("(status = ? AND run_at <= ?) OR (status = ? AND locked_at < ?)", QueueJobPending, now, QueueJobRunning, staleBefore).
+			Order("run_at, id")
+		// SKIP LOCKED позволяет нескольким воркерам и репликам разбирать очередь параллельно
+		if tx.Dialector.Name() == "postgres" {
+			query = query.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"})
+		}
+
+		result := query.First(&job)
+		if result.Error != nil {
+			if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+				job = nil
+				return nil
+			}
+			return result.Error
+		}
+
+		job.Status = QueueJobRunning
+		job.Attempts++
+		job.LockedBy = &worker
+		job.LockedAt = &now
+
+		return tx.Model(job).Updates(map[string]interface{}{
+			"status":    job.Status,
+			"attempts":  job.Attempts,
+			"locked_by": worker,
+			"locked_at": now,
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+func (s *queueStore) Complete(id uint) error {
+	return s.DB.Delete(&QueueJob{}, id).Error
+}
+
+func (s *queueStore) Retry(id uint, runAt time.Time, runErr string) error {
+	return s.DB.Model(&QueueJob{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"status":     QueueJobPending,
+		"run_at":     runAt,
+		"locked_by":  nil,
+		"locked_at":  nil,
+		"last_error": runErr,
+	}).Error
+}
+
+func (s *queueStore) Bury(id uint, runErr string) error {
+	return s.DB.Model(&QueueJob{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"status":     QueueJobDead,
+		"locked_by":  nil,
+		"locked_at":  nil,
+		"last_error": runErr,
+	}).Error
+}
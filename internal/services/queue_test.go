@@ -0,0 +1,247 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"haircompany-shop-rest/pkg/database"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func setupTestQueue(t *testing.T) (*queue, *database.DB) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatal("Failed to connect to test database:", err)
+	}
+
+	err = db.AutoMigrate(&QueueJob{})
+	if err != nil {
+		t.Fatal("Failed to migrate test database:", err)
+	}
+
+	testDB := &database.DB{DB: db}
+	q := NewQueue(context.Background(), &sync.WaitGroup{}, NewQueueStore(testDB)).(*queue)
+
+	return q, testDB
+}
+
+func getQueueJob(t *testing.T, db *database.DB, id uint) *QueueJob {
+	var job QueueJob
+	if err := db.First(&job, id).Error; err != nil {
+		t.Fatalf("Failed to load job %d: %v", id, err)
+	}
+	return &job
+}
+
+func TestQueue_ProcessSuccess(t *testing.T) {
+	q, db := setupTestQueue(t)
+
+	var received FilesPayload
+	q.Register(QueueMoveFiles, HandleJob(func(ctx context.Context, payload FilesPayload) error {
+		received = payload
+		return nil
+	}))
+
+	if err := q.Enqueue(QueueMoveFiles, FilesPayload{Filenames: []string{"a.png"}, Folder: "images/category"}); err != nil {
+		t.Fatalf("Failed to enqueue: %v", err)
+	}
+
+	processed, err := q.processNext("worker")
+	if err != nil || !processed {
+		t.Fatalf("Expected job to be processed, got %v, %v", processed, err)
+	}
+	if received.Folder != "images/category" || len(received.Filenames) != 1 || received.Filenames[0] != "a.png" {
+		t.Errorf("Unexpected payload: %+v", received)
+	}
+
+	var count int64
+	db.Model(&QueueJob{}).Count(&count)
+	if count != 0 {
+		t.Errorf("Expected finished job to be removed, got %d jobs", count)
+	}
+
+	if processed, _ := q.processNext("worker"); processed {
+		t.Error("Expected empty queue")
+	}
+}
+
+func TestQueue_EnqueueTxFollowsTransaction(t *testing.T) {
+	q, db := setupTestQueue(t)
+	payload := FilesPayload{Filenames: []string{"a.png"}, Folder: "images/shade"}
+
+	rollback := errors.New("rollback")
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := q.EnqueueTx(&database.DB{DB: tx}, QueueMoveFiles, payload); err != nil {
+			return err
+		}
+		return rollback
+	})
+	if !errors.Is(err, rollback) {
+		t.Fatalf("Expected rollback error, got %v", err)
+	}
+
+	var count int64
+	db.Model(&QueueJob{}).Count(&count)
+	if count != 0 {
+		t.Fatalf("Expected rolled back job to be discarded, got %d jobs", count)
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		return q.EnqueueTx(&database.DB{DB: tx}, QueueMoveFiles, payload)
+	})
+	if err != nil {
+		t.Fatalf("Failed to enqueue: %v", err)
+	}
+	db.Model(&QueueJob{}).Count(&count)
+	if count != 1 {
+		t.Errorf("Expected committed job to be queued, got %d jobs", count)
+	}
+}
+
+func TestQueue_RetryWithBackoffThenDeadLetter(t *testing.T) {
+	q, db := setupTestQueue(t)
+
+	now := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+	q.now = func() time.Time { return now }
+
+	calls := 0
+	q.Register(QueueMoveFiles, func(ctx context.Context, payload []byte) error {
+		calls++
+		return errors.New("disk is full")
+	})
+	if err := q.Enqueue(QueueMoveFiles, FilesPayload{}); err != nil {
+		t.Fatalf("Failed to enqueue: %v", err)
+	}
+
+	q.processNext("worker")
+	job := getQueueJob(t, db, 1)
+	if job.Status != QueueJobPending || job.Attempts != 1 || job.LastError == nil || *job.LastError != "disk is full" {
+		t.Fatalf("Expected pending job after first failure, got %+v", job)
+	}
+	if !job.RunAt.Equal(now.Add(Backoff(1))) {
+		t.Errorf("Expected retry at %s, got %s", now.Add(Backoff(1)), job.RunAt)
+	}
+
+	if processed, _ := q.processNext("worker"); processed {
+		t.Error("Expected job to wait for its backoff")
+	}
+
+	for i := 2; i <= DefaultMaxAttempts; i++ {
+		now = now.Add(queueMaxBackoff)
+		q.processNext("worker")
+	}
+
+	job = getQueueJob(t, db, 1)
+	if job.Status != QueueJobDead || job.Attempts != DefaultMaxAttempts || calls != DefaultMaxAttempts {
+		t.Errorf("Expected dead job after %d attempts, got %+v with %d calls", DefaultMaxAttempts, job, calls)
+	}
+
+	now = now.Add(queueMaxBackoff)
+	if processed, _ := q.processNext("worker"); processed {
+		t.Error("Expected dead job not to be processed")
+	}
+}
+
+func TestQueue_UnknownTypeAndPanic(t *testing.T) {
+	q, db := setupTestQueue(t)
+
+	q.Register(QueueSendSMS, HandleJob(func(ctx context.Context, payload SMSPayload) error {
+		panic("sender is nil")
+	}))
+	q.Enqueue("unknown", nil)
+	q.Enqueue(QueueSendSMS, SMSPayload{Phone: "79990000000", Message: "hi"})
+
+	q.processNext("worker")
+	q.processNext("worker")
+
+	unknown := getQueueJob(t, db, 1)
+	if unknown.Status != QueueJobDead || !strings.Contains(*unknown.LastError, "no handler") {
+		t.Errorf("Expected job without handler to be dead, got %+v", unknown)
+	}
+
+	panicked := getQueueJob(t, db, 2)
+	if panicked.Status != QueueJobPending || !strings.HasPrefix(*panicked.LastError, "panic: sender is nil") {
+		t.Errorf("Expected panicked job to be retried, got %+v", panicked)
+	}
+}
+
+func TestQueue_ReclaimsStaleJob(t *testing.T) {
+	q, db := setupTestQueue(t)
+
+	now := time.Now()
+	q.now = func() time.Time { return now }
+
+	done := false
+	q.Register(QueueMoveFiles, func(ctx context.Context, payload []byte) error {
+		done = true
+		return nil
+	})
+	q.Enqueue(QueueMoveFiles, FilesPayload{})
+
+	job, err := q.store.Claim("crashed", now, now.Add(-queueStaleTimeout))
+	if err != nil || job == nil {
+		t.Fatalf("Expected job to be claimed, got %v, %v", job, err)
+	}
+	if processed, _ := q.processNext("worker"); processed {
+		t.Fatal("Expected running job not to be claimed twice")
+	}
+
+	now = now.Add(queueStaleTimeout + time.Second)
+	if processed, _ := q.processNext("worker"); !processed || !done {
+		t.Fatal("Expected abandoned job to be claimed again")
+	}
+
+	var count int64
+	db.Model(&QueueJob{}).Count(&count)
+	if count != 0 {
+		t.Errorf("Expected job to be completed, got %d jobs", count)
+	}
+}
+
+func TestQueue_WorkersStopOnShutdown(t *testing.T) {
+	q, _ := setupTestQueue(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := &sync.WaitGroup{}
+	q.ctx, q.wg = ctx, wg
+
+	processed := make(chan struct{})
+	q.Register(QueueMoveFiles, func(ctx context.Context, payload []byte) error {
+		close(processed)
+		return nil
+	})
+
+	q.Start(1)
+	q.Enqueue(QueueMoveFiles, FilesPayload{})
+
+	select {
+	case <-processed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected worker to process the job")
+	}
+
+	cancel()
+	wg.Wait()
+}
+
+func TestBackoff(t *testing.T) {
+	tests := map[int]time.Duration{
+		1:  5 * time.Second,
+		2:  10 * time.Second,
+		3:  20 * time.Second,
+		9:  1280 * time.Second,
+		10: 2560 * time.Second,
+		11: time.Hour,
+		50: time.Hour,
+	}
+
+	for attempt, want := range tests {
+		if got := Backoff(attempt); got != want {
+			t.Errorf("Backoff(%d) = %s, want %s", attempt, got, want)
+		}
+	}
+}
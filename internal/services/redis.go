@@ -3,16 +3,25 @@ package services
 import (
 	"context"
 	"errors"
-	"fmt"
 	"github.com/redis/go-redis/v9"
 	"time"
 )
 
+// ErrKeyNotFound is returned by Get when the key does not exist.
+var ErrKeyNotFound = errors.New("key does not exist")
+
 type RedisService interface {
 	Set(key string, value interface{}, expiration time.Duration) error
 	Get(key string) (string, error)
 	Delete(key string) error
 	Exists(key string) (bool, error)
+	Incr(key string, expiration time.Duration) (int64, error)
+	SetNX(key string, value interface{}, expiration time.Duration) (bool, error)
+	CompareAndDelete(key, value string) (bool, error)
+	CompareAndExpire(key, value string, expiration time.Duration) (bool, error)
+	SAdd(key, member string, expiration time.Duration) error
+	SMembers(key string) ([]string, error)
+	SRem(key, member string) error
 }
 
 type redisService struct {
@@ -40,7 +49,7 @@ func (r *redisService) Set(key string, value interface{}, expiration time.Durati
 func (r *redisService) Get(key string) (string, error) {
 	val, err := r.client.Get(r.ctx, key).Result()
 	if errors.Is(err, redis.Nil) {
-		return "", fmt.Errorf("key does not exist")
+		return "", ErrKeyNotFound
 	}
 	return val, err
 }
@@ -53,3 +62,69 @@ func (r *redisService) Exists(key string) (bool, error) {
 	count, err := r.client.Exists(r.ctx, key).Result()
 	return count > 0, err
 }
+
+// Incr increments the counter stored at key. The expiration is set only when
+// the counter is created, so it counts from the first increment.
+func (r *redisService) Incr(key string, expiration time.Duration) (int64, error) {
+	val, err := r.client.Incr(r.ctx, key).Result()
+	if err != nil {
+		return 0, err
+	}
+
+	if val == 1 {
+		if err := r.client.Expire(r.ctx, key, expiration).Err(); err != nil {
+			return 0, err
+		}
+	}
+
+	return val, nil
+}
+
+// SetNX sets key only if it does not exist yet and reports whether it was set.
+func (r *redisService) SetNX(key string, value interface{}, expiration time.Duration) (bool, error) {
+	return r.client.SetNX(r.ctx, key, value, expiration).Result()
+}
+
+var compareAndDeleteScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// CompareAndDelete deletes key only while it still holds value, so a lock
+// owner never releases a lock that has expired and been taken by someone else.
+func (r *redisService) CompareAndDelete(key, value string) (bool, error) {
+	res, err := compareAndDeleteScript.Run(r.ctx, r.client, []string{key}, value).Int()
+	return res > 0, err
+}
+
+var compareAndExpireScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// CompareAndExpire prolongs key only while it still holds value.
+func (r *redisService) CompareAndExpire(key, value string, expiration time.Duration) (bool, error) {
+	res, err := compareAndExpireScript.Run(r.ctx, r.client, []string{key}, value, expiration.Milliseconds()).Int()
+	return res > 0, err
+}
+
+// SAdd adds member to the set stored at key and resets the set expiration.
+func (r *redisService) SAdd(key, member string, expiration time.Duration) error {
+	pipe := r.client.TxPipeline()
+	pipe.SAdd(r.ctx, key, member)
+	pipe.Expire(r.ctx, key, expiration)
+	_, err := pipe.Exec(r.ctx)
+	return err
+}
+
+func (r *redisService) SMembers(key string) ([]string, error) {
+	return r.client.SMembers(r.ctx, key).Result()
+}
+
+func (r *redisService) SRem(key, member string) error {
+	return r.client.SRem(r.ctx, key, member).Err()
+}
@@ -2,35 +2,104 @@ package services
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
 	"haircompany-shop-rest/pkg/utils"
 	"log"
+	"os"
+	"runtime/debug"
+	"sort"
 	"sync"
 	"time"
 )
 
+const (
+	JobTriggerSchedule = "schedule"
+	JobTriggerManual   = "manual"
+
+	// jobLockTTL ограничивает время жизни блокировки упавшего экземпляра;
+	// пока задача выполняется, блокировка продлевается.
+	jobLockTTL = time.Minute
+	// jobFireTTL должен превышать возможное расхождение часов между экземплярами.
+	jobFireTTL = 10 * time.Minute
+)
+
+var (
+	ErrJobNotFound = errors.New("job not found")
+	ErrJobRunning  = errors.New("job is already running")
+)
+
+// JobRecorder сохраняет историю запусков задач.
+type JobRecorder interface {
+	StartRun(name, trigger, instance string, startedAt time.Time) (uint, error)
+	FinishRun(id uint, finishedAt time.Time, duration time.Duration, runErr string, panicked bool) error
+}
+
+type JobInfo struct {
+	Name     string
+	Schedule string
+	NextRun  time.Time
+	Running  bool
+}
+
 type task struct {
 	name     string
-	callback func()
+	callback func(ctx context.Context) error
+
+	mu       sync.Mutex
+	schedule Schedule
+	nextRun  time.Time
+	running  bool
 }
 
 type Scheduler interface {
-	CreateTask(name string, callback func()) *task
+	CreateTask(name string, callback func(ctx context.Context) error) *task
 	StartEveryDay(hour, minute int, t *task)
+	StartCron(expr string, t *task) error
+	StartEvery(interval time.Duration, t *task)
+	Jobs() []JobInfo
+	Trigger(name string) error
 }
 
 type scheduler struct {
-	ctx context.Context
-	wg  *sync.WaitGroup
+	ctx      context.Context
+	wg       *sync.WaitGroup
+	redis    RedisService
+	recorder JobRecorder
+	instance string
+
+	mu    sync.RWMutex
+	tasks map[string]*task
 }
 
-func NewScheduler(ctx context.Context, wg *sync.WaitGroup) Scheduler {
+// NewScheduler creates a scheduler. When redis is set, a job runs on only one
+// instance at a time; when recorder is set, every run is persisted.
+func NewScheduler(ctx context.Context, wg *sync.WaitGroup, redis RedisService, recorder JobRecorder) Scheduler {
 	return &scheduler{
-		ctx: ctx,
-		wg:  wg,
+		ctx:      ctx,
+		wg:       wg,
+		redis:    redis,
+		recorder: recorder,
+		instance: newInstanceID(),
+		tasks:    make(map[string]*task),
+	}
+}
+
+func newInstanceID() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
 	}
+
+	b := make([]byte, 4)
+	_, _ = rand.Read(b)
+
+	return fmt.Sprintf("%s-%d-%s", host, os.Getpid(), hex.EncodeToString(b))
 }
 
-func (s *scheduler) CreateTask(name string, callback func()) *task {
+func (s *scheduler) CreateTask(name string, callback func(ctx context.Context) error) *task {
 	if name == "" {
 		log.Printf("[Scheduler] Task name cannot be empty")
 		return nil
@@ -40,33 +109,248 @@ func (s *scheduler) CreateTask(name string, callback func()) *task {
 		return nil
 	}
 
-	return &task{
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.tasks[name]; exists {
+		log.Printf("[Scheduler] Task %s is already registered", name)
+		return nil
+	}
+
+	t := &task{
 		name:     name,
 		callback: callback,
 	}
+	s.tasks[name] = t
+
+	return t
 }
 
 func (s *scheduler) StartEveryDay(hour, minute int, t *task) {
+	if err := s.StartCron(fmt.Sprintf("%d %d * * *", minute, hour), t); err != nil {
+		log.Printf("[Scheduler] Failed to schedule task %s: %v", t.name, err)
+	}
+}
+
+func (s *scheduler) StartCron(expr string, t *task) error {
+	schedule, err := ParseSchedule(expr)
+	if err != nil {
+		return err
+	}
+
+	s.start(schedule, t)
+	return nil
+}
+
+func (s *scheduler) StartEvery(interval time.Duration, t *task) {
+	s.start(Every(interval), t)
+}
+
+func (s *scheduler) start(schedule Schedule, t *task) {
+	t.mu.Lock()
+	t.schedule = schedule
+	t.mu.Unlock()
+
 	utils.SafeGo(s.ctx, s.wg, t.name, func(ctx context.Context) {
 		for {
-			now := time.Now()
-			nextRun := time.Date(now.Year(), now.Month(), now.Day(), hour, minute, 0, 0, now.Location())
-
-			if now.After(nextRun) {
-				nextRun = nextRun.Add(24 * time.Hour)
+			nextRun := schedule.Next(time.Now())
+			if nextRun.IsZero() {
+				log.Printf("[Scheduler] Task %s has no upcoming runs", t.name)
+				return
 			}
 
-			wait := nextRun.Sub(now)
-			log.Printf("[Scheduler] Task %s will run in %s", t.name, nextRun.Format("15:04:05"))
+			t.mu.Lock()
+			t.nextRun = nextRun
+			t.mu.Unlock()
 
+			log.Printf("[Scheduler] Task %s will run at %s", t.name, nextRun.Format(time.DateTime))
+
+			timer := time.NewTimer(time.Until(nextRun))
 			select {
 			case <-ctx.Done():
+				timer.Stop()
 				log.Printf("[Scheduler] Stopping task %s due to context cancellation", t.name)
 				return
-			case <-time.After(wait):
-				log.Printf("[Scheduler] Running task: %s", t.name)
-				t.callback()
+			case <-timer.C:
+				s.runScheduled(t, nextRun)
+			}
+		}
+	})
+}
+
+// runScheduled claims the activation time first, so a replica with a slightly
+// late clock cannot repeat a run another replica has already finished.
+func (s *scheduler) runScheduled(t *task, fireAt time.Time) {
+	if s.redis != nil {
+		key := fmt.Sprintf("scheduler:fire:%s:%d", t.name, fireAt.Unix())
+		claimed, err := s.redis.SetNX(key, s.instance, jobFireTTL)
+		if err != nil {
+			log.Printf("[Scheduler] Skipping task %s: failed to claim run: %v", t.name, err)
+			return
+		}
+		if !claimed {
+			log.Printf("[Scheduler] Skipping task %s: already run by another instance", t.name)
+			return
+		}
+	}
+
+	release, err := s.acquire(t)
+	if err != nil {
+		log.Printf("[Scheduler] Skipping task %s: %v", t.name, err)
+		return
+	}
+
+	s.execute(t, JobTriggerSchedule, release)
+}
+
+// Trigger runs the task immediately in the background. It returns
+// ErrJobRunning when the task is running on this or another instance.
+func (s *scheduler) Trigger(name string) error {
+	s.mu.RLock()
+	t, ok := s.tasks[name]
+	s.mu.RUnlock()
+	if !ok {
+		return ErrJobNotFound
+	}
+
+	release, err := s.acquire(t)
+	if err != nil {
+		return err
+	}
+
+	utils.SafeGo(s.ctx, s.wg, t.name, func(ctx context.Context) {
+		s.execute(t, JobTriggerManual, release)
+	})
+
+	return nil
+}
+
+func (s *scheduler) Jobs() []JobInfo {
+	s.mu.RLock()
+	jobs := make([]JobInfo, 0, len(s.tasks))
+	for _, t := range s.tasks {
+		t.mu.Lock()
+		info := JobInfo{
+			Name:    t.name,
+			NextRun: t.nextRun,
+			Running: t.running,
+		}
+		if t.schedule != nil {
+			info.Schedule = t.schedule.String()
+		}
+		t.mu.Unlock()
+		jobs = append(jobs, info)
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].Name < jobs[j].Name
+	})
+
+	return jobs
+}
+
+// acquire takes the local and the distributed lock of the task and returns
+// a function releasing both.
+func (s *scheduler) acquire(t *task) (func(), error) {
+	t.mu.Lock()
+	if t.running {
+		t.mu.Unlock()
+		return nil, ErrJobRunning
+	}
+	t.running = true
+	t.mu.Unlock()
+
+	unlock := func() {
+		t.mu.Lock()
+		t.running = false
+		t.mu.Unlock()
+	}
+
+	if s.redis == nil {
+		return unlock, nil
+	}
+
+	key := "scheduler:lock:" + t.name
+	locked, err := s.redis.SetNX(key, s.instance, jobLockTTL)
+	if err != nil {
+		unlock()
+		return nil, fmt.Errorf("failed to acquire lock: %w", err)
+	}
+	if !locked {
+		unlock()
+		return nil, ErrJobRunning
+	}
+
+	done := make(chan struct{})
+	utils.SafeGo(s.ctx, s.wg, t.name+" lock", func(ctx context.Context) {
+		ticker := time.NewTicker(jobLockTTL / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				ok, err := s.redis.CompareAndExpire(key, s.instance, jobLockTTL)
+				if err != nil {
+					log.Printf("[Scheduler] Failed to prolong lock for task %s: %v", t.name, err)
+				} else if !ok {
+					log.Printf("[Scheduler] Lost lock for task %s", t.name)
+				}
 			}
 		}
 	})
+
+	return func() {
+		close(done)
+		if _, err := s.redis.CompareAndDelete(key, s.instance); err != nil {
+			log.Printf("[Scheduler] Failed to release lock for task %s: %v", t.name, err)
+		}
+		unlock()
+	}, nil
+}
+
+func (s *scheduler) execute(t *task, trigger string, release func()) {
+	defer release()
+
+	startedAt := time.Now()
+	log.Printf("[Scheduler] Running task: %s (%s)", t.name, trigger)
+
+	var runID uint
+	if s.recorder != nil {
+		id, err := s.recorder.StartRun(t.name, trigger, s.instance, startedAt)
+		if err != nil {
+			log.Printf("[Scheduler] Failed to record start of task %s: %v", t.name, err)
+		}
+		runID = id
+	}
+
+	panicked, runErr := invoke(s.ctx, t)
+	finishedAt := time.Now()
+	duration := finishedAt.Sub(startedAt)
+
+	var message string
+	if runErr != nil {
+		message = runErr.Error()
+		log.Printf("[Scheduler] Task %s failed after %s: %s", t.name, duration, message)
+	} else {
+		log.Printf("[Scheduler] Task %s finished in %s", t.name, duration)
+	}
+
+	if s.recorder != nil && runID != 0 {
+		if err := s.recorder.FinishRun(runID, finishedAt, duration, message, panicked); err != nil {
+			log.Printf("[Scheduler] Failed to record finish of task %s: %v", t.name, err)
+		}
+	}
+}
+
+func invoke(ctx context.Context, t *task) (panicked bool, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			panicked = true
+			err = fmt.Errorf("panic: %v\n%s", r, debug.Stack())
+		}
+	}()
+
+	return false, t.callback(ctx)
 }
@@ -0,0 +1,278 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// memoryRedis хранит значения в памяти без учёта TTL
+type memoryRedis struct {
+	mu     sync.Mutex
+	values map[string]string
+	sets   map[string]map[string]bool
+}
+
+func newMemoryRedis() *memoryRedis {
+	return &memoryRedis{values: make(map[string]string), sets: make(map[string]map[string]bool)}
+}
+
+func (m *memoryRedis) Set(key string, value interface{}, _ time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.values[key] = fmt.Sprint(value)
+	return nil
+}
+
+func (m *memoryRedis) Get(key string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	val, ok := m.values[key]
+	if !ok {
+		return "", ErrKeyNotFound
+	}
+	return val, nil
+}
+
+func (m *memoryRedis) Delete(key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.values, key)
+	delete(m.sets, key)
+	return nil
+}
+
+func (m *memoryRedis) Exists(key string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.values[key]
+	return ok, nil
+}
+
+func (m *memoryRedis) Incr(key string, _ time.Duration) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var val int64
+	fmt.Sscan(m.values[key], &val)
+	val++
+	m.values[key] = fmt.Sprint(val)
+	return val, nil
+}
+
+func (m *memoryRedis) SetNX(key string, value interface{}, _ time.Duration) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.values[key]; ok {
+		return false, nil
+	}
+	m.values[key] = fmt.Sprint(value)
+	return true, nil
+}
+
+func (m *memoryRedis) CompareAndDelete(key, value string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.values[key] != value {
+		return false, nil
+	}
+	delete(m.values, key)
+	return true, nil
+}
+
+func (m *memoryRedis) CompareAndExpire(key, value string, _ time.Duration) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.values[key] == value, nil
+}
+
+func (m *memoryRedis) SAdd(key, member string, _ time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.sets[key] == nil {
+		m.sets[key] = make(map[string]bool)
+	}
+	m.sets[key][member] = true
+	return nil
+}
+
+func (m *memoryRedis) SMembers(key string) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	members := make([]string, 0, len(m.sets[key]))
+	for member := range m.sets[key] {
+		members = append(members, member)
+	}
+	return members, nil
+}
+
+func (m *memoryRedis) SRem(key, member string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.sets[key], member)
+	return nil
+}
+
+type recordedRun struct {
+	name, trigger, err string
+	panicked, finished bool
+}
+
+type memoryRecorder struct {
+	mu   sync.Mutex
+	runs []*recordedRun
+}
+
+func (m *memoryRecorder) StartRun(name, trigger, _ string, _ time.Time) (uint, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.runs = append(m.runs, &recordedRun{name: name, trigger: trigger})
+	return uint(len(m.runs)), nil
+}
+
+func (m *memoryRecorder) FinishRun(id uint, _ time.Time, _ time.Duration, runErr string, panicked bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	run := m.runs[id-1]
+	run.err, run.panicked, run.finished = runErr, panicked, true
+	return nil
+}
+
+func newTestScheduler(redis RedisService, recorder JobRecorder) (*scheduler, *sync.WaitGroup) {
+	wg := &sync.WaitGroup{}
+	return NewScheduler(context.Background(), wg, redis, recorder).(*scheduler), wg
+}
+
+func TestScheduler_TriggerRecordsRuns(t *testing.T) {
+	recorder := &memoryRecorder{}
+	s, wg := newTestScheduler(newMemoryRedis(), recorder)
+
+	s.CreateTask("ok", func(ctx context.Context) error { return nil })
+	s.CreateTask("failing", func(ctx context.Context) error { return errors.New("boom") })
+	s.CreateTask("panicking", func(ctx context.Context) error { panic("oops") })
+
+	for _, name := range []string{"ok", "failing", "panicking"} {
+		if err := s.Trigger(name); err != nil {
+			t.Fatalf("Trigger(%q) returned error: %v", name, err)
+		}
+	}
+	wg.Wait()
+
+	if len(recorder.runs) != 3 {
+		t.Fatalf("Expected 3 runs, got %d", len(recorder.runs))
+	}
+	runs := make(map[string]*recordedRun)
+	for _, run := range recorder.runs {
+		if !run.finished || run.trigger != JobTriggerManual {
+			t.Errorf("Unexpected run: %+v", run)
+		}
+		runs[run.name] = run
+	}
+	if runs["ok"].err != "" || runs["ok"].panicked {
+		t.Errorf("Expected successful run, got %+v", runs["ok"])
+	}
+	if runs["failing"].err != "boom" || runs["failing"].panicked {
+		t.Errorf("Expected failed run, got %+v", runs["failing"])
+	}
+	if !strings.HasPrefix(runs["panicking"].err, "panic: oops") || !runs["panicking"].panicked {
+		t.Errorf("Expected panicked run, got %+v", runs["panicking"])
+	}
+
+	if err := s.Trigger("missing"); !errors.Is(err, ErrJobNotFound) {
+		t.Errorf("Expected ErrJobNotFound, got %v", err)
+	}
+}
+
+func TestScheduler_TriggerLockedByAnotherInstance(t *testing.T) {
+	redis := newMemoryRedis()
+	s, wg := newTestScheduler(redis, nil)
+	s.CreateTask("job", func(ctx context.Context) error { return nil })
+
+	redis.values["scheduler:lock:job"] = "other-instance"
+	if err := s.Trigger("job"); !errors.Is(err, ErrJobRunning) {
+		t.Fatalf("Expected ErrJobRunning, got %v", err)
+	}
+
+	delete(redis.values, "scheduler:lock:job")
+	if err := s.Trigger("job"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	wg.Wait()
+
+	if _, ok := redis.values["scheduler:lock:job"]; ok {
+		t.Error("Expected lock to be released after the run")
+	}
+}
+
+func TestScheduler_TriggerWhileRunning(t *testing.T) {
+	s, wg := newTestScheduler(nil, nil)
+
+	started := make(chan struct{})
+	finish := make(chan struct{})
+	s.CreateTask("slow", func(ctx context.Context) error {
+		close(started)
+		<-finish
+		return nil
+	})
+
+	if err := s.Trigger("slow"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	<-started
+
+	if err := s.Trigger("slow"); !errors.Is(err, ErrJobRunning) {
+		t.Errorf("Expected ErrJobRunning, got %v", err)
+	}
+	if jobs := s.Jobs(); len(jobs) != 1 || !jobs[0].Running {
+		t.Errorf("Expected running job, got %+v", jobs)
+	}
+
+	close(finish)
+	wg.Wait()
+
+	if jobs := s.Jobs(); jobs[0].Running {
+		t.Error("Expected job to be idle after the run")
+	}
+}
+
+func TestScheduler_ScheduledRunOnlyOnce(t *testing.T) {
+	redis := newMemoryRedis()
+	recorder := &memoryRecorder{}
+	first, _ := newTestScheduler(redis, recorder)
+	second, _ := newTestScheduler(redis, recorder)
+
+	callback := func(ctx context.Context) error { return nil }
+	firstTask := first.CreateTask("job", callback)
+	secondTask := second.CreateTask("job", callback)
+
+	fireAt := time.Date(2024, time.January, 1, 4, 0, 0, 0, time.UTC)
+	first.runScheduled(firstTask, fireAt)
+	second.runScheduled(secondTask, fireAt)
+
+	if len(recorder.runs) != 1 {
+		t.Fatalf("Expected a single run for one activation, got %d", len(recorder.runs))
+	}
+	if recorder.runs[0].trigger != JobTriggerSchedule {
+		t.Errorf("Expected scheduled trigger, got %s", recorder.runs[0].trigger)
+	}
+
+	second.runScheduled(secondTask, fireAt.Add(24*time.Hour))
+	if len(recorder.runs) != 2 {
+		t.Errorf("Expected next activation to run, got %d runs", len(recorder.runs))
+	}
+}
+
+func TestScheduler_StartCronInvalid(t *testing.T) {
+	s, _ := newTestScheduler(nil, nil)
+	task := s.CreateTask("job", func(ctx context.Context) error { return nil })
+
+	if err := s.StartCron("not a cron", task); err == nil {
+		t.Error("Expected error for invalid expression")
+	}
+	if s.CreateTask("job", func(ctx context.Context) error { return nil }) != nil {
+		t.Error("Expected duplicate task name to be rejected")
+	}
+}
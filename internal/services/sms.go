@@ -0,0 +1,118 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// SMSSender delivers text messages to client phones.
+type SMSSender interface {
+	Send(phone, message string) error
+}
+
+type SMSGatewayOptions struct {
+	URL   string
+	Token string
+}
+
+// NewSMSSender returns the sender selected by driver: "log" for local
+// development or "http" for an SMS gateway.
+func NewSMSSender(driver, logFile string, opts SMSGatewayOptions) (SMSSender, error) {
+	switch driver {
+	case "log":
+		return NewLogSMSSender(logFile), nil
+	case "http":
+		return NewHTTPSMSSender(opts)
+	default:
+		return nil, fmt.Errorf("unknown sms driver %q", driver)
+	}
+}
+
+// logSMSSender is intended for local development: messages are written to the
+// application log and, if filePath is set, appended to that file.
+type logSMSSender struct {
+	filePath string
+	mu       sync.Mutex
+}
+
+func NewLogSMSSender(filePath string) SMSSender {
+	return &logSMSSender{
+		filePath: filePath,
+	}
+}
+
+func (s *logSMSSender) Send(phone, message string) error {
+	log.Printf("[SMS] to %s: %s", phone, message)
+
+	if s.filePath == "" {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	file, err := os.OpenFile(s.filePath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = fmt.Fprintf(file, "%s\t%s\t%s\n", time.Now().Format(time.RFC3339), phone, message)
+	return err
+}
+
+// httpSMSSender posts messages as JSON {"phone", "message"} to an SMS gateway.
+type httpSMSSender struct {
+	url    string
+	token  string
+	client *http.Client
+}
+
+func NewHTTPSMSSender(opts SMSGatewayOptions) (SMSSender, error) {
+	if opts.URL == "" {
+		return nil, fmt.Errorf("sms gateway url is not set")
+	}
+
+	return &httpSMSSender{
+		url:    opts.URL,
+		token:  opts.Token,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+func (s *httpSMSSender) Send(phone, message string) error {
+	body, err := json.Marshal(map[string]string{
+		"phone":   phone,
+		"message": message,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("sms gateway responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
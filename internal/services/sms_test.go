@@ -0,0 +1,51 @@
+package services
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHTTPSMSSender(t *testing.T) {
+	var got map[string]string
+	var auth string
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+		}
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	sender, err := NewSMSSender("http", "", SMSGatewayOptions{URL: server.URL, Token: "secret"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if err := sender.Send("+79991234567", "Код для входа: 123456"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if got["phone"] != "+79991234567" || got["message"] != "Код для входа: 123456" {
+		t.Errorf("Unexpected request body %v", got)
+	}
+	if auth != "Bearer secret" {
+		t.Errorf("Unexpected Authorization header %q", auth)
+	}
+
+	status = http.StatusBadGateway
+	if err := sender.Send("+79991234567", "Код для входа: 123456"); err == nil {
+		t.Error("Expected an error for a failed gateway response")
+	}
+}
+
+func TestNewSMSSender_RequiresGatewayURL(t *testing.T) {
+	if _, err := NewSMSSender("http", "", SMSGatewayOptions{}); err == nil {
+		t.Error("Expected an error without gateway url")
+	}
+	if _, err := NewSMSSender("carrier-pigeon", "", SMSGatewayOptions{}); err == nil {
+		t.Error("Expected an error for an unknown driver")
+	}
+}
@@ -0,0 +1,79 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"path"
+	"strings"
+	"time"
+)
+
+const (
+	StorageLocal = "local"
+	StorageS3    = "s3"
+)
+
+var (
+	ErrObjectNotFound = errors.New("object not found")
+	ErrInvalidKey     = errors.New("invalid object key")
+)
+
+type ObjectInfo struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
+	ETag         string
+	ContentType  string
+}
+
+// StorageDriver хранит загруженные файлы. Ключи — пути с прямыми слешами
+// относительно корня хранилища, например "images/category/photo.png".
+type StorageDriver interface {
+	Put(key string, r io.Reader, contentType string) error
+	// Get returns ErrObjectNotFound if there is no object with the key.
+	Get(key string) (io.ReadCloser, *ObjectInfo, error)
+	// GetRange returns the object from byte offset to its end without reading
+	// the bytes before it. offset must be less than the object size.
+	GetRange(key string, offset int64) (io.ReadCloser, error)
+	// Stat returns ErrObjectNotFound if there is no object with the key.
+	Stat(key string) (*ObjectInfo, error)
+	Move(srcKey, dstKey string) error
+	// Delete does not fail if the object does not exist.
+	Delete(key string) error
+	List(prefix string) ([]ObjectInfo, error)
+}
+
+// NewStorageDriver creates the driver selected in the configuration.
+func NewStorageDriver(driver, localRoot string, s3 S3Options) (StorageDriver, error) {
+	switch driver {
+	case StorageLocal:
+		return NewLocalStorage(localRoot), nil
+	case StorageS3:
+		return NewS3Storage(s3)
+	default:
+		return nil, fmt.Errorf("unknown storage driver: %s", driver)
+	}
+}
+
+// cleanKey rejects keys that could escape the storage root.
+func cleanKey(key string) (string, error) {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return "", ErrInvalidKey
+	}
+
+	cleaned := path.Clean(key)
+	if cleaned != key || cleaned == "." || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", ErrInvalidKey
+	}
+
+	return cleaned, nil
+}
+
+func contentTypeByKey(key string) string {
+	if contentType := mime.TypeByExtension(path.Ext(key)); contentType != "" {
+		return contentType
+	}
+	return "application/octet-stream"
+}
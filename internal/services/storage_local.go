@@ -0,0 +1,220 @@
+package services
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type localStorage struct {
+	root string
+}
+
+// NewLocalStorage stores objects as files under root.
+func NewLocalStorage(root string) StorageDriver {
+	return &localStorage{
+		root: root,
+	}
+}
+
+func (s *localStorage) path(key string) (string, error) {
+	cleaned, err := cleanKey(key)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(s.root, filepath.FromSlash(cleaned)), nil
+}
+
+// Put writes the object to a temporary file first, so readers never see a
+// partially written object.
+func (s *localStorage) Put(key string, r io.Reader, _ string) error {
+	filePath, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(filePath)
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(dir, ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), filePath)
+}
+
+func (s *localStorage) Get(key string) (io.ReadCloser, *ObjectInfo, error) {
+	filePath, err := s.path(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil, ErrObjectNotFound
+		}
+		return nil, nil, err
+	}
+
+	stat, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, nil, err
+	}
+	if stat.IsDir() {
+		file.Close()
+		return nil, nil, ErrObjectNotFound
+	}
+
+	return file, s.info(key, stat), nil
+}
+
+func (s *localStorage) GetRange(key string, offset int64) (io.ReadCloser, error) {
+	file, _, err := s.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := file.(*os.File).Seek(offset, io.SeekStart); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return file, nil
+}
+
+func (s *localStorage) Stat(key string) (*ObjectInfo, error) {
+	filePath, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	stat, err := os.Stat(filePath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ErrObjectNotFound
+		}
+		return nil, err
+	}
+	if stat.IsDir() {
+		return nil, ErrObjectNotFound
+	}
+
+	return s.info(key, stat), nil
+}
+
+func (s *localStorage) Move(srcKey, dstKey string) error {
+	srcPath, err := s.path(srcKey)
+	if err != nil {
+		return err
+	}
+	dstPath, err := s.path(dstKey)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dstPath), os.ModePerm); err != nil {
+		return err
+	}
+
+	if err := os.Rename(srcPath, dstPath); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return ErrObjectNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *localStorage) Delete(key string) error {
+	filePath, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(filePath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+func (s *localStorage) List(prefix string) ([]ObjectInfo, error) {
+	if strings.Contains(prefix, "..") || strings.HasPrefix(prefix, "/") {
+		return nil, ErrInvalidKey
+	}
+
+	// Обходим только каталог, в котором лежат ключи с этим префиксом
+	walkRoot := s.root
+	if i := strings.LastIndex(prefix, "/"); i >= 0 {
+		walkRoot = filepath.Join(s.root, filepath.FromSlash(prefix[:i]))
+	}
+
+	objects := make([]ObjectInfo, 0)
+	err := filepath.WalkDir(walkRoot, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".upload-") {
+			return nil
+		}
+
+		rel, err := filepath.Rel(s.root, filePath)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+
+		stat, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		objects = append(objects, *s.info(key, stat))
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return objects, nil
+}
+
+// info builds the ETag from the size and modification time, which is enough
+// to detect a replaced file without reading it.
+func (s *localStorage) info(key string, stat fs.FileInfo) *ObjectInfo {
+	sum := md5.Sum([]byte(fmt.Sprintf("%s:%d:%d", key, stat.Size(), stat.ModTime().UnixNano())))
+
+	return &ObjectInfo{
+		Key:          key,
+		Size:         stat.Size(),
+		LastModified: stat.ModTime(),
+		ETag:         `"` + hex.EncodeToString(sum[:]) + `"`,
+		ContentType:  contentTypeByKey(key),
+	}
+}
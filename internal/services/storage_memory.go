@@ -0,0 +1,146 @@
+package services
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+type memoryObject struct {
+	data         []byte
+	contentType  string
+	lastModified time.Time
+}
+
+type memoryStorage struct {
+	mu      sync.RWMutex
+	objects map[string]memoryObject
+}
+
+// NewMemoryStorage keeps objects in memory. It is intended for tests.
+func NewMemoryStorage() StorageDriver {
+	return &memoryStorage{
+		objects: make(map[string]memoryObject),
+	}
+}
+
+func (s *memoryStorage) Put(key string, r io.Reader, contentType string) error {
+	key, err := cleanKey(key)
+	if err != nil {
+		return err
+	}
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if contentType == "" {
+		contentType = contentTypeByKey(key)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.objects[key] = memoryObject{data: data, contentType: contentType, lastModified: time.Now()}
+
+	return nil
+}
+
+func (s *memoryStorage) Get(key string) (io.ReadCloser, *ObjectInfo, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	object, ok := s.objects[key]
+	if !ok {
+		return nil, nil, ErrObjectNotFound
+	}
+
+	return io.NopCloser(bytes.NewReader(object.data)), s.info(key, object), nil
+}
+
+func (s *memoryStorage) GetRange(key string, offset int64) (io.ReadCloser, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	object, ok := s.objects[key]
+	if !ok {
+		return nil, ErrObjectNotFound
+	}
+	if offset > int64(len(object.data)) {
+		offset = int64(len(object.data))
+	}
+
+	return io.NopCloser(bytes.NewReader(object.data[offset:])), nil
+}
+
+func (s *memoryStorage) Stat(key string) (*ObjectInfo, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	object, ok := s.objects[key]
+	if !ok {
+		return nil, ErrObjectNotFound
+	}
+
+	return s.info(key, object), nil
+}
+
+func (s *memoryStorage) Move(srcKey, dstKey string) error {
+	dstKey, err := cleanKey(dstKey)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	object, ok := s.objects[srcKey]
+	if !ok {
+		return ErrObjectNotFound
+	}
+	delete(s.objects, srcKey)
+	s.objects[dstKey] = object
+
+	return nil
+}
+
+func (s *memoryStorage) Delete(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.objects, key)
+	return nil
+}
+
+func (s *memoryStorage) List(prefix string) ([]ObjectInfo, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	objects := make([]ObjectInfo, 0)
+	for key, object := range s.objects {
+		if strings.HasPrefix(key, prefix) {
+			objects = append(objects, *s.info(key, object))
+		}
+	}
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i].Key < objects[j].Key
+	})
+
+	return objects, nil
+}
+
+func (s *memoryStorage) info(key string, object memoryObject) *ObjectInfo {
+	sum := md5.Sum(object.data)
+
+	return &ObjectInfo{
+		Key:          key,
+		Size:         int64(len(object.data)),
+		LastModified: object.lastModified,
+		ETag:         `"` + hex.EncodeToString(sum[:]) + `"`,
+		ContentType:  object.contentType,
+	}
+}
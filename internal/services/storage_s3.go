@@ -0,0 +1,413 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// S3Options configures an S3-compatible storage such as AWS S3, MinIO or
+// Yandex Object Storage.
+type S3Options struct {
+	Endpoint  string // Например, https://storage.yandexcloud.net или http://localhost:9000
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	// PathStyle addresses objects as endpoint/bucket/key instead of
+	// bucket.endpoint/key; MinIO needs it.
+	PathStyle bool
+}
+
+type s3Storage struct {
+	client   *http.Client
+	opts     S3Options
+	endpoint *url.URL
+}
+
+func NewS3Storage(opts S3Options) (StorageDriver, error) {
+	endpoint, err := url.Parse(strings.TrimSuffix(opts.Endpoint, "/"))
+	if err != nil || endpoint.Host == "" || (endpoint.Scheme != "http" && endpoint.Scheme != "https") {
+		return nil, fmt.Errorf("invalid S3 endpoint: %q", opts.Endpoint)
+	}
+	if opts.Bucket == "" {
+		return nil, errors.New("S3 bucket is not set")
+	}
+	if opts.AccessKey == "" || opts.SecretKey == "" {
+		return nil, errors.New("S3 credentials are not set")
+	}
+	if opts.Region == "" {
+		opts.Region = "us-east-1"
+	}
+
+	return &s3Storage{
+		client:   &http.Client{Timeout: 60 * time.Second},
+		opts:     opts,
+		endpoint: endpoint,
+	}, nil
+}
+
+func (s *s3Storage) Put(key string, r io.Reader, contentType string) error {
+	key, err := cleanKey(key)
+	if err != nil {
+		return err
+	}
+
+	// Тело нужно целиком: его хеш входит в подпись запроса
+	body, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if contentType == "" {
+		contentType = contentTypeByKey(key)
+	}
+
+	resp, err := s.do(http.MethodPut, key, nil, map[string]string{"Content-Type": contentType}, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return s3ResponseError(resp)
+	}
+
+	return nil
+}
+
+func (s *s3Storage) Get(key string) (io.ReadCloser, *ObjectInfo, error) {
+	resp, err := s.do(http.MethodGet, key, nil, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		return nil, nil, s3ResponseError(resp)
+	}
+
+	return resp.Body, s3ObjectInfo(key, resp.Header), nil
+}
+
+func (s *s3Storage) GetRange(key string, offset int64) (io.ReadCloser, error) {
+	headers := map[string]string{"Range": fmt.Sprintf("bytes=%d-", offset)}
+	resp, err := s.do(http.MethodGet, key, nil, headers, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+		return resp.Body, nil
+	case http.StatusOK:
+		// Хранилище без поддержки Range отдаёт объект целиком
+		if _, err := io.CopyN(io.Discard, resp.Body, offset); err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+		return resp.Body, nil
+	default:
+		defer resp.Body.Close()
+		return nil, s3ResponseError(resp)
+	}
+}
+
+func (s *s3Storage) Stat(key string) (*ObjectInfo, error) {
+	resp, err := s.do(http.MethodHead, key, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, s3ResponseError(resp)
+	}
+
+	return s3ObjectInfo(key, resp.Header), nil
+}
+
+// Move copies the object and deletes the source: S3 has no rename.
+func (s *s3Storage) Move(srcKey, dstKey string) error {
+	dstKey, err := cleanKey(dstKey)
+	if err != nil {
+		return err
+	}
+
+	source := "/" + s.opts.Bucket + "/" + uriEncode(srcKey, false)
+	resp, err := s.do(http.MethodPut, dstKey, nil, map[string]string{"X-Amz-Copy-Source": source}, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return s3ResponseError(resp)
+	}
+	// Копирование может завершиться ошибкой уже после ответа 200
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if err != nil {
+		return err
+	}
+	if bytes.Contains(body, []byte("<Error>")) {
+		return parseS3Error(resp.StatusCode, body)
+	}
+
+	return s.Delete(srcKey)
+}
+
+func (s *s3Storage) Delete(key string) error {
+	resp, err := s.do(http.MethodDelete, key, nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		return s3ResponseError(resp)
+	}
+
+	return nil
+}
+
+type s3ListResult struct {
+	Contents []struct {
+		Key          string    `xml:"Key"`
+		LastModified time.Time `xml:"LastModified"`
+		ETag         string    `xml:"ETag"`
+		Size         int64     `xml:"Size"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+func (s *s3Storage) List(prefix string) ([]ObjectInfo, error) {
+	objects := make([]ObjectInfo, 0)
+	token := ""
+
+	for {
+		query := url.Values{"list-type": {"2"}, "prefix": {prefix}}
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+
+		resp, err := s.do(http.MethodGet, "", query, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			err := s3ResponseError(resp)
+			resp.Body.Close()
+			return nil, err
+		}
+
+		var result s3ListResult
+		err = xml.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode S3 list response: %w", err)
+		}
+
+		for _, item := range result.Contents {
+			objects = append(objects, ObjectInfo{
+				Key:          item.Key,
+				Size:         item.Size,
+				LastModified: item.LastModified,
+				ETag:         item.ETag,
+				ContentType:  contentTypeByKey(item.Key),
+			})
+		}
+
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return objects, nil
+		}
+		token = result.NextContinuationToken
+	}
+}
+
+func (s *s3Storage) objectURL(key string, query url.Values) *url.URL {
+	u := *s.endpoint
+	if s.opts.PathStyle {
+		u.Path = "/" + s.opts.Bucket
+		if key != "" {
+			u.Path += "/" + key
+		}
+	} else {
+		u.Host = s.opts.Bucket + "." + u.Host
+		u.Path = "/" + key
+	}
+	u.RawPath = uriEncode(u.Path, false)
+	u.RawQuery = canonicalQuery(query)
+
+	return &u
+}
+
+func (s *s3Storage) do(method, key string, query url.Values, headers map[string]string, body []byte) (*http.Response, error) {
+	if key != "" {
+		if _, err := cleanKey(key); err != nil {
+			return nil, err
+		}
+	}
+
+	var reader io.Reader = http.NoBody
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+
+	// Загрузки и задачи очереди, начатые до остановки приложения, должны
+	// успеть завершиться, поэтому запрос не привязан к контексту приложения
+	req, err := http.NewRequestWithContext(context.Background(), method, s.objectURL(key, query).String(), reader)
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = int64(len(body))
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	signV4(req, sha256Hex(body), s.opts.AccessKey, s.opts.SecretKey, s.opts.Region, time.Now())
+
+	return s.client.Do(req)
+}
+
+// signV4 signs an S3 request with AWS Signature Version 4. Every header set on
+// the request at this point is signed.
+func signV4(req *http.Request, payloadHash, accessKey, secretKey, region string, now time.Time) {
+	amzDate := now.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + sha256Hex([]byte(canonicalRequest))
+
+	key := hmacSHA256([]byte("AWS4"+secretKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s", accessKey, scope, signedHeaders, signature))
+}
+
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		values := append([]string(nil), query[key]...)
+		sort.Strings(values)
+		for _, value := range values {
+			pairs = append(pairs, uriEncode(key, true)+"="+uriEncode(value, true))
+		}
+	}
+
+	return strings.Join(pairs, "&")
+}
+
+// uriEncode percent-encodes everything except unreserved characters, as the
+// signature requires; slashes are kept unless encodeSlash is set.
+func uriEncode(value string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9', c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func s3ObjectInfo(key string, header http.Header) *ObjectInfo {
+	info := &ObjectInfo{
+		Key:         key,
+		ETag:        header.Get("ETag"),
+		ContentType: header.Get("Content-Type"),
+	}
+	if size, err := strconv.ParseInt(header.Get("Content-Length"), 10, 64); err == nil {
+		info.Size = size
+	}
+	if lastModified, err := http.ParseTime(header.Get("Last-Modified")); err == nil {
+		info.LastModified = lastModified
+	}
+
+	return info
+}
+
+func s3ResponseError(resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	return parseS3Error(resp.StatusCode, body)
+}
+
+func parseS3Error(status int, body []byte) error {
+	var s3Err struct {
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	}
+	_ = xml.Unmarshal(body, &s3Err)
+
+	if status == http.StatusNotFound || s3Err.Code == "NoSuchKey" {
+		return ErrObjectNotFound
+	}
+	if s3Err.Code == "" {
+		return fmt.Errorf("S3 request failed with status %d", status)
+	}
+
+	return fmt.Errorf("S3 request failed with status %d: %s: %s", status, s3Err.Code, s3Err.Message)
+}
@@ -0,0 +1,127 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// TokenDenylist revokes dashboard access tokens before they expire. Entries
+// live no longer than an access token, so the denylist stays small:
+//
+//	dashboard_denied_token:<jti>        a single token
+//	dashboard_denied_session:<sid>      every token of a session
+//	dashboard_revoked_before:<email>    every token of the user issued earlier
+type TokenDenylist interface {
+	RevokeToken(claims *DashboardClaims) error
+	RevokeSession(sessionID string) error
+	RevokeIssuedBefore(email string, before time.Time) error
+	IsRevoked(claims *DashboardClaims) (bool, error)
+}
+
+type tokenDenylist struct {
+	redis RedisService
+}
+
+func NewTokenDenylist(redis RedisService) TokenDenylist {
+	return &tokenDenylist{
+		redis: redis,
+	}
+}
+
+// RevokeToken denies the token until it expires.
+func (d *tokenDenylist) RevokeToken(claims *DashboardClaims) error {
+	if claims.ID == "" {
+		return nil
+	}
+
+	ttl := DashboardAccessTokenTTL
+	if claims.ExpiresAt != nil {
+		ttl = time.Until(claims.ExpiresAt.Time)
+	}
+	if ttl <= 0 {
+		return nil
+	}
+
+	return d.redis.Set(deniedTokenKey(claims.ID), 1, ttl)
+}
+
+func (d *tokenDenylist) RevokeSession(sessionID string) error {
+	if sessionID == "" {
+		return nil
+	}
+
+	return d.redis.Set(deniedSessionKey(sessionID), 1, DashboardAccessTokenTTL)
+}
+
+// RevokeIssuedBefore denies every token of the user issued before the given
+// time, e.g. after a role change, deactivation or password change. iat has
+// second precision, so tokens issued within the same second stay valid:
+// otherwise a login right after the revocation would be rejected too.
+func (d *tokenDenylist) RevokeIssuedBefore(email string, before time.Time) error {
+	return d.redis.Set(revokedBeforeKey(email), before.Unix(), DashboardAccessTokenTTL)
+}
+
+func (d *tokenDenylist) IsRevoked(claims *DashboardClaims) (bool, error) {
+	if claims.ID != "" {
+		denied, err := d.redis.Exists(deniedTokenKey(claims.ID))
+		if err != nil || denied {
+			return denied, err
+		}
+	}
+
+	if claims.SessionID != "" {
+		denied, err := d.redis.Exists(deniedSessionKey(claims.SessionID))
+		if err != nil || denied {
+			return denied, err
+		}
+	}
+
+	value, err := d.redis.Get(revokedBeforeKey(claims.Email))
+	if errors.Is(err, ErrKeyNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	before, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("invalid revocation time %q: %w", value, err)
+	}
+
+	// Токены без iat выпущены до появления отзыва и считаются старыми
+	if claims.IssuedAt == nil {
+		return true, nil
+	}
+
+	return claims.IssuedAt.Unix() < before, nil
+}
+
+// RevokeDashboardUser ends every session of the user and revokes their access
+// tokens: by session exactly, by issue time with second precision.
+func RevokeDashboardUser(sessions DashboardSessionStore, denylist TokenDenylist, email string) error {
+	sessionIDs, err := sessions.RevokeAll(email)
+	if err != nil {
+		return err
+	}
+	for _, sessionID := range sessionIDs {
+		if err := denylist.RevokeSession(sessionID); err != nil {
+			return err
+		}
+	}
+
+	return denylist.RevokeIssuedBefore(email, time.Now())
+}
+
+func deniedTokenKey(tokenID string) string {
+	return fmt.Sprintf("dashboard_denied_token:%s", tokenID)
+}
+
+func deniedSessionKey(sessionID string) string {
+	return fmt.Sprintf("dashboard_denied_session:%s", sessionID)
+}
+
+func revokedBeforeKey(email string) string {
+	return fmt.Sprintf("dashboard_revoked_before:%s", email)
+}
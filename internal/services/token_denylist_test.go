@@ -0,0 +1,96 @@
+package services
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTokenDenylist(t *testing.T) {
+	redis := newMemoryRedis()
+	denylist := NewTokenDenylist(redis)
+	jwtService := NewJWTService("test-dashboard-secret", "test-client-secret")
+
+	issue := func(sessionID string) *DashboardClaims {
+		t.Helper()
+		tokenPair, err := jwtService.GenerateDashboardTokenPair("manager@example.com", "manager", sessionID)
+		if err != nil {
+			t.Fatalf("Failed to generate token pair: %v", err)
+		}
+		claims, err := jwtService.ValidateDashboardToken(tokenPair.AccessToken)
+		if err != nil {
+			t.Fatalf("Failed to validate token: %v", err)
+		}
+		if claims.ID == "" || claims.IssuedAt == nil {
+			t.Fatal("Expected jti and iat in dashboard token")
+		}
+		return claims
+	}
+	assertRevoked := func(claims *DashboardClaims, want bool) {
+		t.Helper()
+		revoked, err := denylist.IsRevoked(claims)
+		if err != nil {
+			t.Fatalf("IsRevoked failed: %v", err)
+		}
+		if revoked != want {
+			t.Errorf("Expected revoked=%v for token %s, got %v", want, claims.ID, revoked)
+		}
+	}
+
+	first, second, other := issue("s1"), issue("s1"), issue("s2")
+	assertRevoked(first, false)
+
+	if err := denylist.RevokeToken(first); err != nil {
+		t.Fatalf("RevokeToken failed: %v", err)
+	}
+	assertRevoked(first, true)
+	assertRevoked(second, false)
+
+	if err := denylist.RevokeSession("s1"); err != nil {
+		t.Fatalf("RevokeSession failed: %v", err)
+	}
+	assertRevoked(second, true)
+	assertRevoked(other, false)
+
+	if err := denylist.RevokeIssuedBefore("manager@example.com", time.Now().Add(time.Second)); err != nil {
+		t.Fatalf("RevokeIssuedBefore failed: %v", err)
+	}
+	assertRevoked(other, true)
+
+	// Токены, выпущенные после отзыва, действуют
+	if err := denylist.RevokeIssuedBefore("manager@example.com", time.Now().Add(-time.Second)); err != nil {
+		t.Fatalf("RevokeIssuedBefore failed: %v", err)
+	}
+	assertRevoked(issue("s3"), false)
+}
+
+func TestRevokeDashboardUser(t *testing.T) {
+	redis := newMemoryRedis()
+	sessions := NewDashboardSessionStore(redis)
+	denylist := NewTokenDenylist(redis)
+	email := "manager@example.com"
+
+	for _, id := range []string{"s1", "s2"} {
+		session := &DashboardSession{ID: id, Email: email, CreatedAt: time.Now(), LastUsedAt: time.Now()}
+		if err := sessions.Save(session, "token-"+id, time.Hour); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+	}
+
+	if err := RevokeDashboardUser(sessions, denylist, email); err != nil {
+		t.Fatalf("RevokeDashboardUser failed: %v", err)
+	}
+
+	if list, err := sessions.List(email); err != nil || len(list) != 0 {
+		t.Errorf("Expected no sessions left, got %v, %v", list, err)
+	}
+	// Токен без iat отзывается только по сессии
+	for _, id := range []string{"s1", "s2"} {
+		revoked, err := denylist.IsRevoked(&DashboardClaims{SessionID: id, Email: "other@example.com"})
+		if err != nil || !revoked {
+			t.Errorf("Expected session %s to be revoked, got %v, %v", id, revoked, err)
+		}
+	}
+	if revoked, err := denylist.IsRevoked(&DashboardClaims{Email: email}); err != nil || !revoked {
+		t.Errorf("Expected tokens issued before the revocation to be revoked, got %v, %v", revoked, err)
+	}
+}
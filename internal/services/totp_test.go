@@ -0,0 +1,63 @@
+package services
+
+import (
+	"encoding/base32"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Тестовый вектор RFC 6238 для SHA-1, последние 6 цифр
+func TestTOTPService_RFC6238Vector(t *testing.T) {
+	secret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+	svc := NewTOTPService("Hair Company")
+
+	for at, code := range map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1234567890: "005924",
+		2000000000: "279037",
+	} {
+		step, ok := svc.Validate(secret, code, time.Unix(at, 0))
+		if !ok || step != at/30 {
+			t.Errorf("Expected code %s to be valid at %d, got step %d, %v", code, at, step, ok)
+		}
+	}
+}
+
+func TestTOTPService_Validate(t *testing.T) {
+	svc := NewTOTPService("Hair Company")
+	secret, err := svc.GenerateSecret()
+	if err != nil {
+		t.Fatalf("GenerateSecret failed: %v", err)
+	}
+	key, _ := totpEncoding.DecodeString(secret)
+
+	now := time.Now()
+	step := now.Unix() / 30
+	if _, ok := svc.Validate(secret, totpCode(key, step-1), now); !ok {
+		t.Error("Expected code of the previous step to be accepted")
+	}
+	if _, ok := svc.Validate(secret, totpCode(key, step+3), now); ok {
+		t.Error("Expected code far in the future to be rejected")
+	}
+	if _, ok := svc.Validate(secret, "12345", now); ok {
+		t.Error("Expected short code to be rejected")
+	}
+}
+
+func TestTOTPService_URI(t *testing.T) {
+	uri := NewTOTPService("Hair Company").URI("JBSWY3DPEHPK3PXP", "admin@example.com")
+
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		t.Fatalf("Invalid URI %s: %v", uri, err)
+	}
+	if parsed.Scheme != "otpauth" || parsed.Host != "totp" || !strings.HasPrefix(parsed.Path, "/Hair Company:admin@example.com") {
+		t.Errorf("Unexpected URI: %s", uri)
+	}
+	if parsed.Query().Get("secret") != "JBSWY3DPEHPK3PXP" || parsed.Query().Get("issuer") != "Hair Company" {
+		t.Errorf("Unexpected URI parameters: %s", uri)
+	}
+}
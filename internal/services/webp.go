@@ -0,0 +1,614 @@
+package services
+
+import (
+	"bytes"
+	"container/heap"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/draw"
+	"io"
+	"sort"
+)
+
+// EncodeWebP кодирует изображение в WebP без потерь (VP8L). Кодер использует
+// преобразования subtract green и predictor, LZ77 и канонические коды Хаффмана;
+// цветовой кэш и meta prefix codes не используются.
+func EncodeWebP(w io.Writer, img image.Image) error {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width < 1 || height < 1 || width > 1<<14 || height > 1<<14 {
+		return errors.New("webp: image dimensions out of range")
+	}
+
+	nrgba, ok := img.(*image.NRGBA)
+	if !ok || nrgba.Rect.Min != (image.Point{}) || nrgba.Stride != 4*width {
+		nrgba = image.NewNRGBA(image.Rect(0, 0, width, height))
+		draw.Draw(nrgba, nrgba.Rect, img, bounds.Min, draw.Src)
+	}
+
+	argb := make([]uint32, width*height)
+	hasAlpha := false
+	for i := range argb {
+		p := nrgba.Pix[4*i : 4*i+4]
+		argb[i] = uint32(p[3])<<24 | uint32(p[0])<<16 | uint32(p[1])<<8 | uint32(p[2])
+		if p[3] != 0xff {
+			hasAlpha = true
+		}
+	}
+
+	bw := &webpBitWriter{}
+	bw.writeBits(0x2f, 8)
+	bw.writeBits(uint32(width-1), 14)
+	bw.writeBits(uint32(height-1), 14)
+	if hasAlpha {
+		bw.writeBits(1, 1)
+	} else {
+		bw.writeBits(0, 1)
+	}
+	bw.writeBits(0, 3)
+
+	// Subtract green
+	bw.writeBits(1, 1)
+	bw.writeBits(2, 2)
+	for i, p := range argb {
+		green := (p >> 8) & 0xff
+		r := ((p >> 16) - green) & 0xff
+		b := (p - green) & 0xff
+		argb[i] = p&0xff00ff00 | r<<16 | b
+	}
+
+	// Predictor
+	const predictorBits = 4
+	bw.writeBits(1, 1)
+	bw.writeBits(0, 2)
+	bw.writeBits(predictorBits-2, 3)
+	modes, residuals := webpPredict(argb, width, height, predictorBits)
+	webpWriteImageData(bw, modes, false)
+
+	bw.writeBits(0, 1)
+	webpWriteImageData(bw, residuals, true)
+
+	data := bw.bytes()
+	chunkSize := len(data)
+	padded := chunkSize + chunkSize&1
+
+	header := make([]byte, 20)
+	copy(header[0:], "RIFF")
+	binary.LittleEndian.PutUint32(header[4:], uint32(4+8+padded))
+	copy(header[8:], "WEBPVP8L")
+	binary.LittleEndian.PutUint32(header[16:], uint32(chunkSize))
+
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	if padded != chunkSize {
+		if _, err := w.Write([]byte{0}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type webpBitWriter struct {
+	buf   bytes.Buffer
+	bits  uint64
+	nBits uint
+}
+
+func (b *webpBitWriter) writeBits(value uint32, n uint) {
+	b.bits |= uint64(value) << b.nBits
+	b.nBits += n
+	for b.nBits >= 8 {
+		b.buf.WriteByte(byte(b.bits))
+		b.bits >>= 8
+		b.nBits -= 8
+	}
+}
+
+func (b *webpBitWriter) bytes() []byte {
+	if b.nBits > 0 {
+		b.buf.WriteByte(byte(b.bits))
+		b.bits, b.nBits = 0, 0
+	}
+	return b.buf.Bytes()
+}
+
+// webpPredictorModes — режимы, из которых выбирается предсказатель для блока.
+var webpPredictorModes = []uint32{1, 2, 7, 11, 12}
+
+func webpPredict(argb []uint32, width, height int, bits uint) ([]uint32, []uint32) {
+	tilesX := (width + 1<<bits - 1) >> bits
+	tilesY := (height + 1<<bits - 1) >> bits
+	modes := make([]uint32, tilesX*tilesY)
+	residuals := make([]uint32, len(argb))
+
+	for ty := 0; ty < tilesY; ty++ {
+		for tx := 0; tx < tilesX; tx++ {
+			best, bestCost := webpPredictorModes[0], -1
+			for _, mode := range webpPredictorModes {
+				cost := 0
+				for y := ty << bits; y < height && y < (ty+1)<<bits; y++ {
+					for x := tx << bits; x < width && x < (tx+1)<<bits; x++ {
+						cost += webpResidualCost(webpSub(argb[y*width+x], webpPredictPixel(argb, width, x, y, mode)))
+					}
+				}
+				if bestCost < 0 || cost < bestCost {
+					best, bestCost = mode, cost
+				}
+			}
+			modes[ty*tilesX+tx] = 0xff000000 | best<<8
+		}
+	}
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			mode := modes[(y>>bits)*tilesX+(x>>bits)] >> 8 & 0xf
+			residuals[y*width+x] = webpSub(argb[y*width+x], webpPredictPixel(argb, width, x, y, mode))
+		}
+	}
+
+	return modes, residuals
+}
+
+// webpPredictPixel повторяет правила декодера: первый пиксель предсказывается
+// чёрным, первая строка — левым соседом, первый столбец — верхним.
+func webpPredictPixel(argb []uint32, width, x, y int, mode uint32) uint32 {
+	switch {
+	case x == 0 && y == 0:
+		return 0xff000000
+	case y == 0:
+		return argb[x-1]
+	case x == 0:
+		return argb[(y-1)*width]
+	}
+
+	i := y*width + x
+	l, t, tl := argb[i-1], argb[i-width], argb[i-width-1]
+	switch mode {
+	case 1:
+		return l
+	case 2:
+		return t
+	case 7:
+		return webpChannels(l, t, func(a, b uint32) uint32 { return (a + b) / 2 })
+	case 11:
+		pl := webpDistance(t, tl)
+		pt := webpDistance(l, tl)
+		if pl < pt {
+			return l
+		}
+		return t
+	case 12:
+		var out uint32
+		for shift := uint(0); shift < 32; shift += 8 {
+			v := int(l>>shift&0xff) + int(t>>shift&0xff) - int(tl>>shift&0xff)
+			out |= uint32(min(max(v, 0), 255)) << shift
+		}
+		return out
+	}
+
+	return 0xff000000
+}
+
+func webpChannels(a, b uint32, fn func(a, b uint32) uint32) uint32 {
+	var out uint32
+	for shift := uint(0); shift < 32; shift += 8 {
+		out |= (fn(a>>shift&0xff, b>>shift&0xff) & 0xff) << shift
+	}
+	return out
+}
+
+func webpSub(a, b uint32) uint32 {
+	return webpChannels(a, b, func(a, b uint32) uint32 { return a - b })
+}
+
+func webpDistance(a, b uint32) int {
+	sum := 0
+	for shift := uint(0); shift < 32; shift += 8 {
+		d := int(a>>shift&0xff) - int(b>>shift&0xff)
+		if d < 0 {
+			d = -d
+		}
+		sum += d
+	}
+	return sum
+}
+
+// webpResidualCost оценивает остаток: значения около 0 и 255 дешевле.
+func webpResidualCost(residual uint32) int {
+	cost := 0
+	for shift := uint(0); shift < 32; shift += 8 {
+		v := int(residual >> shift & 0xff)
+		cost += min(v, 256-v)
+	}
+	return cost
+}
+
+const (
+	webpNumLiterals     = 256
+	webpNumLengthCodes  = 24
+	webpNumDistanceCode = 40
+	webpMaxCodeLength   = 15
+	webpMinMatch        = 3
+	webpMaxMatch        = 4096
+	webpWindow          = 1<<20 - 120
+	webpHashBits        = 15
+	webpChainDepth      = 32
+)
+
+// webpSymbol — литерал (пиксель) или обратная ссылка LZ77.
+type webpSymbol struct {
+	pixel    uint32
+	length   int
+	distance int
+}
+
+func webpWriteImageData(bw *webpBitWriter, argb []uint32, topLevel bool) {
+	// Цветовой кэш не используется
+	bw.writeBits(0, 1)
+	if topLevel {
+		// Один набор кодов на всё изображение
+		bw.writeBits(0, 1)
+	}
+
+	symbols := webpBackwardRefs(argb)
+
+	var histograms [5][]int
+	histograms[0] = make([]int, webpNumLiterals+webpNumLengthCodes)
+	histograms[1] = make([]int, webpNumLiterals)
+	histograms[2] = make([]int, webpNumLiterals)
+	histograms[3] = make([]int, webpNumLiterals)
+	histograms[4] = make([]int, webpNumDistanceCode)
+
+	for _, s := range symbols {
+		if s.length > 0 {
+			lengthCode, _, _ := webpPrefixEncode(s.length)
+			distanceCode, _, _ := webpPrefixEncode(s.distance + 120)
+			histograms[0][webpNumLiterals+lengthCode]++
+			histograms[4][distanceCode]++
+			continue
+		}
+		histograms[0][s.pixel>>8&0xff]++
+		histograms[1][s.pixel>>16&0xff]++
+		histograms[2][s.pixel&0xff]++
+		histograms[3][s.pixel>>24]++
+	}
+
+	var codes [5]webpHuffmanCode
+	for i, histogram := range histograms {
+		codes[i] = webpWriteHuffmanCode(bw, histogram)
+	}
+
+	for _, s := range symbols {
+		if s.length > 0 {
+			code, extraBits, extra := webpPrefixEncode(s.length)
+			codes[0].write(bw, webpNumLiterals+code)
+			bw.writeBits(extra, extraBits)
+			code, extraBits, extra = webpPrefixEncode(s.distance + 120)
+			codes[4].write(bw, code)
+			bw.writeBits(extra, extraBits)
+			continue
+		}
+		codes[0].write(bw, int(s.pixel>>8&0xff))
+		codes[1].write(bw, int(s.pixel>>16&0xff))
+		codes[2].write(bw, int(s.pixel&0xff))
+		codes[3].write(bw, int(s.pixel>>24))
+	}
+}
+
+// webpBackwardRefs жадно ищет повторы по хеш-цепочкам из двух пикселей.
+func webpBackwardRefs(argb []uint32) []webpSymbol {
+	symbols := make([]webpSymbol, 0, len(argb))
+	head := make([]int32, 1<<webpHashBits)
+	for i := range head {
+		head[i] = -1
+	}
+	prev := make([]int32, len(argb))
+
+	hash := func(i int) uint32 {
+		return (argb[i]*0x9e3779b1 ^ argb[i+1]*0x85ebca6b) >> (32 - webpHashBits)
+	}
+	insert := func(i int) {
+		if i+1 < len(argb) {
+			h := hash(i)
+			prev[i] = head[h]
+			head[h] = int32(i)
+		}
+	}
+
+	for i := 0; i < len(argb); {
+		bestLength, bestDistance := 0, 0
+		if i+webpMinMatch <= len(argb) {
+			maxLength := min(webpMaxMatch, len(argb)-i)
+			candidate := head[hash(i)]
+			for depth := 0; candidate >= 0 && depth < webpChainDepth; depth++ {
+				distance := i - int(candidate)
+				if distance > webpWindow {
+					break
+				}
+				length := 0
+				for length < maxLength && argb[int(candidate)+length] == argb[i+length] {
+					length++
+				}
+				if length > bestLength {
+					bestLength, bestDistance = length, distance
+					if length == maxLength {
+						break
+					}
+				}
+				candidate = prev[candidate]
+			}
+		}
+
+		if bestLength >= webpMinMatch {
+			symbols = append(symbols, webpSymbol{length: bestLength, distance: bestDistance})
+			for j := 0; j < bestLength; j++ {
+				insert(i + j)
+			}
+			i += bestLength
+			continue
+		}
+
+		symbols = append(symbols, webpSymbol{pixel: argb[i]})
+		insert(i)
+		i++
+	}
+
+	return symbols
+}
+
+// webpPrefixEncode кодирует длину или расстояние LZ77 (value >= 1) в префиксный
+// код и дополнительные биты.
+func webpPrefixEncode(value int) (code int, extraBits uint, extra uint32) {
+	v := value - 1
+	if v < 4 {
+		return v, 0, 0
+	}
+	highest := 0
+	for t := v; t > 1; t >>= 1 {
+		highest++
+	}
+	second := (v >> (highest - 1)) & 1
+	extraBits = uint(highest - 1)
+
+	return 2*highest + second, extraBits, uint32(v & (1<<extraBits - 1))
+}
+
+type webpHuffmanCode struct {
+	codes   []uint32
+	lengths []uint8
+}
+
+func (c webpHuffmanCode) write(bw *webpBitWriter, symbol int) {
+	if c.lengths[symbol] > 0 {
+		bw.writeBits(c.codes[symbol], uint(c.lengths[symbol]))
+	}
+}
+
+var webpCodeLengthOrder = [19]int{17, 18, 0, 1, 2, 3, 4, 5, 16, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+
+// webpWriteHuffmanCode строит код по гистограмме и записывает его длины.
+func webpWriteHuffmanCode(bw *webpBitWriter, histogram []int) webpHuffmanCode {
+	used := make([]int, 0, 2)
+	for symbol, count := range histogram {
+		if count > 0 {
+			used = append(used, symbol)
+			if len(used) > 2 {
+				break
+			}
+		}
+	}
+
+	// Простой код для одного-двух символов меньше 256
+	if len(used) <= 2 && (len(used) == 0 || used[len(used)-1] < 256) {
+		code := webpHuffmanCode{codes: make([]uint32, len(histogram)), lengths: make([]uint8, len(histogram))}
+		if len(used) == 0 {
+			used = append(used, 0)
+		}
+
+		bw.writeBits(1, 1)
+		bw.writeBits(uint32(len(used)-1), 1)
+		if used[0] < 2 {
+			bw.writeBits(0, 1)
+			bw.writeBits(uint32(used[0]), 1)
+		} else {
+			bw.writeBits(1, 1)
+			bw.writeBits(uint32(used[0]), 8)
+		}
+		if len(used) == 2 {
+			bw.writeBits(uint32(used[1]), 8)
+			code.lengths[used[0]], code.lengths[used[1]] = 1, 1
+			code.codes[used[0]], code.codes[used[1]] = 0, 1
+		}
+		return code
+	}
+
+	lengths := webpHuffmanLengths(histogram, webpMaxCodeLength)
+
+	type token struct {
+		symbol int
+		extra  uint32
+		bits   uint
+	}
+	var tokens []token
+	for i := 0; i < len(lengths); {
+		if lengths[i] != 0 {
+			tokens = append(tokens, token{symbol: int(lengths[i])})
+			i++
+			continue
+		}
+		run := 0
+		for i+run < len(lengths) && lengths[i+run] == 0 {
+			run++
+		}
+		i += run
+		for run > 0 {
+			switch {
+			case run >= 11:
+				n := min(run, 138)
+				tokens = append(tokens, token{symbol: 18, extra: uint32(n - 11), bits: 7})
+				run -= n
+			case run >= 3:
+				tokens = append(tokens, token{symbol: 17, extra: uint32(run - 3), bits: 3})
+				run = 0
+			default:
+				tokens = append(tokens, token{symbol: 0})
+				run--
+			}
+		}
+	}
+
+	clHistogram := make([]int, 19)
+	for _, t := range tokens {
+		clHistogram[t.symbol]++
+	}
+	clLengths := webpHuffmanLengths(clHistogram, 7)
+	clCode := webpCanonicalCode(clLengths)
+
+	numCodes := 4
+	for i, symbol := range webpCodeLengthOrder {
+		if clLengths[symbol] != 0 {
+			numCodes = max(numCodes, i+1)
+		}
+	}
+
+	bw.writeBits(0, 1)
+	bw.writeBits(uint32(numCodes-4), 4)
+	for _, symbol := range webpCodeLengthOrder[:numCodes] {
+		bw.writeBits(uint32(clLengths[symbol]), 3)
+	}
+	// Длины записаны для всего алфавита, max_symbol не нужен
+	bw.writeBits(0, 1)
+	for _, t := range tokens {
+		clCode.write(bw, t.symbol)
+		bw.writeBits(t.extra, t.bits)
+	}
+
+	return webpCanonicalCode(lengths)
+}
+
+// webpHuffmanLengths возвращает длины кодов не длиннее limit. Код всегда
+// содержит хотя бы два символа, иначе его не получится записать обычным способом.
+func webpHuffmanLengths(histogram []int, limit uint8) []uint8 {
+	counts := append([]int(nil), histogram...)
+	nonZero := 0
+	for _, c := range counts {
+		if c > 0 {
+			nonZero++
+		}
+	}
+	for i := 0; nonZero < 2 && i < len(counts); i++ {
+		if counts[i] == 0 {
+			counts[i] = 1
+			nonZero++
+		}
+	}
+
+	for {
+		lengths := webpBuildLengths(counts)
+		longest := uint8(0)
+		for _, l := range lengths {
+			longest = max(longest, l)
+		}
+		if longest <= limit {
+			return lengths
+		}
+		// Сглаживаем частоты, пока дерево не станет достаточно плоским
+		for i, c := range counts {
+			if c > 0 {
+				counts[i] = (c + 1) / 2
+			}
+		}
+	}
+}
+
+type webpNode struct {
+	count       int
+	symbol      int
+	left, right *webpNode
+}
+
+type webpNodeHeap []*webpNode
+
+func (h webpNodeHeap) Len() int { return len(h) }
+func (h webpNodeHeap) Less(i, j int) bool {
+	if h[i].count != h[j].count {
+		return h[i].count < h[j].count
+	}
+	return h[i].symbol < h[j].symbol
+}
+func (h webpNodeHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+func (h *webpNodeHeap) Push(x any)   { *h = append(*h, x.(*webpNode)) }
+func (h *webpNodeHeap) Pop() any {
+	old := *h
+	n := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return n
+}
+
+func webpBuildLengths(counts []int) []uint8 {
+	lengths := make([]uint8, len(counts))
+	h := &webpNodeHeap{}
+	for symbol, count := range counts {
+		if count > 0 {
+			*h = append(*h, &webpNode{count: count, symbol: symbol})
+		}
+	}
+	heap.Init(h)
+
+	for h.Len() > 1 {
+		a := heap.Pop(h).(*webpNode)
+		b := heap.Pop(h).(*webpNode)
+		heap.Push(h, &webpNode{count: a.count + b.count, symbol: min(a.symbol, b.symbol), left: a, right: b})
+	}
+
+	var walk func(n *webpNode, depth uint8)
+	walk = func(n *webpNode, depth uint8) {
+		if n.left == nil {
+			lengths[n.symbol] = max(depth, 1)
+			return
+		}
+		walk(n.left, depth+1)
+		walk(n.right, depth+1)
+	}
+	walk(heap.Pop(h).(*webpNode), 0)
+
+	return lengths
+}
+
+// webpCanonicalCode назначает канонические коды. Биты кода переворачиваются:
+// поток VP8L читается начиная с младших битов.
+func webpCanonicalCode(lengths []uint8) webpHuffmanCode {
+	code := webpHuffmanCode{codes: make([]uint32, len(lengths)), lengths: lengths}
+
+	symbols := make([]int, 0, len(lengths))
+	for symbol, l := range lengths {
+		if l > 0 {
+			symbols = append(symbols, symbol)
+		}
+	}
+	sort.SliceStable(symbols, func(i, j int) bool {
+		return lengths[symbols[i]] < lengths[symbols[j]]
+	})
+
+	next, prevLength := uint32(0), uint8(0)
+	for _, symbol := range symbols {
+		l := lengths[symbol]
+		next <<= l - prevLength
+		prevLength = l
+
+		reversed := uint32(0)
+		for b := uint8(0); b < l; b++ {
+			reversed |= (next >> b & 1) << (l - 1 - b)
+		}
+		code.codes[symbol] = reversed
+		next++
+	}
+
+	return code
+}
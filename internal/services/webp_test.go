@@ -0,0 +1,109 @@
+package services
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"math/rand"
+	"testing"
+
+	"golang.org/x/image/webp"
+)
+
+func TestEncodeWebP_RoundTrip(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+
+	cases := map[string]*image.NRGBA{
+		"1x1":      image.NewNRGBA(image.Rect(0, 0, 1, 1)),
+		"gradient": image.NewNRGBA(image.Rect(0, 0, 67, 45)),
+		"noise":    image.NewNRGBA(image.Rect(0, 0, 33, 17)),
+		"alpha":    image.NewNRGBA(image.Rect(0, 0, 20, 20)),
+		"flat":     image.NewNRGBA(image.Rect(0, 0, 300, 40)),
+	}
+	for y := 0; y < 45; y++ {
+		for x := 0; x < 67; x++ {
+			cases["gradient"].SetNRGBA(x, y, color.NRGBA{R: uint8(x * 3), G: uint8(y * 5), B: uint8(x + y), A: 255})
+		}
+	}
+	rnd.Read(cases["noise"].Pix)
+	for y := 0; y < 20; y++ {
+		for x := 0; x < 20; x++ {
+			cases["alpha"].SetNRGBA(x, y, color.NRGBA{R: 200, G: uint8(x * 10), B: 30, A: uint8(y * 12)})
+		}
+	}
+	for i := 0; i < len(cases["flat"].Pix); i += 4 {
+		copy(cases["flat"].Pix[i:], []byte{10, 20, 30, 255})
+	}
+
+	for name, img := range cases {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := EncodeWebP(&buf, img); err != nil {
+				t.Fatalf("EncodeWebP failed: %v", err)
+			}
+
+			decoded, err := webp.Decode(&buf)
+			if err != nil {
+				t.Fatalf("Failed to decode: %v", err)
+			}
+			if decoded.Bounds() != img.Bounds() {
+				t.Fatalf("Expected bounds %v, got %v", img.Bounds(), decoded.Bounds())
+			}
+			for y := 0; y < img.Rect.Dy(); y++ {
+				for x := 0; x < img.Rect.Dx(); x++ {
+					want := img.NRGBAAt(x, y)
+					got := color.NRGBAModel.Convert(decoded.At(x, y)).(color.NRGBA)
+					if want.A == 0 {
+						want, got = color.NRGBA{}, color.NRGBA{A: got.A}
+					}
+					if got != want {
+						t.Fatalf("Pixel (%d, %d): expected %v, got %v", x, y, want, got)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestEncodeWebP_Compresses(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 256, 256))
+	for y := 0; y < 256; y++ {
+		for x := 0; x < 256; x++ {
+			img.SetNRGBA(x, y, color.NRGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := EncodeWebP(&buf, img); err != nil {
+		t.Fatalf("EncodeWebP failed: %v", err)
+	}
+	if buf.Len() > len(img.Pix)/20 {
+		t.Errorf("Expected a smooth gradient to compress well, got %d bytes", buf.Len())
+	}
+}
+
+func TestWebpHuffmanLengths_Limit(t *testing.T) {
+	// Геометрическое распределение даёт коды длиннее 15 бит без ограничения
+	histogram := make([]int, 280)
+	for i := 0; i < 30; i++ {
+		histogram[i] = 1 << (30 - i)
+	}
+
+	lengths := webpHuffmanLengths(histogram, webpMaxCodeLength)
+
+	kraft := 0
+	for i, l := range lengths {
+		if l > webpMaxCodeLength {
+			t.Fatalf("Code length %d exceeds limit", l)
+		}
+		if histogram[i] > 0 && l == 0 {
+			t.Fatalf("Used symbol %d has no code", i)
+		}
+		if l > 0 {
+			kraft += 1 << (webpMaxCodeLength - l)
+		}
+	}
+	if kraft != 1<<webpMaxCodeLength {
+		t.Errorf("Expected a complete code, Kraft sum is %d/%d", kraft, 1<<webpMaxCodeLength)
+	}
+}
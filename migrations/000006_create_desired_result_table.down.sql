@@ -0,0 +1 @@
+DROP TABLE desired_results;
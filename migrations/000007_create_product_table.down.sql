@@ -0,0 +1,6 @@
+DROP TABLE product_shades;
+DROP TABLE product_desired_results;
+DROP INDEX idx_products_product_type_id;
+DROP INDEX idx_products_line_id;
+DROP INDEX idx_products_category_id;
+DROP TABLE products;
@@ -0,0 +1,38 @@
+CREATE TABLE products
+(
+    id              SERIAL PRIMARY KEY,
+    name            VARCHAR(255) NOT NULL UNIQUE,
+    slug            VARCHAR(255) NOT NULL UNIQUE,
+    description     TEXT,
+    composition     TEXT,
+    application     TEXT,
+    image           VARCHAR(255),
+    category_id     INTEGER      NOT NULL REFERENCES categories (id) ON DELETE RESTRICT,
+    line_id         INTEGER      NOT NULL REFERENCES lines (id) ON DELETE RESTRICT,
+    product_type_id INTEGER      NOT NULL REFERENCES product_types (id) ON DELETE RESTRICT,
+    sort_index      INTEGER      NOT NULL DEFAULT 100,
+    seo_title       VARCHAR(255),
+    seo_description TEXT,
+    seo_keys        TEXT,
+    is_active       BOOLEAN      NOT NULL DEFAULT TRUE,
+    created_at      TIMESTAMP    NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMP    NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_products_category_id ON products (category_id);
+CREATE INDEX idx_products_line_id ON products (line_id);
+CREATE INDEX idx_products_product_type_id ON products (product_type_id);
+
+CREATE TABLE product_desired_results
+(
+    product_id        INTEGER NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    desired_result_id INTEGER NOT NULL REFERENCES desired_results (id) ON DELETE RESTRICT,
+    PRIMARY KEY (product_id, desired_result_id)
+);
+
+CREATE TABLE product_shades
+(
+    product_id INTEGER NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    shade_id   INTEGER NOT NULL REFERENCES shades (id) ON DELETE RESTRICT,
+    PRIMARY KEY (product_id, shade_id)
+);
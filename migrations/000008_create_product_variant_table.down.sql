@@ -0,0 +1,3 @@
+DROP INDEX idx_product_variants_shade_id;
+DROP INDEX idx_product_variants_product_id;
+DROP TABLE product_variants;
@@ -0,0 +1,19 @@
+CREATE TABLE product_variants
+(
+    id         SERIAL PRIMARY KEY,
+    product_id INTEGER      NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    shade_id   INTEGER      REFERENCES shades (id) ON DELETE RESTRICT,
+    article    VARCHAR(64)  NOT NULL UNIQUE,
+    barcode    VARCHAR(13)  NOT NULL UNIQUE,
+    volume     INTEGER      NOT NULL,
+    weight     INTEGER      NOT NULL DEFAULT 0,
+    price      BIGINT       NOT NULL,
+    old_price  BIGINT,
+    sort_index INTEGER      NOT NULL DEFAULT 100,
+    is_active  BOOLEAN      NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP    NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP    NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_product_variants_product_id ON product_variants (product_id);
+CREATE INDEX idx_product_variants_shade_id ON product_variants (shade_id);
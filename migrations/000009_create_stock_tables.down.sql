@@ -0,0 +1,7 @@
+DROP INDEX idx_stock_reservations_reference;
+DROP TABLE stock_reservations;
+DROP TYPE stock_reservation_status;
+DROP INDEX idx_stock_movements_product_variant_id;
+DROP TABLE stock_movements;
+DROP TYPE stock_movement_type;
+DROP TABLE stocks;
@@ -0,0 +1,42 @@
+CREATE TABLE stocks
+(
+    product_variant_id INTEGER PRIMARY KEY REFERENCES product_variants (id) ON DELETE CASCADE,
+    quantity           INTEGER   NOT NULL DEFAULT 0,
+    reserved           INTEGER   NOT NULL DEFAULT 0,
+    created_at         TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at         TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_stocks_quantity CHECK (quantity >= 0),
+    CONSTRAINT chk_stocks_reserved CHECK (reserved >= 0 AND reserved <= quantity)
+);
+
+CREATE TYPE stock_movement_type AS ENUM ('adjustment', 'reserve', 'release', 'commit');
+
+CREATE TABLE stock_movements
+(
+    id                 SERIAL PRIMARY KEY,
+    product_variant_id INTEGER             NOT NULL REFERENCES product_variants (id) ON DELETE CASCADE,
+    type               stock_movement_type NOT NULL,
+    quantity_delta     INTEGER             NOT NULL DEFAULT 0,
+    reserved_delta     INTEGER             NOT NULL DEFAULT 0,
+    reason             VARCHAR(255),
+    reference          VARCHAR(64),
+    created_by         VARCHAR(255),
+    created_at         TIMESTAMP           NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_stock_movements_product_variant_id ON stock_movements (product_variant_id);
+
+CREATE TYPE stock_reservation_status AS ENUM ('active', 'released', 'committed');
+
+CREATE TABLE stock_reservations
+(
+    id                 SERIAL PRIMARY KEY,
+    reference          VARCHAR(64)              NOT NULL,
+    product_variant_id INTEGER                  NOT NULL REFERENCES product_variants (id) ON DELETE CASCADE,
+    quantity           INTEGER                  NOT NULL CHECK (quantity > 0),
+    status             stock_reservation_status NOT NULL DEFAULT 'active',
+    created_at         TIMESTAMP                NOT NULL DEFAULT NOW(),
+    updated_at         TIMESTAMP                NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_stock_reservations_reference ON stock_reservations (reference);
@@ -0,0 +1 @@
+DROP TABLE client_users;
\ No newline at end of file
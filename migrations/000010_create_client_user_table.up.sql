@@ -0,0 +1,7 @@
+CREATE TABLE client_users
+(
+    id         SERIAL PRIMARY KEY,
+    phone      VARCHAR(255) NOT NULL UNIQUE,
+    created_at TIMESTAMP    NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP    NOT NULL DEFAULT now()
+);
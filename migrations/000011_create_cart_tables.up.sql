@@ -0,0 +1,23 @@
+CREATE TABLE carts
+(
+    id             SERIAL PRIMARY KEY,
+    token          VARCHAR(64) UNIQUE,
+    client_user_id INTEGER UNIQUE REFERENCES client_users (id) ON DELETE CASCADE,
+    created_at     TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at     TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_carts_owner CHECK (token IS NOT NULL OR client_user_id IS NOT NULL)
+);
+
+CREATE TABLE cart_items
+(
+    id                 SERIAL PRIMARY KEY,
+    cart_id            INTEGER   NOT NULL REFERENCES carts (id) ON DELETE CASCADE,
+    product_variant_id INTEGER   NOT NULL REFERENCES product_variants (id) ON DELETE CASCADE,
+    quantity           INTEGER   NOT NULL CHECK (quantity > 0),
+    price_at_add       BIGINT    NOT NULL,
+    created_at         TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at         TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (cart_id, product_variant_id)
+);
+
+CREATE INDEX idx_cart_items_product_variant_id ON cart_items (product_variant_id);
@@ -0,0 +1,5 @@
+DROP TABLE order_status_history;
+DROP TABLE order_items;
+DROP TABLE orders;
+DROP TYPE delivery_method;
+DROP TYPE order_status;
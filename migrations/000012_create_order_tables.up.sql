@@ -0,0 +1,51 @@
+CREATE TYPE order_status AS ENUM ('new', 'confirmed', 'paid', 'shipped', 'delivered', 'cancelled', 'returned');
+CREATE TYPE delivery_method AS ENUM ('courier', 'pickup', 'post');
+
+CREATE TABLE orders
+(
+    id               SERIAL PRIMARY KEY,
+    client_user_id   INTEGER REFERENCES client_users (id) ON DELETE SET NULL,
+    status           order_status    NOT NULL DEFAULT 'new',
+    customer_name    VARCHAR(255)    NOT NULL,
+    customer_phone   VARCHAR(32)     NOT NULL,
+    customer_email   VARCHAR(255),
+    delivery_method  delivery_method NOT NULL,
+    delivery_address TEXT,
+    comment          TEXT,
+    items_count      INTEGER         NOT NULL,
+    total            BIGINT          NOT NULL,
+    created_at       TIMESTAMP       NOT NULL DEFAULT NOW(),
+    updated_at       TIMESTAMP       NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_orders_client_user_id ON orders (client_user_id);
+CREATE INDEX idx_orders_status ON orders (status);
+
+CREATE TABLE order_items
+(
+    id                 SERIAL PRIMARY KEY,
+    order_id           INTEGER      NOT NULL REFERENCES orders (id) ON DELETE CASCADE,
+    product_variant_id INTEGER REFERENCES product_variants (id) ON DELETE SET NULL,
+    product_name       VARCHAR(255) NOT NULL,
+    article            VARCHAR(64)  NOT NULL,
+    price              BIGINT       NOT NULL,
+    quantity           INTEGER      NOT NULL CHECK (quantity > 0),
+    subtotal           BIGINT       NOT NULL,
+    created_at         TIMESTAMP    NOT NULL DEFAULT NOW(),
+    updated_at         TIMESTAMP    NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_order_items_order_id ON order_items (order_id);
+
+CREATE TABLE order_status_history
+(
+    id          SERIAL PRIMARY KEY,
+    order_id    INTEGER      NOT NULL REFERENCES orders (id) ON DELETE CASCADE,
+    from_status order_status,
+    to_status   order_status NOT NULL,
+    comment     TEXT,
+    changed_by  VARCHAR(255),
+    created_at  TIMESTAMP    NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_order_status_history_order_id ON order_status_history (order_id);
@@ -0,0 +1,5 @@
+DROP TABLE payment_events;
+DROP TABLE payments;
+ALTER TABLE orders
+    DROP COLUMN payment_status;
+DROP TYPE payment_status;
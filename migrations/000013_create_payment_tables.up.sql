@@ -0,0 +1,32 @@
+CREATE TYPE payment_status AS ENUM ('pending', 'succeeded', 'cancelled', 'refunded');
+
+ALTER TABLE orders
+    ADD COLUMN payment_status VARCHAR(16) NOT NULL DEFAULT 'unpaid';
+
+CREATE TABLE payments
+(
+    id               SERIAL PRIMARY KEY,
+    order_id         INTEGER        NOT NULL REFERENCES orders (id) ON DELETE RESTRICT,
+    provider         VARCHAR(32)    NOT NULL,
+    external_id      VARCHAR(128)   NOT NULL,
+    amount           BIGINT         NOT NULL CHECK (amount > 0),
+    status           payment_status NOT NULL DEFAULT 'pending',
+    confirmation_url TEXT,
+    created_at       TIMESTAMP      NOT NULL DEFAULT NOW(),
+    updated_at       TIMESTAMP      NOT NULL DEFAULT NOW(),
+    UNIQUE (provider, external_id)
+);
+
+CREATE INDEX idx_payments_order_id ON payments (order_id);
+
+-- Обработанные уведомления провайдера: повторная доставка не меняет статус дважды
+CREATE TABLE payment_events
+(
+    id         SERIAL PRIMARY KEY,
+    provider   VARCHAR(32)  NOT NULL,
+    event_id   VARCHAR(128) NOT NULL,
+    payment_id INTEGER      NOT NULL REFERENCES payments (id) ON DELETE CASCADE,
+    status     payment_status NOT NULL,
+    created_at TIMESTAMP    NOT NULL DEFAULT NOW(),
+    UNIQUE (provider, event_id)
+);
@@ -0,0 +1,17 @@
+ALTER TABLE order_items
+    DROP COLUMN discount;
+
+ALTER TABLE orders
+    DROP COLUMN subtotal,
+    DROP COLUMN discount,
+    DROP COLUMN promo_code;
+
+ALTER TABLE carts
+    DROP COLUMN promo_code;
+
+DROP TABLE promo_code_usages;
+DROP TABLE promo_code_product_types;
+DROP TABLE promo_code_lines;
+DROP TABLE promo_code_categories;
+DROP TABLE promo_codes;
+DROP TYPE promo_discount_type;
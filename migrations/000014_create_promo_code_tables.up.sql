@@ -0,0 +1,66 @@
+CREATE TYPE promo_discount_type AS ENUM ('percent', 'fixed');
+
+CREATE TABLE promo_codes
+(
+    id                     SERIAL PRIMARY KEY,
+    code                   VARCHAR(64)         NOT NULL UNIQUE,
+    description            TEXT,
+    discount_type          promo_discount_type NOT NULL,
+    discount_value         BIGINT              NOT NULL CHECK (discount_value > 0),
+    min_order_amount       BIGINT              NOT NULL DEFAULT 0,
+    starts_at              TIMESTAMP,
+    ends_at                TIMESTAMP,
+    usage_limit            INTEGER,
+    usage_limit_per_client INTEGER,
+    used_count             INTEGER             NOT NULL DEFAULT 0,
+    is_active              BOOLEAN             NOT NULL DEFAULT TRUE,
+    created_at             TIMESTAMP           NOT NULL DEFAULT NOW(),
+    updated_at             TIMESTAMP           NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_promo_codes_percent CHECK (discount_type <> 'percent' OR discount_value <= 100)
+);
+
+CREATE TABLE promo_code_categories
+(
+    promo_code_id INTEGER NOT NULL REFERENCES promo_codes (id) ON DELETE CASCADE,
+    category_id   INTEGER NOT NULL REFERENCES categories (id) ON DELETE CASCADE,
+    PRIMARY KEY (promo_code_id, category_id)
+);
+
+CREATE TABLE promo_code_lines
+(
+    promo_code_id INTEGER NOT NULL REFERENCES promo_codes (id) ON DELETE CASCADE,
+    line_id       INTEGER NOT NULL REFERENCES lines (id) ON DELETE CASCADE,
+    PRIMARY KEY (promo_code_id, line_id)
+);
+
+CREATE TABLE promo_code_product_types
+(
+    promo_code_id   INTEGER NOT NULL REFERENCES promo_codes (id) ON DELETE CASCADE,
+    product_type_id INTEGER NOT NULL REFERENCES product_types (id) ON DELETE CASCADE,
+    PRIMARY KEY (promo_code_id, product_type_id)
+);
+
+CREATE TABLE promo_code_usages
+(
+    id            SERIAL PRIMARY KEY,
+    promo_code_id INTEGER     NOT NULL REFERENCES promo_codes (id) ON DELETE CASCADE,
+    order_id      INTEGER     NOT NULL UNIQUE REFERENCES orders (id) ON DELETE CASCADE,
+    client_phone  VARCHAR(32) NOT NULL,
+    created_at    TIMESTAMP   NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_promo_code_usages_code_phone ON promo_code_usages (promo_code_id, client_phone);
+
+ALTER TABLE carts
+    ADD COLUMN promo_code VARCHAR(64);
+
+ALTER TABLE orders
+    ADD COLUMN subtotal   BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN discount   BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN promo_code VARCHAR(64);
+
+UPDATE orders
+SET subtotal = total;
+
+ALTER TABLE order_items
+    ADD COLUMN discount BIGINT NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+DROP TABLE product_slug_redirects;
+DROP TABLE category_slug_redirects;
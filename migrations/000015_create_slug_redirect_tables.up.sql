@@ -0,0 +1,19 @@
+CREATE TABLE category_slug_redirects
+(
+    id          SERIAL PRIMARY KEY,
+    old_slug    VARCHAR(255) NOT NULL UNIQUE,
+    category_id INTEGER      NOT NULL REFERENCES categories (id) ON DELETE CASCADE,
+    created_at  TIMESTAMP    NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_category_slug_redirects_category_id ON category_slug_redirects (category_id);
+
+CREATE TABLE product_slug_redirects
+(
+    id         SERIAL PRIMARY KEY,
+    old_slug   VARCHAR(255) NOT NULL UNIQUE,
+    product_id INTEGER      NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    created_at TIMESTAMP    NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_product_slug_redirects_product_id ON product_slug_redirects (product_id);
@@ -0,0 +1,32 @@
+DELETE FROM categories WHERE deleted_at IS NOT NULL;
+DELETE FROM lines WHERE deleted_at IS NOT NULL;
+DELETE FROM product_types WHERE deleted_at IS NOT NULL;
+DELETE FROM shades WHERE deleted_at IS NOT NULL;
+DELETE FROM desired_results WHERE deleted_at IS NOT NULL;
+
+DROP INDEX idx_categories_name;
+DROP INDEX idx_categories_slug;
+DROP INDEX idx_lines_name;
+DROP INDEX idx_product_types_name;
+DROP INDEX idx_desired_results_name;
+
+ALTER TABLE categories
+    ADD CONSTRAINT categories_name_key UNIQUE (name),
+    ADD CONSTRAINT categories_slug_key UNIQUE (slug);
+ALTER TABLE lines
+    ADD CONSTRAINT lines_name_key UNIQUE (name);
+ALTER TABLE product_types
+    ADD CONSTRAINT product_types_name_key UNIQUE (name);
+ALTER TABLE desired_results
+    ADD CONSTRAINT desired_results_name_key UNIQUE (name);
+
+ALTER TABLE categories
+    DROP COLUMN deleted_at;
+ALTER TABLE lines
+    DROP COLUMN deleted_at;
+ALTER TABLE product_types
+    DROP COLUMN deleted_at;
+ALTER TABLE shades
+    DROP COLUMN deleted_at;
+ALTER TABLE desired_results
+    DROP COLUMN deleted_at;
@@ -0,0 +1,33 @@
+ALTER TABLE categories
+    ADD COLUMN deleted_at TIMESTAMP;
+ALTER TABLE lines
+    ADD COLUMN deleted_at TIMESTAMP;
+ALTER TABLE product_types
+    ADD COLUMN deleted_at TIMESTAMP;
+ALTER TABLE shades
+    ADD COLUMN deleted_at TIMESTAMP;
+ALTER TABLE desired_results
+    ADD COLUMN deleted_at TIMESTAMP;
+
+CREATE INDEX idx_categories_deleted_at ON categories (deleted_at);
+CREATE INDEX idx_lines_deleted_at ON lines (deleted_at);
+CREATE INDEX idx_product_types_deleted_at ON product_types (deleted_at);
+CREATE INDEX idx_shades_deleted_at ON shades (deleted_at);
+CREATE INDEX idx_desired_results_deleted_at ON desired_results (deleted_at);
+
+-- Имена и slug удалённых записей не должны мешать созданию новых с теми же значениями
+ALTER TABLE categories
+    DROP CONSTRAINT categories_name_key,
+    DROP CONSTRAINT categories_slug_key;
+ALTER TABLE lines
+    DROP CONSTRAINT lines_name_key;
+ALTER TABLE product_types
+    DROP CONSTRAINT product_types_name_key;
+ALTER TABLE desired_results
+    DROP CONSTRAINT desired_results_name_key;
+
+CREATE UNIQUE INDEX idx_categories_name ON categories (name) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX idx_categories_slug ON categories (slug) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX idx_lines_name ON lines (name) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX idx_product_types_name ON product_types (name) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX idx_desired_results_name ON desired_results (name) WHERE deleted_at IS NULL;
@@ -0,0 +1,14 @@
+CREATE TABLE job_runs
+(
+    id          SERIAL PRIMARY KEY,
+    job_name    VARCHAR(128) NOT NULL,
+    trigger     VARCHAR(16)  NOT NULL,
+    instance    VARCHAR(255) NOT NULL,
+    started_at  TIMESTAMP    NOT NULL,
+    finished_at TIMESTAMP,
+    duration_ms BIGINT,
+    error       TEXT,
+    panicked    BOOLEAN      NOT NULL DEFAULT FALSE
+);
+
+CREATE INDEX idx_job_runs_job_name_started_at ON job_runs (job_name, started_at DESC);
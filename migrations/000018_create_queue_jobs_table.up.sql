@@ -0,0 +1,18 @@
+CREATE TABLE queue_jobs
+(
+    id           SERIAL PRIMARY KEY,
+    type         VARCHAR(64)  NOT NULL,
+    payload      TEXT         NOT NULL,
+    status       VARCHAR(16)  NOT NULL DEFAULT 'pending',
+    attempts     INTEGER      NOT NULL DEFAULT 0,
+    max_attempts INTEGER      NOT NULL,
+    run_at       TIMESTAMP    NOT NULL DEFAULT NOW(),
+    locked_by    VARCHAR(255),
+    locked_at    TIMESTAMP,
+    last_error   TEXT,
+    created_at   TIMESTAMP    NOT NULL DEFAULT NOW(),
+    updated_at   TIMESTAMP    NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_queue_jobs_status CHECK (status IN ('pending', 'running', 'dead'))
+);
+
+CREATE INDEX idx_queue_jobs_status_run_at ON queue_jobs (status, run_at);
@@ -0,0 +1 @@
+DROP TABLE uploaded_files;
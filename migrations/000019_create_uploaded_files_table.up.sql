@@ -0,0 +1,17 @@
+CREATE TABLE uploaded_files
+(
+    id          SERIAL PRIMARY KEY,
+    filename    VARCHAR(255) NOT NULL UNIQUE,
+    image_type  VARCHAR(32)  NOT NULL,
+    status      VARCHAR(16)  NOT NULL DEFAULT 'temp',
+    owner_type  VARCHAR(32),
+    owner_id    INTEGER,
+    owner_field VARCHAR(32),
+    promoted_at TIMESTAMP,
+    created_at  TIMESTAMP    NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMP    NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_uploaded_files_status CHECK (status IN ('temp', 'permanent'))
+);
+
+CREATE INDEX idx_uploaded_files_status_created_at ON uploaded_files (status, created_at);
+CREATE INDEX idx_uploaded_files_owner ON uploaded_files (owner_type, owner_id);
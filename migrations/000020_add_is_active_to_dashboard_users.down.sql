@@ -0,0 +1,2 @@
+ALTER TABLE dashboard_users
+    DROP COLUMN is_active;
@@ -0,0 +1,2 @@
+ALTER TABLE dashboard_users
+    ADD COLUMN is_active BOOLEAN NOT NULL DEFAULT TRUE;
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS dashboard_user_recovery_codes;
+
+ALTER TABLE dashboard_users
+    DROP COLUMN totp_enabled,
+    DROP COLUMN totp_secret;
@@ -0,0 +1,13 @@
+ALTER TABLE dashboard_users
+    ADD COLUMN totp_secret  VARCHAR(64),
+    ADD COLUMN totp_enabled BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE dashboard_user_recovery_codes
+(
+    id                SERIAL PRIMARY KEY,
+    dashboard_user_id INTEGER     NOT NULL REFERENCES dashboard_users (id) ON DELETE CASCADE,
+    code_hash         VARCHAR(64) NOT NULL,
+    used_at           TIMESTAMP,
+    created_at        TIMESTAMP   NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_dashboard_user_recovery_codes UNIQUE (dashboard_user_id, code_hash)
+);
@@ -0,0 +1,13 @@
+UPDATE payments SET status = 'succeeded' WHERE status = 'refunding';
+
+ALTER TYPE payment_status RENAME TO payment_status_old;
+CREATE TYPE payment_status AS ENUM ('pending', 'succeeded', 'cancelled', 'refunded');
+
+ALTER TABLE payments
+    ALTER COLUMN status DROP DEFAULT,
+    ALTER COLUMN status TYPE payment_status USING status::text::payment_status,
+    ALTER COLUMN status SET DEFAULT 'pending';
+ALTER TABLE payment_events
+    ALTER COLUMN status TYPE payment_status USING status::text::payment_status;
+
+DROP TYPE payment_status_old;
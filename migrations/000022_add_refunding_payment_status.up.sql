@@ -0,0 +1,2 @@
+-- Возврат сначала занимает платёж, и только потом уходит к провайдеру
+ALTER TYPE payment_status ADD VALUE IF NOT EXISTS 'refunding';
@@ -0,0 +1,71 @@
+package database
+
+import (
+	"fmt"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"haircompany-shop-rest/pkg/request"
+	"strings"
+)
+
+// FindPage loads one page of records matching the list query filters and
+// returns it together with the total number of matching records.
+func FindPage[T any](db *gorm.DB, query request.ListQuery) ([]*T, int64, error) {
+	records := make([]*T, 0)
+	var total int64
+
+	filtered := ApplyFilters(db.Model(new(T)), query.Filters)
+	if err := filtered.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if total == 0 {
+		return records, 0, nil
+	}
+
+	result := ApplySort(ApplyFilters(db, query.Filters), query.Sort).
+		Offset(query.Offset()).
+		Limit(query.PerPage).
+		Find(&records)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+
+	return records, total, nil
+}
+
+// ApplyFilters adds WHERE conditions for the list query filters. Columns come
+// from the endpoint's ListOptions, never from user input.
+func ApplyFilters(db *gorm.DB, filters []request.FilterCondition) *gorm.DB {
+	for _, filter := range filters {
+		switch {
+		case filter.Type == request.FilterContains:
+			pattern := "%" + escapeLike(strings.ToLower(filter.Value.(string))) + "%"
+			db = db.Where(fmt.Sprintf(`LOWER(%s) LIKE ? ESCAPE '\'`, filter.Column), pattern)
+		case filter.Value == nil:
+			db = db.Where(fmt.Sprintf("%s IS NULL", filter.Column))
+		default:
+			db = db.Where(fmt.Sprintf("%s = ?", filter.Column), filter.Value)
+		}
+	}
+
+	return db
+}
+
+// ApplySort adds ORDER BY for the list query. The primary key is always the
+// last sort key so that pages are stable.
+func ApplySort(db *gorm.DB, sort []request.Sort) *gorm.DB {
+	hasId := false
+	for _, s := range sort {
+		db = db.Order(clause.OrderByColumn{Column: clause.Column{Name: s.Column}, Desc: s.Desc})
+		hasId = hasId || s.Column == "id"
+	}
+	if !hasId {
+		db = db.Order("id")
+	}
+
+	return db
+}
+
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
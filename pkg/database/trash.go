@@ -0,0 +1,58 @@
+package database
+
+import (
+	"errors"
+	"gorm.io/gorm"
+	"haircompany-shop-rest/pkg/request"
+	"log"
+	"time"
+)
+
+// FindDeletedPage is FindPage over the soft-deleted records of T.
+func FindDeletedPage[T any](db *gorm.DB, query request.ListQuery) ([]*T, int64, error) {
+	deleted := db.Unscoped().Where("deleted_at IS NOT NULL").Session(&gorm.Session{})
+
+	return FindPage[T](deleted, query)
+}
+
+// FindDeleted returns the soft-deleted record of T with the given id, or nil if there is none.
+func FindDeleted[T any](db *gorm.DB, id uint) (*T, error) {
+	var record *T
+
+	result := db.Unscoped().Where("deleted_at IS NOT NULL").First(&record, id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, result.Error
+	}
+
+	return record, nil
+}
+
+// Restore clears deleted_at of a soft-deleted record of T.
+func Restore[T any](db *gorm.DB, id uint) error {
+	return db.Unscoped().Model(new(T)).Where("id = ? AND deleted_at IS NOT NULL", id).Update("deleted_at", nil).Error
+}
+
+// PurgeDeleted permanently removes records of T that were soft-deleted before the given time
+// and returns the removed ones. A record the database refuses to remove, e.g. because it is
+// still referenced, is logged and stays in the trash until the next run.
+func PurgeDeleted[T any](db *gorm.DB, before time.Time) ([]*T, error) {
+	var records []*T
+	result := db.Unscoped().Where("deleted_at IS NOT NULL AND deleted_at < ?", before).Find(&records)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	purged := make([]*T, 0, len(records))
+	for _, record := range records {
+		if err := db.Unscoped().Delete(record).Error; err != nil {
+			log.Printf("[Trash] failed to purge %T: %v", record, err)
+			continue
+		}
+		purged = append(purged, record)
+	}
+
+	return purged, nil
+}
@@ -0,0 +1,168 @@
+package request
+
+import (
+	"haircompany-shop-rest/pkg/response"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	DefaultPerPage = 20
+	MaxPerPage     = 100
+)
+
+type FilterType int
+
+const (
+	// FilterContains matches a case-insensitive substring.
+	FilterContains FilterType = iota
+	// FilterEquals matches the exact string.
+	FilterEquals
+	// FilterInt matches an integer column; the value "null" matches NULL.
+	FilterInt
+	// FilterBool matches a boolean column.
+	FilterBool
+)
+
+type Filter struct {
+	Column string
+	Type   FilterType
+}
+
+// ListOptions declares which query parameters a list endpoint accepts for
+// sorting and filtering and which columns they map to.
+type ListOptions struct {
+	SortFields   map[string]string
+	FilterFields map[string]Filter
+	DefaultSort  []Sort
+}
+
+type Sort struct {
+	Column string
+	Desc   bool
+}
+
+type FilterCondition struct {
+	Column string
+	Type   FilterType
+	Value  any // nil for FilterInt matching NULL
+}
+
+// ListQuery is a validated list request with query parameter names already
+// resolved to columns.
+type ListQuery struct {
+	Page    int
+	PerPage int
+	Sort    []Sort
+	Filters []FilterCondition
+}
+
+func (q ListQuery) Offset() int {
+	return (q.Page - 1) * q.PerPage
+}
+
+// ParseListQuery reads page, perPage, sort and field filters from the URL query.
+// Sort is a comma-separated list of fields, a leading minus means descending:
+// sort=-sortIndex,name. Parameters not declared in options are ignored.
+func ParseListQuery(values url.Values, options ListOptions) (ListQuery, []response.ErrorField) {
+	var errFields []response.ErrorField
+	query := ListQuery{
+		Page:    1,
+		PerPage: DefaultPerPage,
+		Sort:    options.DefaultSort,
+	}
+
+	if value := values.Get("page"); value != "" {
+		page, err := strconv.Atoi(value)
+		if err != nil || page < 1 {
+			errFields = append(errFields, response.NewErrorField("page", string(response.BadRequest)))
+		} else {
+			query.Page = page
+		}
+	}
+
+	if value := values.Get("perPage"); value != "" {
+		perPage, err := strconv.Atoi(value)
+		if err != nil || perPage < 1 || perPage > MaxPerPage {
+			errFields = append(errFields, response.NewErrorField("perPage", string(response.BadRequest)))
+		} else {
+			query.PerPage = perPage
+		}
+	}
+
+	if value := values.Get("sort"); value != "" {
+		sorting, ok := parseSort(value, options.SortFields)
+		if !ok {
+			errFields = append(errFields, response.NewErrorField("sort", string(response.BadRequest)))
+		} else {
+			query.Sort = sorting
+		}
+	}
+
+	names := make([]string, 0, len(options.FilterFields))
+	for name := range options.FilterFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if !values.Has(name) {
+			continue
+		}
+
+		condition, ok := parseFilter(values.Get(name), options.FilterFields[name])
+		if !ok {
+			errFields = append(errFields, response.NewErrorField(name, string(response.BadRequest)))
+			continue
+		}
+		query.Filters = append(query.Filters, condition)
+	}
+
+	return query, errFields
+}
+
+func parseSort(value string, sortFields map[string]string) ([]Sort, bool) {
+	var sorting []Sort
+	for _, field := range strings.Split(value, ",") {
+		field = strings.TrimSpace(field)
+		desc := strings.HasPrefix(field, "-")
+		column, ok := sortFields[strings.TrimPrefix(field, "-")]
+		if !ok {
+			return nil, false
+		}
+		sorting = append(sorting, Sort{Column: column, Desc: desc})
+	}
+
+	return sorting, true
+}
+
+func parseFilter(value string, filter Filter) (FilterCondition, bool) {
+	condition := FilterCondition{Column: filter.Column, Type: filter.Type}
+
+	switch filter.Type {
+	case FilterInt:
+		if value == "null" {
+			return condition, true
+		}
+		number, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return condition, false
+		}
+		condition.Value = number
+	case FilterBool:
+		boolean, err := strconv.ParseBool(value)
+		if err != nil {
+			return condition, false
+		}
+		condition.Value = boolean
+	default:
+		if value == "" {
+			return condition, false
+		}
+		condition.Value = value
+	}
+
+	return condition, true
+}
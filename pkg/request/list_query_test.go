@@ -0,0 +1,86 @@
+package request
+
+import (
+	"net/url"
+	"testing"
+)
+
+var testOptions = ListOptions{
+	SortFields: map[string]string{"name": "name", "sortIndex": "sort_index"},
+	FilterFields: map[string]Filter{
+		"name":     {Column: "name", Type: FilterContains},
+		"parentId": {Column: "parent_id", Type: FilterInt},
+		"isActive": {Column: "is_active", Type: FilterBool},
+	},
+	DefaultSort: []Sort{{Column: "sort_index"}},
+}
+
+func TestParseListQuery_Defaults(t *testing.T) {
+	query, errFields := ParseListQuery(url.Values{}, testOptions)
+	if errFields != nil {
+		t.Fatalf("Expected no errors, got %v", errFields)
+	}
+
+	if query.Page != 1 || query.PerPage != DefaultPerPage || query.Offset() != 0 {
+		t.Errorf("Expected first page of %d, got page %d perPage %d", DefaultPerPage, query.Page, query.PerPage)
+	}
+	if len(query.Sort) != 1 || query.Sort[0].Column != "sort_index" {
+		t.Errorf("Expected default sort, got %+v", query.Sort)
+	}
+	if len(query.Filters) != 0 {
+		t.Errorf("Expected no filters, got %+v", query.Filters)
+	}
+}
+
+func TestParseListQuery_Values(t *testing.T) {
+	values := url.Values{
+		"page":     {"3"},
+		"perPage":  {"10"},
+		"sort":     {"-sortIndex,name"},
+		"parentId": {"null"},
+		"isActive": {"false"},
+		"unknown":  {"ignored"},
+	}
+
+	query, errFields := ParseListQuery(values, testOptions)
+	if errFields != nil {
+		t.Fatalf("Expected no errors, got %v", errFields)
+	}
+
+	if query.Offset() != 20 {
+		t.Errorf("Expected offset 20, got %d", query.Offset())
+	}
+	if len(query.Sort) != 2 || !query.Sort[0].Desc || query.Sort[0].Column != "sort_index" || query.Sort[1].Desc {
+		t.Errorf("Unexpected sort %+v", query.Sort)
+	}
+	if len(query.Filters) != 2 {
+		t.Fatalf("Expected 2 filters, got %+v", query.Filters)
+	}
+	if query.Filters[0].Column != "is_active" || query.Filters[0].Value != false {
+		t.Errorf("Unexpected bool filter %+v", query.Filters[0])
+	}
+	if query.Filters[1].Column != "parent_id" || query.Filters[1].Value != nil {
+		t.Errorf("Expected parentId=null to match NULL, got %+v", query.Filters[1])
+	}
+}
+
+func TestParseListQuery_Invalid(t *testing.T) {
+	values := url.Values{
+		"page":     {"0"},
+		"perPage":  {"1000"},
+		"sort":     {"password"},
+		"isActive": {"maybe"},
+	}
+
+	_, errFields := ParseListQuery(values, testOptions)
+
+	fields := map[string]bool{}
+	for _, field := range errFields {
+		fields[field.Field] = true
+	}
+	for _, name := range []string{"page", "perPage", "sort", "isActive"} {
+		if !fields[name] {
+			t.Errorf("Expected error for %s, got %+v", name, errFields)
+		}
+	}
+}
@@ -17,17 +17,62 @@ const (
 	HasLinkedEntities ErrorCode = "HAS_LINKED_ENTITIES"
 	Forbidden         ErrorCode = "FORBIDDEN"
 	Unauthorized      ErrorCode = "UNAUTHORIZED"
+	InvalidBarcode    ErrorCode = "INVALID_BARCODE"
+	InsufficientStock ErrorCode = "INSUFFICIENT_STOCK"
+	InvalidPhone      ErrorCode = "INVALID_PHONE"
+	InvalidCode       ErrorCode = "INVALID_CODE"
+	TooManyRequests   ErrorCode = "TOO_MANY_REQUESTS"
+	InvalidEmail      ErrorCode = "INVALID_EMAIL"
+	InvalidTransition ErrorCode = "INVALID_TRANSITION"
+	EmptyCart         ErrorCode = "EMPTY_CART"
+	PaymentNotAllowed ErrorCode = "PAYMENT_NOT_ALLOWED"
+	InvalidSignature  ErrorCode = "INVALID_SIGNATURE"
+
+	PromoCodeNotFound      ErrorCode = "PROMO_CODE_NOT_FOUND"
+	PromoCodeInactive      ErrorCode = "PROMO_CODE_INACTIVE"
+	PromoCodeNotStarted    ErrorCode = "PROMO_CODE_NOT_STARTED"
+	PromoCodeExpired       ErrorCode = "PROMO_CODE_EXPIRED"
+	PromoCodeUsageLimit    ErrorCode = "PROMO_CODE_USAGE_LIMIT_REACHED"
+	PromoCodeClientLimit   ErrorCode = "PROMO_CODE_CLIENT_LIMIT_REACHED"
+	PromoCodeMinAmount     ErrorCode = "PROMO_CODE_MIN_AMOUNT_NOT_REACHED"
+	PromoCodeNotApplicable ErrorCode = "PROMO_CODE_NOT_APPLICABLE"
+	CategoryNotEligible    ErrorCode = "CATEGORY_NOT_ELIGIBLE"
+	LineNotEligible        ErrorCode = "LINE_NOT_ELIGIBLE"
+	ProductTypeNotEligible ErrorCode = "PRODUCT_TYPE_NOT_ELIGIBLE"
+
+	ContentTypeMismatch ErrorCode = "CONTENT_TYPE_MISMATCH"
+	InvalidImage        ErrorCode = "INVALID_IMAGE"
+	ImageTooSmall       ErrorCode = "IMAGE_TOO_SMALL"
+	ImageTooLarge       ErrorCode = "IMAGE_TOO_LARGE"
+	InvalidAspectRatio  ErrorCode = "INVALID_ASPECT_RATIO"
+
+	CategoryCycle ErrorCode = "CATEGORY_CYCLE"
+	JobRunning    ErrorCode = "JOB_ALREADY_RUNNING"
+	LastAdmin     ErrorCode = "LAST_ADMIN"
+
+	InvalidPassword   ErrorCode = "INVALID_PASSWORD"
+	InvalidToken      ErrorCode = "INVALID_TOKEN"
+	TwoFactorEnabled  ErrorCode = "TWO_FACTOR_ALREADY_ENABLED"
+	TwoFactorDisabled ErrorCode = "TWO_FACTOR_NOT_ENABLED"
 )
 
 func GetErrorCodeByTag(tag string) ErrorCode {
 	switch tag {
-	case "required":
+	case "required", "required_unless":
 		return NotBlank
 	case "min":
 		return MinLength
 	case "max":
 		return MaxLength
-	case "hex_color":
+	case "hex_color", "hexadecimal", "len", "numeric", "gt", "gte", "lt", "lte":
+		return BadRequest
+	case "ean":
+		return InvalidBarcode
+	case "e164":
+		return InvalidPhone
+	case "email":
+		return InvalidEmail
+	case "oneof":
 		return BadRequest
 	default:
 		return ServerError
@@ -15,6 +15,18 @@ type successResponse struct {
 	Data      any  `json:"data,omitempty"`
 }
 
+type Pagination struct {
+	Total   int64 `json:"total" example:"42"`
+	Page    int   `json:"page" example:"1"`
+	PerPage int   `json:"perPage" example:"20"`
+}
+
+type paginatedResponse struct {
+	IsSuccess  bool       `json:"isSuccess"`
+	Data       any        `json:"data"`
+	Pagination Pagination `json:"pagination"`
+}
+
 type errorResponse struct {
 	IsSuccess bool         `json:"isSuccess"`
 	Message   string       `json:"message"`
@@ -43,6 +55,21 @@ func SendSuccess(w http.ResponseWriter, statusCode int, data any) {
 	}
 }
 
+func SendPaginated(w http.ResponseWriter, statusCode int, data any, pagination Pagination) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	response := &paginatedResponse{
+		IsSuccess:  true,
+		Data:       data,
+		Pagination: pagination,
+	}
+
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		http.Error(w, "Failed to encode paginated response", http.StatusInternalServerError)
+	}
+}
+
 func SendError(w http.ResponseWriter, statusCode int, message string, errorCode ErrorCode) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(statusCode)
@@ -0,0 +1,26 @@
+package utils
+
+// IDs collects the IDs of related entities, e.g. the shades of a product.
+func IDs[T any](items []T, id func(T) uint) []uint {
+	ids := make([]uint, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, id(item))
+	}
+
+	return ids
+}
+
+// UniqueIDs drops repeated IDs and keeps the order of first occurrence.
+func UniqueIDs(ids []uint) []uint {
+	seen := make(map[uint]struct{}, len(ids))
+	unique := make([]uint, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+
+	return unique
+}
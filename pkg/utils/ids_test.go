@@ -0,0 +1,22 @@
+package utils
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestIDs(t *testing.T) {
+	type entity struct{ ID uint }
+	items := []*entity{{ID: 3}, {ID: 1}, {ID: 3}}
+
+	ids := IDs(items, func(e *entity) uint { return e.ID })
+	if !slices.Equal(ids, []uint{3, 1, 3}) {
+		t.Errorf("Expected [3 1 3], got %v", ids)
+	}
+	if unique := UniqueIDs(ids); !slices.Equal(unique, []uint{3, 1}) {
+		t.Errorf("Expected [3 1], got %v", unique)
+	}
+	if ids := IDs([]*entity(nil), func(e *entity) uint { return e.ID }); ids == nil || len(ids) != 0 {
+		t.Errorf("Expected an empty slice, got %v", ids)
+	}
+}